@@ -0,0 +1,27 @@
+package audit
+
+import "context"
+
+// contextKey is a custom type to avoid collisions in context keys.
+type contextKey string
+
+// sourceKey is the context key under which the source of the request is stored.
+const sourceKey contextKey = "auditSource"
+
+// Source describes where an audited request came from.
+// The identifier of the request is taken from the context separately, see requestid.FromContext.
+type Source struct {
+	IP string // IP address of the client.
+}
+
+// ContextWithSource returns a copy of ctx that carries the source of the request.
+func ContextWithSource(ctx context.Context, source Source) context.Context {
+	return context.WithValue(ctx, sourceKey, source)
+}
+
+// SourceFromContext retrieves the source of the request from the context.
+// It returns an empty Source when the context carries none.
+func SourceFromContext(ctx context.Context) Source {
+	source, _ := ctx.Value(sourceKey).(Source)
+	return source
+}
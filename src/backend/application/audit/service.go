@@ -0,0 +1,107 @@
+package audit
+
+import (
+	"application/auth"
+	"application/event"
+	"application/requestid"
+	"context"
+	"domain/audit/entity"
+	"domain/audit/events"
+	"domain/audit/repository"
+	"log"
+)
+
+// Actions recorded in the audit log for authentication.
+const (
+	ActionTokenIssue  = "token.issue"
+	ActionAuthFailure = "auth.failure"
+	ActionAuthDenied  = "auth.denied"
+)
+
+// Service provides application services for recording security relevant actions in the audit log.
+type Service struct {
+	repository repository.AuditRepository
+	dispatcher event.Dispatcher
+}
+
+// Option defines a functional option for configuring the Service.
+type Option func(*Service)
+
+// WithDispatcher publishes every saved record as an AuditRecordedEvent through the dispatcher.
+func WithDispatcher(d event.Dispatcher) Option {
+	return func(s *Service) {
+		s.dispatcher = d
+	}
+}
+
+// NewService initializes a new Service.
+func NewService(r repository.AuditRepository, opts ...Option) *Service {
+	s := &Service{repository: r}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Record appends a successful audit entry for the given action and target.
+// The actor is resolved from the authenticated principal stored in the context.
+func (s *Service) Record(ctx context.Context, action, target string, details map[string]any) error {
+	return s.RecordOutcome(ctx, action, target, entity.OutcomeSuccess, details)
+}
+
+// RecordOutcome appends an audit entry for the given action, target and outcome.
+// The actor is resolved from the authenticated principal and the source from the request stored in the context.
+// Publishing the record is best effort: the audit log in the database remains the source of truth.
+func (s *Service) RecordOutcome(ctx context.Context, action, target, outcome string, details map[string]any) error {
+	source := SourceFromContext(ctx)
+	rec := entity.GetRecord().
+		SetActor(auth.PrincipalFromContext(ctx)).
+		SetAction(action).
+		SetTarget(target).
+		SetOutcome(outcome).
+		SetSourceIp(source.IP).
+		SetRequestId(requestid.FromContext(ctx)).
+		SetDetails(details)
+	defer rec.Release()
+
+	if err := s.repository.Save(ctx, rec); err != nil {
+		return err
+	}
+	if s.dispatcher != nil {
+		if err := s.dispatcher.Dispatch(ctx, events.NewAuditRecordedEvent(rec)); err != nil {
+			log.Printf("failed to publish audit record %d: %v", rec.GetId(), err)
+		}
+	}
+	return nil
+}
+
+// Report appends an audit entry like RecordOutcome, logging failures instead of returning them.
+// It is used where auditing must not change the response, e.g., when rejecting a request, or once the audited
+// change was committed, so a failure to audit it neither hides the change from the caller nor invites a retry
+// repeating it.
+func (s *Service) Report(ctx context.Context, action, target, outcome string, details map[string]any) {
+	if err := s.RecordOutcome(ctx, action, target, outcome, details); err != nil {
+		log.Printf("failed to record %s audit entry: %v", action, err)
+	}
+}
+
+// ListRecords retrieves a page of the audit records matching the filter, newest first.
+// It also returns the cursor of the next page, i.e. the ID of the last record returned, or zero if there are
+// no more records.
+func (s *Service) ListRecords(ctx context.Context, filter repository.Filter) ([]*entity.Record, int64, error) {
+	limit := filter.Limit
+	filter.Limit++ // Fetch one more record to find out whether another page follows
+	list, err := s.repository.GetList(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(list) <= limit {
+		return list, 0, nil
+	}
+
+	for _, item := range list[limit:] {
+		item.Release()
+	}
+	list = list[:limit]
+	return list, list[limit-1].GetId(), nil
+}
@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"context"
+	"domain/auth/entity"
+)
+
+// contextKey is a custom type to avoid collisions in context keys.
+type contextKey string
+
+// Context keys under which the authenticated principal and its tracker are stored.
+const (
+	claimsKey  contextKey = "userClaims"
+	trackerKey contextKey = "principalTracker"
+)
+
+// principalTracker records the principal authenticated further down the handler chain.
+type principalTracker struct {
+	principal string
+}
+
+// ContextWithClaims returns a copy of ctx that carries the claims of the authenticated principal.
+// The principal is also reported to the tracker of the context, if any.
+func ContextWithClaims(ctx context.Context, claims *entity.TokenClaims) context.Context {
+	ctx = context.WithValue(ctx, claimsKey, claims)
+	if t, ok := ctx.Value(trackerKey).(*principalTracker); ok {
+		t.principal = PrincipalFromContext(ctx)
+	}
+	return ctx
+}
+
+// TrackPrincipal returns a copy of ctx that records the principal authenticated in any context derived from it,
+// and a function returning that principal, or "anonymous" if none was authenticated.
+// It allows outer middlewares, e.g., access logs, to report the principal of the request once it was handled.
+func TrackPrincipal(ctx context.Context) (context.Context, func() string) {
+	t := &principalTracker{principal: "anonymous"}
+	return context.WithValue(ctx, trackerKey, t), func() string { return t.principal }
+}
+
+// ClaimsFromContext retrieves the claims of the authenticated principal from the context.
+func ClaimsFromContext(ctx context.Context) (*entity.TokenClaims, bool) {
+	claims, ok := ctx.Value(claimsKey).(*entity.TokenClaims)
+	return claims, ok
+}
+
+// PrincipalFromContext returns the name of the authenticated principal, preferring the token subject over
+// its issuer. It returns "anonymous" when the context carries no claims.
+func PrincipalFromContext(ctx context.Context) string {
+	claims, ok := ClaimsFromContext(ctx)
+	if !ok || claims == nil {
+		return "anonymous"
+	}
+	if claims.GetSubject() != "" {
+		return claims.GetSubject()
+	}
+	return claims.GetIssuer()
+}
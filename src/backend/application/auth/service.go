@@ -9,6 +9,13 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
+const (
+	// FeedAudience is the audience of the tokens carried in the URLs of the feeds, only accepted by the feeds.
+	FeedAudience = "feeds"
+	// FeedTokenParam is the query parameter of the feed URLs carrying their token.
+	FeedTokenParam = "token"
+)
+
 // Service provides application services for managing JWT tokens.
 type Service struct {
 	config    *config.Configuration
@@ -22,11 +29,29 @@ func NewService(c *config.Configuration) *Service {
 
 // Generate creates a JWT token for the provided entity.TokenClaims.
 func (s *Service) Generate(claims *entity.TokenClaims) (string, error) {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+	return s.sign(claims, "")
+}
+
+// GenerateFeed creates a JWT token for the provided entity.TokenClaims restricted to FeedAudience, so it is only
+// accepted by VerifyFeed.
+func (s *Service) GenerateFeed(claims *entity.TokenClaims) (string, error) {
+	return s.sign(claims, FeedAudience)
+}
+
+// sign creates a JWT token for the claims, restricted to the audience if any.
+func (s *Service) sign(claims *entity.TokenClaims, audience string) (string, error) {
+	mapClaims := jwt.MapClaims{
 		"iss":   claims.GetIssuer(),
 		"scope": claims.GetScope(),
 		"exp":   claims.GetExpiresAt(),
-	})
+	}
+	if claims.GetSubject() != "" {
+		mapClaims["sub"] = claims.GetSubject()
+	}
+	if audience != "" {
+		mapClaims["aud"] = audience
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, mapClaims)
 
 	// Sign the token using the secret key
 	signedToken, err := token.SignedString(s.secretKey)
@@ -37,9 +62,30 @@ func (s *Service) Generate(claims *entity.TokenClaims) (string, error) {
 	return signedToken, nil
 }
 
-// Verify checks the provided token string, ensuring it is valid.
+// Verify checks the provided token string, ensuring it is valid and not restricted to an audience.
 func (s *Service) Verify(t string) (*entity.TokenClaims, error) {
-	token, err := jwt.Parse(t, s.keyFunc)
+	claims, err := s.parse(t)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := claims["aud"]; ok {
+		return nil, fmt.Errorf("invalid token: restricted to an audience")
+	}
+	return s.extractClaims(claims)
+}
+
+// VerifyFeed checks the provided token string, ensuring it is valid and restricted to FeedAudience.
+func (s *Service) VerifyFeed(t string) (*entity.TokenClaims, error) {
+	claims, err := s.parse(t, jwt.WithAudience(FeedAudience))
+	if err != nil {
+		return nil, err
+	}
+	return s.extractClaims(claims)
+}
+
+// parse parses the token string, checking its signature and the given options, and returns its claims.
+func (s *Service) parse(t string, options ...jwt.ParserOption) (jwt.MapClaims, error) {
+	token, err := jwt.Parse(t, s.keyFunc, options...)
 	if err != nil || !token.Valid {
 		return nil, fmt.Errorf("invalid token: %w", err)
 	}
@@ -48,8 +94,7 @@ func (s *Service) Verify(t string) (*entity.TokenClaims, error) {
 	if !ok {
 		return nil, fmt.Errorf("invalid claims structure")
 	}
-
-	return s.extractClaims(claims)
+	return claims, nil
 }
 
 // keyFunc retrieves the signing key and validates the signing method.
@@ -77,7 +122,8 @@ func (s *Service) extractClaims(claims jwt.MapClaims) (*entity.TokenClaims, erro
 		return nil, err
 	}
 
-	return entity.GetTokenClaims().SetIssuer(issuer).SetScope(scope).SetExpiresAt(exp), err
+	return entity.GetTokenClaims().SetIssuer(issuer).SetSubject(s.getSubject(claims)).SetScope(scope).
+		SetExpiresAt(exp), err
 }
 
 // getIssuer retrieves and validates the issuer claim.
@@ -89,6 +135,13 @@ func (s *Service) getIssuer(claims jwt.MapClaims) (string, error) {
 	return issuer, nil
 }
 
+// getSubject retrieves the optional subject claim.
+// Tokens minted before role-based access control was introduced carry no subject.
+func (s *Service) getSubject(claims jwt.MapClaims) string {
+	subject, _ := claims["sub"].(string)
+	return subject
+}
+
 // getScope retrieves and validates the scope claim.
 func (s *Service) getScope(claims jwt.MapClaims) ([]string, error) {
 	rawScope, ok := claims["scope"].([]any)
@@ -0,0 +1,63 @@
+package certauth
+
+import (
+	"application/role"
+	"context"
+	"crypto/x509"
+	authEntity "domain/auth/entity"
+	roleEntity "domain/role/entity"
+	"errors"
+	"fmt"
+)
+
+// ErrUnknownIdentity is returned when no identity of a client certificate is mapped to a principal.
+var ErrUnknownIdentity = errors.New("client certificate identity is not mapped to a principal")
+
+// Mapper maps verified client certificates to internal principals. The principal is treated as an API client,
+// so it is granted the scopes of the roles assigned to it like clients authenticated by token or signature.
+type Mapper struct {
+	identities map[string]string // Certificate identities (SANs, common names or subjects) keyed to principals.
+	roles      *role.Service     // Role service resolving the scopes of the principal.
+}
+
+// NewMapper creates a new instance of Mapper for the given identity to principal mapping.
+func NewMapper(identities map[string]string, roles *role.Service) *Mapper {
+	return &Mapper{identities: identities, roles: roles}
+}
+
+// Principal returns the principal mapped to the certificate. The URI, DNS and email SANs are tried first,
+// followed by the subject common name and the full subject distinguished name.
+func (m *Mapper) Principal(cert *x509.Certificate) (string, bool) {
+	candidates := make([]string, 0, len(cert.URIs)+len(cert.DNSNames)+len(cert.EmailAddresses)+2)
+	for _, uri := range cert.URIs {
+		candidates = append(candidates, uri.String())
+	}
+	candidates = append(candidates, cert.DNSNames...)
+	candidates = append(candidates, cert.EmailAddresses...)
+	candidates = append(candidates, cert.Subject.CommonName, cert.Subject.String())
+
+	for _, candidate := range candidates {
+		if principal, ok := m.identities[candidate]; candidate != "" && ok {
+			return principal, true
+		}
+	}
+	return "", false
+}
+
+// Claims returns the claims of the principal mapped to the certificate. They expire with the certificate.
+func (m *Mapper) Claims(ctx context.Context, cert *x509.Certificate) (*authEntity.TokenClaims, error) {
+	principal, ok := m.Principal(cert)
+	if !ok {
+		return nil, ErrUnknownIdentity
+	}
+
+	scopes, err := m.roles.EffectiveScopes(ctx, roleEntity.SubjectClient, principal)
+	if err != nil {
+		return nil, fmt.Errorf("resolve scopes: %w", err)
+	}
+	return authEntity.GetTokenClaims().
+		SetIssuer(principal).
+		SetSubject(principal).
+		SetScope(scopes).
+		SetExpiresAt(cert.NotAfter.Unix()), nil
+}
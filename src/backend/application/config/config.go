@@ -1,89 +1,185 @@
 package config
 
 import (
-	"os"
-	"strconv"
+	"time"
 )
 
 // Configuration holds the main application configuration settings.
+// Settings are layered: defaults, then the YAML file, then environment variables, then command line flags.
 type Configuration struct {
-	Port      int            // Application server port.
-	Env       string         // Environment (e.g., "development", "production").
-	Jwt       JWTConfig      // Jwt configuration for authentication.
-	DB        DatabaseConfig // Database configuration for connecting to the data source.
-	Nats      NatsConfig     // NATS configuration.
-	GRPC      GrpcConfig     // Configuration for gRPC server settings.
-	TLSConfig TLSConfig      // Configuration for TLS settings.
+	Port        int               `yaml:"port"`        // Application server port.
+	Env         string            `yaml:"env"`         // Environment (e.g., "development", "production").
+	LogLevel    string            `yaml:"log_level"`   // Minimum level of the logs: "debug", "info", "warn" or "error".
+	Shutdown    time.Duration     `yaml:"shutdown"`    // Overall deadline of the graceful shutdown.
+	Jwt         JWTConfig         `yaml:"jwt"`         // Jwt configuration for authentication.
+	Signature   SignatureConfig   `yaml:"signature"`   // Configuration for HMAC signed requests.
+	Audit       AuditConfig       `yaml:"audit"`       // Configuration for the security audit trail.
+	Metrics     MetricsConfig     `yaml:"metrics"`     // Configuration for the Prometheus metrics endpoints.
+	Tracing     TracingConfig     `yaml:"tracing"`     // Configuration for distributed tracing.
+	Health      HealthConfig      `yaml:"health"`      // Configuration for the readiness checks.
+	RateLimit   RateLimitConfig   `yaml:"rate_limit"`  // Configuration for the rate limits of the clients.
+	Batch       BatchConfig       `yaml:"batch"`       // Configuration for the batch operations.
+	Idempotency IdempotencyConfig `yaml:"idempotency"` // Configuration for the idempotency keys of requests.
+	Import      ImportConfig      `yaml:"import"`      // Configuration for the bulk imports of vacancies.
+	Feed        FeedConfig        `yaml:"feed"`        // Configuration for the RSS and Atom feeds of vacancies.
+	Stream      StreamConfig      `yaml:"stream"`      // Configuration for the event stream of vacancy changes.
+	Features    FeatureConfig     `yaml:"features"`    // Feature flags switching optional endpoints on or off.
+	DB          DatabaseConfig    `yaml:"db"`          // Database configuration for connecting to the data source.
+	Nats        NatsConfig        `yaml:"nats"`        // NATS configuration.
+	GRPC        GrpcConfig        `yaml:"grpc"`        // Configuration for gRPC server settings.
+	TLSConfig   TLSConfig         `yaml:"tls"`         // Configuration for TLS settings.
 }
 
 // GrpcConfig holds settings for gRPC servers.
 type GrpcConfig struct {
-	AuthServerPort    string // Port for the Auth gRPC server.
-	VacancyServerPort string // Port for the Vacancy gRPC server.
+	AuthServerPort    string            `yaml:"auth_server_port"`    // Port for the Auth gRPC server.
+	VacancyServerPort string            `yaml:"vacancy_server_port"` // Port for the Vacancy gRPC server.
+	ClientIdentities  map[string]string `yaml:"client_identities"`   // Client certificate identities mapped to principals.
 }
 
-// TLSConfig holds settings for TLS.
+// TLSConfig holds settings for TLS, shared by the REST and gRPC servers. The gRPC servers serve TLS in production;
+// the REST server only if enabled, as it is usually behind a reverse proxy terminating TLS.
 type TLSConfig struct {
-	Certificate string // Path to the TLS certificate file.
-	Key         string // Path to the TLS key file.
+	Certificate    string        `yaml:"certificate"`     // Path to the TLS certificate file.
+	Key            string        `yaml:"key"`             // Path to the TLS key file.
+	ClientCA       string        `yaml:"client_ca"`       // Path to the client CA bundle enabling mutual TLS.
+	ClientAuth     string        `yaml:"client_auth"`     // Client certificate policy: "require" or "verify_if_given".
+	MinVersion     string        `yaml:"min_version"`     // Minimum TLS version accepted: "1.2" or "1.3".
+	HTTPEnabled    bool          `yaml:"http_enabled"`    // Whether the REST server serves TLS itself.
+	ReloadInterval time.Duration `yaml:"reload_interval"` // Interval the files are checked for changes at; 0 disables.
 }
 
 // NatsConfig holds configuration settings for connecting to a NATS server.
 type NatsConfig struct {
-	URL string // URL of the NATS server.
+	URL string `yaml:"url"` // URL of the NATS server.
 }
 
 // JWTConfig holds configuration settings for JWT-based authentication.
 type JWTConfig struct {
-	Secret string // Secret key for signing JWT tokens.
+	Secret string `yaml:"secret"` // Secret key for signing JWT tokens.
+}
+
+// SignatureConfig holds configuration settings for HMAC signed requests.
+type SignatureConfig struct {
+	Window time.Duration `yaml:"window"` // Maximum clock difference accepted between the signature and the server.
+}
+
+// AuditConfig holds configuration settings for the security audit trail.
+type AuditConfig struct {
+	Publish bool `yaml:"publish"` // Whether audit records are also published on NATS, in addition to Postgres.
+}
+
+// MetricsConfig holds configuration settings for the Prometheus metrics endpoints.
+// A port of zero serves the REST API metrics at /metrics of the API, restricted to the admin scope, and disables
+// the metrics of the gRPC servers, which have no HTTP listener.
+type MetricsConfig struct {
+	Port              int `yaml:"port"`                // Separate port serving the REST API metrics.
+	AuthServerPort    int `yaml:"auth_server_port"`    // Port serving the metrics of the Auth gRPC server.
+	VacancyServerPort int `yaml:"vacancy_server_port"` // Port serving the metrics of the Vacancy gRPC server.
+}
+
+// TracingConfig holds configuration settings for distributed tracing.
+// Trace contexts are always propagated; spans are only recorded when an exporter is configured.
+type TracingConfig struct {
+	Exporter    string  `yaml:"exporter"`     // Span exporter: "" (none), "jsonl" or "otlp".
+	File        string  `yaml:"file"`         // Path of the file the "jsonl" exporter appends spans to.
+	Endpoint    string  `yaml:"endpoint"`     // URL of the OTLP/HTTP traces endpoint of the "otlp" exporter.
+	SampleRatio float64 `yaml:"sample_ratio"` // Ratio of new traces recorded, from 0 (none) to 1 (all).
+	ServiceName string  `yaml:"service_name"` // Name of the service reported with the spans; defaults to the process.
+}
+
+// HealthConfig holds configuration settings for the readiness checks of the dependencies.
+type HealthConfig struct {
+	Timeout  time.Duration `yaml:"timeout"`   // Maximum duration of a check; a slower dependency is reported down.
+	CacheTTL time.Duration `yaml:"cache_ttl"` // Duration the result of a check is reused.
+}
+
+// RateLimitConfig holds configuration settings for the rate limits of the clients. Policies are specified as
+// "<requests>/<unit>[,<burst>]" with the unit s, m, h or d, e.g., "10/s,20"; an empty policy is unlimited.
+type RateLimitConfig struct {
+	Store    string            `yaml:"store"`    // Store of the token buckets: "memory", "postgres" or "nats".
+	Default  string            `yaml:"default"`  // Policy of the routes and methods without their own.
+	Policies map[string]string `yaml:"policies"` // Policies by route (e.g., "GET /v1/vacancies") or gRPC method.
+}
+
+// BatchConfig holds configuration settings for the batch operations on vacancies.
+type BatchConfig struct {
+	MaxItems int `yaml:"max_items"` // Maximum number of items of a batch.
+}
+
+// IdempotencyConfig holds configuration settings for the idempotency keys sent with requests.
+type IdempotencyConfig struct {
+	TTL time.Duration `yaml:"ttl"` // Duration the responses of the requests are replayed to their retries.
+}
+
+// ImportConfig holds configuration settings for the bulk imports of vacancies.
+type ImportConfig struct {
+	MaxBytes int `yaml:"max_bytes"` // Maximum size of an uploaded import, in bytes.
+}
+
+// FeedConfig holds configuration settings for the RSS and Atom feeds of vacancies.
+type FeedConfig struct {
+	ItemLimit int           `yaml:"item_limit"` // Maximum number of vacancies of a feed, the most recent ones.
+	TokenTTL  time.Duration `yaml:"token_ttl"`  // Duration the signed tokens of the feed URLs are valid.
+}
+
+// StreamConfig holds configuration settings for the Server-Sent Events stream of vacancy changes.
+type StreamConfig struct {
+	ReplaySize int           `yaml:"replay_size"` // Number of the latest changes replayed to resuming clients.
+	Heartbeat  time.Duration `yaml:"heartbeat"`   // Interval of the heartbeats keeping idle streams open.
+}
+
+// FeatureConfig holds the feature flags, switching optional endpoints on or off while running. A switched off
+// endpoint answers 404 Not Found.
+type FeatureConfig struct {
+	Import bool `yaml:"import"` // Whether vacancies may be imported from files.
+	Feeds  bool `yaml:"feeds"`  // Whether the RSS and Atom feeds of vacancies and their tokens are served.
+	Stream bool `yaml:"stream"` // Whether the event stream of vacancy changes is served.
 }
 
 // DatabaseConfig holds settings for database connection.
 type DatabaseConfig struct {
-	DSN string // Data source name for database connection.
+	DSN string `yaml:"dsn"` // Data source name for database connection.
 }
 
-// LoadConfig loads the configuration settings from environment variables, falling back to default
-// values if variables are not set.
-func LoadConfig() *Configuration {
-	config := &Configuration{
-		Port: getEnvAsInt("PORT", 4005),
-		Env:  getEnv("ENV", "dev"),
-		Jwt: JWTConfig{
-			Secret: getEnv("JWT_SECRET", ""),
-		},
-		DB: DatabaseConfig{
-			DSN: getEnv("DB_DSN", ""),
-		},
-		Nats: NatsConfig{
-			URL: getEnv("NATS_URL", ""),
+// Default returns the configuration used for the settings not set by any other source.
+func Default() *Configuration {
+	return &Configuration{
+		Port:      4005,
+		Env:       EnvDev,
+		LogLevel:  "info",
+		Shutdown:  15 * time.Second,
+		Signature: SignatureConfig{Window: 300 * time.Second},
+		Tracing: TracingConfig{
+			File:        "spans.jsonl",
+			Endpoint:    "http://localhost:4318/v1/traces",
+			SampleRatio: 1,
 		},
-		GRPC: GrpcConfig{
-			AuthServerPort:    getEnv("GRPC_AUTH_SERVER_PORT", ""),
-			VacancyServerPort: getEnv("GRPC_VACANCY_SERVER_PORT", ""),
+		Health: HealthConfig{
+			Timeout:  2 * time.Second,
+			CacheTTL: 5 * time.Second,
 		},
+		RateLimit:   RateLimitConfig{Store: "memory", Policies: map[string]string{}},
+		Batch:       BatchConfig{MaxItems: 1000},
+		Idempotency: IdempotencyConfig{TTL: 24 * time.Hour},
+		Import:      ImportConfig{MaxBytes: 32 << 20},
+		Feed:        FeedConfig{ItemLimit: 50, TokenTTL: 365 * 24 * time.Hour},
+		Stream:      StreamConfig{ReplaySize: 1000, Heartbeat: 15 * time.Second},
+		Features:    FeatureConfig{Import: true, Feeds: true, Stream: true},
+		GRPC:        GrpcConfig{ClientIdentities: map[string]string{}},
 		TLSConfig: TLSConfig{
-			Certificate: getEnv("TLS_CERTIFICATE", ""),
-			Key:         getEnv("TLS_KEY", ""),
+			ClientAuth:     "verify_if_given",
+			MinVersion:     "1.2",
+			ReloadInterval: 30 * time.Second,
 		},
 	}
-
-	return config
-}
-
-// getEnv fetches the value of an environment variable or returns a fallback.
-func getEnv(key, fallback string) string {
-	if v, ok := os.LookupEnv(key); ok {
-		return v
-	}
-	return fallback
 }
 
-// getEnvAsInt fetches the value of an environment variable as an integer or returns a fallback.
-func getEnvAsInt(key string, fallback int) int {
-	v := getEnv(key, "")
-	if value, err := strconv.Atoi(v); err == nil {
-		return value
-	}
-	return fallback
+// LoadConfig loads the configuration settings from the YAML file named by CONFIG_FILE, if any, and from
+// environment variables, falling back to default values if they are not set. It neither parses command line
+// flags nor validates the settings, and ignores invalid values; processes load their configuration with a
+// Loader instead.
+func LoadConfig() *Configuration {
+	config, _ := NewLoader(nil).load()
+	return config
 }
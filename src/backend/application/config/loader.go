@@ -0,0 +1,153 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// fileSuffix is appended to the key of a secret setting to name the environment variable or flag holding the path
+// of a file the secret is read from, e.g., DB_DSN_FILE or -db-dsn-file.
+const fileSuffix = "_FILE"
+
+// Loader loads the configuration in layers: defaults, then the YAML file, then environment variables, then command
+// line flags, each layer overriding the settings of the previous ones. Secret settings may be read from files.
+type Loader struct {
+	args []string // Command line arguments, without the program name.
+}
+
+// NewLoader creates a Loader parsing the given command line arguments, e.g., os.Args[1:].
+// The file is named by the -config flag or the CONFIG_FILE environment variable; no file is read if neither is set.
+func NewLoader(args []string) *Loader {
+	return &Loader{args: args}
+}
+
+// Load loads and validates the configuration. The returned error reports every problem found at once.
+func (l *Loader) Load() (*Configuration, error) {
+	config, err := l.load()
+	return config, errors.Join(err, config.Validate())
+}
+
+// load loads the configuration without validating it. Invalid values are reported and leave the setting unchanged.
+func (l *Loader) load() (*Configuration, error) {
+	config := Default()
+	flags, file, err := l.parseFlags()
+	if err != nil {
+		return config, err
+	}
+
+	var errs []error
+	if file == "" {
+		file = os.Getenv("CONFIG_FILE")
+	}
+	if file != "" {
+		errs = append(errs, readFile(config, file))
+	}
+	for _, s := range settings {
+		errs = append(errs, applyEnv(config, s))
+	}
+	for _, s := range settings {
+		errs = append(errs, applyFlag(config, s, flags))
+	}
+	return config, errors.Join(errs...)
+}
+
+// parseFlags parses the command line arguments and returns the values of the flags set, by flag name, and the
+// path of the configuration file.
+func (l *Loader) parseFlags() (map[string]string, string, error) {
+	fs := flag.NewFlagSet("config", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	file := fs.String("config", "", "YAML configuration file")
+	for _, s := range settings {
+		fs.String(s.flagName(), "", s.usage)
+		if s.secret {
+			fs.String(s.flagName()+"-file", "", "file holding the "+s.usage)
+		}
+	}
+	if err := fs.Parse(l.args); err != nil {
+		return nil, "", fmt.Errorf("flags: %w", err)
+	}
+	if fs.NArg() > 0 {
+		return nil, "", fmt.Errorf("flags: unexpected argument %q", fs.Arg(0))
+	}
+
+	values := make(map[string]string)
+	fs.Visit(func(f *flag.Flag) {
+		values[f.Name] = f.Value.String()
+	})
+	return values, *file, nil
+}
+
+// readFile decodes the YAML file into the configuration. Unknown keys are rejected, so typos are not ignored.
+func readFile(config *Configuration, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("config file: %w", err)
+	}
+	defer f.Close()
+
+	decoder := yaml.NewDecoder(f)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(config); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("config file %s: %w", path, err)
+	}
+	return nil
+}
+
+// applyEnv sets the setting from its environment variable or, for secrets, from the file named by <key>_FILE.
+// Empty variables are treated as unset.
+func applyEnv(config *Configuration, s setting) error {
+	value, file := os.Getenv(s.key), ""
+	if s.secret {
+		file = os.Getenv(s.key + fileSuffix)
+	}
+	return apply(config, s, s.key, value, file)
+}
+
+// applyFlag sets the setting from its command line flag or, for secrets, from the file named by its -file flag.
+func applyFlag(config *Configuration, s setting, flags map[string]string) error {
+	value, file := flags[s.flagName()], ""
+	if s.secret {
+		file = flags[s.flagName()+"-file"]
+	}
+	return apply(config, s, "-"+s.flagName(), value, file)
+}
+
+// apply sets the setting from the value or the contents of the file, whichever is given, naming the source in
+// the errors.
+func apply(config *Configuration, s setting, source, value, file string) error {
+	switch {
+	case value != "" && file != "":
+		return fmt.Errorf("%s: both the value and a file are set", source)
+	case file != "":
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("%s: %w", source, err)
+		}
+		value = strings.TrimSpace(string(content))
+	case value == "":
+		return nil
+	}
+
+	if err := s.set(config, value); err != nil {
+		return fmt.Errorf("%s: %w", source, err)
+	}
+	return nil
+}
+
+// MustLoad loads and validates the configuration of a process from the given command line arguments. It prints
+// every problem found and exits with status 2 if the configuration is invalid.
+func MustLoad(args []string) (*Loader, *Configuration) {
+	loader := NewLoader(args)
+	config, err := loader.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid configuration:\n%v\n", err)
+		os.Exit(2)
+	}
+	return loader, config
+}
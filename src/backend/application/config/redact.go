@@ -0,0 +1,63 @@
+package config
+
+import (
+	"io"
+	"maps"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// redacted replaces secrets and passwords in printed configurations.
+const redacted = "REDACTED"
+
+// passwordPair matches the password of a key=value connection string, quoted or not.
+var passwordPair = regexp.MustCompile(`(password\s*=\s*)('(?:[^'\\]|\\.)*'|\S+)`)
+
+// Redacted returns a copy of the configuration with the secrets replaced, safe to print or log.
+// The JWT secret is replaced, as are the passwords of the database and NATS connection strings and the NATS tokens.
+func (c *Configuration) Redacted() *Configuration {
+	r := *c
+	r.GRPC.ClientIdentities = maps.Clone(c.GRPC.ClientIdentities)
+	if r.Jwt.Secret != "" {
+		r.Jwt.Secret = redacted
+	}
+	r.DB.DSN = redactConnString(r.DB.DSN, false)
+
+	servers := strings.Split(r.Nats.URL, ",")
+	for i, server := range servers {
+		servers[i] = redactConnString(server, true)
+	}
+	r.Nats.URL = strings.Join(servers, ",")
+	return &r
+}
+
+// Print writes the configuration as YAML with the secrets redacted, in the format read from the configuration file.
+func (c *Configuration) Print(w io.Writer) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(c.Redacted()); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// redactConnString replaces the password of a URL or of a key=value connection string. If token is true, a user
+// without a password is an authentication token, e.g., nats://token@localhost:4222, and is replaced as well.
+func redactConnString(value string, token bool) string {
+	if strings.Contains(value, "://") {
+		u, err := url.Parse(value)
+		if err != nil {
+			return redacted
+		}
+		if _, ok := u.User.Password(); ok {
+			u.User = url.UserPassword(u.User.Username(), redacted)
+		} else if token && u.User != nil {
+			u.User = url.User(redacted)
+		}
+		return u.String()
+	}
+	return passwordPair.ReplaceAllString(value, "${1}"+redacted)
+}
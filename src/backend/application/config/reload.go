@@ -0,0 +1,86 @@
+package config
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+)
+
+// Reloader reloads the configuration on SIGHUP and passes it to the registered handlers, which apply the settings
+// safe to change while running, e.g., the log level or the trace sample ratio. Other settings, such as ports or
+// connection strings, only take effect on restart.
+type Reloader struct {
+	loader   *Loader
+	logger   *slog.Logger
+	mu       sync.Mutex               // Serializes the reloads.
+	handlers []func(c *Configuration) // Handlers applying the reloaded configuration.
+	signals  chan os.Signal           // Receives the SIGHUP signals while watching.
+	cancel   context.CancelFunc       // Stops watching.
+	done     chan struct{}            // Closed once watching has stopped.
+}
+
+// NewReloader creates a Reloader loading the configuration with the given loader.
+func NewReloader(loader *Loader, logger *slog.Logger) *Reloader {
+	return &Reloader{loader: loader, logger: logger}
+}
+
+// OnReload registers a handler applying the reloaded configuration. It must be called before Start.
+func (r *Reloader) OnReload(handler func(c *Configuration)) {
+	r.handlers = append(r.handlers, handler)
+}
+
+// Reload loads the configuration and passes it to the handlers. An invalid configuration is reported and not
+// applied, so the application keeps running with its current settings.
+func (r *Reloader) Reload() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	config, err := r.loader.Load()
+	if err != nil {
+		r.logger.Error("Configuration not reloaded", "error", err)
+		return err
+	}
+	for _, handler := range r.handlers {
+		handler(config)
+	}
+	r.logger.Info("Configuration reloaded", "log_level", config.LogLevel)
+	return nil
+}
+
+// Start reloads the configuration on every SIGHUP until Stop is called. It matches lifecycle.Hook.Start.
+func (r *Reloader) Start(context.Context) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.signals, r.cancel, r.done = make(chan os.Signal, 1), cancel, make(chan struct{})
+	signal.Notify(r.signals, syscall.SIGHUP)
+
+	go func() {
+		defer close(r.done)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-r.signals:
+				_ = r.Reload()
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop stops reloading the configuration on SIGHUP. It matches lifecycle.Hook.Stop.
+func (r *Reloader) Stop(ctx context.Context) error {
+	if r.cancel == nil {
+		return nil
+	}
+	signal.Stop(r.signals)
+	r.cancel()
+	select {
+	case <-r.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
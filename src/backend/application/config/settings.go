@@ -0,0 +1,186 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// setting is a configuration setting settable by an environment variable and the matching command line flag.
+type setting struct {
+	key    string                                 // Name of the environment variable, e.g., "DB_DSN".
+	usage  string                                 // Description shown in the help of the flag.
+	secret bool                                   // Whether the value may be read from the file named by <key>_FILE.
+	set    func(c *Configuration, v string) error // Parses the value and stores it in the configuration.
+}
+
+// flagName returns the name of the command line flag of the setting, e.g., "db-dsn" for DB_DSN.
+func (s setting) flagName() string {
+	return strings.ReplaceAll(strings.ToLower(s.key), "_", "-")
+}
+
+// settings lists every setting settable by environment variables and flags.
+var settings = []setting{
+	stringSetting("ENV", "environment: dev or prod", func(c *Configuration) *string { return &c.Env }),
+	intSetting("PORT", "REST API port", func(c *Configuration) *int { return &c.Port }),
+	stringSetting("LOG_LEVEL", "minimum log level: debug, info, warn or error",
+		func(c *Configuration) *string { return &c.LogLevel }),
+	durationSetting("SHUTDOWN_TIMEOUT_SECONDS", "graceful shutdown deadline in seconds", time.Second,
+		func(c *Configuration) *time.Duration { return &c.Shutdown }),
+	secretSetting("JWT_SECRET", "secret signing the JWT tokens", func(c *Configuration) *string { return &c.Jwt.Secret }),
+	durationSetting("SIGNATURE_WINDOW_SECONDS", "accepted clock skew of signed requests in seconds", time.Second,
+		func(c *Configuration) *time.Duration { return &c.Signature.Window }),
+	boolSetting("AUDIT_PUBLISH", "publish audit records on NATS",
+		func(c *Configuration) *bool { return &c.Audit.Publish }),
+	intSetting("METRICS_PORT", "separate port of the REST API metrics",
+		func(c *Configuration) *int { return &c.Metrics.Port }),
+	intSetting("GRPC_AUTH_METRICS_PORT", "port of the Auth gRPC server metrics",
+		func(c *Configuration) *int { return &c.Metrics.AuthServerPort }),
+	intSetting("GRPC_VACANCY_METRICS_PORT", "port of the Vacancy gRPC server metrics",
+		func(c *Configuration) *int { return &c.Metrics.VacancyServerPort }),
+	stringSetting("TRACING_EXPORTER", "span exporter: jsonl or otlp",
+		func(c *Configuration) *string { return &c.Tracing.Exporter }),
+	stringSetting("TRACING_FILE", "file of the jsonl span exporter",
+		func(c *Configuration) *string { return &c.Tracing.File }),
+	stringSetting("TRACING_OTLP_ENDPOINT", "OTLP/HTTP traces endpoint",
+		func(c *Configuration) *string { return &c.Tracing.Endpoint }),
+	floatSetting("TRACING_SAMPLE_RATIO", "ratio of recorded traces, from 0 to 1",
+		func(c *Configuration) *float64 { return &c.Tracing.SampleRatio }),
+	stringSetting("TRACING_SERVICE_NAME", "service name reported with the spans",
+		func(c *Configuration) *string { return &c.Tracing.ServiceName }),
+	durationSetting("HEALTH_CHECK_TIMEOUT_MS", "timeout of a readiness check in milliseconds", time.Millisecond,
+		func(c *Configuration) *time.Duration { return &c.Health.Timeout }),
+	durationSetting("HEALTH_CHECK_CACHE_MS", "cache duration of a readiness check in milliseconds", time.Millisecond,
+		func(c *Configuration) *time.Duration { return &c.Health.CacheTTL }),
+	stringSetting("RATE_LIMIT_STORE", "store of the rate limit buckets: memory, postgres or nats",
+		func(c *Configuration) *string { return &c.RateLimit.Store }),
+	stringSetting("RATE_LIMIT_DEFAULT", "default rate limit policy, e.g., 10/s,20; empty is unlimited",
+		func(c *Configuration) *string { return &c.RateLimit.Default }),
+	mapSetting("RATE_LIMIT_POLICIES", "rate limit policies by route or gRPC method, as route=policy;...",
+		func(c *Configuration) *map[string]string { return &c.RateLimit.Policies }),
+	intSetting("BATCH_MAX_ITEMS", "maximum number of items of a batch operation",
+		func(c *Configuration) *int { return &c.Batch.MaxItems }),
+	durationSetting("IDEMPOTENCY_TTL_SECONDS", "duration the responses to idempotency keys are kept, in seconds",
+		time.Second, func(c *Configuration) *time.Duration { return &c.Idempotency.TTL }),
+	intSetting("IMPORT_MAX_BYTES", "maximum size of an uploaded vacancy import in bytes",
+		func(c *Configuration) *int { return &c.Import.MaxBytes }),
+	intSetting("FEED_ITEM_LIMIT", "maximum number of vacancies of an RSS or Atom feed",
+		func(c *Configuration) *int { return &c.Feed.ItemLimit }),
+	durationSetting("FEED_TOKEN_TTL_DAYS", "duration the signed tokens of the feed URLs are valid, in days",
+		24*time.Hour, func(c *Configuration) *time.Duration { return &c.Feed.TokenTTL }),
+	intSetting("STREAM_REPLAY_SIZE", "number of the latest vacancy changes replayed to resuming event streams",
+		func(c *Configuration) *int { return &c.Stream.ReplaySize }),
+	durationSetting("STREAM_HEARTBEAT_SECONDS", "interval of the heartbeats of the vacancy event stream, in seconds",
+		time.Second, func(c *Configuration) *time.Duration { return &c.Stream.Heartbeat }),
+	boolSetting("FEATURE_IMPORT", "serve the import of vacancies from files",
+		func(c *Configuration) *bool { return &c.Features.Import }),
+	boolSetting("FEATURE_FEEDS", "serve the RSS and Atom feeds of vacancies",
+		func(c *Configuration) *bool { return &c.Features.Feeds }),
+	boolSetting("FEATURE_STREAM", "serve the event stream of vacancy changes",
+		func(c *Configuration) *bool { return &c.Features.Stream }),
+	secretSetting("DB_DSN", "database connection string", func(c *Configuration) *string { return &c.DB.DSN }),
+	secretSetting("NATS_URL", "NATS server URL", func(c *Configuration) *string { return &c.Nats.URL }),
+	stringSetting("GRPC_AUTH_SERVER_PORT", "Auth gRPC server port",
+		func(c *Configuration) *string { return &c.GRPC.AuthServerPort }),
+	stringSetting("GRPC_VACANCY_SERVER_PORT", "Vacancy gRPC server port",
+		func(c *Configuration) *string { return &c.GRPC.VacancyServerPort }),
+	mapSetting("GRPC_CLIENT_IDENTITIES", "client certificate identities mapped to principals, as id=principal;...",
+		func(c *Configuration) *map[string]string { return &c.GRPC.ClientIdentities }),
+	stringSetting("TLS_CERTIFICATE", "TLS certificate file",
+		func(c *Configuration) *string { return &c.TLSConfig.Certificate }),
+	stringSetting("TLS_KEY", "TLS key file", func(c *Configuration) *string { return &c.TLSConfig.Key }),
+	stringSetting("TLS_CLIENT_CA", "client CA bundle enabling mutual TLS",
+		func(c *Configuration) *string { return &c.TLSConfig.ClientCA }),
+	stringSetting("TLS_CLIENT_AUTH", "client certificate verification: require or verify_if_given",
+		func(c *Configuration) *string { return &c.TLSConfig.ClientAuth }),
+	stringSetting("TLS_MIN_VERSION", "minimum TLS version: 1.2 or 1.3",
+		func(c *Configuration) *string { return &c.TLSConfig.MinVersion }),
+	boolSetting("TLS_HTTP_ENABLED", "serve TLS on the REST server",
+		func(c *Configuration) *bool { return &c.TLSConfig.HTTPEnabled }),
+	durationSetting("TLS_RELOAD_INTERVAL_SECONDS", "interval the TLS files are checked for changes at, in seconds",
+		time.Second, func(c *Configuration) *time.Duration { return &c.TLSConfig.ReloadInterval }),
+}
+
+// stringSetting returns a setting storing the value as is.
+func stringSetting(key, usage string, field func(c *Configuration) *string) setting {
+	return setting{key: key, usage: usage, set: func(c *Configuration, v string) error {
+		*field(c) = v
+		return nil
+	}}
+}
+
+// secretSetting returns a string setting whose value may be read from a file.
+func secretSetting(key, usage string, field func(c *Configuration) *string) setting {
+	s := stringSetting(key, usage, field)
+	s.secret = true
+	return s
+}
+
+// intSetting returns a setting parsing the value as an integer.
+func intSetting(key, usage string, field func(c *Configuration) *int) setting {
+	return setting{key: key, usage: usage, set: func(c *Configuration, v string) error {
+		value, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", v)
+		}
+		*field(c) = value
+		return nil
+	}}
+}
+
+// floatSetting returns a setting parsing the value as a floating point number.
+func floatSetting(key, usage string, field func(c *Configuration) *float64) setting {
+	return setting{key: key, usage: usage, set: func(c *Configuration, v string) error {
+		value, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return fmt.Errorf("invalid number %q", v)
+		}
+		*field(c) = value
+		return nil
+	}}
+}
+
+// boolSetting returns a setting parsing the value as a boolean.
+func boolSetting(key, usage string, field func(c *Configuration) *bool) setting {
+	return setting{key: key, usage: usage, set: func(c *Configuration, v string) error {
+		value, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("invalid boolean %q", v)
+		}
+		*field(c) = value
+		return nil
+	}}
+}
+
+// durationSetting returns a setting parsing the value as an integer number of the given unit.
+func durationSetting(key, usage string, unit time.Duration, field func(c *Configuration) *time.Duration) setting {
+	return setting{key: key, usage: usage, set: func(c *Configuration, v string) error {
+		value, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", v)
+		}
+		*field(c) = time.Duration(value) * unit
+		return nil
+	}}
+}
+
+// mapSetting returns a setting parsing the value as semicolon separated key=value pairs.
+// Pairs are split at the last "=", so keys may be distinguished names (e.g., "CN=bot,O=Pulse Finder=bot").
+func mapSetting(key, usage string, field func(c *Configuration) *map[string]string) setting {
+	return setting{key: key, usage: usage, set: func(c *Configuration, v string) error {
+		values := make(map[string]string)
+		for _, pair := range strings.Split(v, ";") {
+			if strings.TrimSpace(pair) == "" {
+				continue
+			}
+			i := strings.LastIndex(pair, "=")
+			if i <= 0 || i == len(pair)-1 {
+				return fmt.Errorf("invalid pair %q, expected key=value", pair)
+			}
+			values[strings.TrimSpace(pair[:i])] = strings.TrimSpace(pair[i+1:])
+		}
+		*field(c) = values
+		return nil
+	}}
+}
@@ -0,0 +1,148 @@
+package config
+
+import (
+	"application/ratelimit"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Environments of the application.
+const (
+	EnvProd = "prod"
+	EnvDev  = "dev"
+)
+
+// problems collects the problems found by Validate.
+type problems []error
+
+// check records a problem with the setting of the given key unless ok is true.
+func (p *problems) check(ok bool, key, format string, args ...any) {
+	if !ok {
+		*p = append(*p, fmt.Errorf("%s: %s", key, fmt.Sprintf(format, args...)))
+	}
+}
+
+// Validate checks the configuration and returns an error reporting every problem found, or nil if it is valid.
+// Problems name the environment variable of the setting, which is also the upper case name of its flag.
+func (c *Configuration) Validate() error {
+	var p problems
+	c.validateServer(&p)
+	c.validateDependencies(&p)
+	c.validateTelemetry(&p)
+	c.validateTLS(&p)
+	c.validateRateLimit(&p)
+	return errors.Join(p...)
+}
+
+// validateServer checks the settings of the servers of the application.
+func (c *Configuration) validateServer(p *problems) {
+	p.check(c.Env == EnvProd || c.Env == EnvDev, "ENV", "must be %q or %q, got %q", EnvProd, EnvDev, c.Env)
+	p.check(c.Port > 0 && c.Port <= 65535, "PORT", "must be between 1 and 65535, got %d", c.Port)
+	p.check(c.Shutdown > 0, "SHUTDOWN_TIMEOUT_SECONDS", "must be positive")
+	p.check(c.Jwt.Secret != "", "JWT_SECRET", "is required")
+	p.check(c.Signature.Window > 0, "SIGNATURE_WINDOW_SECONDS", "must be positive")
+	p.check(c.Batch.MaxItems > 0, "BATCH_MAX_ITEMS", "must be positive, got %d", c.Batch.MaxItems)
+	p.check(c.Idempotency.TTL > 0, "IDEMPOTENCY_TTL_SECONDS", "must be positive")
+	p.check(c.Import.MaxBytes > 0, "IMPORT_MAX_BYTES", "must be positive, got %d", c.Import.MaxBytes)
+	p.check(c.Feed.ItemLimit > 0, "FEED_ITEM_LIMIT", "must be positive, got %d", c.Feed.ItemLimit)
+	p.check(c.Feed.TokenTTL > 0, "FEED_TOKEN_TTL_DAYS", "must be positive")
+	p.check(c.Stream.ReplaySize > 0, "STREAM_REPLAY_SIZE", "must be positive, got %d", c.Stream.ReplaySize)
+	p.check(c.Stream.Heartbeat > 0, "STREAM_HEARTBEAT_SECONDS", "must be positive")
+	p.check(c.GRPC.AuthServerPort == "" || validPort(c.GRPC.AuthServerPort), "GRPC_AUTH_SERVER_PORT",
+		"must be between 1 and 65535, got %q", c.GRPC.AuthServerPort)
+	p.check(c.GRPC.VacancyServerPort == "" || validPort(c.GRPC.VacancyServerPort), "GRPC_VACANCY_SERVER_PORT",
+		"must be between 1 and 65535, got %q", c.GRPC.VacancyServerPort)
+}
+
+// validateDependencies checks the settings of the database and NATS connections and of their readiness checks.
+func (c *Configuration) validateDependencies(p *problems) {
+	p.check(c.DB.DSN != "", "DB_DSN", "is required")
+	p.check(c.DB.DSN == "" || validDSN(c.DB.DSN), "DB_DSN", "must be a postgres:// URL or key=value pairs")
+	p.check(c.Nats.URL != "", "NATS_URL", "is required")
+	p.check(c.Nats.URL == "" || validServers(c.Nats.URL), "NATS_URL",
+		"must be a comma separated list of URLs, e.g., nats://localhost:4222")
+	p.check(c.Health.Timeout > 0, "HEALTH_CHECK_TIMEOUT_MS", "must be positive")
+	p.check(c.Health.CacheTTL >= 0, "HEALTH_CHECK_CACHE_MS", "must not be negative")
+}
+
+// validateTelemetry checks the settings of the logs, metrics and traces.
+func (c *Configuration) validateTelemetry(p *problems) {
+	p.check(validLogLevel(c.LogLevel), "LOG_LEVEL", "must be debug, info, warn or error, got %q", c.LogLevel)
+	p.check(validMetricsPort(c.Metrics.Port), "METRICS_PORT", "must be between 0 (disabled) and 65535")
+	p.check(c.Metrics.Port == 0 || c.Metrics.Port != c.Port, "METRICS_PORT", "must differ from PORT")
+	p.check(validMetricsPort(c.Metrics.AuthServerPort), "GRPC_AUTH_METRICS_PORT",
+		"must be between 0 (disabled) and 65535")
+	p.check(validMetricsPort(c.Metrics.VacancyServerPort), "GRPC_VACANCY_METRICS_PORT",
+		"must be between 0 (disabled) and 65535")
+	p.check(c.Tracing.Exporter == "" || c.Tracing.Exporter == "jsonl" || c.Tracing.Exporter == "otlp",
+		"TRACING_EXPORTER", "must be empty, \"jsonl\" or \"otlp\", got %q", c.Tracing.Exporter)
+	p.check(c.Tracing.SampleRatio >= 0 && c.Tracing.SampleRatio <= 1,
+		"TRACING_SAMPLE_RATIO", "must be between 0 and 1, got %g", c.Tracing.SampleRatio)
+}
+
+// validateTLS checks the settings of the TLS certificates.
+func (c *Configuration) validateTLS(p *problems) {
+	tls := c.TLSConfig
+	p.check((tls.Certificate == "") == (tls.Key == ""), "TLS_CERTIFICATE", "must be set together with TLS_KEY")
+	p.check(tls.ClientCA == "" || tls.Certificate != "", "TLS_CLIENT_CA", "requires TLS_CERTIFICATE and TLS_KEY")
+	p.check(tls.ClientAuth == "require" || tls.ClientAuth == "verify_if_given",
+		"TLS_CLIENT_AUTH", "must be \"require\" or \"verify_if_given\", got %q", tls.ClientAuth)
+	p.check(tls.MinVersion == "1.2" || tls.MinVersion == "1.3",
+		"TLS_MIN_VERSION", "must be \"1.2\" or \"1.3\", got %q", tls.MinVersion)
+	p.check(!tls.HTTPEnabled || tls.Certificate != "", "TLS_HTTP_ENABLED", "requires TLS_CERTIFICATE and TLS_KEY")
+	p.check(tls.ReloadInterval >= 0, "TLS_RELOAD_INTERVAL_SECONDS", "must not be negative")
+}
+
+// validateRateLimit checks the settings of the rate limits.
+func (c *Configuration) validateRateLimit(p *problems) {
+	store := c.RateLimit.Store
+	p.check(store == "memory" || store == "postgres" || store == "nats",
+		"RATE_LIMIT_STORE", "must be \"memory\", \"postgres\" or \"nats\", got %q", store)
+	_, err := ratelimit.NewPolicies(c.RateLimit.Default, c.RateLimit.Policies)
+	p.check(err == nil, "RATE_LIMIT_POLICIES", "%v", err)
+}
+
+// validLogLevel reports whether the level is a slog level name.
+func validLogLevel(level string) bool {
+	var l slog.Level
+	return l.UnmarshalText([]byte(level)) == nil
+}
+
+// validDSN reports whether the DSN is a Postgres URL or a list of key=value pairs.
+func validDSN(dsn string) bool {
+	if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+		return validURL(dsn)
+	}
+	return strings.Contains(dsn, "=")
+}
+
+// validURL reports whether the value is an absolute URL with a host.
+func validURL(value string) bool {
+	u, err := url.Parse(value)
+	return err == nil && u.Scheme != "" && u.Host != ""
+}
+
+// validServers reports whether the value is a comma separated list of URLs, as accepted by the NATS client.
+func validServers(value string) bool {
+	for _, server := range strings.Split(value, ",") {
+		if !validURL(strings.TrimSpace(server)) {
+			return false
+		}
+	}
+	return true
+}
+
+// validMetricsPort reports whether the value is a TCP port number or zero.
+func validMetricsPort(port int) bool {
+	return port >= 0 && port <= 65535
+}
+
+// validPort reports whether the value is a TCP port number.
+func validPort(port string) bool {
+	p, err := strconv.Atoi(port)
+	return err == nil && p > 0 && p <= 65535
+}
@@ -1,19 +1,29 @@
 package dependency
 
-import "sync"
+import (
+	"sync"
+	"sync/atomic"
+)
 
 // LazyDependency encapsulates lazy initialization logic for any type T.
 // It initializes dependencies only upon first access.
 type LazyDependency[T any] struct {
-	once     sync.Once // Ensures initialization only happens once
-	value    T         // Holds the lazily initialized value
-	InitFunc func() T  // Initialization function for the dependency
+	once        sync.Once   // Ensures initialization only happens once
+	initialized atomic.Bool // Whether the value was initialized
+	value       T           // Holds the lazily initialized value
+	InitFunc    func() T    // Initialization function for the dependency
 }
 
 // Get initializes the dependency on the first call and returns it thereafter.
 func (l *LazyDependency[T]) Get() T {
 	l.once.Do(func() {
 		l.value = l.InitFunc()
+		l.initialized.Store(true)
 	})
 	return l.value
 }
+
+// Initialized reports whether the dependency was initialized, e.g., so that only resources in use are released.
+func (l *LazyDependency[T]) Initialized() bool {
+	return l.initialized.Load()
+}
@@ -1,45 +1,92 @@
 package application
 
 import (
+	"application/audit"
 	"application/config"
 	"application/dependency"
+	"application/feature"
+	"application/lifecycle"
+	"application/metrics"
+	"application/ratelimit"
+	"application/requestid"
+	"application/tracing"
+	"application/vacancy"
+	"context"
+	diAudit "domain/audit"
 	diAuth "domain/auth"
 	diHealthcheck "domain/healthcheck"
+	diOrganization "domain/organization"
+	diRole "domain/role"
+	diSigning "domain/signing"
 	diVacancy "domain/vacancy"
 	diInfrastructure "infrastructure"
+	"infrastructure/certs"
 	"infrastructure/database"
+	"infrastructure/migrations"
+	infraTracing "infrastructure/tracing"
 	diInterfaces "interfaces"
 	"interfaces/api/utils"
 	"log"
 	"log/slog"
 	"os"
+	"path/filepath"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// Names of the shared components registered by RegisterLifecycle, which the servers of the process depend on.
+const (
+	ComponentConfig       = "config"
+	ComponentCertificates = "certificates"
+	ComponentTracer       = "tracer"
+	ComponentDatabase     = "database"
+	ComponentNats         = "nats"
+)
+
 // Container is a struct that holds all the dependencies for the application.
 // It acts as a central registry for services, ensuring that dependencies are managed in a lazy loaded manner.
 type Container struct {
 	Config                  dependency.LazyDependency[*config.Configuration]
+	Reloader                dependency.LazyDependency[*config.Reloader]
+	LogLevel                *slog.LevelVar // Minimum level of the logs, changed when the configuration is reloaded.
+	Features                dependency.LazyDependency[*feature.Flags]
 	DB                      dependency.LazyDependency[*pgxpool.Pool]
 	Handler                 dependency.LazyDependency[*utils.Handler]
 	Errors                  dependency.LazyDependency[*utils.Errors]
+	Metrics                 dependency.LazyDependency[*metrics.Registry]
+	Tracer                  dependency.LazyDependency[*tracing.Tracer]
 	InfrastructureContainer dependency.LazyDependency[*diInfrastructure.Container]
 	InterfacesContainer     dependency.LazyDependency[*diInterfaces.Container]
 	HealthCheckContainer    dependency.LazyDependency[*diHealthcheck.Container]
+	AuditContainer          dependency.LazyDependency[*diAudit.Container]
+	RoleContainer           dependency.LazyDependency[*diRole.Container]
+	OrganizationContainer   dependency.LazyDependency[*diOrganization.Container]
+	SigningContainer        dependency.LazyDependency[*diSigning.Container]
 	JwtAuthContainer        dependency.LazyDependency[*diAuth.Container]
 	VacancyContainer        dependency.LazyDependency[*diVacancy.Container]
 }
 
-// NewContainer creates and returns a new instance of Container.
-// Each dependency is configured to initialize only when first accessed.
-func NewContainer() *Container {
-	container := &Container{}
-	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+// NewContainer creates and returns a new instance of Container using the configuration loaded by the loader, which
+// reloads it on SIGHUP. Each dependency is configured to initialize only when first accessed.
+func NewContainer(loader *config.Loader, cfg *config.Configuration) *Container {
+	container := &Container{LogLevel: new(slog.LevelVar)}
+	_ = container.LogLevel.UnmarshalText([]byte(cfg.LogLevel))
+	logger := slog.New(requestid.NewLogHandler(slog.NewTextHandler(os.Stdout,
+		&slog.HandlerOptions{Level: container.LogLevel})))
 
 	// Create container with base dependencies
 	container.Config = dependency.LazyDependency[*config.Configuration]{
-		InitFunc: config.LoadConfig,
+		InitFunc: func() *config.Configuration { return cfg },
+	}
+	container.Reloader = dependency.LazyDependency[*config.Reloader]{
+		InitFunc: func() *config.Reloader {
+			reloader := config.NewReloader(loader, logger)
+			reloader.OnReload(container.applySettings)
+			return reloader
+		},
+	}
+	container.Features = dependency.LazyDependency[*feature.Flags]{
+		InitFunc: func() *feature.Flags { return feature.NewFlags(container.Config.Get().Features) },
 	}
 	container.Handler = dependency.LazyDependency[*utils.Handler]{
 		InitFunc: utils.NewHandler,
@@ -50,6 +97,25 @@ func NewContainer() *Container {
 		},
 	}
 
+	container.Metrics = dependency.LazyDependency[*metrics.Registry]{
+		InitFunc: metrics.NewRegistry,
+	}
+
+	container.Tracer = dependency.LazyDependency[*tracing.Tracer]{
+		InitFunc: func() *tracing.Tracer {
+			cfg := container.Config.Get().Tracing
+			exporter, err := infraTracing.NewExporter(cfg.Exporter, cfg.File, cfg.Endpoint)
+			if err != nil {
+				log.Fatalf("Failed to initialize tracing: %v", err)
+			}
+			service := cfg.ServiceName
+			if service == "" {
+				service = filepath.Base(os.Args[0])
+			}
+			return tracing.NewTracer(service, exporter, tracing.RatioSampler(cfg.SampleRatio))
+		},
+	}
+
 	// Database
 	container.DB = dependency.LazyDependency[*pgxpool.Pool]{
 		InitFunc: func() *pgxpool.Pool {
@@ -57,6 +123,7 @@ func NewContainer() *Container {
 			if err != nil {
 				log.Fatalf("Failed to initialize database: %v", err)
 			}
+			database.RegisterPoolMetrics(container.Metrics.Get(), "application", db)
 			return db
 		},
 	}
@@ -64,12 +131,20 @@ func NewContainer() *Container {
 	// Domain/layer containers
 	container.InfrastructureContainer = dependency.LazyDependency[*diInfrastructure.Container]{
 		InitFunc: func() *diInfrastructure.Container {
-			return diInfrastructure.NewContainer(container.Config.Get())
+			return diInfrastructure.NewContainer(container.Config.Get(), container.Metrics.Get())
 		},
 	}
 	container.InterfacesContainer = dependency.LazyDependency[*diInterfaces.Container]{
 		InitFunc: func() *diInterfaces.Container {
-			return diInterfaces.NewContainer(container.Config.Get(), container.Errors.Get())
+			return diInterfaces.NewContainer(
+				container.Config.Get(),
+				container.SigningContainer.Get().Verifier.Get(),
+				container.AuditContainer.Get().AuditService.Get(),
+				container.Metrics.Get(),
+				container.InfrastructureContainer.Get().RateLimiter.Get(),
+				container.InfrastructureContainer.Get().Idempotency.Get(),
+				container.Features.Get(),
+				container.Errors.Get())
 		},
 	}
 	container.HealthCheckContainer = dependency.LazyDependency[*diHealthcheck.Container]{
@@ -77,6 +152,47 @@ func NewContainer() *Container {
 			return diHealthcheck.NewContainer(
 				container.Config.Get(),
 				container.Handler.Get(),
+				container.Errors.Get(),
+				database.PingChecker(container.DB.Get()),
+				database.MigrationChecker(container.DB.Get(), migrations.LatestVersion()),
+				container.InfrastructureContainer.Get().NatsDispatcher.Get().Checker())
+		},
+	}
+	container.AuditContainer = dependency.LazyDependency[*diAudit.Container]{
+		InitFunc: func() *diAudit.Container {
+			var opts []audit.Option
+			if container.Config.Get().Audit.Publish {
+				opts = append(opts, audit.WithDispatcher(container.InfrastructureContainer.Get().EventDispatcher.Get()))
+			}
+			return diAudit.NewContainer(container.DB.Get(), container.Handler.Get(), container.Errors.Get(), opts...)
+		},
+	}
+	container.RoleContainer = dependency.LazyDependency[*diRole.Container]{
+		InitFunc: func() *diRole.Container {
+			return diRole.NewContainer(
+				container.DB.Get(),
+				container.AuditContainer.Get().AuditService.Get(),
+				container.Handler.Get(),
+				container.Errors.Get())
+		},
+	}
+	container.OrganizationContainer = dependency.LazyDependency[*diOrganization.Container]{
+		InitFunc: func() *diOrganization.Container {
+			return diOrganization.NewContainer(
+				container.DB.Get(),
+				container.AuditContainer.Get().AuditService.Get(),
+				container.Handler.Get(),
+				container.Errors.Get())
+		},
+	}
+	container.SigningContainer = dependency.LazyDependency[*diSigning.Container]{
+		InitFunc: func() *diSigning.Container {
+			return diSigning.NewContainer(
+				container.Config.Get(),
+				container.DB.Get(),
+				container.AuditContainer.Get().AuditService.Get(),
+				container.RoleContainer.Get().RoleService.Get(),
+				container.Handler.Get(),
 				container.Errors.Get())
 		},
 	}
@@ -84,19 +200,114 @@ func NewContainer() *Container {
 		InitFunc: func() *diAuth.Container {
 			return diAuth.NewContainer(
 				container.Config.Get(),
+				container.RoleContainer.Get().RoleService.Get(),
+				container.AuditContainer.Get().AuditService.Get(),
 				container.Handler.Get(),
 				container.Errors.Get())
 		},
 	}
 	container.VacancyContainer = dependency.LazyDependency[*diVacancy.Container]{
 		InitFunc: func() *diVacancy.Container {
-			return diVacancy.NewContainer(
+			c := diVacancy.NewContainer(
+				container.Config.Get(),
 				container.DB.Get(),
 				container.InfrastructureContainer.Get().EventDispatcher.Get(),
+				container.InfrastructureContainer.Get().EventSubscriber.Get(),
+				container.OrganizationContainer.Get().OrganizationService.Get(),
+				container.AuditContainer.Get().AuditService.Get(),
 				container.Handler.Get(),
 				container.Errors.Get())
+			vacancy.RegisterMetrics(container.Metrics.Get(), c.VacancyService.Get())
+			return c
 		},
 	}
 
 	return container
 }
+
+// applySettings applies the settings of a reloaded configuration which are safe to change while running.
+func (c *Container) applySettings(cfg *config.Configuration) {
+	_ = c.LogLevel.UnmarshalText([]byte(cfg.LogLevel))
+	if c.Features.Initialized() {
+		c.Features.Get().Set(cfg.Features)
+	}
+	if c.Tracer.Initialized() {
+		c.Tracer.Get().SetSampler(tracing.RatioSampler(cfg.Tracing.SampleRatio))
+	}
+	if c.InfrastructureContainer.Initialized() && c.InfrastructureContainer.Get().RateLimiter.Initialized() {
+		policies, _ := ratelimit.NewPolicies(cfg.RateLimit.Default, cfg.RateLimit.Policies) // Checked by Validate
+		c.InfrastructureContainer.Get().RateLimiter.Get().SetPolicies(policies)
+	}
+	if c.InfrastructureContainer.Initialized() && c.InfrastructureContainer.Get().Idempotency.Initialized() {
+		c.InfrastructureContainer.Get().Idempotency.Get().SetTTL(cfg.Idempotency.TTL)
+	}
+	if certificates := c.certificates(); certificates != nil {
+		if err := certificates.Reload(); err != nil {
+			slog.Error("Failed to reload TLS certificate", "error", err)
+		}
+	}
+}
+
+// certificates returns the TLS certificate manager if a server of the process uses it, or nil.
+func (c *Container) certificates() *certs.Manager {
+	if !c.InfrastructureContainer.Initialized() || !c.InfrastructureContainer.Get().Certificates.Initialized() {
+		return nil
+	}
+	return c.InfrastructureContainer.Get().Certificates.Get()
+}
+
+// RegisterLifecycle registers the shared resources of the container with the supervisor, so they are released once
+// the servers depending on them have stopped. Only the resources initialized by the process are released. The
+// configuration and the TLS certificate are reloaded on SIGHUP while the supervisor runs, and the certificate
+// also when its files change.
+func (c *Container) RegisterLifecycle(s *lifecycle.Supervisor) {
+	s.Register(lifecycle.Hook{
+		Name:  ComponentConfig,
+		Start: c.Reloader.Get().Start,
+		Stop:  c.Reloader.Get().Stop,
+	})
+	s.Register(lifecycle.Hook{
+		Name: ComponentCertificates,
+		Start: func(ctx context.Context) error {
+			if certificates := c.certificates(); certificates != nil {
+				return certificates.Start(ctx)
+			}
+			return nil
+		},
+		Stop: func(ctx context.Context) error {
+			if certificates := c.certificates(); certificates != nil {
+				return certificates.Stop(ctx)
+			}
+			return nil
+		},
+	})
+	s.Register(lifecycle.Hook{
+		Name: ComponentTracer,
+		Stop: func(ctx context.Context) error {
+			return c.Tracer.Get().Shutdown(ctx)
+		},
+	})
+	s.Register(lifecycle.Hook{
+		Name:      ComponentDatabase,
+		DependsOn: []string{ComponentTracer},
+		Stop: func(context.Context) error {
+			if c.DB.Initialized() {
+				c.DB.Get().Close()
+			}
+			if c.InfrastructureContainer.Initialized() && c.InfrastructureContainer.Get().DB.Initialized() {
+				c.InfrastructureContainer.Get().DB.Get().Close()
+			}
+			return nil
+		},
+	})
+	s.Register(lifecycle.Hook{
+		Name:      ComponentNats,
+		DependsOn: []string{ComponentTracer},
+		Stop: func(ctx context.Context) error {
+			if !c.InfrastructureContainer.Initialized() || !c.InfrastructureContainer.Get().NatsDispatcher.Initialized() {
+				return nil
+			}
+			return c.InfrastructureContainer.Get().NatsDispatcher.Get().Close(ctx)
+		},
+	})
+}
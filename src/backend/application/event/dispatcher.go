@@ -1,10 +1,18 @@
 package event
 
-import "domain"
+import (
+	"context"
+	"domain"
+)
 
 // Dispatcher defines an interface for dispatching events that occur within the domain.
 type Dispatcher interface {
 	// Dispatch sends a domain event to the appropriate subscribers.
+	// The context carries request scoped values, e.g., the request ID, propagated with the event.
 	// It returns an error if the dispatching fails.
-	Dispatch(event domain.Event) error
+	Dispatch(ctx context.Context, event domain.Event) error
+
+	// DispatchBatch sends the domain events of a batch operation to the appropriate subscribers at once.
+	// It returns an error if the dispatching of any event fails.
+	DispatchBatch(ctx context.Context, events []domain.Event) error
 }
@@ -0,0 +1,12 @@
+package event
+
+// Handler handles an event received by a subscriber, given its type and its payload as published.
+type Handler func(eventType string, payload []byte)
+
+// Subscriber defines an interface for receiving the events dispatched within the domain, e.g., by other instances
+// of the application.
+type Subscriber interface {
+	// Subscribe calls the handler with every event dispatched from now on, one at a time.
+	// It returns a function cancelling the subscription, or an error if subscribing fails.
+	Subscribe(handler Handler) (unsubscribe func() error, err error)
+}
@@ -0,0 +1,36 @@
+package feature
+
+import (
+	"application/config"
+	"sync/atomic"
+)
+
+// Features switched on or off by the flags.
+const (
+	Import = "import" // Import of vacancies from files.
+	Feeds  = "feeds"  // RSS and Atom feeds of vacancies and their tokens.
+	Stream = "stream" // Event stream of vacancy changes.
+)
+
+// Flags holds the feature flags of the process, which may be switched while running, e.g., when the configuration
+// is reloaded.
+type Flags struct {
+	enabled atomic.Pointer[map[string]bool]
+}
+
+// NewFlags creates Flags switched as configured.
+func NewFlags(c config.FeatureConfig) *Flags {
+	f := &Flags{}
+	f.Set(c)
+	return f
+}
+
+// Set switches the flags as configured.
+func (f *Flags) Set(c config.FeatureConfig) {
+	f.enabled.Store(&map[string]bool{Import: c.Import, Feeds: c.Feeds, Stream: c.Stream})
+}
+
+// Enabled reports whether the feature is switched on.
+func (f *Flags) Enabled(feature string) bool {
+	return (*f.enabled.Load())[feature]
+}
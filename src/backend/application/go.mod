@@ -7,6 +7,7 @@ require github.com/julienschmidt/httprouter v1.3.0
 require (
 	github.com/golang-jwt/jwt/v5 v5.2.1
 	github.com/jackc/pgx/v5 v5.7.1
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
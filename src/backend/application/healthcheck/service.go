@@ -2,35 +2,116 @@ package healthcheck
 
 import (
 	"application/config"
-	"interfaces/api/healthcheck/dto"
+	"context"
+	"domain/healthcheck/entity"
+	"errors"
 	"runtime/debug"
+	"sync"
 	"time"
 )
 
+// Checker verifies that a dependency of the application, e.g., the database, is available.
+type Checker struct {
+	Name  string                          // Name of the component reported in the health check.
+	Check func(ctx context.Context) error // Returns an error if the component is unavailable.
+}
+
+// cachedCheck runs a checker and reuses its result until it expires.
+type cachedCheck struct {
+	Checker
+	mu      sync.Mutex        // Serializes the runs of the check, so concurrent probes share one result.
+	result  *entity.Component // Result of the last run, nil before the first one.
+	expires time.Time         // Time after which the check is run again.
+}
+
 // Service provides health check details.
 type Service struct {
 	config *config.Configuration
+	checks []*cachedCheck
 }
 
-// NewService creates a new instance of Service.
-func NewService(c *config.Configuration) *Service {
-	return &Service{config: c}
+// NewService creates a new instance of Service running the given checkers for readiness.
+func NewService(c *config.Configuration, checkers ...Checker) *Service {
+	checks := make([]*cachedCheck, len(checkers))
+	for i, checker := range checkers {
+		checks[i] = &cachedCheck{Checker: checker}
+	}
+	return &Service{config: c, checks: checks}
 }
 
-// Process populates the response with health check details.
-func (s *Service) Process(r *dto.Response) {
-	timestamp := time.Now().UTC().Format(time.DateTime)
-	status := "available"
+// Live returns the health of the process itself, without checking its dependencies.
+func (s *Service) Live() *entity.HealthCheck {
+	return s.newHealthCheck()
+}
+
+// Ready returns the health of the application including the status of each of its dependencies.
+// The checks run concurrently, each bounded by the configured timeout, and their results are cached for the
+// configured duration. The application is unavailable if any of them fails.
+func (s *Service) Ready(ctx context.Context) *entity.HealthCheck {
+	components := make([]*entity.Component, len(s.checks))
+	var wg sync.WaitGroup
+	for i, check := range s.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			components[i] = s.run(ctx, check)
+		}()
+	}
+	wg.Wait()
+
+	h := s.newHealthCheck()
+	for _, c := range components {
+		h.AddComponent(c)
+	}
+	return h
+}
+
+// run returns the cached result of the check, running it if the result expired.
+func (s *Service) run(ctx context.Context, check *cachedCheck) *entity.Component {
+	check.mu.Lock()
+	defer check.mu.Unlock()
+	if check.result != nil && time.Now().Before(check.expires) {
+		return check.result
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.config.Health.Timeout)
+	defer cancel()
+	start := time.Now()
+	err := check.Check(ctx)
+	if err == nil && ctx.Err() != nil {
+		err = ctx.Err()
+	}
+
+	result := (&entity.Component{}).
+		SetName(check.Name).
+		SetStatus(entity.ComponentUp).
+		SetLatency(time.Since(start)).
+		SetCheckedAt(start.UTC())
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			err = errors.New("check timed out")
+		}
+		result.SetStatus(entity.ComponentDown).SetError(err.Error())
+	}
+
+	// A check canceled by the caller says nothing about the component, so its result is not cached
+	if !errors.Is(ctx.Err(), context.Canceled) {
+		check.result, check.expires = result, start.Add(s.config.Health.CacheTTL)
+	}
+	return result
+}
 
-	// Populate the response with health check information
-	r.Status = &status
-	r.SystemInfo.Environment = &s.config.Env
-	r.SystemInfo.Version = s.getRevision()
-	r.Timestamp = &timestamp
+// newHealthCheck returns an available health check of the running application.
+func (s *Service) newHealthCheck() *entity.HealthCheck {
+	return (&entity.HealthCheck{}).
+		SetStatus(entity.StatusAvailable).
+		SetEnvironment(s.config.Env).
+		SetVersion(s.getRevision()).
+		SetCheckedAt(time.Now().UTC())
 }
 
 // getRevision retrieves the VCS revision, if available.
-func (s *Service) getRevision() *string {
+func (s *Service) getRevision() string {
 	revision := "unknown"
 	if info, ok := debug.ReadBuildInfo(); ok {
 		for _, setting := range info.Settings {
@@ -41,5 +122,5 @@ func (s *Service) getRevision() *string {
 		}
 	}
 
-	return &revision
+	return revision
 }
@@ -0,0 +1,128 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// Headers and metadata keys of idempotent requests, in HTTP requests and gRPC metadata.
+const (
+	HeaderKey      = "Idempotency-Key"     // Key chosen by the client, identifying a request and its retries.
+	HeaderReplayed = "Idempotent-Replayed" // Set to "true" on the responses replayed to retries.
+)
+
+// MaxKeyLength is the length of the longest idempotency key accepted.
+const MaxKeyLength = 255
+
+// lockTimeout is the time a request is held in progress at most, after which it is deemed abandoned, e.g., by a
+// crashed instance, and retries are processed again.
+const lockTimeout = time.Minute
+
+var (
+	// ErrKeyReused is returned when the idempotency key of a request was used by another request.
+	ErrKeyReused = errors.New("the idempotency key was already used with another request")
+
+	// ErrInProgress is returned when the request with the idempotency key is still in progress.
+	ErrInProgress = errors.New("the request with the idempotency key is still in progress")
+)
+
+// Service makes requests idempotent: the response of a request sent with an idempotency key is stored for the
+// TTL and replayed to its retries, so a client retrying a request that timed out does not apply it twice.
+type Service struct {
+	store Store
+	ttl   atomic.Int64 // Time the responses are kept, in nanoseconds.
+	now   func() time.Time
+}
+
+// NewService creates a new Service keeping the responses in the store for the given TTL.
+func NewService(store Store, ttl time.Duration) *Service {
+	s := &Service{store: store, now: time.Now}
+	s.SetTTL(ttl)
+	return s
+}
+
+// SetTTL replaces the time the responses are kept, e.g., when the configuration is reloaded. Responses already
+// stored keep their expiration.
+func (s *Service) SetTTL(ttl time.Duration) {
+	s.ttl.Store(int64(ttl))
+}
+
+// Begin begins the request with the scoped key (see Key) and fingerprint (see Fingerprint). It returns the response
+// of the completed request with the key, to replay instead of processing the request again, or nil if the request
+// should be processed, in which case the caller must Complete or Release the returned lock.
+// Returns ErrKeyReused if the key was used by a request with another fingerprint, or ErrInProgress if the request
+// with the key is still in progress.
+func (s *Service) Begin(ctx context.Context, key, fingerprint string) (Lock, *Response, error) {
+	owner := make([]byte, 16)
+	_, _ = rand.Read(owner) // crypto/rand.Read never returns an error
+	lock := Lock{Key: key, Owner: hex.EncodeToString(owner)}
+
+	now := s.now()
+	r, err := s.store.Begin(ctx, lock, fingerprint, now, now.Add(min(s.TTL(), lockTimeout)))
+	switch {
+	case err != nil:
+		return Lock{}, nil, err
+	case r == nil:
+		return lock, nil, nil
+	case r.Fingerprint != fingerprint:
+		return Lock{}, nil, ErrKeyReused
+	case r.Response == nil:
+		return Lock{}, nil, ErrInProgress
+	}
+	return Lock{}, r.Response, nil
+}
+
+// Complete stores the response of the request holding the lock for the TTL. The response is dropped if the lock
+// expired and a retry took the key over.
+func (s *Service) Complete(ctx context.Context, lock Lock, response Response) error {
+	return s.store.Complete(ctx, lock, response, s.now().Add(s.TTL()))
+}
+
+// Release releases the request holding the lock without storing its response, e.g., after a server error, so it
+// may be retried. A retry that took the key over keeps it.
+func (s *Service) Release(ctx context.Context, lock Lock) error {
+	return s.store.Release(ctx, lock)
+}
+
+// TTL returns the time the responses are kept.
+func (s *Service) TTL() time.Duration {
+	return time.Duration(s.ttl.Load())
+}
+
+// ValidKey reports whether the idempotency key sent by a client is usable: from 1 to MaxKeyLength printable ASCII
+// characters.
+func ValidKey(key string) bool {
+	if key == "" || len(key) > MaxKeyLength {
+		return false
+	}
+	for i := 0; i < len(key); i++ {
+		if key[i] < ' ' || key[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// Key scopes the idempotency key sent by a client to the operation and the client, so clients cannot replay the
+// responses of each other, e.g., "POST /v1/vacancies|subject:pulse-finder-bot|<key>".
+func Key(operation, subject, key string) string {
+	return operation + "|subject:" + subject + "|" + key
+}
+
+// Fingerprint returns the fingerprint of a request made of the given parts, e.g., its path and body, telling its
+// retries from other requests reusing its idempotency key.
+func Fingerprint(parts ...[]byte) string {
+	h := sha256.New()
+	for _, part := range parts {
+		// Prefix each part with its length, so moving bytes from one part to the next changes the fingerprint
+		_, _ = fmt.Fprintf(h, "%d:", len(part))
+		_, _ = h.Write(part)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
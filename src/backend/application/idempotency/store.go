@@ -0,0 +1,44 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+)
+
+// Store holds the requests sent with an idempotency key, along with their responses, until they expire. Stores
+// shared by several instances recognize the retries sent to any of them.
+type Store interface {
+	// Begin records the request with the key and fingerprint as in progress until expiresAt, held by the owner of
+	// the lock, unless the key holds a request that has not expired at the given time, which is returned instead.
+	// Returns nil if the request was recorded.
+	Begin(ctx context.Context, lock Lock, fingerprint string, now, expiresAt time.Time) (*Request, error)
+
+	// Complete stores the response of the request in progress with the key, keeping it until expiresAt, unless
+	// another owner took the key over.
+	Complete(ctx context.Context, lock Lock, response Response, expiresAt time.Time) error
+
+	// Release deletes the request in progress with the key, so it may be retried, unless another owner took the key
+	// over.
+	Release(ctx context.Context, lock Lock) error
+}
+
+// Lock is held by a request in progress with an idempotency key, which must complete or release it. Its owner tells
+// the request from a retry taking the key over once the lock expired, e.g., while the request was slow, so the
+// former cannot overwrite or delete the request of the latter.
+type Lock struct {
+	Key   string // Scoped idempotency key of the request (see Key).
+	Owner string // Random token of the request holding the key.
+}
+
+// Request is a request stored with its idempotency key.
+type Request struct {
+	Fingerprint string    // Hash of the request, telling its retries from other requests reusing the key.
+	Response    *Response // Response of the request, nil while it is in progress.
+}
+
+// Response is the response of a request, replayed to its retries.
+type Response struct {
+	Status  int               // HTTP status, or gRPC status code.
+	Headers map[string]string // Headers of the response replayed with it, e.g., Location.
+	Body    []byte            // Body of the response, or encoded status of a gRPC error.
+}
@@ -0,0 +1,44 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+)
+
+// RegisterHTTPServer registers an HTTP server as a component. The listener is bound on start, so an unavailable
+// port fails the startup, and the pending requests are drained on stop; connections still open at the deadline
+// are closed at once. A server with a TLS configuration serves TLS, its certificate being provided by the
+// configuration.
+func (s *Supervisor) RegisterHTTPServer(name string, srv *http.Server, dependsOn ...string) {
+	s.Register(Hook{
+		Name:      name,
+		DependsOn: dependsOn,
+		Start: func(context.Context) error {
+			listener, err := net.Listen("tcp", srv.Addr)
+			if err != nil {
+				return err
+			}
+			s.logger.Info("Starting server", "component", name, "address", listener.Addr().String(),
+				"tls", srv.TLSConfig != nil)
+			s.Go(name, func() error {
+				if err := serve(srv, listener); !errors.Is(err, http.ErrServerClosed) {
+					return err
+				}
+				return nil
+			})
+			return nil
+		},
+		Stop:  srv.Shutdown,
+		Force: func() { _ = srv.Close() },
+	})
+}
+
+// serve accepts the connections of the listener, over TLS if the server has a TLS configuration.
+func serve(srv *http.Server, listener net.Listener) error {
+	if srv.TLSConfig != nil {
+		return srv.ServeTLS(listener, "", "")
+	}
+	return srv.Serve(listener)
+}
@@ -0,0 +1,193 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Hook describes a component of the application managed by the Supervisor, e.g., a server or a connection pool.
+type Hook struct {
+	Name      string                          // Unique name of the component, used in logs and dependencies.
+	DependsOn []string                        // Components started before this one and stopped after it.
+	Start     func(ctx context.Context) error // Starts the component and returns once it is ready; optional.
+	Stop      func(ctx context.Context) error // Stops the component gracefully before the context expires; optional.
+	Force     func()                          // Stops the component at once when Stop misses the deadline; optional.
+}
+
+// Supervisor starts the registered components in dependency order and stops them in reverse order, within an
+// overall deadline. Components running in the background report their failure with Go, which stops the application.
+type Supervisor struct {
+	logger  *slog.Logger
+	timeout time.Duration // Overall deadline of the shutdown.
+	hooks   []Hook        // Registered hooks, in registration order.
+	started []Hook        // Started hooks, in start order.
+	failed  chan error    // Receives the first failure of a background component.
+	once    sync.Once     // Ensures only the first failure is reported.
+}
+
+// NewSupervisor creates a Supervisor logging to the given logger and stopping the components within the timeout.
+func NewSupervisor(logger *slog.Logger, timeout time.Duration) *Supervisor {
+	return &Supervisor{logger: logger, timeout: timeout, failed: make(chan error, 1)}
+}
+
+// Register adds a component to the supervisor. It must be called before Start.
+func (s *Supervisor) Register(h Hook) {
+	s.hooks = append(s.hooks, h)
+}
+
+// Go runs a blocking function of a component in the background, e.g., the serve loop of a server. An error
+// returned by the function makes Run stop the application.
+func (s *Supervisor) Go(name string, run func() error) {
+	go func() {
+		if err := run(); err != nil {
+			s.once.Do(func() { s.failed <- fmt.Errorf("%s: %w", name, err) })
+		}
+	}()
+}
+
+// Run starts the components, waits until the context is canceled (e.g., by a termination signal) or a background
+// component fails, and stops the components. It returns the failure that stopped the application, if any, joined
+// with the errors of the shutdown.
+func (s *Supervisor) Run(ctx context.Context) error {
+	if err := s.Start(ctx); err != nil {
+		return errors.Join(err, s.Stop())
+	}
+
+	var cause error
+	select {
+	case <-ctx.Done():
+		s.logger.Info("Shutdown requested")
+	case cause = <-s.failed:
+		s.logger.Error("Component failed, shutting down", "error", cause)
+	}
+	return errors.Join(cause, s.Stop())
+}
+
+// Start starts the components in dependency order. If a component fails to start, the components already
+// started are left running and the error is returned; Stop stops them.
+func (s *Supervisor) Start(ctx context.Context) error {
+	ordered, err := s.order()
+	if err != nil {
+		return err
+	}
+
+	for _, h := range ordered {
+		if h.Start != nil {
+			start := time.Now()
+			if err = h.Start(ctx); err != nil {
+				return fmt.Errorf("failed to start %s: %w", h.Name, err)
+			}
+			s.logger.Info("Component started", "component", h.Name, "duration", time.Since(start))
+		}
+		s.started = append(s.started, h)
+	}
+	return nil
+}
+
+// Stop stops the started components in reverse order within the overall deadline. Once the deadline has passed,
+// the components still running, and those not yet stopped, are stopped at once by their Force function.
+func (s *Supervisor) Stop() error {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	var errs []error
+	for i := len(s.started) - 1; i >= 0; i-- {
+		h := s.started[i]
+		if err := s.stop(ctx, h); err != nil {
+			errs = append(errs, fmt.Errorf("failed to stop %s: %w", h.Name, err))
+		}
+	}
+	s.started = nil
+	return errors.Join(errs...)
+}
+
+// stop stops a component, forcing it if the deadline passes first.
+func (s *Supervisor) stop(ctx context.Context, h Hook) error {
+	if h.Stop == nil {
+		return nil
+	}
+	if ctx.Err() != nil {
+		return s.force(h)
+	}
+
+	start := time.Now()
+	done := make(chan error, 1)
+	go func() { done <- h.Stop(ctx) }()
+
+	select {
+	case err := <-done:
+		switch {
+		case err == nil:
+			s.logger.Info("Component stopped", "component", h.Name, "duration", time.Since(start))
+			return nil
+		case errors.Is(err, context.DeadlineExceeded):
+			return s.force(h)
+		default:
+			return err
+		}
+	case <-ctx.Done():
+		return s.force(h)
+	}
+}
+
+// force stops a component at once after the deadline passed.
+func (s *Supervisor) force(h Hook) error {
+	s.logger.Warn("Shutdown deadline exceeded, forcing stop", "component", h.Name, "timeout", s.timeout)
+	if h.Force != nil {
+		h.Force()
+	}
+	return context.DeadlineExceeded
+}
+
+// order returns the hooks sorted so that every hook follows its dependencies, keeping the registration order
+// otherwise. It fails on unknown dependencies and on cycles.
+func (s *Supervisor) order() ([]Hook, error) {
+	byName := make(map[string]Hook, len(s.hooks))
+	for _, h := range s.hooks {
+		if _, ok := byName[h.Name]; ok {
+			return nil, fmt.Errorf("component %s is registered twice", h.Name)
+		}
+		byName[h.Name] = h
+	}
+
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := make(map[string]int, len(s.hooks))
+	ordered := make([]Hook, 0, len(s.hooks))
+
+	var visit func(h Hook) error
+	visit = func(h Hook) error {
+		switch state[h.Name] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("dependency cycle involving component %s", h.Name)
+		}
+		state[h.Name] = visiting
+		for _, name := range h.DependsOn {
+			dependency, ok := byName[name]
+			if !ok {
+				return fmt.Errorf("component %s depends on unknown component %s", h.Name, name)
+			}
+			if err := visit(dependency); err != nil {
+				return err
+			}
+		}
+		state[h.Name] = visited
+		ordered = append(ordered, h)
+		return nil
+	}
+
+	for _, h := range s.hooks {
+		if err := visit(h); err != nil {
+			return nil, err
+		}
+	}
+	return ordered, nil
+}
@@ -0,0 +1,74 @@
+package metrics
+
+import (
+	"bufio"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// labelSeparator joins label values into the key of a series; it cannot occur in valid UTF-8 text.
+const labelSeparator = "\xff"
+
+// helpReplacer escapes backslashes and line feeds in help texts.
+var helpReplacer = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+
+// labelReplacer escapes backslashes, double quotes and line feeds in label values.
+var labelReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// escapeHelp escapes a help text for the exposition format.
+func escapeHelp(s string) string {
+	return helpReplacer.Replace(s)
+}
+
+// seriesKey returns the key identifying the series with the given label values.
+func seriesKey(values []string) string {
+	return strings.Join(values, labelSeparator)
+}
+
+// writeSample writes a single sample line, e.g., `name{a="1",le="0.5"} 3`.
+// The extra label, if its name is not empty, is appended after the labels of the series.
+func writeSample(w *bufio.Writer, name string, labels, values []string, extraName, extraValue string, v float64) {
+	_, _ = w.WriteString(name)
+	if len(labels) > 0 || extraName != "" {
+		_ = w.WriteByte('{')
+		for i, label := range labels {
+			if i > 0 {
+				_ = w.WriteByte(',')
+			}
+			writeLabel(w, label, values[i])
+		}
+		if extraName != "" {
+			if len(labels) > 0 {
+				_ = w.WriteByte(',')
+			}
+			writeLabel(w, extraName, extraValue)
+		}
+		_ = w.WriteByte('}')
+	}
+	_ = w.WriteByte(' ')
+	_, _ = w.WriteString(formatFloat(v))
+	_ = w.WriteByte('\n')
+}
+
+// writeLabel writes a label pair, escaping its value.
+func writeLabel(w *bufio.Writer, name, value string) {
+	_, _ = w.WriteString(name)
+	_, _ = w.WriteString(`="`)
+	_, _ = w.WriteString(labelReplacer.Replace(value))
+	_ = w.WriteByte('"')
+}
+
+// formatFloat formats a sample value as expected by the exposition format.
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	default:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	}
+}
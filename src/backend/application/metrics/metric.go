@@ -0,0 +1,242 @@
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// DefaultBuckets are the upper bounds, in seconds, of the latency histograms.
+var DefaultBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// vec holds the series of a family, keyed by their label values.
+type vec[T any] struct {
+	name, help, kind string
+	labels           []string
+	mu               sync.RWMutex
+	series           map[string]*series[T]
+	newValue         func() *T
+}
+
+// series is a single labelled time series of a family.
+type series[T any] struct {
+	values []string
+	value  *T
+}
+
+// describe returns the name, help text, type and label names of the family.
+func (v *vec[T]) describe() (string, string, string, []string) {
+	return v.name, v.help, v.kind, v.labels
+}
+
+// with returns the value of the series with the given label values, creating it on first use.
+// It panics if the number of values does not match the label names, as that is a programming error.
+func (v *vec[T]) with(values []string) *T {
+	if len(values) != len(v.labels) {
+		panic(fmt.Sprintf("metrics: %s expects %d label values, got %d", v.name, len(v.labels), len(values)))
+	}
+	key := seriesKey(values)
+
+	v.mu.RLock()
+	s, ok := v.series[key]
+	v.mu.RUnlock()
+	if ok {
+		return s.value
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if s, ok = v.series[key]; !ok {
+		s = &series[T]{values: slices.Clone(values), value: v.newValue()}
+		v.series[key] = s
+	}
+	return s.value
+}
+
+// sorted returns the series of the family ordered by their label values, so the output is stable.
+func (v *vec[T]) sorted() []*series[T] {
+	v.mu.RLock()
+	list := make([]*series[T], 0, len(v.series))
+	for _, s := range v.series {
+		list = append(list, s)
+	}
+	v.mu.RUnlock()
+	sort.Slice(list, func(i, j int) bool {
+		return seriesKey(list[i].values) < seriesKey(list[j].values)
+	})
+	return list
+}
+
+// Counter is a monotonically increasing value.
+type Counter struct {
+	bits atomic.Uint64
+}
+
+// Inc increments the counter by one.
+func (c *Counter) Inc() {
+	c.Add(1)
+}
+
+// Add increments the counter by the given non-negative value.
+func (c *Counter) Add(delta float64) {
+	for {
+		old := c.bits.Load()
+		if c.bits.CompareAndSwap(old, math.Float64bits(math.Float64frombits(old)+delta)) {
+			return
+		}
+	}
+}
+
+// Value returns the current value of the counter.
+func (c *Counter) Value() float64 {
+	return math.Float64frombits(c.bits.Load())
+}
+
+// CounterVec is a counter family partitioned by labels.
+type CounterVec struct {
+	*vec[Counter]
+}
+
+// NewCounterVec registers a counter family with the given label names, or returns the one already registered
+// under the name.
+func (r *Registry) NewCounterVec(name, help string, labels ...string) *CounterVec {
+	return register(r, name, func() *CounterVec {
+		return &CounterVec{vec: newVec[Counter](name, help, typeCounter, labels)}
+	})
+}
+
+// With returns the counter of the series with the given label values.
+func (c *CounterVec) With(values ...string) *Counter {
+	return c.with(values)
+}
+
+// write writes the series of the counter family.
+func (c *CounterVec) write(w *bufio.Writer) {
+	for _, s := range c.sorted() {
+		writeSample(w, c.name, c.labels, s.values, "", "", s.value.Value())
+	}
+}
+
+// Gauge is a value that can go up and down.
+type Gauge struct {
+	bits atomic.Uint64
+}
+
+// Set sets the gauge to the given value.
+func (g *Gauge) Set(value float64) {
+	g.bits.Store(math.Float64bits(value))
+}
+
+// Value returns the current value of the gauge.
+func (g *Gauge) Value() float64 {
+	return math.Float64frombits(g.bits.Load())
+}
+
+// GaugeVec is a gauge family partitioned by labels.
+type GaugeVec struct {
+	*vec[Gauge]
+}
+
+// NewGaugeVec registers a gauge family with the given label names, or returns the one already registered
+// under the name.
+func (r *Registry) NewGaugeVec(name, help string, labels ...string) *GaugeVec {
+	return register(r, name, func() *GaugeVec {
+		return &GaugeVec{vec: newVec[Gauge](name, help, typeGauge, labels)}
+	})
+}
+
+// With returns the gauge of the series with the given label values.
+func (g *GaugeVec) With(values ...string) *Gauge {
+	return g.with(values)
+}
+
+// write writes the series of the gauge family.
+func (g *GaugeVec) write(w *bufio.Writer) {
+	for _, s := range g.sorted() {
+		writeSample(w, g.name, g.labels, s.values, "", "", s.value.Value())
+	}
+}
+
+// Histogram counts observations in cumulative buckets and tracks their sum.
+type Histogram struct {
+	mu      sync.Mutex
+	bounds  []float64
+	buckets []uint64 // Observations per bucket, not cumulative; the last one counts those above all bounds.
+	sum     float64
+	count   uint64
+}
+
+// Observe adds a single observation to the histogram.
+func (h *Histogram) Observe(value float64) {
+	i := sort.SearchFloat64s(h.bounds, value)
+	h.mu.Lock()
+	h.buckets[i]++
+	h.sum += value
+	h.count++
+	h.mu.Unlock()
+}
+
+// snapshot returns the cumulative bucket counts, the sum and the count of the observations.
+func (h *Histogram) snapshot() ([]uint64, float64, uint64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	cumulative := make([]uint64, len(h.buckets))
+	var total uint64
+	for i, n := range h.buckets {
+		total += n
+		cumulative[i] = total
+	}
+	return cumulative, h.sum, h.count
+}
+
+// HistogramVec is a histogram family partitioned by labels.
+type HistogramVec struct {
+	*vec[Histogram]
+	bounds []float64
+}
+
+// NewHistogramVec registers a histogram family with the given bucket upper bounds and label names, or returns
+// the one already registered under the name. The bounds must be sorted in increasing order.
+func (r *Registry) NewHistogramVec(name, help string, bounds []float64, labels ...string) *HistogramVec {
+	return register(r, name, func() *HistogramVec {
+		v := newVec[Histogram](name, help, typeHistogram, labels)
+		v.newValue = func() *Histogram {
+			return &Histogram{bounds: bounds, buckets: make([]uint64, len(bounds)+1)}
+		}
+		return &HistogramVec{vec: v, bounds: bounds}
+	})
+}
+
+// With returns the histogram of the series with the given label values.
+func (h *HistogramVec) With(values ...string) *Histogram {
+	return h.with(values)
+}
+
+// write writes the buckets, sum and count of every series of the histogram family.
+func (h *HistogramVec) write(w *bufio.Writer) {
+	for _, s := range h.sorted() {
+		cumulative, sum, count := s.value.snapshot()
+		for i, bound := range h.bounds {
+			writeSample(w, h.name+"_bucket", h.labels, s.values, "le", formatFloat(bound), float64(cumulative[i]))
+		}
+		writeSample(w, h.name+"_bucket", h.labels, s.values, "le", "+Inf", float64(count))
+		writeSample(w, h.name+"_sum", h.labels, s.values, "", "", sum)
+		writeSample(w, h.name+"_count", h.labels, s.values, "", "", float64(count))
+	}
+}
+
+// newVec creates an empty family of the given type.
+func newVec[T any](name, help, kind string, labels []string) *vec[T] {
+	return &vec[T]{
+		name:     name,
+		help:     help,
+		kind:     kind,
+		labels:   slices.Clone(labels),
+		series:   make(map[string]*series[T]),
+		newValue: func() *T { return new(T) },
+	}
+}
@@ -0,0 +1,110 @@
+package metrics
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// ContentType is the media type of the Prometheus text exposition format written by the registry.
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// Metric types of the exposition format.
+const (
+	typeCounter   = "counter"
+	typeGauge     = "gauge"
+	typeHistogram = "histogram"
+)
+
+// family is a named metric with a fixed set of label names, whose series are written in the exposition format.
+type family interface {
+	// describe returns the name, help text, type and label names of the family.
+	describe() (name, help, kind string, labels []string)
+	// write writes the series of the family, without the HELP and TYPE lines.
+	write(w *bufio.Writer)
+}
+
+// Registry holds metric families and writes them in the Prometheus text exposition format.
+// It is safe for concurrent use.
+type Registry struct {
+	mu       sync.Mutex
+	families map[string]family
+	hooks    []func(ctx context.Context)
+}
+
+// NewRegistry creates and returns a new, empty instance of Registry.
+func NewRegistry() *Registry {
+	return &Registry{families: make(map[string]family)}
+}
+
+// OnCollect registers a function called before every exposition, e.g., to update gauges mirroring state kept
+// elsewhere such as connection pool statistics. The context is the one of the scrape request.
+func (r *Registry) OnCollect(hook func(ctx context.Context)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.hooks = append(r.hooks, hook)
+}
+
+// register adds the family created by create under the given name, or returns the family already registered
+// under that name. It panics if the existing family differs in type or labels, as that is a programming error.
+func register[T family](r *Registry, name string, create func() T) T {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	f := create()
+	existing, ok := r.families[name]
+	if !ok {
+		r.families[name] = f
+		return f
+	}
+	_, _, kind, labels := f.describe()
+	_, _, existingKind, existingLabels := existing.describe()
+	typed, same := existing.(T)
+	if !same || kind != existingKind || !slices.Equal(labels, existingLabels) {
+		panic(fmt.Sprintf("metrics: %s is already registered with a different type or labels", name))
+	}
+	return typed
+}
+
+// Write runs the collect hooks and writes all families, sorted by name, in the Prometheus text exposition format.
+func (r *Registry) Write(ctx context.Context, w io.Writer) error {
+	r.mu.Lock()
+	hooks := slices.Clone(r.hooks)
+	r.mu.Unlock()
+	for _, hook := range hooks {
+		hook(ctx)
+	}
+
+	r.mu.Lock()
+	families := make([]family, 0, len(r.families))
+	for _, f := range r.families {
+		families = append(families, f)
+	}
+	r.mu.Unlock()
+	slices.SortFunc(families, func(a, b family) int {
+		nameA, _, _, _ := a.describe()
+		nameB, _, _, _ := b.describe()
+		return strings.Compare(nameA, nameB)
+	})
+
+	bw := bufio.NewWriter(w)
+	for _, f := range families {
+		name, help, kind, _ := f.describe()
+		_, _ = fmt.Fprintf(bw, "# HELP %s %s\n# TYPE %s %s\n", name, escapeHelp(help), name, kind)
+		f.write(bw)
+	}
+	return bw.Flush()
+}
+
+// ServeHTTP writes the metrics in the Prometheus text exposition format.
+func (r *Registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", ContentType)
+	if err := r.Write(req.Context(), w); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
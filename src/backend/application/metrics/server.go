@@ -0,0 +1,25 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Path is the path the metrics are served at.
+const Path = "/metrics"
+
+// NewServer returns an HTTP server exposing the registry at /metrics on the given port.
+// It allows scraping the metrics from a separate, e.g., cluster internal, port without authentication.
+func NewServer(port int, r *Registry) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("GET "+Path, r)
+
+	return &http.Server{
+		Addr:         ":" + strconv.Itoa(port),
+		Handler:      mux,
+		ReadTimeout:  5 * time.Second,
+		WriteTimeout: 10 * time.Second,
+		IdleTimeout:  15 * time.Second,
+	}
+}
@@ -0,0 +1,176 @@
+package organization
+
+import (
+	"application/audit"
+	"application/auth"
+	"context"
+	auditEntity "domain/audit/entity"
+	authEntity "domain/auth/entity"
+	"domain/organization/entity"
+	"domain/organization/repository"
+	"errors"
+	"fmt"
+)
+
+// Actions recorded in the audit log for organization management.
+const (
+	ActionOrganizationCreate = "organization.create"
+	ActionMemberInvite       = "organization_member.invite"
+	ActionMemberAccept       = "organization_member.accept"
+)
+
+// ErrNotPermitted is returned when the caller is not allowed to act on behalf of an organization.
+var ErrNotPermitted = errors.New("not permitted to act on behalf of the organization")
+
+// Service provides application services for managing organizations and their members.
+type Service struct {
+	repository repository.OrganizationRepository
+	audit      *audit.Service
+}
+
+// NewService initializes a new Service.
+func NewService(r repository.OrganizationRepository, a *audit.Service) *Service {
+	return &Service{repository: r, audit: a}
+}
+
+// CreateOrganization registers a new organization owned by the caller and records the change in the audit log.
+func (s *Service) CreateOrganization(ctx context.Context, o *entity.Organization) error {
+	principal := auth.PrincipalFromContext(ctx)
+	o.SetCreatedBy(principal)
+
+	owner := entity.GetMember().
+		SetMemberId(principal).
+		SetRole(entity.RoleOwner).
+		SetStatus(entity.StatusActive).
+		SetInvitedBy(principal)
+	defer owner.Release()
+
+	if err := s.repository.Save(ctx, o, owner); err != nil {
+		return err
+	}
+	s.audit.Report(ctx, ActionOrganizationCreate, organizationTarget(o.GetId()), auditEntity.OutcomeSuccess,
+		map[string]any{"name": o.GetName()})
+	return nil
+}
+
+// ListOrganizations retrieves the organizations the caller is an active member of.
+func (s *Service) ListOrganizations(ctx context.Context) ([]*entity.Organization, error) {
+	return s.repository.GetListByMember(ctx, auth.PrincipalFromContext(ctx))
+}
+
+// ListMembers retrieves the members and pending invitations of an organization.
+// The caller must be an active member of the organization or hold cross-organization rights.
+func (s *Service) ListMembers(ctx context.Context, organizationId int64) ([]*entity.Member, error) {
+	if err := s.Authorize(ctx, organizationId); err != nil {
+		return nil, err
+	}
+	return s.repository.GetMembers(ctx, organizationId)
+}
+
+// InviteMember invites a principal to join an organization and records the change in the audit log.
+// The caller must be an active owner of the organization or hold cross-organization rights.
+func (s *Service) InviteMember(ctx context.Context, m *entity.Member) error {
+	if err := s.authorizeOwner(ctx, m.GetOrganizationId()); err != nil {
+		return err
+	}
+
+	m.SetRole(entity.RoleMember).SetStatus(entity.StatusInvited).SetInvitedBy(auth.PrincipalFromContext(ctx))
+	if err := s.repository.SaveMember(ctx, m); err != nil {
+		return err
+	}
+	s.audit.Report(ctx, ActionMemberInvite, organizationTarget(m.GetOrganizationId()), auditEntity.OutcomeSuccess,
+		map[string]any{"member_id": m.GetMemberId()})
+	return nil
+}
+
+// AcceptInvitation activates the pending membership of the caller and records the change in the audit log.
+// Returns repository.ErrMemberNotFound if the caller was not invited.
+func (s *Service) AcceptInvitation(ctx context.Context, organizationId int64) (*entity.Member, error) {
+	principal := auth.PrincipalFromContext(ctx)
+	m, err := s.repository.GetMember(ctx, organizationId, principal)
+	if err != nil {
+		return nil, err
+	}
+	if m.IsActive() {
+		return m, nil
+	}
+
+	m.SetStatus(entity.StatusActive)
+	if err = s.repository.UpdateMemberStatus(ctx, m); err != nil {
+		m.Release()
+		return nil, err
+	}
+	s.audit.Report(ctx, ActionMemberAccept, organizationTarget(organizationId), auditEntity.OutcomeSuccess,
+		map[string]any{"member_id": principal})
+	return m, nil
+}
+
+// Authorize checks that the caller may act on behalf of the organization, i.e. that it is an active member or
+// holds cross-organization rights. A zero organizationId denotes no organization, on whose behalf only principals
+// with cross-organization rights may act, e.g., to create vacancies without an owner.
+// Returns ErrNotPermitted if the check fails.
+func (s *Service) Authorize(ctx context.Context, organizationId int64) error {
+	if HasCrossOrganizationRights(ctx) {
+		return nil
+	}
+	if organizationId == 0 {
+		return ErrNotPermitted
+	}
+
+	m, err := s.repository.GetMember(ctx, organizationId, auth.PrincipalFromContext(ctx))
+	if errors.Is(err, repository.ErrMemberNotFound) {
+		return ErrNotPermitted
+	}
+	if err != nil {
+		return err
+	}
+	defer m.Release()
+
+	if !m.IsActive() {
+		return ErrNotPermitted
+	}
+	return nil
+}
+
+// HasCrossOrganizationRights reports whether the caller may manage vacancies of every organization.
+// Administrators and ingestion clients, i.e. principals allowed to purge all vacancies, hold these rights.
+func HasCrossOrganizationRights(ctx context.Context) bool {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok || claims == nil {
+		return false
+	}
+	return claims.HasScope(authEntity.ScopeAdmin) || claims.HasScope(authEntity.ScopePurge)
+}
+
+// authorizeOwner checks that the caller is an active owner of the organization or holds cross-organization
+// rights. Returns repository.ErrOrganizationNotFound if the organization does not exist.
+func (s *Service) authorizeOwner(ctx context.Context, organizationId int64) error {
+	o, err := s.repository.Get(ctx, organizationId)
+	if err != nil {
+		return err
+	}
+	o.Release()
+
+	if HasCrossOrganizationRights(ctx) {
+		return nil
+	}
+
+	m, err := s.repository.GetMember(ctx, organizationId, auth.PrincipalFromContext(ctx))
+	if errors.Is(err, repository.ErrMemberNotFound) {
+		return ErrNotPermitted
+	}
+	if err != nil {
+		return err
+	}
+	defer m.Release()
+
+	if !m.IsOwner() {
+		return ErrNotPermitted
+	}
+	return nil
+}
+
+// organizationTarget formats the audit target of an organization.
+func organizationTarget(id int64) string {
+	return fmt.Sprintf("organization:%d", id)
+}
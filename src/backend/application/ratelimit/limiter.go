@@ -0,0 +1,104 @@
+package ratelimit
+
+import (
+	"application/metrics"
+	"context"
+	"log/slog"
+	"math"
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+// Limiter enforces the rate limit policies of the routes on each client, identified by the subject of its token
+// or signing key, or by its IP address when unauthenticated.
+type Limiter struct {
+	store    Store
+	policies atomic.Pointer[Policies]
+	rejected *metrics.CounterVec // Rejected requests by policy, if metrics are enabled.
+	now      func() time.Time
+}
+
+// Option configures optional behavior of the Limiter.
+type Option func(*Limiter)
+
+// WithMetrics counts the rejected requests by policy in the given registry.
+func WithMetrics(r *metrics.Registry) Option {
+	return func(l *Limiter) {
+		l.rejected = r.NewCounterVec("rate_limit_rejected_total",
+			"Number of requests rejected by rate limits by policy.", "policy")
+	}
+}
+
+// NewLimiter creates a Limiter enforcing the policies with the buckets of the store.
+func NewLimiter(store Store, policies *Policies, opts ...Option) *Limiter {
+	l := &Limiter{store: store, now: time.Now}
+	l.policies.Store(policies)
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+// SetPolicies replaces the policies, e.g., when the configuration is reloaded. Buckets are kept, so clients are
+// not granted a new burst.
+func (l *Limiter) SetPolicies(policies *Policies) {
+	l.policies.Store(policies)
+}
+
+// Allow takes a token from the bucket of the client for the route and returns the decision. Requests to unlimited
+// routes are allowed without decision, as are requests for which the store fails, so an unavailable store does not
+// take the API down.
+func (l *Limiter) Allow(ctx context.Context, route, client string) (Decision, bool) {
+	policy := l.policies.Load().For(route)
+	if policy.Unlimited() {
+		return Decision{Allowed: true}, false
+	}
+
+	d, err := l.store.Take(ctx, policy.Name+"|"+client, policy, l.now())
+	if err != nil {
+		slog.ErrorContext(ctx, "Rate limit store failed, allowing request", "policy", policy.Name, "error", err)
+		return Decision{Allowed: true}, false
+	}
+	if !d.Allowed && l.rejected != nil {
+		l.rejected.With(policy.Name).Inc()
+	}
+	return d, true
+}
+
+// ClientKey identifies the client of a request by the subject of its token or signing key, if authenticated, or
+// by its IP address.
+func ClientKey(subject, ip string) string {
+	if subject != "" {
+		return "subject:" + subject
+	}
+	return "ip:" + ip
+}
+
+// Headers reporting the rate limit of a request to the client, in HTTP responses and gRPC metadata.
+const (
+	HeaderLimit      = "RateLimit-Limit"     // Capacity of the bucket.
+	HeaderRemaining  = "RateLimit-Remaining" // Requests the client may still send at once.
+	HeaderReset      = "RateLimit-Reset"     // Seconds until the bucket is full again.
+	HeaderPolicy     = "RateLimit-Policy"    // Capacity of the bucket and seconds it takes to fill, e.g., "20;w=2".
+	HeaderRetryAfter = "Retry-After"         // Seconds until the next request is allowed, if it was rejected.
+)
+
+// Headers returns the headers reporting the decision to the client, by name.
+func (d Decision) Headers() map[string]string {
+	headers := map[string]string{
+		HeaderLimit:     strconv.Itoa(d.Policy.Burst),
+		HeaderRemaining: strconv.Itoa(d.Remaining),
+		HeaderReset:     strconv.Itoa(seconds(d.Reset)),
+		HeaderPolicy:    strconv.Itoa(d.Policy.Burst) + ";w=" + strconv.Itoa(seconds(d.Policy.Window())),
+	}
+	if !d.Allowed {
+		headers[HeaderRetryAfter] = strconv.Itoa(seconds(d.RetryAfter))
+	}
+	return headers
+}
+
+// seconds rounds the duration up to whole seconds, so clients waiting for it are not rejected again.
+func seconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
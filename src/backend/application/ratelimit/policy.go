@@ -0,0 +1,132 @@
+package ratelimit
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// periods maps the units of a policy specification to their duration.
+var periods = map[string]time.Duration{
+	"s": time.Second,
+	"m": time.Minute,
+	"h": time.Hour,
+	"d": 24 * time.Hour,
+}
+
+// Policy limits the requests of each client with a token bucket: the bucket holds up to Burst tokens, each request
+// takes one, and tokens are added back at Rate per second. A policy with a zero rate is unlimited.
+type Policy struct {
+	Name  string  // Name of the policy, separating its buckets from those of other policies.
+	Rate  float64 // Tokens added to the bucket per second.
+	Burst int     // Capacity of the bucket, i.e., the number of requests allowed at once.
+}
+
+// ParsePolicy parses a policy specification "<requests>/<unit>[,<burst>]", where the unit is s, m, h or d,
+// e.g., "10/s,20" for 10 requests per second with bursts of 20, or "1000/d" for a daily quota. The burst defaults
+// to the number of requests. An empty specification is unlimited.
+func ParsePolicy(name, spec string) (Policy, error) {
+	if spec == "" {
+		return Policy{Name: name}, nil
+	}
+
+	rate, burst, hasBurst := strings.Cut(spec, ",")
+	requests, unit, ok := strings.Cut(rate, "/")
+	period, known := periods[strings.TrimSpace(unit)]
+	if !ok || !known {
+		return Policy{}, fmt.Errorf("invalid policy %q, expected <requests>/<s|m|h|d>[,<burst>]", spec)
+	}
+	count, err := strconv.Atoi(strings.TrimSpace(requests))
+	if err != nil || count <= 0 {
+		return Policy{}, fmt.Errorf("invalid number of requests in policy %q", spec)
+	}
+
+	p := Policy{Name: name, Rate: float64(count) / period.Seconds(), Burst: count}
+	if hasBurst {
+		if p.Burst, err = strconv.Atoi(strings.TrimSpace(burst)); err != nil || p.Burst <= 0 {
+			return Policy{}, fmt.Errorf("invalid burst in policy %q", spec)
+		}
+	}
+	return p, nil
+}
+
+// Unlimited reports whether the policy lets every request through.
+func (p Policy) Unlimited() bool {
+	return p.Rate <= 0
+}
+
+// Window returns the time an empty bucket takes to be filled again.
+func (p Policy) Window() time.Duration {
+	return time.Duration(float64(p.Burst) / p.Rate * float64(time.Second))
+}
+
+// Bucket is the state of the token bucket of a client. A zero Bucket is full.
+type Bucket struct {
+	Tokens    float64   `json:"tokens"`     // Tokens left when the bucket was last updated.
+	UpdatedAt time.Time `json:"updated_at"` // Time the bucket was last updated.
+}
+
+// FullAt returns the time the bucket is filled again under the policy, after which it can be discarded.
+func (b Bucket) FullAt(p Policy) time.Time {
+	return b.UpdatedAt.Add(time.Duration((float64(p.Burst) - b.Tokens) / p.Rate * float64(time.Second)))
+}
+
+// Decision is the outcome of a request under a policy, reported to the client in the RateLimit headers.
+type Decision struct {
+	Allowed    bool          // Whether the request may proceed.
+	Policy     Policy        // Policy the request was checked against.
+	Remaining  int           // Requests the client may still send at once.
+	Reset      time.Duration // Time until the bucket is full again.
+	RetryAfter time.Duration // Time until the next request is allowed, if this one was rejected.
+}
+
+// Take refills the bucket for the time elapsed since its last update and takes a token from it, if one is left.
+// The bucket is updated in place and the decision returned.
+func (p Policy) Take(b *Bucket, now time.Time) Decision {
+	tokens := float64(p.Burst)
+	if !b.UpdatedAt.IsZero() {
+		tokens = math.Min(tokens, b.Tokens+now.Sub(b.UpdatedAt).Seconds()*p.Rate)
+	}
+
+	d := Decision{Policy: p, Allowed: tokens >= 1}
+	if d.Allowed {
+		tokens--
+	} else {
+		d.RetryAfter = time.Duration((1 - tokens) / p.Rate * float64(time.Second))
+	}
+	b.Tokens, b.UpdatedAt = tokens, now
+	d.Remaining = int(tokens)
+	d.Reset = b.FullAt(p).Sub(now)
+	return d
+}
+
+// Policies holds the policy of each route and the default policy of the other routes.
+type Policies struct {
+	Default Policy            // Policy of the routes without their own.
+	Routes  map[string]Policy // Policies by route, e.g., "GET /v1/vacancies" or a gRPC method.
+}
+
+// NewPolicies parses the specification of the default policy and those of the routes, see ParsePolicy.
+func NewPolicies(defaultSpec string, routeSpecs map[string]string) (*Policies, error) {
+	def, err := ParsePolicy("default", defaultSpec)
+	if err != nil {
+		return nil, err
+	}
+	p := &Policies{Default: def, Routes: make(map[string]Policy, len(routeSpecs))}
+	for route, spec := range routeSpecs {
+		if p.Routes[route], err = ParsePolicy(route, spec); err != nil {
+			return nil, fmt.Errorf("route %s: %w", route, err)
+		}
+	}
+	return p, nil
+}
+
+// For returns the policy of the route.
+func (p *Policies) For(route string) Policy {
+	if policy, ok := p.Routes[route]; ok {
+		return policy
+	}
+	return p.Default
+}
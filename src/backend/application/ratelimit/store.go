@@ -0,0 +1,62 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Store holds the token buckets of the clients. Stores shared by several instances enforce the limits across them.
+type Store interface {
+	// Take takes a token from the bucket of the key under the policy at the given time, see Policy.Take.
+	Take(ctx context.Context, key string, policy Policy, now time.Time) (Decision, error)
+}
+
+// memoryBucket is a bucket of the MemoryStore with the time it is full again.
+type memoryBucket struct {
+	bucket Bucket
+	fullAt time.Time
+}
+
+// MemoryStore is an in-process Store, enforcing the limits of a single instance. Full buckets are swept at most
+// once per interval.
+type MemoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*memoryBucket
+	interval  time.Duration
+	lastSweep time.Time
+}
+
+// NewMemoryStore creates a new MemoryStore sweeping full buckets at most once per interval.
+func NewMemoryStore(interval time.Duration) *MemoryStore {
+	return &MemoryStore{buckets: make(map[string]*memoryBucket), interval: interval}
+}
+
+// Take takes a token from the bucket of the key under the policy at the given time.
+func (s *MemoryStore) Take(_ context.Context, key string, policy Policy, now time.Time) (Decision, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sweep(now)
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &memoryBucket{}
+		s.buckets[key] = b
+	}
+	d := policy.Take(&b.bucket, now)
+	b.fullAt = b.bucket.FullAt(policy)
+	return d, nil
+}
+
+// sweep removes the full buckets if the sweep interval has elapsed. The caller must hold the lock.
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < s.interval {
+		return
+	}
+	for key, b := range s.buckets {
+		if !b.fullAt.After(now) {
+			delete(s.buckets, key)
+		}
+	}
+	s.lastSweep = now
+}
@@ -0,0 +1,35 @@
+package requestid
+
+import (
+	"context"
+	"log/slog"
+)
+
+// LogHandler is a slog.Handler adding the request ID of the context to every record logged with one.
+type LogHandler struct {
+	slog.Handler // Handler formatting and writing the records.
+}
+
+// NewLogHandler wraps the given handler, so records logged with a context, e.g., by slog.InfoContext, carry
+// the request ID as the "request_id" attribute.
+func NewLogHandler(h slog.Handler) *LogHandler {
+	return &LogHandler{Handler: h}
+}
+
+// Handle adds the request ID of the context, if any, to the record and passes it to the wrapped handler.
+func (h *LogHandler) Handle(ctx context.Context, r slog.Record) error {
+	if id := FromContext(ctx); id != "" {
+		r.AddAttrs(slog.String("request_id", id))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+// WithAttrs returns a LogHandler whose wrapped handler includes the given attributes.
+func (h *LogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return NewLogHandler(h.Handler.WithAttrs(attrs))
+}
+
+// WithGroup returns a LogHandler whose wrapped handler nests the following attributes in the given group.
+func (h *LogHandler) WithGroup(name string) slog.Handler {
+	return NewLogHandler(h.Handler.WithGroup(name))
+}
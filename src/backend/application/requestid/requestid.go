@@ -0,0 +1,60 @@
+package requestid
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// Header is the HTTP header, NATS message header and, in lower case, gRPC metadata key carrying the request ID.
+const Header = "X-Request-Id"
+
+// maxLength is the maximum length of a request ID accepted from a client.
+const maxLength = 128
+
+// contextKey is a custom type to avoid collisions in context keys.
+type contextKey string
+
+// idKey is the context key under which the request ID is stored.
+const idKey contextKey = "requestId"
+
+// New generates a random request ID of 32 hexadecimal characters.
+func New() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b) // crypto/rand.Read never returns an error
+	return hex.EncodeToString(b)
+}
+
+// Valid reports whether a request ID received from a client may be propagated.
+// It accepts up to 128 printable ASCII characters without spaces, so the ID cannot forge log lines or headers.
+func Valid(id string) bool {
+	if id == "" || len(id) > maxLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] <= ' ' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// Resolve returns the given request ID if it is valid, or a newly generated one otherwise.
+func Resolve(id string) string {
+	if Valid(id) {
+		return id
+	}
+	return New()
+}
+
+// ContextWithId returns a copy of ctx that carries the request ID.
+func ContextWithId(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, idKey, id)
+}
+
+// FromContext retrieves the request ID from the context.
+// It returns an empty string when the context carries none.
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(idKey).(string)
+	return id
+}
@@ -0,0 +1,120 @@
+package role
+
+import (
+	"application/audit"
+	"context"
+	auditEntity "domain/audit/entity"
+	"domain/role/entity"
+	"domain/role/repository"
+	"fmt"
+)
+
+// Actions recorded in the audit log for role management.
+const (
+	ActionRoleCreate       = "role.create"
+	ActionRoleUpdate       = "role.update"
+	ActionAssignmentCreate = "role_assignment.create"
+	ActionAssignmentDelete = "role_assignment.delete"
+)
+
+// Service provides application services for managing roles and role assignments.
+type Service struct {
+	repository repository.RoleRepository
+	audit      *audit.Service
+}
+
+// NewService initializes a new Service.
+func NewService(r repository.RoleRepository, a *audit.Service) *Service {
+	return &Service{repository: r, audit: a}
+}
+
+// ListRoles retrieves all roles.
+func (s *Service) ListRoles(ctx context.Context) ([]*entity.Role, error) {
+	return s.repository.GetRoles(ctx)
+}
+
+// GetRole retrieves a role by its unique ID.
+func (s *Service) GetRole(ctx context.Context, id int64) (*entity.Role, error) {
+	return s.repository.GetRole(ctx, id)
+}
+
+// CreateRole saves a new role and records the change in the audit log.
+func (s *Service) CreateRole(ctx context.Context, r *entity.Role) error {
+	if err := s.repository.SaveRole(ctx, r); err != nil {
+		return err
+	}
+	s.audit.Report(ctx, ActionRoleCreate, roleTarget(r.GetId()), auditEntity.OutcomeSuccess, roleDetails(r))
+	return nil
+}
+
+// UpdateRole updates an existing role and records the change in the audit log.
+func (s *Service) UpdateRole(ctx context.Context, r *entity.Role) error {
+	if err := s.repository.UpdateRole(ctx, r); err != nil {
+		return err
+	}
+	s.audit.Report(ctx, ActionRoleUpdate, roleTarget(r.GetId()), auditEntity.OutcomeSuccess, roleDetails(r))
+	return nil
+}
+
+// ListAssignments retrieves role assignments, optionally filtered by subject type and subject ID.
+func (s *Service) ListAssignments(ctx context.Context, subjectType, subjectId string) ([]*entity.Assignment, error) {
+	return s.repository.GetAssignments(ctx, subjectType, subjectId)
+}
+
+// AssignRole grants a role to a subject and records the change in the audit log.
+func (s *Service) AssignRole(ctx context.Context, a *entity.Assignment) error {
+	if err := s.repository.SaveAssignment(ctx, a); err != nil {
+		return err
+	}
+	s.audit.Report(ctx, ActionAssignmentCreate, assignmentTarget(a.GetId()), auditEntity.OutcomeSuccess,
+		assignmentDetails(a))
+	return nil
+}
+
+// RevokeAssignment removes a role assignment and records the change in the audit log.
+func (s *Service) RevokeAssignment(ctx context.Context, id int64) error {
+	a, err := s.repository.DeleteAssignment(ctx, id)
+	if err != nil {
+		return err
+	}
+	defer a.Release()
+	s.audit.Report(ctx, ActionAssignmentDelete, assignmentTarget(a.GetId()), auditEntity.OutcomeSuccess,
+		assignmentDetails(a))
+	return nil
+}
+
+// EffectiveScopes returns the union of the scopes granted to a subject through its roles.
+// The result is empty when the subject holds no roles.
+func (s *Service) EffectiveScopes(ctx context.Context, subjectType, subjectId string) ([]string, error) {
+	return s.repository.GetScopes(ctx, subjectType, subjectId)
+}
+
+// roleTarget formats the audit target of a role.
+func roleTarget(id int64) string {
+	return fmt.Sprintf("role:%d", id)
+}
+
+// assignmentTarget formats the audit target of a role assignment.
+func assignmentTarget(id int64) string {
+	return fmt.Sprintf("role_assignment:%d", id)
+}
+
+// roleDetails returns the audit details of a role.
+func roleDetails(r *entity.Role) map[string]any {
+	return map[string]any{
+		"name":        r.GetName(),
+		"description": r.GetDescription(),
+		"scopes":      r.GetScopes(),
+		"version":     r.GetVersion(),
+	}
+}
+
+// assignmentDetails returns the audit details of a role assignment.
+func assignmentDetails(a *entity.Assignment) map[string]any {
+	return map[string]any{
+		"subject_type": a.GetSubjectType(),
+		"subject_id":   a.GetSubjectId(),
+		"role_id":      a.GetRoleId(),
+		"role":         a.GetRoleName(),
+	}
+}
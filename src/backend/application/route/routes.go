@@ -2,54 +2,641 @@ package route
 
 import (
 	"application"
+	"application/feature"
+	"application/idempotency"
+	"application/metrics"
+	"application/vacancy"
+	auditEntity "domain/audit/entity"
+	"domain/auth/entity"
+	roleEntity "domain/role/entity"
+	auditDto "interfaces/api/audit/dto"
+	authDto "interfaces/api/auth/dto"
+	healthDto "interfaces/api/healthcheck/dto"
+	organizationDto "interfaces/api/organization/dto"
+	roleDto "interfaces/api/role/dto"
+	signingDto "interfaces/api/signing/dto"
+	"interfaces/api/utils/codec"
+	"interfaces/api/utils/patch"
+	vacancyDto "interfaces/api/vacancy/dto"
+	"interfaces/api/vacancy/dto/batch"
+	"interfaces/api/vacancy/dto/export"
+	"interfaces/api/vacancy/dto/feed"
+	"interfaces/api/vacancy/dto/imports"
+	"interfaces/api/vacancy/dto/jobposting"
+	"interfaces/api/vacancy/dto/list"
 	"interfaces/middleware"
+	"interfaces/openapi"
 	"net/http"
+	"path"
+	"strings"
 
 	"github.com/julienschmidt/httprouter"
 )
 
+// OpenApiPath is the path the OpenAPI document of the REST API is served at.
+const OpenApiPath = "/v1/openapi.json"
+
+// multipartContentType is the content type of the request bodies uploading files.
+const multipartContentType = "multipart/form-data"
+
+// ifMatchHeader is the header giving the version of the vacancy an update applies to.
+var ifMatchHeader = openapi.Parameter{Name: "If-Match", Schema: openapi.String(
+	"Version of the vacancy the update applies to, e.g., \"3\"; required by patches and replacements unless the " +
+		"body holds it")}
+
 // Register initializes all router groups.
 func Register(di *application.Container) http.Handler {
-	router := httprouter.New()
+	router := middleware.NewRouter()
 
-	// Register unprotected route for JWT generation
+	// Answer unknown routes, unsupported methods and panics of the handlers in the error format of the API
+	errors := di.Errors.Get()
+	router.NotFound = http.HandlerFunc(errors.NotFoundResponse)
+	router.MethodNotAllowed = http.HandlerFunc(errors.MethodNotAllowedResponse)
+	router.PanicHandler = di.InterfacesContainer.Get().Recovery.Get().Recover
+
+	// Register unprotected routes for JWT generation, the probes of orchestrators and the OpenAPI document
 	registerAuthenticationRoute(router, di)
+	registerProbeRoutes(router, di)
+	registerOpenApiRoute(router, di)
 
-	middlewares := []middleware.Middleware{
-		di.InterfacesContainer.Get().JwtAuthMiddleware.Get().Handle,
+	// Create a group for protected routes
+	protectedGroup := &group{
+		RouteGroup: middleware.NewRouteGroup(router,
+			di.InterfacesContainer.Get().JwtAuthMiddleware.Get().Handle,
+			di.InterfacesContainer.Get().RateLimit.Get().Handle(router),
+			di.InterfacesContainer.Get().Validation.Get().Handle(router),
+		),
+		spec:    di.InterfacesContainer.Get().OpenApi.Get(),
+		auth:    []string{openapi.BearerAuth},
+		limited: true,
 	}
-	// Create a RouteGroup for protected routes
-	protectedGroup := middleware.NewRouteGroup(router, middlewares...)
 
 	// Register protected routes
 	registerHealthCheckRoute(protectedGroup, di)
 	registerVacancyRoutes(protectedGroup, di)
 
-	return router
+	// Register scope protected routes, accessible to tokens of any issuer granting the required scopes
+	registerVacancyMutationRoutes(router, di)
+	registerVacancyBatchRoutes(router, di)
+	registerFeedRoutes(router, di)
+	registerOrganizationRoutes(router, di)
+	registerAdminRoutes(router, di)
+	registerMetricsRoute(router, di)
+
+	// Assign request IDs, trace, instrument and log every request, recover from panics and record authentication
+	// failures in the audit log, in this order
+	ic := di.InterfacesContainer.Get()
+	return middleware.Chain(router,
+		middleware.RequestId,
+		middleware.Tracing(router),
+		ic.Metrics.Get().Handle(router),
+		ic.AccessLog.Get().Handle,
+		ic.Recovery.Get().Handle,
+		ic.AuditMiddleware.Get().Handle,
+	)
+}
+
+// group is a RouteGroup describing the operations of its routes in the OpenAPI document, along with the
+// credentials they accept.
+type group struct {
+	*middleware.RouteGroup
+	spec    *openapi.Spec // Document the operations are added to.
+	auth    []string      // Security schemes accepted by the routes.
+	scopes  []string      // Scopes the credentials must grant.
+	limited bool          // Whether the rate of requests is limited, answering 429 Too Many Requests.
+}
+
+// handle registers the handler for the route of the operation and adds the operation to the OpenAPI document.
+func (g *group) handle(op openapi.Operation, handler http.HandlerFunc) {
+	g.describe(op)
+	g.HandlerFunc(op.Method, op.Path, handler)
+}
+
+// describe adds the operation to the OpenAPI document, with the credentials accepted by the group. Scopes already
+// set on the operation take precedence over the scopes of the group.
+func (g *group) describe(op openapi.Operation) {
+	op.Auth = g.auth
+	if op.Scopes == nil {
+		op.Scopes = g.scopes
+	}
+	if g.limited {
+		op.Errors = append(op.Errors, http.StatusTooManyRequests)
+	}
+	g.spec.Add(op)
+}
+
+// publicGroup creates a group for routes accessible without credentials and without rate limit.
+func publicGroup(router *middleware.Router, di *application.Container) *group {
+	return &group{RouteGroup: middleware.NewRouteGroup(router), spec: di.InterfacesContainer.Get().OpenApi.Get()}
+}
+
+// scopeGroup creates a group that authenticates the request, either by its HMAC signature or by its token,
+// requires the given scopes, limits the rate of requests of the authenticated client and validates the request
+// against the OpenAPI document.
+func scopeGroup(router *middleware.Router, di *application.Container, scopes ...string) *group {
+	ic := di.InterfacesContainer.Get()
+	hmac := ic.HmacMiddleware.Get()
+	jwt := ic.JwtAuthMiddleware.Get()
+	return &group{
+		RouteGroup: middleware.NewRouteGroup(router, hmac.Handle, jwt.Authenticate, jwt.RequireScope(scopes...),
+			ic.RateLimit.Get().Handle(router), ic.Validation.Get().Handle(router)),
+		spec:    ic.OpenApi.Get(),
+		auth:    []string{openapi.HmacAuth, openapi.BearerAuth},
+		scopes:  scopes,
+		limited: true,
+	}
 }
 
 // registerHealthCheckRoute defines the health check route.
-func registerHealthCheckRoute(rg *middleware.RouteGroup, di *application.Container) {
-	rg.HandlerFunc(http.MethodGet, "/v1/healthcheck", di.HealthCheckContainer.Get().HealthCheckHandler.Get().Execute)
+func registerHealthCheckRoute(g *group, di *application.Container) {
+	g.handle(openapi.Operation{
+		Method: http.MethodGet, Path: "/v1/healthcheck", Id: "getHealthCheck", Tag: "Health",
+		Summary:  "Report the status of the service and its dependencies",
+		Response: healthDto.Response{},
+	}, di.HealthCheckContainer.Get().HealthCheckHandler.Get().Execute)
+}
+
+// registerProbeRoutes defines the liveness and readiness probe routes.
+func registerProbeRoutes(router *middleware.Router, di *application.Container) {
+	g := publicGroup(router, di)
+	hc := di.HealthCheckContainer.Get()
+	g.handle(openapi.Operation{
+		Method: http.MethodGet, Path: "/livez", Id: "getLiveness", Tag: "Health",
+		Summary:  "Report that the process is running",
+		Response: healthDto.Response{},
+	}, hc.LivenessHandler.Get().Execute)
+	g.handle(openapi.Operation{
+		Method: http.MethodGet, Path: "/readyz", Id: "getReadiness", Tag: "Health",
+		Summary:   "Report whether the dependencies are available, with 503 Service Unavailable otherwise",
+		Response:  healthDto.Response{},
+		Responses: map[int]any{http.StatusServiceUnavailable: healthDto.Response{}},
+	}, hc.ReadinessHandler.Get().Execute)
 }
 
-// registerAuthenticationRoute defines the route for generating JWT tokens.
-func registerAuthenticationRoute(router *httprouter.Router, di *application.Container) {
-	router.HandlerFunc(http.MethodGet, "/v1/jwt", di.JwtAuthContainer.Get().JwtAuthHandler.Get().Execute)
+// registerOpenApiRoute defines the route serving the OpenAPI document of the REST API.
+func registerOpenApiRoute(router *middleware.Router, di *application.Container) {
+	spec := di.InterfacesContainer.Get().OpenApi.Get()
+	publicGroup(router, di).handle(openapi.Operation{
+		Method: http.MethodGet, Path: OpenApiPath, Id: "getOpenApi", Tag: "Documentation",
+		Summary:  "Get this OpenAPI document",
+		Response: map[string]any{},
+	}, spec.ServeHTTP)
 }
 
-// registerVacancyRoutes defines vacancy related routes.
-func registerVacancyRoutes(rg *middleware.RouteGroup, di *application.Container) {
+// registerAuthenticationRoute defines the route for generating JWT tokens, rate limited by client IP address.
+func registerAuthenticationRoute(router *middleware.Router, di *application.Container) {
+	ic := di.InterfacesContainer.Get()
+	g := &group{
+		RouteGroup: middleware.NewRouteGroup(router, ic.RateLimit.Get().Handle(router)),
+		spec:       ic.OpenApi.Get(),
+		limited:    true,
+	}
+	g.handle(openapi.Operation{
+		Method: http.MethodGet, Path: "/v1/jwt", Id: "issueToken", Tag: "Authentication",
+		Summary:  "Issue a JWT granting the scopes of the roles of the web client",
+		Response: authDto.Response{},
+	}, di.JwtAuthContainer.Get().JwtAuthHandler.Get().Execute)
+}
+
+// registerVacancyRoutes defines vacancy related read routes. httprouter cannot tell apart a literal segment from a
+// parameter, so the export and the event stream share the route of a single vacancy, dispatching on its ID. They are
+// recorded as routes of their own, so their long requests are labelled apart from the reads of single vacancies.
+func registerVacancyRoutes(g *group, di *application.Container) {
 	const (
-		vacancyCreate = "/v1/vacancies"
 		vacancyGet    = "/v1/vacancies/:id"
+		vacancyList   = "/v1/vacancies"
+		vacancyExport = "/v1/vacancies/export"
+		vacancyStream = "/v1/vacancies/stream"
+	)
+	vc := di.VacancyContainer.Get()
+	fields := openapi.Parameter{Name: "fields", Schema: openapi.String("Comma-separated fields to return, all by " +
+		"default, ignored by JSON-LD: " + strings.Join(vacancy.ExportColumns(), ","))}
+	g.describe(openapi.Operation{
+		Method: http.MethodGet, Path: vacancyGet, Id: "getVacancy", Tag: "Vacancies",
+		Summary:  "Get a vacancy, as a schema.org JobPosting in JSON-LD if accepted",
+		Query:    []openapi.Parameter{fields},
+		Response: vacancyDto.Response{},
+		Variants: map[string]any{jobposting.MediaType: jobposting.JobPosting{}},
+		Errors:   []int{http.StatusUnprocessableEntity},
+	})
+	g.handle(openapi.Operation{
+		Method: http.MethodGet, Path: vacancyList, Id: "listVacancies", Tag: "Vacancies",
+		Summary: "List the vacancies matching the filters, a page at a time, as a schema.org ItemList in " +
+			"JSON-LD if accepted",
+		Query:    append(openapi.QueryOf(list.Request{}), fields),
+		Response: []vacancyDto.Response{},
+		Variants: map[string]any{jobposting.MediaType: jobposting.ItemList{}},
+		Errors:   []int{http.StatusNotFound},
+	}, vc.ListHandler.Get().Execute)
+	g.describe(openapi.Operation{
+		Method: http.MethodGet, Path: vacancyExport, Id: "exportVacancies", Tag: "Vacancies",
+		Summary: "Stream every vacancy matching the filters as CSV or NDJSON, gzipped if accepted",
+		Query: []openapi.Parameter{
+			{Name: "title", Schema: openapi.String("Part of the title of the vacancies, ignoring case")},
+			{Name: "company", Schema: openapi.String("Part of the company of the vacancies, ignoring case")},
+			{Name: "sort_field", Schema: openapi.Enum("Field the vacancies are sorted by, id by default",
+				vacancy.ExportSortFields()...)},
+			{Name: "sort_order", Schema: openapi.Enum("Order the vacancies are sorted in, asc by default",
+				export.OrderAsc, export.OrderDesc)},
+			{Name: "format", Schema: openapi.Enum("Format of the export, csv by default", export.Formats()...)},
+			{Name: "columns", Schema: openapi.String("Comma-separated columns to export, in order, all by default: " +
+				strings.Join(vacancy.ExportColumns(), ","))},
+		},
+		Response:    "",
+		ContentType: export.MediaTypeCSV,
+		Produces:    []string{export.MediaTypeNDJSON},
+	})
+	g.describe(openapi.Operation{
+		Method: http.MethodGet, Path: vacancyStream, Id: "streamVacancies", Tag: "Vacancies",
+		Summary: "Stream the created, updated and deleted events of the vacancies matching the filters as " +
+			"Server-Sent Events, resuming after the Last-Event-ID",
+		Query: []openapi.Parameter{
+			{Name: "title", Schema: openapi.String("Part of the title of the vacancies, ignoring case")},
+			{Name: "company", Schema: openapi.String("Part of the company of the vacancies, ignoring case")},
+		},
+		Headers: []openapi.Parameter{{Name: "Last-Event-ID", Schema: openapi.String(
+			"ID of the last event received, replaying the events following it if still kept, a reset event otherwise")}},
+		Response:    "",
+		ContentType: "text/event-stream",
+		Errors:      []int{http.StatusServiceUnavailable},
+	})
+
+	g.Route(http.MethodGet, vacancyExport)
+	g.Route(http.MethodGet, vacancyStream)
+	get, exportAll := vc.GetHandler.Get().Execute, vc.ExportHandler.Get().Execute
+	stream := middleware.ApplyMiddleware(vc.StreamHandler.Get().Execute,
+		di.InterfacesContainer.Get().Features.Get().Require(feature.Stream))
+	g.HandlerFunc(http.MethodGet, vacancyGet, func(w http.ResponseWriter, r *http.Request) {
+		switch httprouter.ParamsFromContext(r.Context()).ByName("id") {
+		case path.Base(vacancyExport):
+			exportAll(w, r)
+		case path.Base(vacancyStream):
+			stream(w, r)
+		default:
+			get(w, r)
+		}
+	})
+}
+
+// registerVacancyMutationRoutes defines vacancy routes that modify data and require the matching scope.
+func registerVacancyMutationRoutes(router *middleware.Router, di *application.Container) {
+	const (
+		vacancyCreate = "/v1/vacancies"
 		vacancyDelete = "/v1/vacancies/:id"
 		vacancyPatch  = "/v1/vacancies/:id"
-		vacancyList   = "/v1/vacancies"
+		vacancyPut    = "/v1/vacancies/:id"
 	)
-	rg.HandlerFunc(http.MethodPost, vacancyCreate, di.VacancyContainer.Get().CreateHandler.Get().Execute)
-	rg.HandlerFunc(http.MethodGet, vacancyGet, di.VacancyContainer.Get().GetHandler.Get().Execute)
-	rg.HandlerFunc(http.MethodDelete, vacancyDelete, di.VacancyContainer.Get().DeleteHandler.Get().Execute)
-	rg.HandlerFunc(http.MethodPatch, vacancyPatch, di.VacancyContainer.Get().UpdateHandler.Get().Execute)
-	rg.HandlerFunc(http.MethodGet, vacancyList, di.VacancyContainer.Get().ListHandler.Get().Execute)
+	vc := di.VacancyContainer.Get()
+	idempotent := di.InterfacesContainer.Get().Idempotency.Get().Handle(router)
+
+	writeGroup := scopeGroup(router, di, entity.ScopeWrite)
+	writeGroup.handle(openapi.Operation{
+		Method: http.MethodPost, Path: vacancyCreate, Id: "createVacancy", Tag: "Vacancies",
+		Summary: "Create a vacancy, owned by an organization of the caller if given, once per idempotency key",
+		Headers: []openapi.Parameter{{Name: idempotency.HeaderKey, Schema: openapi.String(
+			"Key identifying the request and its retries, which get the response of the original request")}},
+		Body: vacancyDto.Request{},
+		Bodies: map[string]any{codec.MessagePack{}.MediaType(): vacancyDto.Request{},
+			codec.Protobuf{}.MediaType(): vacancyDto.Request{}},
+		Required: []string{"title", "company", "description", "posted_at"},
+		Status:   http.StatusCreated,
+		Response: vacancyDto.Response{},
+		Errors:   []int{http.StatusConflict, http.StatusUnsupportedMediaType},
+	}, middleware.ApplyMiddleware(vc.CreateHandler.Get().Execute, idempotent))
+	writeGroup.handle(openapi.Operation{
+		Method: http.MethodPatch, Path: vacancyPatch, Id: "updateVacancy", Tag: "Vacancies",
+		Summary: "Update the given fields of a vacancy, or apply a JSON Merge Patch or a JSON Patch to it",
+		Headers: []openapi.Parameter{ifMatchHeader},
+		Body:    vacancyDto.Request{},
+		Bodies: map[string]any{
+			patch.MergePatchContentType: vacancyDto.Request{},
+			patch.JsonPatchContentType:  []patch.Operation{},
+		},
+		Response: vacancyDto.Response{},
+		Errors:   []int{http.StatusConflict, http.StatusPreconditionRequired, http.StatusUnsupportedMediaType},
+	}, vc.UpdateHandler.Get().Execute)
+	writeGroup.handle(openapi.Operation{
+		Method: http.MethodPut, Path: vacancyPut, Id: "replaceVacancy", Tag: "Vacancies",
+		Summary:  "Replace a vacancy",
+		Headers:  []openapi.Parameter{ifMatchHeader},
+		Body:     vacancyDto.Request{},
+		Required: []string{"title", "company", "description", "posted_at", "location"},
+		Response: vacancyDto.Response{},
+		Errors:   []int{http.StatusConflict, http.StatusPreconditionRequired},
+	}, vc.UpdateHandler.Get().Replace)
+
+	deleteGroup := scopeGroup(router, di, entity.ScopeDelete)
+	deleteGroup.handle(openapi.Operation{
+		Method: http.MethodDelete, Path: vacancyDelete, Id: "deleteVacancy", Tag: "Vacancies",
+		Summary: "Delete a vacancy",
+		Status:  http.StatusNoContent,
+	}, vc.DeleteHandler.Get().Execute)
+}
+
+// registerVacancyBatchRoutes defines the custom methods creating, updating and deleting vacancies in batches, e.g.,
+// POST /v1/vacancies:batchCreate, and importing them from files. httprouter cannot tell apart literal suffixes of a
+// segment, so the methods share a single route dispatching them by name, each requiring its own scope and recorded
+// as a route of its own, while unknown methods are labelled as unmatched.
+func registerVacancyBatchRoutes(router *middleware.Router, di *application.Container) {
+	const (
+		vacancyCollection = "/v1/vacancies"
+		customMethod      = "method"
+	)
+	ic := di.InterfacesContainer.Get()
+	jwt := ic.JwtAuthMiddleware.Get()
+	g := &group{
+		RouteGroup: middleware.NewRouteGroup(router, ic.HmacMiddleware.Get().Handle, jwt.Authenticate,
+			ic.RateLimit.Get().Handle(router)),
+		spec:    ic.OpenApi.Get(),
+		auth:    []string{openapi.HmacAuth, openapi.BearerAuth},
+		limited: true,
+	}
+	bh := di.VacancyContainer.Get().BatchHandler.Get()
+
+	methods := make(map[string]http.HandlerFunc)
+	method := func(name, scope string, op openapi.Operation, handler http.HandlerFunc) {
+		op.Method, op.Path, op.Id, op.Tag = http.MethodPost, vacancyCollection+":"+name, name+"Vacancies", "Vacancies"
+		op.Scopes = []string{scope}
+		if op.Response == nil {
+			op.Response = batch.Response{}
+			op.Responses = map[int]any{http.StatusMultiStatus: batch.Response{}}
+		}
+		if op.BodyType == "" {
+			op.Bodies = map[string]any{codec.MessagePack{}.MediaType(): op.Body}
+			op.Errors = append(op.Errors, http.StatusUnsupportedMediaType)
+		}
+		g.describe(op)
+		g.Route(op.Method, op.Path)
+		methods[name] = middleware.ApplyMiddleware(handler, jwt.RequireScope(scope),
+			ic.Validation.Get().Handle(router))
+	}
+	method("batchCreate", entity.ScopeWrite, openapi.Operation{
+		Summary:  "Create vacancies in a batch, answering the result of every item",
+		Body:     batch.Request{},
+		Required: []string{"items"},
+	}, bh.Create)
+	method("batchUpdate", entity.ScopeWrite, openapi.Operation{
+		Summary:  "Update the given fields of vacancies in a batch, answering the result of every item",
+		Body:     batch.Request{},
+		Required: []string{"items"},
+	}, bh.Update)
+	method("batchDelete", entity.ScopeDelete, openapi.Operation{
+		Summary:  "Delete vacancies in a batch, answering the result of every item",
+		Body:     batch.DeleteRequest{},
+		Required: []string{"ids"},
+	}, bh.Delete)
+	method("import", entity.ScopeWrite, openapi.Operation{
+		Summary: "Import vacancies from an uploaded CSV or NDJSON file, validating every row, in batches",
+		Query: []openapi.Parameter{
+			{Name: "format", Schema: openapi.Enum("Format of the file, csv by default", vacancy.ImportFormats()...)},
+			{Name: "mapping", Schema: openapi.String("Column of the file holding each field, as field=column,... " +
+				"if not the field: " + strings.Join(vacancy.ImportFields(), ","))},
+			{Name: "dry_run", Schema: &openapi.Schema{Type: openapi.TypeBoolean,
+				Description: "Validate the rows and report their errors without importing them"}},
+		},
+		Body:      imports.Form{},
+		BodyType:  multipartContentType,
+		Response:  imports.Report{},
+		Responses: map[int]any{http.StatusMultiStatus: imports.Report{}},
+		Produces:  []string{imports.MediaTypeCSV},
+		Errors:    []int{http.StatusRequestEntityTooLarge},
+	}, middleware.ApplyMiddleware(di.VacancyContainer.Get().ImportHandler.Get().Execute,
+		ic.Features.Get().Require(feature.Import)))
+
+	errors := di.Errors.Get()
+	g.HandlerFunc(http.MethodPost, vacancyCollection+":"+customMethod, func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(httprouter.ParamsFromContext(r.Context()).ByName(customMethod), ":")
+		handler, ok := methods[name]
+		if !ok {
+			errors.NotFoundResponse(w, r)
+			return
+		}
+		handler(w, r)
+	})
+}
+
+// registerFeedRoutes defines the RSS and Atom feeds of vacancies, which also accept the read-only token carried by
+// their URL, and the route issuing that token, while the feeds feature is switched on.
+func registerFeedRoutes(router *middleware.Router, di *application.Container) {
+	const (
+		feedRss   = "/v1/feeds/vacancies.rss"
+		feedAtom  = "/v1/feeds/vacancies.atom"
+		feedToken = "/v1/feeds/token"
+	)
+	ic := di.InterfacesContainer.Get()
+	jwt := ic.JwtAuthMiddleware.Get()
+	feeds := ic.Features.Get().Require(feature.Feeds)
+	g := &group{
+		RouteGroup: middleware.NewRouteGroup(router, feeds, jwt.AuthenticateFeed, ic.HmacMiddleware.Get().Handle,
+			jwt.Authenticate, jwt.RequireScope(entity.ScopeRead), ic.RateLimit.Get().Handle(router),
+			ic.Validation.Get().Handle(router)),
+		spec:    ic.OpenApi.Get(),
+		auth:    []string{openapi.FeedAuth, openapi.HmacAuth, openapi.BearerAuth},
+		scopes:  []string{entity.ScopeRead},
+		limited: true,
+	}
+	fh := di.VacancyContainer.Get().FeedHandler.Get()
+	query := []openapi.Parameter{
+		{Name: "title", Schema: openapi.String("Part of the title of the vacancies, ignoring case")},
+		{Name: "company", Schema: openapi.String("Part of the company of the vacancies, ignoring case")},
+		{Name: "location", Schema: openapi.String("Part of the location of the vacancies, ignoring case")},
+	}
+	g.handle(openapi.Operation{
+		Method: http.MethodGet, Path: feedRss, Id: "getVacancyRssFeed", Tag: "Feeds",
+		Summary:     "Get the RSS 2.0 feed of the most recently added vacancies matching the filters",
+		Query:       query,
+		Response:    "",
+		ContentType: feed.MediaTypeRSS,
+		Responses:   map[int]any{http.StatusNotModified: nil},
+	}, fh.Rss)
+	g.handle(openapi.Operation{
+		Method: http.MethodGet, Path: feedAtom, Id: "getVacancyAtomFeed", Tag: "Feeds",
+		Summary:     "Get the Atom feed of the most recently added vacancies matching the filters",
+		Query:       query,
+		Response:    "",
+		ContentType: feed.MediaTypeAtom,
+		Responses:   map[int]any{http.StatusNotModified: nil},
+	}, fh.Atom)
+
+	scopeGroup(router, di, entity.ScopeRead).handle(openapi.Operation{
+		Method: http.MethodPost, Path: feedToken, Id: "issueFeedToken", Tag: "Feeds",
+		Summary:  "Issue a long-lived, read-only token to carry in the feed URLs, only accepted by the feeds",
+		Response: authDto.Response{},
+	}, middleware.ApplyMiddleware(di.JwtAuthContainer.Get().FeedHandler.Get().Execute, feeds))
+}
+
+// registerOrganizationRoutes defines the routes for managing the organizations and memberships of the caller.
+func registerOrganizationRoutes(router *middleware.Router, di *application.Container) {
+	const (
+		organizationList   = "/v1/organizations"
+		organizationCreate = "/v1/organizations"
+		memberList         = "/v1/organizations/:id/members"
+		memberInvite       = "/v1/organizations/:id/members"
+		memberAccept       = "/v1/organizations/:id/members/accept"
+	)
+	oc := di.OrganizationContainer.Get()
+
+	readGroup := scopeGroup(router, di, entity.ScopeRead)
+	readGroup.handle(openapi.Operation{
+		Method: http.MethodGet, Path: organizationList, Id: "listOrganizations", Tag: "Organizations",
+		Summary:  "List the organizations the caller is a member of",
+		Response: []organizationDto.OrganizationResponse{},
+	}, oc.ListHandler.Get().Execute)
+	readGroup.handle(openapi.Operation{
+		Method: http.MethodGet, Path: memberList, Id: "listMembers", Tag: "Organizations",
+		Summary:  "List the members of an organization of the caller",
+		Response: []organizationDto.MemberResponse{},
+	}, oc.ListMemberHandler.Get().Execute)
+
+	writeGroup := scopeGroup(router, di, entity.ScopeWrite)
+	writeGroup.handle(openapi.Operation{
+		Method: http.MethodPost, Path: organizationCreate, Id: "createOrganization", Tag: "Organizations",
+		Summary:  "Create an organization owned by the caller",
+		Body:     organizationDto.OrganizationRequest{},
+		Required: []string{"name"},
+		Status:   http.StatusCreated,
+		Response: organizationDto.OrganizationResponse{},
+		Errors:   []int{http.StatusConflict},
+	}, oc.CreateHandler.Get().Execute)
+	writeGroup.handle(openapi.Operation{
+		Method: http.MethodPost, Path: memberInvite, Id: "inviteMember", Tag: "Organizations",
+		Summary:  "Invite a member to an organization owned by the caller",
+		Body:     organizationDto.MemberRequest{},
+		Required: []string{"member_id"},
+		Status:   http.StatusCreated,
+		Response: organizationDto.MemberResponse{},
+		Errors:   []int{http.StatusConflict},
+	}, oc.InviteMemberHandler.Get().Execute)
+	writeGroup.handle(openapi.Operation{
+		Method: http.MethodPost, Path: memberAccept, Id: "acceptInvitation", Tag: "Organizations",
+		Summary:  "Accept the invitation of the caller to an organization",
+		Response: organizationDto.MemberResponse{},
+	}, oc.AcceptHandler.Get().Execute)
+}
+
+// registerAdminRoutes defines the role and signing key management and audit log routes, restricted to the admin
+// scope.
+func registerAdminRoutes(router *middleware.Router, di *application.Container) {
+	g := scopeGroup(router, di, entity.ScopeAdmin)
+	registerRoleRoutes(g, di)
+	registerSigningKeyRoutes(g, di)
+
+	g.handle(openapi.Operation{
+		Method: http.MethodGet, Path: "/v1/admin/audit-log", Id: "listAuditRecords", Tag: "Audit",
+		Summary: "Query the audit log, newest records first, a page at a time",
+		Query: []openapi.Parameter{
+			{Name: "actor", Schema: openapi.String("Subject of the token or client ID of the actor")},
+			{Name: "action", Schema: openapi.String("Action performed, e.g., vacancy.create")},
+			{Name: "target", Schema: openapi.String("Resource acted upon, e.g., vacancy:42")},
+			{Name: "outcome", Schema: openapi.Enum("Outcome of the action",
+				auditEntity.OutcomeSuccess, auditEntity.OutcomeFailure, auditEntity.OutcomeDenied)},
+			{Name: "from", Schema: &openapi.Schema{Type: openapi.TypeString, Format: "date-time"}},
+			{Name: "to", Schema: &openapi.Schema{Type: openapi.TypeString, Format: "date-time"}},
+			{Name: "cursor", Schema: openapi.String("next_cursor of the previous page")},
+			{Name: "limit", Schema: openapi.Integer("Number of records of the page", openapi.Bound(1),
+				openapi.Bound(100))},
+		},
+		Response: auditDto.ListResponse{},
+	}, di.AuditContainer.Get().ListHandler.Get().Execute)
+}
+
+// registerRoleRoutes defines the routes managing the roles and their assignments.
+func registerRoleRoutes(g *group, di *application.Container) {
+	const (
+		roleList         = "/v1/admin/roles"
+		roleCreate       = "/v1/admin/roles"
+		rolePatch        = "/v1/admin/roles/:id"
+		assignmentList   = "/v1/admin/role-assignments"
+		assignmentCreate = "/v1/admin/role-assignments"
+		assignmentDelete = "/v1/admin/role-assignments/:id"
+	)
+	rc := di.RoleContainer.Get()
+	g.handle(openapi.Operation{
+		Method: http.MethodGet, Path: roleList, Id: "listRoles", Tag: "Roles",
+		Summary:  "List the roles",
+		Response: []roleDto.RoleResponse{},
+	}, rc.ListHandler.Get().Execute)
+	g.handle(openapi.Operation{
+		Method: http.MethodPost, Path: roleCreate, Id: "createRole", Tag: "Roles",
+		Summary:  "Create a role granting scopes",
+		Body:     roleDto.RoleRequest{},
+		Required: []string{"name", "scopes"},
+		Status:   http.StatusCreated,
+		Response: roleDto.RoleResponse{},
+	}, rc.CreateHandler.Get().Execute)
+	g.handle(openapi.Operation{
+		Method: http.MethodPatch, Path: rolePatch, Id: "updateRole", Tag: "Roles",
+		Summary:  "Update the given fields of a role, at the given version if any",
+		Body:     roleDto.RoleRequest{},
+		Response: roleDto.RoleResponse{},
+		Errors:   []int{http.StatusConflict},
+	}, rc.UpdateHandler.Get().Execute)
+
+	g.handle(openapi.Operation{
+		Method: http.MethodGet, Path: assignmentList, Id: "listRoleAssignments", Tag: "Roles",
+		Summary: "List the role assignments, of a single subject if given",
+		Query: []openapi.Parameter{
+			{Name: "subject_type", Schema: openapi.Enum("Type of the subject",
+				roleEntity.SubjectUser, roleEntity.SubjectClient)},
+			{Name: "subject_id", Schema: openapi.String("Subject of the token or client ID")},
+		},
+		Response: []roleDto.AssignmentResponse{},
+	}, rc.ListAssignmentHandler.Get().Execute)
+	g.handle(openapi.Operation{
+		Method: http.MethodPost, Path: assignmentCreate, Id: "createRoleAssignment", Tag: "Roles",
+		Summary:  "Assign a role to a user or API client",
+		Body:     roleDto.AssignmentRequest{},
+		Required: []string{"subject_type", "subject_id", "role_id"},
+		Status:   http.StatusCreated,
+		Response: roleDto.AssignmentResponse{},
+		Errors:   []int{http.StatusConflict},
+	}, rc.CreateAssignmentHandler.Get().Execute)
+	g.handle(openapi.Operation{
+		Method: http.MethodDelete, Path: assignmentDelete, Id: "deleteRoleAssignment", Tag: "Roles",
+		Summary: "Remove a role assignment",
+		Status:  http.StatusNoContent,
+	}, rc.DeleteAssignmentHandler.Get().Execute)
+}
+
+// registerSigningKeyRoutes defines the routes managing the HMAC signing keys of the API clients.
+func registerSigningKeyRoutes(g *group, di *application.Container) {
+	const (
+		signingKeyList   = "/v1/admin/signing-keys"
+		signingKeyIssue  = "/v1/admin/signing-keys"
+		signingKeyRevoke = "/v1/admin/signing-keys/:id"
+	)
+	sc := di.SigningContainer.Get()
+	g.handle(openapi.Operation{
+		Method: http.MethodGet, Path: signingKeyList, Id: "listSigningKeys", Tag: "Signing keys",
+		Summary:  "List the signing keys, of a single API client if given",
+		Query:    []openapi.Parameter{{Name: "client_id", Schema: openapi.String("ID of the API client")}},
+		Response: []signingDto.KeyResponse{},
+	}, sc.ListHandler.Get().Execute)
+	g.handle(openapi.Operation{
+		Method: http.MethodPost, Path: signingKeyIssue, Id: "issueSigningKey", Tag: "Signing keys",
+		Summary:  "Issue a signing key to an API client, returning its secret once",
+		Body:     signingDto.KeyRequest{},
+		Required: []string{"client_id"},
+		Status:   http.StatusCreated,
+		Response: signingDto.KeyResponse{},
+	}, sc.IssueHandler.Get().Execute)
+	g.handle(openapi.Operation{
+		Method: http.MethodDelete, Path: signingKeyRevoke, Id: "revokeSigningKey", Tag: "Signing keys",
+		Summary: "Revoke a signing key",
+		Status:  http.StatusNoContent,
+	}, sc.RevokeHandler.Get().Execute)
+}
+
+// registerMetricsRoute defines the Prometheus metrics route, restricted to the admin scope.
+// The route is omitted when the metrics are served on a separate port.
+func registerMetricsRoute(router *middleware.Router, di *application.Container) {
+	if di.Config.Get().Metrics.Port > 0 {
+		return
+	}
+	scopeGroup(router, di, entity.ScopeAdmin).handle(openapi.Operation{
+		Method: http.MethodGet, Path: metrics.Path, Id: "getMetrics", Tag: "Metrics",
+		Summary:     "Get the Prometheus metrics",
+		Response:    "",
+		ContentType: "text/plain",
+	}, di.Metrics.Get().ServeHTTP)
 }
@@ -0,0 +1,59 @@
+package signing
+
+import (
+	"sync"
+	"time"
+)
+
+// NonceCache remembers the nonces of verified requests to reject replays.
+type NonceCache interface {
+	// Add records the nonce until the given expiry time.
+	// Returns false if the nonce has already been recorded and has not expired yet.
+	Add(nonce string, expiresAt time.Time) bool
+}
+
+// MemoryNonceCache is an in-process NonceCache. Expired nonces are swept at most once per interval.
+type MemoryNonceCache struct {
+	mu        sync.Mutex
+	nonces    map[string]time.Time
+	interval  time.Duration
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewMemoryNonceCache creates a new MemoryNonceCache sweeping expired nonces at most once per interval.
+func NewMemoryNonceCache(interval time.Duration) *MemoryNonceCache {
+	return &MemoryNonceCache{
+		nonces:   make(map[string]time.Time),
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// Add records the nonce until the given expiry time.
+func (c *MemoryNonceCache) Add(nonce string, expiresAt time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	c.sweep(now)
+
+	if exp, ok := c.nonces[nonce]; ok && exp.After(now) {
+		return false
+	}
+	c.nonces[nonce] = expiresAt
+	return true
+}
+
+// sweep removes the expired nonces if the sweep interval has elapsed. The caller must hold the lock.
+func (c *MemoryNonceCache) sweep(now time.Time) {
+	if now.Sub(c.lastSweep) < c.interval {
+		return
+	}
+	for nonce, exp := range c.nonces {
+		if !exp.After(now) {
+			delete(c.nonces, nonce)
+		}
+	}
+	c.lastSweep = now
+}
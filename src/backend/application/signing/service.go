@@ -0,0 +1,91 @@
+package signing
+
+import (
+	"application/audit"
+	"context"
+	"crypto/rand"
+	auditEntity "domain/audit/entity"
+	"domain/signing/entity"
+	"domain/signing/repository"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+)
+
+// Actions recorded in the audit log for signing key management.
+const (
+	ActionKeyIssue  = "signing_key.issue"
+	ActionKeyRevoke = "signing_key.revoke"
+)
+
+// Service provides application services for issuing and revoking the signing keys of API clients.
+type Service struct {
+	repository repository.KeyRepository
+	audit      *audit.Service
+}
+
+// NewService initializes a new Service.
+func NewService(r repository.KeyRepository, a *audit.Service) *Service {
+	return &Service{repository: r, audit: a}
+}
+
+// IssueKey generates a new signing key for an API client and records the change in the audit log.
+// The returned key carries the secret, which cannot be retrieved afterwards.
+func (s *Service) IssueKey(ctx context.Context, clientId string) (*entity.Key, error) {
+	keyId, err := randomString(8, hex.EncodeToString)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := randomString(32, base64.RawURLEncoding.EncodeToString)
+	if err != nil {
+		return nil, err
+	}
+
+	k := entity.GetKey().SetKeyId("hk_" + keyId).SetClientId(clientId).SetSecret(secret)
+	if err = s.repository.Save(ctx, k); err != nil {
+		k.Release()
+		return nil, err
+	}
+	s.audit.Report(ctx, ActionKeyIssue, keyTarget(k.GetId()), auditEntity.OutcomeSuccess, keyDetails(k))
+	return k, nil
+}
+
+// ListKeys retrieves the keys issued to an API client, or to every client if clientId is empty.
+func (s *Service) ListKeys(ctx context.Context, clientId string) ([]*entity.Key, error) {
+	return s.repository.GetList(ctx, clientId)
+}
+
+// RevokeKey revokes a signing key and records the change in the audit log.
+// Requests signed with the key are rejected from then on.
+func (s *Service) RevokeKey(ctx context.Context, id int64) error {
+	k, err := s.repository.Revoke(ctx, id)
+	if err != nil {
+		return err
+	}
+	defer k.Release()
+
+	s.audit.Report(ctx, ActionKeyRevoke, keyTarget(k.GetId()), auditEntity.OutcomeSuccess, keyDetails(k))
+	return nil
+}
+
+// randomString returns n cryptographically random bytes in the given encoding.
+func randomString(n int, encode func([]byte) string) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate random bytes: %w", err)
+	}
+	return encode(b), nil
+}
+
+// keyTarget formats the audit target of a signing key.
+func keyTarget(id int64) string {
+	return fmt.Sprintf("signing_key:%d", id)
+}
+
+// keyDetails returns the audit details of a signing key. The secret is never recorded.
+func keyDetails(k *entity.Key) map[string]any {
+	return map[string]any{
+		"key_id":    k.GetKeyId(),
+		"client_id": k.GetClientId(),
+	}
+}
@@ -0,0 +1,56 @@
+package signing
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+)
+
+// Names of the REST headers carrying a request signature. gRPC clients send the same names, lower-cased,
+// as request metadata.
+const (
+	HeaderKeyId     = "X-Signature-Key-Id"    // Public identifier of the signing key.
+	HeaderTimestamp = "X-Signature-Timestamp" // UNIX time in seconds when the request was signed.
+	HeaderNonce     = "X-Signature-Nonce"     // Random value unique to each request.
+	HeaderDigest    = "X-Content-Sha256"      // Hex encoded SHA-256 digest of the request body.
+	HeaderSignature = "X-Signature"           // Base64 encoded HMAC-SHA256 of the canonical request.
+)
+
+// SignedRequest holds the parts of a request covered by its signature.
+// For gRPC calls, Method is "POST", Path is the full method name and the body is the deterministic
+// protobuf encoding of the request message.
+type SignedRequest struct {
+	KeyId     string // Public identifier of the signing key.
+	Method    string // HTTP method of the request.
+	Path      string // Request URI, including the query string.
+	Timestamp string // UNIX time in seconds when the request was signed.
+	Nonce     string // Random value unique to each request.
+	Digest    string // Hex encoded SHA-256 digest of the request body.
+	Signature string // Base64 encoded signature sent by the client.
+}
+
+// complete reports whether every signature header of the request was sent.
+func (r *SignedRequest) complete() bool {
+	return r.KeyId != "" && r.Timestamp != "" && r.Nonce != "" && r.Digest != "" && r.Signature != ""
+}
+
+// Canonical returns the string covered by the signature: the method, path, timestamp, nonce and body
+// digest, separated by newlines.
+func (r *SignedRequest) Canonical() string {
+	return strings.Join([]string{strings.ToUpper(r.Method), r.Path, r.Timestamp, r.Nonce, r.Digest}, "\n")
+}
+
+// Sign computes the base64 encoded HMAC-SHA256 signature of the canonical request with the given secret.
+func Sign(secret string, r *SignedRequest) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(r.Canonical()))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// Digest returns the hex encoded SHA-256 digest of the request body.
+func Digest(body []byte) string {
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
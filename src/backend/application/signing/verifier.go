@@ -0,0 +1,100 @@
+package signing
+
+import (
+	"application/role"
+	"context"
+	"crypto/hmac"
+	authEntity "domain/auth/entity"
+	roleEntity "domain/role/entity"
+	"domain/signing/repository"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+var (
+	// ErrMissingSignature is returned when the request does not carry every signature field.
+	ErrMissingSignature = errors.New("missing signature fields")
+	// ErrExpiredSignature is returned when the signature timestamp falls outside the accepted time window.
+	ErrExpiredSignature = errors.New("signature timestamp outside the accepted window")
+	// ErrDigestMismatch is returned when the body digest does not match the request body.
+	ErrDigestMismatch = errors.New("body digest mismatch")
+	// ErrInvalidSignature is returned when the signing key is unknown or the signature does not match.
+	ErrInvalidSignature = errors.New("invalid signature")
+	// ErrReplayedNonce is returned when the nonce has already been used within the time window.
+	ErrReplayedNonce = errors.New("nonce already used")
+)
+
+// Verifier authenticates signed requests.
+type Verifier struct {
+	keys   repository.KeyRepository
+	roles  *role.Service
+	nonces NonceCache
+	window time.Duration
+	now    func() time.Time
+}
+
+// NewVerifier initializes a new Verifier accepting signatures whose timestamp is within window of the
+// current time.
+func NewVerifier(k repository.KeyRepository, r *role.Service, n NonceCache, window time.Duration) *Verifier {
+	return &Verifier{keys: k, roles: r, nonces: n, window: window, now: time.Now}
+}
+
+// Verify checks the signature of the request and returns the claims of the API client owning the signing key.
+// The claims carry the effective scopes of the client, so signed requests are authorized like bearer tokens.
+// The nonce is only recorded once the signature is valid, so forged requests cannot exhaust it.
+func (v *Verifier) Verify(ctx context.Context, r *SignedRequest, body []byte) (*authEntity.TokenClaims, error) {
+	if !r.complete() {
+		return nil, ErrMissingSignature
+	}
+
+	signedAt, err := v.checkTimestamp(r.Timestamp)
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal([]byte(r.Digest), []byte(Digest(body))) {
+		return nil, ErrDigestMismatch
+	}
+
+	key, err := v.keys.GetActive(ctx, r.KeyId)
+	if errors.Is(err, repository.ErrKeyNotFound) {
+		return nil, ErrInvalidSignature
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer key.Release()
+
+	if !hmac.Equal([]byte(r.Signature), []byte(Sign(key.GetSecret(), r))) {
+		return nil, ErrInvalidSignature
+	}
+	expiresAt := signedAt.Add(v.window)
+	if !v.nonces.Add(r.KeyId+":"+r.Nonce, expiresAt) {
+		return nil, ErrReplayedNonce
+	}
+
+	scopes, err := v.roles.EffectiveScopes(ctx, roleEntity.SubjectClient, key.GetClientId())
+	if err != nil {
+		return nil, fmt.Errorf("resolve scopes: %w", err)
+	}
+	return authEntity.GetTokenClaims().
+		SetIssuer(key.GetClientId()).
+		SetSubject(key.GetClientId()).
+		SetScope(scopes).
+		SetExpiresAt(expiresAt.Unix()), nil
+}
+
+// checkTimestamp parses the signature timestamp and checks that it is within the accepted time window.
+func (v *Verifier) checkTimestamp(timestamp string) (time.Time, error) {
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return time.Time{}, ErrExpiredSignature
+	}
+
+	signedAt := time.Unix(seconds, 0)
+	if diff := v.now().Sub(signedAt); diff > v.window || diff < -v.window {
+		return time.Time{}, ErrExpiredSignature
+	}
+	return signedAt, nil
+}
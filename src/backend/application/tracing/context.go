@@ -0,0 +1,131 @@
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+)
+
+// Header is the W3C trace context header and, in lower case, gRPC metadata key propagating the span context.
+const Header = "traceparent"
+
+// traceparentVersion is the only version of the traceparent header written and fully understood.
+const traceparentVersion = "00"
+
+// flagSampled is the trace flag marking a sampled trace.
+const flagSampled = 0x01
+
+// TraceId identifies a trace across all its spans and services.
+type TraceId [16]byte
+
+// SpanId identifies a single span within a trace.
+type SpanId [8]byte
+
+// String returns the lower case hexadecimal representation of the trace ID.
+func (t TraceId) String() string { return hex.EncodeToString(t[:]) }
+
+// String returns the lower case hexadecimal representation of the span ID.
+func (s SpanId) String() string { return hex.EncodeToString(s[:]) }
+
+// IsValid reports whether the trace ID is not all zeros.
+func (t TraceId) IsValid() bool { return t != TraceId{} }
+
+// IsValid reports whether the span ID is not all zeros.
+func (s SpanId) IsValid() bool { return s != SpanId{} }
+
+// SpanContext is the part of a span propagated to other services.
+type SpanContext struct {
+	TraceId TraceId // Trace the span belongs to.
+	SpanId  SpanId  // The span itself.
+	Sampled bool    // Whether the trace is recorded and exported.
+}
+
+// IsValid reports whether the span context has both a trace and a span ID.
+func (sc SpanContext) IsValid() bool {
+	return sc.TraceId.IsValid() && sc.SpanId.IsValid()
+}
+
+// Traceparent returns the span context formatted as a W3C traceparent header value.
+func (sc SpanContext) Traceparent() string {
+	flags := "00"
+	if sc.Sampled {
+		flags = "01"
+	}
+	return traceparentVersion + "-" + sc.TraceId.String() + "-" + sc.SpanId.String() + "-" + flags
+}
+
+// ParseTraceparent parses a W3C traceparent header value.
+// It reports false if the value is malformed or carries invalid IDs, in which case a new trace should be started.
+// Values of future versions are accepted as long as their first four fields are well-formed.
+func ParseTraceparent(value string) (SpanContext, bool) {
+	parts := strings.Split(strings.TrimSpace(value), "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || parts[0] == "ff" {
+		return SpanContext{}, false
+	}
+	if parts[0] == traceparentVersion && len(parts) != 4 {
+		return SpanContext{}, false
+	}
+
+	var sc SpanContext
+	var flags [1]byte
+	if !decodeHex(parts[1], sc.TraceId[:]) || !decodeHex(parts[2], sc.SpanId[:]) || !decodeHex(parts[3], flags[:]) {
+		return SpanContext{}, false
+	}
+	sc.Sampled = flags[0]&flagSampled != 0
+	return sc, sc.IsValid()
+}
+
+// decodeHex decodes a lower case hexadecimal string of exactly the length of dst.
+func decodeHex(s string, dst []byte) bool {
+	if len(s) != hex.EncodedLen(len(dst)) || strings.ToLower(s) != s {
+		return false
+	}
+	_, err := hex.Decode(dst, []byte(s))
+	return err == nil
+}
+
+// newTraceId generates a random trace ID.
+func newTraceId() TraceId {
+	var t TraceId
+	_, _ = rand.Read(t[:]) // crypto/rand.Read never returns an error
+	return t
+}
+
+// newSpanId generates a random span ID.
+func newSpanId() SpanId {
+	var s SpanId
+	_, _ = rand.Read(s[:]) // crypto/rand.Read never returns an error
+	return s
+}
+
+// contextKey is a custom type to avoid collisions in context keys.
+type contextKey string
+
+// Context keys under which the active span and a remote parent span context are stored.
+const (
+	spanKey   contextKey = "span"
+	remoteKey contextKey = "remoteSpanContext"
+)
+
+// ContextWithRemote returns a copy of ctx carrying the span context received from another service, which becomes
+// the parent of the next span started with it.
+func ContextWithRemote(ctx context.Context, sc SpanContext) context.Context {
+	return context.WithValue(ctx, remoteKey, sc)
+}
+
+// SpanFromContext returns the active span of the context, or nil if there is none.
+func SpanFromContext(ctx context.Context) *Span {
+	span, _ := ctx.Value(spanKey).(*Span)
+	return span
+}
+
+// SpanContextFromContext returns the span context to propagate with outgoing requests made with the context:
+// the one of the active span, or else the remote one received with the request.
+func SpanContextFromContext(ctx context.Context) (SpanContext, bool) {
+	if span := SpanFromContext(ctx); span != nil {
+		return span.SpanContext(), true
+	}
+	sc, ok := ctx.Value(remoteKey).(SpanContext)
+	return sc, ok && sc.IsValid()
+}
@@ -0,0 +1,132 @@
+package tracing
+
+import (
+	"sync"
+	"time"
+)
+
+// SpanKind describes the relationship of a span to its parent and children.
+type SpanKind string
+
+// Kinds of spans, as defined by OpenTelemetry.
+const (
+	KindInternal SpanKind = "internal" // An operation within the service.
+	KindServer   SpanKind = "server"   // The handling of a request received from another service.
+	KindClient   SpanKind = "client"   // A request sent to another service, e.g., a database query.
+	KindProducer SpanKind = "producer" // A message published for asynchronous processing.
+)
+
+// Status codes of ended spans.
+const (
+	StatusUnset = "unset" // The operation completed without an error being recorded.
+	StatusError = "error" // The operation failed.
+)
+
+// Span is a timed operation within a trace. It is safe for concurrent use.
+type Span struct {
+	tracer     *Tracer
+	sc         SpanContext
+	parent     SpanId
+	name       string
+	kind       SpanKind
+	start      time.Time
+	mu         sync.Mutex
+	attributes map[string]any
+	status     string
+	message    string
+	ended      bool
+}
+
+// SpanData is the exported representation of an ended span.
+type SpanData struct {
+	TraceId       string         `json:"trace_id"`                 // Trace ID as 32 hexadecimal characters.
+	SpanId        string         `json:"span_id"`                  // Span ID as 16 hexadecimal characters.
+	ParentSpanId  string         `json:"parent_span_id,omitempty"` // Parent span ID, empty for root spans.
+	Service       string         `json:"service"`                  // Name of the service recording the span.
+	Name          string         `json:"name"`                     // Name of the operation.
+	Kind          SpanKind       `json:"kind"`                     // Kind of the span.
+	Start         time.Time      `json:"start"`                    // Start of the operation.
+	End           time.Time      `json:"end"`                      // End of the operation.
+	Attributes    map[string]any `json:"attributes,omitempty"`     // Attributes describing the operation.
+	Status        string         `json:"status"`                   // StatusUnset or StatusError.
+	StatusMessage string         `json:"status_message,omitempty"` // Description of the error, if any.
+}
+
+// SpanContext returns the span context to propagate to other services.
+func (s *Span) SpanContext() SpanContext {
+	return s.sc
+}
+
+// IsRecording reports whether the span is sampled and thus exported once ended.
+func (s *Span) IsRecording() bool {
+	return s.sc.Sampled && s.tracer.exporter != nil
+}
+
+// SetAttribute sets an attribute describing the operation, e.g., "http.route".
+func (s *Span) SetAttribute(key string, value any) {
+	if !s.IsRecording() {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.attributes == nil {
+		s.attributes = make(map[string]any)
+	}
+	s.attributes[key] = value
+}
+
+// RecordError marks the span as failed with the given error. A nil error is ignored.
+func (s *Span) RecordError(err error) {
+	if err == nil {
+		return
+	}
+	s.SetError(err.Error())
+}
+
+// SetError marks the operation of the span as failed with the given description, for failures that are not
+// reported as errors, e.g., responses with a server error status code.
+func (s *Span) SetError(message string) {
+	if !s.IsRecording() {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status = StatusError
+	s.message = message
+}
+
+// End ends the span and queues it for export if it is sampled. Calls after the first one are ignored.
+func (s *Span) End() {
+	if !s.IsRecording() {
+		return
+	}
+	end := time.Now()
+
+	s.mu.Lock()
+	if s.ended {
+		s.mu.Unlock()
+		return
+	}
+	s.ended = true
+	data := SpanData{
+		TraceId:       s.sc.TraceId.String(),
+		SpanId:        s.sc.SpanId.String(),
+		Service:       s.tracer.service,
+		Name:          s.name,
+		Kind:          s.kind,
+		Start:         s.start,
+		End:           end,
+		Attributes:    s.attributes,
+		Status:        StatusUnset,
+		StatusMessage: s.message,
+	}
+	if s.status != "" {
+		data.Status = s.status
+	}
+	s.mu.Unlock()
+
+	if s.parent.IsValid() {
+		data.ParentSpanId = s.parent.String()
+	}
+	s.tracer.enqueue(data)
+}
@@ -0,0 +1,194 @@
+package tracing
+
+import (
+	"context"
+	"encoding/binary"
+	"log"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Batching of the exported spans.
+const (
+	queueSize     = 2048            // Maximum number of ended spans waiting to be exported; further spans are dropped.
+	batchSize     = 256             // Maximum number of spans exported at once.
+	flushInterval = 5 * time.Second // Maximum time an ended span waits to be exported.
+)
+
+// Exporter sends ended spans to a backend, e.g., a file or an OpenTelemetry collector.
+type Exporter interface {
+	// Export sends a batch of spans. It is never called concurrently.
+	Export(ctx context.Context, spans []SpanData) error
+	// Shutdown releases the resources of the exporter once all spans were exported.
+	Shutdown(ctx context.Context) error
+}
+
+// Sampler decides whether a new trace is recorded; spans with a parent follow the decision of the parent.
+type Sampler func(traceId TraceId) bool
+
+// RatioSampler returns a Sampler recording the given ratio of traces, from 0 (none) to 1 (all).
+// The decision is derived from the trace ID, so it is consistent for all services using the same ratio.
+func RatioSampler(ratio float64) Sampler {
+	switch {
+	case ratio >= 1:
+		return func(TraceId) bool { return true }
+	case ratio <= 0:
+		return func(TraceId) bool { return false }
+	}
+	bound := uint64(ratio * math.MaxUint64)
+	return func(t TraceId) bool {
+		return binary.BigEndian.Uint64(t[8:]) < bound
+	}
+}
+
+// Tracer starts spans and exports the sampled ones in batches in the background.
+type Tracer struct {
+	service  string                  // Name of the service reported with the spans.
+	sampler  atomic.Pointer[Sampler] // Sampler deciding whether new traces are recorded.
+	exporter Exporter                // Exporter of the ended spans, or nil to record none.
+	queue    chan SpanData           // Ended spans waiting to be exported.
+	done     chan struct{}           // Closed once the export loop has finished.
+	mu       sync.RWMutex            // Guards closed against concurrent enqueues.
+	closed   bool                    // Whether the queue was closed by Shutdown.
+	dropped  atomic.Int64            // Number of spans dropped because the queue was full.
+}
+
+// NewTracer creates a Tracer for the given service and starts exporting its spans.
+// A nil exporter makes the tracer propagate span contexts without recording any span.
+func NewTracer(service string, exporter Exporter, sampler Sampler) *Tracer {
+	t := &Tracer{service: service, exporter: exporter, done: make(chan struct{})}
+	t.SetSampler(sampler)
+	if exporter == nil {
+		close(t.done)
+		return t
+	}
+	t.queue = make(chan SpanData, queueSize)
+	go t.export()
+	return t
+}
+
+// Service returns the name of the service reported with the spans.
+func (t *Tracer) Service() string {
+	return t.service
+}
+
+// SetSampler replaces the sampler deciding whether new traces are recorded, e.g., when the configuration is reloaded.
+func (t *Tracer) SetSampler(sampler Sampler) {
+	t.sampler.Store(&sampler)
+}
+
+// Start starts a span of the given kind as a child of the active or remote span of the context, if any.
+// It returns a copy of the context with the new span active. The span must be ended by calling Span.End.
+func (t *Tracer) Start(ctx context.Context, name string, kind SpanKind) (context.Context, *Span) {
+	span := &Span{tracer: t, name: name, kind: kind, start: time.Now()}
+	if parent, ok := SpanContextFromContext(ctx); ok {
+		span.parent = parent.SpanId
+		span.sc = SpanContext{TraceId: parent.TraceId, Sampled: parent.Sampled}
+	} else {
+		span.sc.TraceId = newTraceId()
+		span.sc.Sampled = (*t.sampler.Load())(span.sc.TraceId)
+	}
+	span.sc.SpanId = newSpanId()
+	return context.WithValue(ctx, spanKey, span), span
+}
+
+// Shutdown exports the pending spans and shuts the exporter down. Spans ended afterwards are dropped.
+func (t *Tracer) Shutdown(ctx context.Context) error {
+	if t.exporter == nil {
+		return nil
+	}
+	t.mu.Lock()
+	if !t.closed {
+		t.closed = true
+		close(t.queue)
+	}
+	t.mu.Unlock()
+
+	select {
+	case <-t.done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	if n := t.dropped.Load(); n > 0 {
+		log.Printf("tracing: dropped %d spans because the export queue was full", n)
+	}
+	return t.exporter.Shutdown(ctx)
+}
+
+// enqueue queues an ended span for export, dropping it if the queue is full or the tracer shut down.
+func (t *Tracer) enqueue(data SpanData) {
+	if t.exporter == nil {
+		return
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if t.closed {
+		t.dropped.Add(1)
+		return
+	}
+	select {
+	case t.queue <- data:
+	default:
+		t.dropped.Add(1)
+	}
+}
+
+// export sends the queued spans in batches until the queue is closed.
+func (t *Tracer) export() {
+	defer close(t.done)
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]SpanData, 0, batchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := t.exporter.Export(context.Background(), batch); err != nil {
+			log.Printf("tracing: failed to export %d spans: %v", len(batch), err)
+		}
+		batch = batch[:0]
+	}
+
+	for {
+		select {
+		case data, ok := <-t.queue:
+			if !ok {
+				flush()
+				return
+			}
+			batch = append(batch, data)
+			if len(batch) == batchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		}
+	}
+}
+
+// defaultTracer is the tracer used by the package level Start function.
+var defaultTracer atomic.Pointer[Tracer]
+
+// noopTracer propagates span contexts without recording spans until a default tracer is set.
+var noopTracer = NewTracer("", nil, RatioSampler(0))
+
+// SetDefault makes the tracer the one used by the package level Start function.
+func SetDefault(t *Tracer) {
+	defaultTracer.Store(t)
+}
+
+// Default returns the tracer set by SetDefault, or a tracer recording no spans if none was set.
+func Default() *Tracer {
+	if t := defaultTracer.Load(); t != nil {
+		return t
+	}
+	return noopTracer
+}
+
+// Start starts a span with the default tracer, see Tracer.Start.
+func Start(ctx context.Context, name string, kind SpanKind) (context.Context, *Span) {
+	return Default().Start(ctx, name, kind)
+}
@@ -0,0 +1,199 @@
+package vacancy
+
+import (
+	"application/organization"
+	"context"
+	"domain"
+	auditEntity "domain/audit/entity"
+	"domain/vacancy/entity"
+	"domain/vacancy/events"
+	"domain/vacancy/repository"
+)
+
+// batchOperation describes how a batch operation applies its items and reports the applied ones.
+type batchOperation struct {
+	action  string                               // Action recorded in the audit log for each applied item.
+	apply   func(pending []int) ([]error, error) // Applies the items with the given indexes in the repository.
+	target  func(i int) string                   // Audit target of the item with the given index.
+	details func(i int) map[string]any           // Audit details of the item with the given index, if any.
+	event   func(i int) domain.Event             // Event dispatched for the item with the given index.
+}
+
+// GetVacancies retrieves the job vacancies with the given IDs from the database, skipping the ones that do not
+// exist.
+func (s *Service) GetVacancies(ctx context.Context, ids []int64) ([]*entity.Vacancy, error) {
+	return s.repository.GetBatch(ctx, ids)
+}
+
+// CreateVacancies saves new job vacancies in a single transaction, records each in the audit log and dispatches
+// their VacancyCreatedEvents as a batch.
+// The caller must belong to the organization owning each vacancy unless it holds cross-organization rights.
+// Returns the error of each vacancy, nil if it was saved: organization.ErrNotPermitted if the check fails, or the
+// error of the repository. In atomic mode, a failing vacancy aborts the others, which get
+// repository.ErrBatchAborted. Returns nil and the error if the batch fails as a whole; the error is returned along
+// with the error of each vacancy if recording or dispatching the applied vacancies fails.
+func (s *Service) CreateVacancies(ctx context.Context, list []*entity.Vacancy, atomic bool) ([]error, error) {
+	errs := make([]error, len(list))
+	decisions := make(map[int64]error)
+	for i, v := range list {
+		errs[i] = s.authorizeOrganization(ctx, decisions, v.GetOrganizationId())
+		s.reportDenied(ctx, errs[i], ActionVacancyCreate, "vacancy")
+	}
+
+	return s.applyBatch(ctx, errs, atomic, batchOperation{
+		action: ActionVacancyCreate,
+		apply: func(pending []int) ([]error, error) {
+			return s.repository.SaveBatch(ctx, pick(list, pending), atomic)
+		},
+		target:  func(i int) string { return vacancyTarget(list[i].GetId()) },
+		details: func(i int) map[string]any { return vacancyDetails(list[i]) },
+		event:   func(i int) domain.Event { return events.NewVacancyCreatedEvent(list[i].GetId()) },
+	})
+}
+
+// UpdateVacancies updates existing job vacancies in a single transaction, records each change in the audit log
+// and dispatches their VacancyUpdatedEvents as a batch.
+// The caller must belong to the organization owning each vacancy unless it holds cross-organization rights.
+// Returns the error of each vacancy as CreateVacancies, and repository.ErrVacancyNotFound for the vacancies that
+// do not exist.
+func (s *Service) UpdateVacancies(ctx context.Context, list []*entity.Vacancy, atomic bool) ([]error, error) {
+	ids := make([]int64, len(list))
+	for i, v := range list {
+		ids[i] = v.GetId()
+	}
+	errs, err := s.authorizeOwners(ctx, ids, ActionVacancyUpdate)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.applyBatch(ctx, errs, atomic, batchOperation{
+		action: ActionVacancyUpdate,
+		apply: func(pending []int) ([]error, error) {
+			return s.repository.UpdateBatch(ctx, pick(list, pending), atomic)
+		},
+		target:  func(i int) string { return vacancyTarget(ids[i]) },
+		details: func(i int) map[string]any { return vacancyDetails(list[i]) },
+		event:   func(i int) domain.Event { return events.NewVacancyUpdatedEvent(ids[i]) },
+	})
+}
+
+// DeleteVacancies deletes existing job vacancies in a single transaction, records each deletion in the audit log
+// and dispatches their VacancyDeletedEvents as a batch.
+// The caller must belong to the organization owning each vacancy unless it holds cross-organization rights.
+// Returns the error of each vacancy as UpdateVacancies.
+func (s *Service) DeleteVacancies(ctx context.Context, ids []int64, atomic bool) ([]error, error) {
+	errs, err := s.authorizeOwners(ctx, ids, ActionVacancyDelete)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.applyBatch(ctx, errs, atomic, batchOperation{
+		action: ActionVacancyDelete,
+		apply: func(pending []int) ([]error, error) {
+			return s.repository.DeleteBatch(ctx, pick(ids, pending), atomic)
+		},
+		target:  func(i int) string { return vacancyTarget(ids[i]) },
+		details: func(int) map[string]any { return nil },
+		event:   func(i int) domain.Event { return events.NewVacancyDeletedEvent(ids[i]) },
+	})
+}
+
+// applyBatch applies the operation to the items without an error in errs, stores the result of each in errs and
+// returns errs. In atomic mode, nothing is applied if any item already failed, and the other items get
+// repository.ErrBatchAborted. The applied items are recorded in the audit log and their events dispatched as a
+// batch. Returns nil and the error if the operation fails as a whole.
+func (s *Service) applyBatch(ctx context.Context, errs []error, atomic bool, op batchOperation) ([]error, error) {
+	var pending []int
+	for i, err := range errs {
+		if err == nil {
+			pending = append(pending, i)
+		}
+	}
+	if len(pending) == 0 {
+		return errs, nil
+	}
+	if atomic && len(pending) < len(errs) {
+		for _, i := range pending {
+			errs[i] = repository.ErrBatchAborted
+		}
+		return errs, nil
+	}
+
+	results, err := op.apply(pending)
+	if err != nil {
+		return nil, err
+	}
+	for k, i := range pending {
+		errs[i] = results[k]
+	}
+	return errs, s.reportBatch(ctx, errs, pending, op)
+}
+
+// reportBatch records the applied items among the pending ones in the audit log and dispatches their events as a
+// batch.
+func (s *Service) reportBatch(ctx context.Context, errs []error, pending []int, op batchOperation) error {
+	var applied []domain.Event
+	for _, i := range pending {
+		if errs[i] != nil {
+			continue
+		}
+		s.audit.Report(ctx, op.action, op.target(i), auditEntity.OutcomeSuccess, op.details(i))
+		applied = append(applied, op.event(i))
+	}
+	return s.dispatcher.DispatchBatch(ctx, applied)
+}
+
+// authorizeOwners checks that the caller may manage each stored vacancy on behalf of its owning organization.
+// Vacancies without an owner remain open to every caller granted the scope of the action.
+// Returns the error of each vacancy, repository.ErrVacancyNotFound if it does not exist, or an error if the
+// vacancies cannot be retrieved.
+func (s *Service) authorizeOwners(ctx context.Context, ids []int64, action string) ([]error, error) {
+	errs := make([]error, len(ids))
+	if organization.HasCrossOrganizationRights(ctx) {
+		return errs, nil
+	}
+
+	stored, err := s.repository.GetBatch(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	owners := make(map[int64]int64, len(stored))
+	for _, v := range stored {
+		owners[v.GetId()] = v.GetOrganizationId()
+	}
+
+	decisions := make(map[int64]error)
+	for i, id := range ids {
+		owner, ok := owners[id]
+		if !ok {
+			errs[i] = repository.ErrVacancyNotFound
+			continue
+		}
+		if owner == 0 {
+			continue
+		}
+		errs[i] = s.authorizeOrganization(ctx, decisions, owner)
+		s.reportDenied(ctx, errs[i], action, vacancyTarget(id))
+	}
+	return errs, nil
+}
+
+// authorizeOrganization checks that the caller may act on behalf of the organization, reusing the decisions
+// already taken for the batch.
+func (s *Service) authorizeOrganization(ctx context.Context, decisions map[int64]error, id int64) error {
+	if err, ok := decisions[id]; ok {
+		return err
+	}
+	err := s.organizations.Authorize(ctx, id)
+	decisions[id] = err
+	return err
+}
+
+// pick returns the items with the given indexes.
+func pick[T any](items []T, indexes []int) []T {
+	picked := make([]T, len(indexes))
+	for k, i := range indexes {
+		picked[k] = items[i]
+	}
+	return picked
+}
@@ -0,0 +1,37 @@
+package vacancy
+
+import "slices"
+
+// Columns of an exported job vacancy, also the fields a get or a list may be limited to.
+const (
+	ColumnId             = "id"
+	ColumnTitle          = "title"
+	ColumnCompany        = "company"
+	ColumnDescription    = "description"
+	ColumnPostedAt       = "posted_at"
+	ColumnLocation       = "location"
+	ColumnOrganizationId = "organization_id"
+	ColumnVersion        = "version"
+)
+
+// ExportColumns returns the columns of an exported job vacancy, in the order they are exported by default.
+func ExportColumns() []string {
+	return []string{ColumnId, ColumnTitle, ColumnCompany, ColumnDescription, ColumnPostedAt, ColumnLocation,
+		ColumnOrganizationId, ColumnVersion}
+}
+
+// ExportSortFields returns the fields the exported job vacancies may be sorted by.
+func ExportSortFields() []string {
+	return []string{ColumnId, ColumnTitle, ColumnCompany}
+}
+
+// ValidExportColumns reports whether the columns selected for an export are known columns, each selected once.
+func ValidExportColumns(columns []string) bool {
+	known := ExportColumns()
+	for i, column := range columns {
+		if !slices.Contains(known, column) || slices.Contains(columns[:i], column) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,273 @@
+package vacancy
+
+import (
+	"application/organization"
+	"context"
+	organizationRepository "domain/organization/repository"
+	"domain/vacancy/entity"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Formats of an import.
+const (
+	ImportFormatCSV    = "csv"    // Comma-separated values with a header row naming the columns, RFC 4180.
+	ImportFormatNDJSON = "ndjson" // Newline-delimited JSON, one object per vacancy.
+)
+
+// ErrImportUnreadable is returned when the file of an import cannot be read, e.g., a malformed CSV header row or
+// an interrupted upload.
+var ErrImportUnreadable = errors.New("file cannot be read")
+
+// Details of the rows that could not be created.
+const (
+	importNotPermittedDetail   = "your user account doesn't have permission to create vacancies for the organization"
+	importNoOrganizationDetail = "organization does not exist"
+	importFailedDetail         = "the vacancy could not be created"
+	importAbortedDetail        = "a batch of rows could not be created; the rows after it were not read"
+)
+
+// ImportOptions describes how the vacancies of a file are imported.
+type ImportOptions struct {
+	Format    string // Format of the file, ImportFormatCSV or ImportFormatNDJSON.
+	Mapping   string // Column of the file holding each field, as field=column,... if not the field.
+	DryRun    bool   // Validates the rows and reports their errors without importing them.
+	BatchSize int    // Number of valid rows created together.
+}
+
+// ImportReport is the result of an import, with the errors of every failed row.
+type ImportReport struct {
+	DryRun   bool             // Whether the rows were only validated.
+	Rows     int              // Number of rows read.
+	Valid    int              // Number of rows passing the validation.
+	Imported int              // Number of vacancies created, none in a dry run.
+	Failed   int              // Number of rows not imported, or invalid in a dry run.
+	Errors   []ImportRowError // Errors of the failed rows, in the order of the rows.
+	Aborted  string           // Why the import stopped before the end of the file, if it did.
+}
+
+// ImportRowError is an error of a row of an import.
+type ImportRowError struct {
+	Line   int    // Line of the row in the file.
+	Field  string // Field of the vacancy in error, if any.
+	Column string // Column of the file holding the field.
+	Detail string // Explanation of the error.
+}
+
+// importBatch holds the valid rows of an import waiting to be created together.
+type importBatch struct {
+	lines []int             // Lines of the rows.
+	list  []*entity.Vacancy // Vacancies of the rows.
+}
+
+// ImportFormats returns the formats of an import.
+func ImportFormats() []string {
+	return []string{ImportFormatCSV, ImportFormatNDJSON}
+}
+
+// ImportFields returns the fields of the vacancies read from an import.
+func ImportFields() []string {
+	return []string{ColumnTitle, ColumnCompany, ColumnDescription, ColumnPostedAt, ColumnLocation,
+		ColumnOrganizationId}
+}
+
+// ParseImportMapping parses the mapping of the fields to the columns of a file, e.g.,
+// "title=Job Title,company=Employer", and returns the column of every field. The fields not mapped are read from
+// the column of the same name.
+func ParseImportMapping(mapping string) (map[string]string, error) {
+	columns := make(map[string]string)
+	for _, field := range ImportFields() {
+		columns[field] = field
+	}
+	if strings.TrimSpace(mapping) == "" {
+		return columns, nil
+	}
+
+	mapped := make(map[string]bool)
+	for _, pair := range strings.Split(mapping, ",") {
+		field, column, ok := strings.Cut(pair, "=")
+		field, column = strings.TrimSpace(field), strings.TrimSpace(column)
+		switch {
+		case !ok || column == "":
+			return nil, fmt.Errorf("%q must be a field=column pair", pair)
+		case !slices.Contains(ImportFields(), field):
+			return nil, fmt.Errorf("%q is not a field, expected one of %s", field,
+				strings.Join(ImportFields(), ", "))
+		case mapped[field]:
+			return nil, fmt.Errorf("%q is mapped more than once", field)
+		}
+		mapped[field] = true
+		columns[field] = column
+	}
+	return columns, nil
+}
+
+// ImportVacancies imports the vacancies of the file, in the format and with the column mapping of the options,
+// after validating each row as a created vacancy. The valid rows are created in batches, in best-effort mode, as
+// the file is read, unless the import is a dry run.
+// The caller must belong to the organization owning each vacancy unless it holds cross-organization rights.
+// Returns the report of the rows read, with an error if the mapping is invalid, in which case the report is nil,
+// if the file cannot be read, wrapping ErrImportUnreadable, or if a batch fails as a whole, in which case its rows
+// are reported failed. Either way, the rows of the previous batches remain imported.
+func (s *Service) ImportVacancies(ctx context.Context, file io.Reader, o ImportOptions) (*ImportReport, error) {
+	mapping, err := ParseImportMapping(o.Mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	reader := newImportReader(o.Format, file)
+	report := &ImportReport{DryRun: o.DryRun, Errors: []ImportRowError{}}
+	batch := &importBatch{}
+	defer batch.release()
+	for {
+		row, err := reader.read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return report, fmt.Errorf("%w: %w", ErrImportUnreadable, err)
+		}
+
+		report.Rows++
+		e := report.validateRow(row, mapping)
+		if e == nil {
+			continue
+		}
+		report.Valid++
+		batch.lines, batch.list = append(batch.lines, row.line), append(batch.list, e)
+		if len(batch.list) < o.BatchSize {
+			continue
+		}
+		if err = s.flushImport(ctx, report, batch); err != nil {
+			return report, err
+		}
+	}
+	return report, s.flushImport(ctx, report, batch)
+}
+
+// flushImport creates the vacancies of the batch, unless the import is a dry run, records the result of each in
+// the report and empties the batch. Returns an error if the batch fails as a whole, recording its rows as failed.
+func (s *Service) flushImport(ctx context.Context, report *ImportReport, batch *importBatch) error {
+	defer batch.release()
+	if report.DryRun || len(batch.list) == 0 {
+		return nil
+	}
+
+	errs, err := s.CreateVacancies(ctx, batch.list, false)
+	if errs == nil {
+		report.Aborted = importAbortedDetail
+		for _, line := range batch.lines {
+			report.fail(line, importAbortedDetail)
+		}
+		return err
+	}
+	if err != nil {
+		log.Printf("failed to dispatch the events of imported vacancies: %v", err)
+	}
+	for k, err := range errs {
+		if err == nil {
+			report.Imported++
+			continue
+		}
+		report.fail(batch.lines[k], importErrorDetail(err))
+	}
+	return nil
+}
+
+// release releases the vacancies of the batch and empties it.
+func (b *importBatch) release() {
+	for _, v := range b.list {
+		v.Release()
+	}
+	b.lines, b.list = b.lines[:0], b.list[:0]
+}
+
+// importErrorDetail returns the detail of the error of a row that could not be created, logging unexpected errors.
+func importErrorDetail(err error) string {
+	switch {
+	case errors.Is(err, organization.ErrNotPermitted):
+		return importNotPermittedDetail
+	case errors.Is(err, organizationRepository.ErrOrganizationNotFound):
+		return importNoOrganizationDetail
+	}
+	log.Printf("failed to import vacancy: %v", err)
+	return importFailedDetail
+}
+
+// validateRow validates the row as a created vacancy, with the rules of the entity, reading each field from its
+// column in the mapping, and returns the vacancy, or records the errors of the row in the report and returns nil.
+// The caller should release the vacancy.
+func (r *ImportReport) validateRow(row *importRow, mapping map[string]string) *entity.Vacancy {
+	if row.err != nil {
+		r.fail(row.line, row.err.Error())
+		return nil
+	}
+
+	e := entity.GetVacancy()
+	errs := make(map[string]string)
+	text := map[string]func(string) *entity.Vacancy{ColumnTitle: e.SetTitle, ColumnCompany: e.SetCompany,
+		ColumnDescription: e.SetDescription, ColumnLocation: e.SetLocation}
+	for field, set := range text {
+		value := row.values[mapping[field]]
+		if err := entity.ValidateText(field, value); err != nil {
+			errs[field] = err.Error()
+		}
+		set(value)
+	}
+	postedAt, err := entity.ParsePostedAt(row.values[mapping[ColumnPostedAt]])
+	if err != nil {
+		errs[ColumnPostedAt] = err.Error()
+	}
+	e.SetPostedAt(postedAt)
+	if value, ok := row.values[mapping[ColumnOrganizationId]]; ok {
+		validateOrganizationId(e, value, errs)
+	}
+
+	if len(errs) > 0 {
+		e.Release()
+		r.failFields(row.line, errs, mapping)
+		return nil
+	}
+	return e
+}
+
+// validateOrganizationId sets the organization owning the vacancy, a positive ID, or records its error.
+func validateOrganizationId(e *entity.Vacancy, value string, errs map[string]string) {
+	organizationId, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	if err != nil {
+		errs[ColumnOrganizationId] = "organization_id must be an integer"
+		return
+	}
+	if err = entity.ValidateOrganizationId(organizationId); err != nil {
+		errs[ColumnOrganizationId] = err.Error()
+		return
+	}
+	e.SetOrganizationId(organizationId)
+}
+
+// fail records a failed row with the given error.
+func (r *ImportReport) fail(line int, detail string) {
+	r.Failed++
+	r.Errors = append(r.Errors, ImportRowError{Line: line, Detail: detail})
+}
+
+// failFields records a failed row with the errors of its fields, sorted by field, locating each field in its
+// column in the mapping.
+func (r *ImportReport) failFields(line int, errs map[string]string, mapping map[string]string) {
+	fields := make([]string, 0, len(errs))
+	for field := range errs {
+		fields = append(fields, field)
+	}
+	slices.Sort(fields)
+
+	r.Failed++
+	for _, field := range fields {
+		r.Errors = append(r.Errors, ImportRowError{Line: line, Field: field, Column: mapping[field],
+			Detail: errs[field]})
+	}
+}
@@ -0,0 +1,148 @@
+package vacancy
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// maxLineSize is the size of the longest NDJSON line read.
+const maxLineSize = 1 << 20
+
+// byteOrderMark starts the CSV files saved by some spreadsheets.
+const byteOrderMark = "\uFEFF"
+
+// importRow is a row read from an import, with its values by column.
+type importRow struct {
+	line   int               // Line of the row in the file, starting at 1.
+	values map[string]string // Values by column, or by member of an NDJSON object. Empty values are left out.
+	err    error             // Error of a row that cannot be parsed, e.g., invalid JSON.
+}
+
+// importReader reads the rows of an import.
+type importReader interface {
+	// read reads the next row. Rows that cannot be parsed are returned with their error, and io.EOF at the end of
+	// the file. Other errors, e.g., of the underlying reader, end the import.
+	read() (*importRow, error)
+}
+
+// newImportReader creates an importReader reading the rows of the file in the format, ImportFormatCSV or
+// ImportFormatNDJSON.
+func newImportReader(format string, r io.Reader) importReader {
+	if format == ImportFormatNDJSON {
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 0, 64<<10), maxLineSize)
+		return &ndjsonReader{scanner: scanner}
+	}
+	reader := csv.NewReader(r)
+	reader.ReuseRecord = true
+	return &csvReader{reader: reader}
+}
+
+// csvReader reads the records of a CSV file after its header row, which names the columns.
+type csvReader struct {
+	reader *csv.Reader
+	header []string
+}
+
+// read reads the next record as a row.
+func (c *csvReader) read() (*importRow, error) {
+	if c.header == nil {
+		header, err := c.reader.Read()
+		if err != nil {
+			return nil, c.readError(err)
+		}
+		c.header = make([]string, len(header))
+		for i, column := range header {
+			c.header[i] = strings.TrimSpace(column)
+		}
+		c.header[0] = strings.TrimPrefix(c.header[0], byteOrderMark)
+	}
+
+	record, err := c.reader.Read()
+	var parseError *csv.ParseError
+	switch {
+	case errors.As(err, &parseError):
+		return &importRow{line: parseError.StartLine, err: parseError.Err}, nil
+	case err != nil:
+		return nil, c.readError(err)
+	}
+
+	line, _ := c.reader.FieldPos(0)
+	row := &importRow{line: line, values: make(map[string]string, len(record))}
+	for i, value := range record {
+		if value != "" {
+			row.values[c.header[i]] = value
+		}
+	}
+	return row, nil
+}
+
+// readError returns the error ending the import, io.EOF at the end of the file.
+func (c *csvReader) readError(err error) error {
+	var parseError *csv.ParseError
+	if errors.As(err, &parseError) {
+		return fmt.Errorf("header row: %w", parseError.Err)
+	}
+	return err
+}
+
+// ndjsonReader reads the lines of an NDJSON file, skipping the blank ones.
+type ndjsonReader struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+// read reads the object of the next line as a row.
+func (n *ndjsonReader) read() (*importRow, error) {
+	for n.scanner.Scan() {
+		n.line++
+		if len(bytes.TrimSpace(n.scanner.Bytes())) > 0 {
+			return n.parse(n.scanner.Bytes()), nil
+		}
+	}
+	if errors.Is(n.scanner.Err(), bufio.ErrTooLong) {
+		return nil, fmt.Errorf("line %d is longer than %d bytes", n.line+1, maxLineSize)
+	}
+	if err := n.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+// parse parses the JSON object of a line. Its members must be strings, numbers, booleans or null, which is the
+// same as leaving the member out.
+func (n *ndjsonReader) parse(line []byte) *importRow {
+	row := &importRow{line: n.line}
+	decoder := json.NewDecoder(bytes.NewReader(line))
+	decoder.UseNumber()
+
+	var object map[string]any
+	if err := decoder.Decode(&object); err != nil || decoder.More() || object == nil {
+		row.err = errors.New("line must hold a single JSON object")
+		return row
+	}
+	row.values = make(map[string]string, len(object))
+	for key, value := range object {
+		switch v := value.(type) {
+		case nil:
+		case string:
+			if v != "" {
+				row.values[key] = v
+			}
+		case json.Number:
+			row.values[key] = v.String()
+		case bool:
+			row.values[key] = strconv.FormatBool(v)
+		default:
+			row.err = fmt.Errorf("%s must be a string, a number, a boolean or null", key)
+		}
+	}
+	return row
+}
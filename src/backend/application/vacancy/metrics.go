@@ -0,0 +1,30 @@
+package vacancy
+
+import (
+	"application/metrics"
+	"context"
+	"log"
+	"math"
+	"time"
+)
+
+// countTimeout bounds the time spent counting the vacancies on a scrape.
+const countTimeout = 2 * time.Second
+
+// RegisterMetrics exposes the number of stored vacancies in the registry as the "vacancies" gauge.
+// The number is counted on every scrape; it is reported as NaN when counting fails.
+func RegisterMetrics(r *metrics.Registry, s *Service) {
+	total := r.NewGaugeVec("vacancies", "Number of stored job vacancies.")
+	r.OnCollect(func(ctx context.Context) {
+		ctx, cancel := context.WithTimeout(ctx, countTimeout)
+		defer cancel()
+
+		count, err := s.CountVacancies(ctx)
+		if err != nil {
+			log.Printf("failed to count vacancies for metrics: %v", err)
+			total.With().Set(math.NaN())
+			return
+		}
+		total.With().Set(float64(count))
+	})
+}
@@ -1,58 +1,103 @@
 package vacancy
 
 import (
+	"application/audit"
 	"application/event"
+	"application/organization"
 	"context"
+	auditEntity "domain/audit/entity"
 	"domain/vacancy/entity"
 	"domain/vacancy/events"
 	"domain/vacancy/repository"
+	"errors"
+	"fmt"
+)
+
+// Actions recorded in the audit log for vacancy management.
+const (
+	ActionVacancyCreate = "vacancy.create"
+	ActionVacancyUpdate = "vacancy.update"
+	ActionVacancyDelete = "vacancy.delete"
+	ActionVacancyPurge  = "vacancy.purge"
 )
 
 // Service provides application services for managing job vacancies.
 type Service struct {
-	repository repository.VacancyRepository
-	dispatcher event.Dispatcher
+	repository    repository.VacancyRepository
+	dispatcher    event.Dispatcher
+	organizations *organization.Service
+	audit         *audit.Service
 }
 
 // NewService initializes a new Service.
-func NewService(r repository.VacancyRepository, d event.Dispatcher) *Service {
-	return &Service{repository: r, dispatcher: d}
+func NewService(
+	r repository.VacancyRepository,
+	d event.Dispatcher,
+	o *organization.Service,
+	a *audit.Service,
+) *Service {
+	return &Service{repository: r, dispatcher: d, organizations: o, audit: a}
 }
 
-// CreateVacancy saves a new job vacancy to the database and dispatches a VacancyCreatedEvent.
-// Returns an error if saving the vacancy or dispatching the event fails.
+// CreateVacancy saves a new job vacancy to the database, records it in the audit log and dispatches
+// a VacancyCreatedEvent.
+// The caller must belong to the organization owning the vacancy unless it holds cross-organization rights.
+// Returns organization.ErrNotPermitted if the check fails, or an error if saving the vacancy or dispatching
+// the event fails.
 func (s *Service) CreateVacancy(ctx context.Context, v *entity.Vacancy) error {
+	if err := s.organizations.Authorize(ctx, v.GetOrganizationId()); err != nil {
+		s.reportDenied(ctx, err, ActionVacancyCreate, "vacancy")
+		return err
+	}
 	if err := s.repository.Save(ctx, v); err != nil {
 		return err
 	}
+	s.audit.Report(ctx, ActionVacancyCreate, vacancyTarget(v.GetId()), auditEntity.OutcomeSuccess, vacancyDetails(v))
 	e := events.NewVacancyCreatedEvent(v.GetId())
-	return s.dispatcher.Dispatch(e)
+	return s.dispatcher.Dispatch(ctx, e)
 }
 
-// UpdateVacancy updates an existing job vacancy in the database and dispatches a VacancyUpdatedEvent.
-// Returns an error if updating the vacancy or dispatching the event fails.
+// UpdateVacancy updates an existing job vacancy in the database, records the change in the audit log and
+// dispatches a VacancyUpdatedEvent.
+// The caller must belong to the organization owning the vacancy unless it holds cross-organization rights.
+// Returns organization.ErrNotPermitted if the check fails, or an error if updating the vacancy or dispatching
+// the event fails.
 func (s *Service) UpdateVacancy(ctx context.Context, v *entity.Vacancy) error {
+	if err := s.authorizeOwner(ctx, v.GetId()); err != nil {
+		s.reportDenied(ctx, err, ActionVacancyUpdate, vacancyTarget(v.GetId()))
+		return err
+	}
 	if err := s.repository.Update(ctx, v); err != nil {
 		return err
 	}
+	s.audit.Report(ctx, ActionVacancyUpdate, vacancyTarget(v.GetId()), auditEntity.OutcomeSuccess, vacancyDetails(v))
 	e := events.NewVacancyUpdatedEvent(v.GetId())
-	return s.dispatcher.Dispatch(e)
+	return s.dispatcher.Dispatch(ctx, e)
 }
 
-// DeleteVacancy deletes an existing job vacancy from the database and dispatches a VacancyDeletedEvent.
-// Returns an error if deleting the vacancy or dispatching the event fails.
+// DeleteVacancy deletes an existing job vacancy from the database, records the deletion in the audit log and
+// dispatches a VacancyDeletedEvent.
+// The caller must belong to the organization owning the vacancy unless it holds cross-organization rights.
+// Returns organization.ErrNotPermitted if the check fails, or an error if deleting the vacancy or dispatching
+// the event fails.
 func (s *Service) DeleteVacancy(ctx context.Context, id int64) error {
+	if err := s.authorizeOwner(ctx, id); err != nil {
+		s.reportDenied(ctx, err, ActionVacancyDelete, vacancyTarget(id))
+		return err
+	}
 	if err := s.repository.Delete(ctx, id); err != nil {
 		return err
 	}
+	s.audit.Report(ctx, ActionVacancyDelete, vacancyTarget(id), auditEntity.OutcomeSuccess, nil)
 	e := events.NewVacancyDeletedEvent(id)
-	return s.dispatcher.Dispatch(e)
+	return s.dispatcher.Dispatch(ctx, e)
 }
 
-// GetVacancy retrieves a job vacancy by its unique ID from the database.
+// GetVacancy retrieves a job vacancy by its unique ID from the database, reading only the given fields, among
+// ExportColumns, if any.
 // Returns the vacancy if found, or an error if retrieval fails.
-func (s *Service) GetVacancy(ctx context.Context, id int64) (*entity.Vacancy, error) {
-	v, err := s.repository.Get(ctx, id)
+func (s *Service) GetVacancy(ctx context.Context, id int64, fields ...string) (*entity.Vacancy, error) {
+	v, err := s.repository.Get(ctx, id, fields...)
 	if err != nil {
 		return nil, err
 	}
@@ -70,22 +115,95 @@ func (s *Service) ListVacancies(ctx context.Context) ([]*entity.Vacancy, error)
 }
 
 // ListFilteredVacancies retrieves a list of job vacancies based on filter criteria from the database.
-// Accepts parameters for title, company, pagination (page and pageSize), and sorting (sortField and sortOrder).
+// Accepts parameters for title, company, pagination (page and pageSize), sorting (sortField and sortOrder), and the
+// fields to read, among ExportColumns, all of them if none.
 // Returns a slice of job vacancies or an error if retrieval fails.
 func (s *Service) ListFilteredVacancies(
 	ctx context.Context,
 	title, company string,
 	page, pageSize int,
 	sortField, sortOrder string,
+	fields ...string,
 ) ([]*entity.Vacancy, error) {
-	list, err := s.repository.GetFilteredList(ctx, title, company, page, pageSize, sortField, sortOrder)
+	list, err := s.repository.GetFilteredList(ctx, title, company, page, pageSize, sortField, sortOrder, fields...)
 	if err != nil {
 		return nil, err
 	}
 	return list, nil
 }
 
-// PurgeVacancies removes all job vacancies from the database.
+// FeedVacancies retrieves the most recently added job vacancies matching the title, company and location filters,
+// newest first, up to limit, for the feeds of vacancies.
+// Returns a slice of job vacancies or an error if retrieval fails.
+func (s *Service) FeedVacancies(
+	ctx context.Context,
+	title, company, location string,
+	limit int,
+) ([]*entity.Vacancy, error) {
+	return s.repository.GetFeed(ctx, title, company, location, limit)
+}
+
+// ExportVacancies passes the job vacancies matching the title and company filters, sorted by sortField and
+// sortOrder, to fn one at a time, without loading them all in memory. The vacancy passed to fn is reused, so it must
+// not be kept. Returns the error of fn, which stops the export, or an error if retrieval fails.
+func (s *Service) ExportVacancies(
+	ctx context.Context,
+	title, company string,
+	sortField, sortOrder string,
+	fn func(v *entity.Vacancy) error,
+) error {
+	return s.repository.Export(ctx, title, company, sortField, sortOrder, fn)
+}
+
+// CountVacancies returns the number of job vacancies in the database.
+func (s *Service) CountVacancies(ctx context.Context) (int64, error) {
+	return s.repository.Count(ctx)
+}
+
+// PurgeVacancies removes all job vacancies from the database and records the purge in the audit log.
 func (s *Service) PurgeVacancies(ctx context.Context) error {
-	return s.repository.Purge(ctx)
+	if err := s.repository.Purge(ctx); err != nil {
+		return err
+	}
+	s.audit.Report(ctx, ActionVacancyPurge, "vacancies", auditEntity.OutcomeSuccess, nil)
+	return nil
+}
+
+// authorizeOwner checks that the caller may manage the stored vacancy on behalf of its owning organization.
+// Vacancies without an owner, such as those created before organizations, remain open to every caller granted the
+// scope of the action.
+func (s *Service) authorizeOwner(ctx context.Context, id int64) error {
+	if organization.HasCrossOrganizationRights(ctx) {
+		return nil
+	}
+
+	stored, err := s.repository.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if stored.GetOrganizationId() == 0 {
+		return nil
+	}
+	return s.organizations.Authorize(ctx, stored.GetOrganizationId())
+}
+
+// reportDenied records a denied action in the audit log if the caller was not permitted to perform it.
+func (s *Service) reportDenied(ctx context.Context, err error, action, target string) {
+	if errors.Is(err, organization.ErrNotPermitted) {
+		s.audit.Report(ctx, action, target, auditEntity.OutcomeDenied, nil)
+	}
+}
+
+// vacancyTarget formats the audit target of a vacancy.
+func vacancyTarget(id int64) string {
+	return fmt.Sprintf("vacancy:%d", id)
+}
+
+// vacancyDetails returns the audit details of a vacancy.
+func vacancyDetails(v *entity.Vacancy) map[string]any {
+	return map[string]any{
+		"title":           v.GetTitle(),
+		"company":         v.GetCompany(),
+		"organization_id": v.GetOrganizationId(),
+	}
 }
@@ -0,0 +1,236 @@
+package vacancy
+
+import (
+	"application/event"
+	"context"
+	"domain/vacancy/entity"
+	"domain/vacancy/events"
+	"domain/vacancy/repository"
+	"encoding/json"
+	"errors"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Types of the changes of job vacancies sent by the ChangeStream.
+const (
+	ChangeCreated = "created"
+	ChangeUpdated = "updated"
+	ChangeDeleted = "deleted"
+)
+
+const (
+	// changeLoadTimeout bounds the time spent reading a created or updated vacancy.
+	changeLoadTimeout = 2 * time.Second
+
+	// followerBuffer is the number of changes queued for a follower before it is dropped for falling behind.
+	followerBuffer = 64
+)
+
+// ErrStreamClosed is returned when following a ChangeStream which was closed.
+var ErrStreamClosed = errors.New("change stream closed")
+
+// changeTypes maps the types of the vacancy events to the types of the changes they announce.
+var changeTypes = map[string]string{
+	string(events.VacancyCreated): ChangeCreated,
+	string(events.VacancyUpdated): ChangeUpdated,
+	string(events.VacancyDeleted): ChangeDeleted,
+}
+
+// Change is a change of a job vacancy, as sent to the followers of a ChangeStream.
+type Change struct {
+	Id        string          // ID of the change in the stream, "<epoch>-<sequence>".
+	Type      string          // Type of the change: ChangeCreated, ChangeUpdated or ChangeDeleted.
+	VacancyId int64           // ID of the changed vacancy.
+	Vacancy   *entity.Vacancy // Vacancy as changed, nil if deleted.
+}
+
+// Matches reports whether the changed vacancy matches the title and company filters, as a case-insensitive partial
+// match like the list of vacancies. Deleted vacancies match any filter, as their fields are no longer known.
+func (c *Change) Matches(title, company string) bool {
+	if c.Vacancy == nil {
+		return true
+	}
+	return containsFold(c.Vacancy.GetTitle(), title) && containsFold(c.Vacancy.GetCompany(), company)
+}
+
+// ChangeStream fans out the changes of job vacancies announced by the events of every instance to its followers.
+// The latest changes are kept for the followers resuming the stream from a change they received. The IDs of the
+// changes start with the epoch of the stream, so changes received from a previous run or another instance are
+// recognized as unknown.
+type ChangeStream struct {
+	service     *Service
+	size        int    // Maximum number of changes kept for resuming followers.
+	epoch       string // Start of the stream, in milliseconds in base 36.
+	unsubscribe func() error
+
+	mu        sync.Mutex
+	sequence  uint64    // Sequence number of the latest change.
+	replay    []*Change // Latest changes, oldest first.
+	followers map[*Follower]struct{}
+	closed    bool
+}
+
+// NewChangeStream subscribes to the events of the subscriber and returns a ChangeStream keeping the latest size
+// changes, reading the created and updated vacancies with the service.
+func NewChangeStream(s *Service, subscriber event.Subscriber, size int) (*ChangeStream, error) {
+	cs := &ChangeStream{
+		service:   s,
+		size:      size,
+		epoch:     strconv.FormatInt(time.Now().UnixMilli(), 36),
+		followers: make(map[*Follower]struct{}),
+	}
+	unsubscribe, err := subscriber.Subscribe(cs.handle)
+	if err != nil {
+		return nil, err
+	}
+	cs.unsubscribe = unsubscribe
+	return cs, nil
+}
+
+// Follow starts following the stream. Given the ID of the last change received by the client, the follower replays
+// the changes following it, or is reset if they are no longer kept.
+// Returns ErrStreamClosed if the stream was closed.
+func (s *ChangeStream) Follow(lastId string) (*Follower, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil, ErrStreamClosed
+	}
+
+	f := &Follower{stream: s, changes: make(chan *Change, followerBuffer)}
+	if lastId != "" {
+		f.Replay, f.Reset = s.since(lastId)
+	}
+	if len(s.replay) > 0 {
+		f.LastId = s.replay[len(s.replay)-1].Id
+	}
+	s.followers[f] = struct{}{}
+	return f, nil
+}
+
+// Close stops the followers and unsubscribes from the events.
+func (s *ChangeStream) Close() {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return
+	}
+	s.closed = true
+	for f := range s.followers {
+		s.drop(f)
+	}
+	s.mu.Unlock()
+
+	if err := s.unsubscribe(); err != nil {
+		log.Printf("failed to unsubscribe the vacancy change stream: %v", err)
+	}
+}
+
+// handle turns the vacancy events into changes, reading the created and updated vacancies, and sends them to the
+// followers. Other events are ignored, as are the changes of vacancies deleted since.
+func (s *ChangeStream) handle(eventType string, payload []byte) {
+	changeType, ok := changeTypes[eventType]
+	if !ok {
+		return
+	}
+	var e struct{ VacancyId int64 } // Payload shared by the vacancy events
+	if err := json.Unmarshal(payload, &e); err != nil {
+		log.Printf("failed to decode %s event: %v", eventType, err)
+		return
+	}
+
+	c := &Change{Type: changeType, VacancyId: e.VacancyId}
+	if changeType != ChangeDeleted {
+		ctx, cancel := context.WithTimeout(context.Background(), changeLoadTimeout)
+		defer cancel()
+		v, err := s.service.GetVacancy(ctx, e.VacancyId)
+		if errors.Is(err, repository.ErrVacancyNotFound) {
+			return
+		}
+		if err != nil {
+			log.Printf("failed to read vacancy %d of %s event: %v", e.VacancyId, eventType, err)
+			return
+		}
+		c.Vacancy = v
+	}
+	s.publish(c)
+}
+
+// publish numbers the change, keeps it for replays and sends it to the followers, dropping the ones too far behind.
+func (s *ChangeStream) publish(c *Change) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+
+	s.sequence++
+	c.Id = s.epoch + "-" + strconv.FormatUint(s.sequence, 10)
+	if len(s.replay) == s.size {
+		copy(s.replay, s.replay[1:])
+		s.replay = s.replay[:s.size-1]
+	}
+	s.replay = append(s.replay, c)
+
+	for f := range s.followers {
+		select {
+		case f.changes <- c:
+		default:
+			s.drop(f)
+		}
+	}
+}
+
+// since returns the changes following the change with the given ID, or true if they are not all kept, e.g., as the
+// change is from another epoch or too old.
+func (s *ChangeStream) since(lastId string) ([]*Change, bool) {
+	epoch, number, _ := strings.Cut(lastId, "-")
+	sequence, err := strconv.ParseUint(number, 10, 64)
+	if err != nil || epoch != s.epoch || sequence > s.sequence {
+		return nil, true
+	}
+	oldest := s.sequence - uint64(len(s.replay)) + 1
+	if sequence+1 < oldest {
+		return nil, true
+	}
+	return append([]*Change(nil), s.replay[sequence+1-oldest:]...), false
+}
+
+// drop stops sending changes to the follower and closes its channel. The lock must be held.
+func (s *ChangeStream) drop(f *Follower) {
+	if _, ok := s.followers[f]; ok {
+		delete(s.followers, f)
+		close(f.changes)
+	}
+}
+
+// Follower receives the changes of a ChangeStream from the moment it started following it.
+type Follower struct {
+	Replay  []*Change // Changes following the last change received by the client, to send first.
+	Reset   bool      // Whether the changes following the last change received by the client are unknown.
+	LastId  string    // ID of the latest change when the follower started, empty if none.
+	stream  *ChangeStream
+	changes chan *Change
+}
+
+// Changes returns the channel of the changes, closed once the follower stopped, fell too far behind or the stream
+// was closed.
+func (f *Follower) Changes() <-chan *Change {
+	return f.changes
+}
+
+// Stop stops following the stream.
+func (f *Follower) Stop() {
+	f.stream.mu.Lock()
+	defer f.stream.mu.Unlock()
+	f.stream.drop(f)
+}
+
+// containsFold reports whether s contains substr, ignoring case.
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
@@ -1,16 +1,60 @@
 package main
 
-import "application"
+import (
+	"application"
+	"application/config"
+	"application/lifecycle"
+	"application/metrics"
+	"application/tracing"
+	"context"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// Component names of the servers of the process.
+const (
+	componentVacancyServer = "vacancy-grpc-server"
+	componentMetrics       = "metrics-server"
+)
 
 func main() {
-	app := application.NewContainer().InfrastructureContainer.Get()
+	container := application.NewContainer(config.MustLoad(os.Args[1:]))
+	logger := container.Errors.Get().Logger
+	slog.SetDefault(logger)
+	tracing.SetDefault(container.Tracer.Get())
+	cfg := container.Config.Get()
+	app := container.InfrastructureContainer.Get()
 	vacancyServer := app.VacancyServer.Get()
 	vacancyService := app.VacancyServiceServer.Get()
 
 	// Register the Vacancy Service
 	vacancyServer.RegisterService(vacancyService)
+	vacancyServer.RegisterHealthService(app.VacancyHealthServer.Get())
+
+	// Serve the gRPC requests until an interrupt signal, then stop the server before the shared resources
+	supervisor := lifecycle.NewSupervisor(logger, cfg.Shutdown)
+	container.RegisterLifecycle(supervisor)
+	supervisor.Register(lifecycle.Hook{
+		Name: componentVacancyServer,
+		DependsOn: []string{application.ComponentCertificates, application.ComponentTracer,
+			application.ComponentDatabase, application.ComponentNats},
+		Start: func(context.Context) error {
+			supervisor.Go(componentVacancyServer, vacancyServer.Serve)
+			return nil
+		},
+		Stop: vacancyServer.Shutdown,
+	})
+	if port := cfg.Metrics.VacancyServerPort; port > 0 {
+		supervisor.RegisterHTTPServer(componentMetrics, metrics.NewServer(port, container.Metrics.Get()),
+			application.ComponentDatabase)
+	}
 
-	// Start the gRPC server
-	vacancyServer.Start()
-	vacancyServer.WaitForShutdown()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	if err := supervisor.Run(ctx); err != nil {
+		logger.Error("Vacancy gRPC server stopped with errors", "error", err)
+		os.Exit(1)
+	}
 }
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"application"
+	"application/auth"
+	"application/config"
+	"application/lifecycle"
+	"application/vacancy"
+	"context"
+	"domain/auth/entity"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"interfaces/api/vacancy/dto/imports"
+	"interfaces/api/vacancy/validators"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+// importPrincipal is the principal the vacancies imported from the command line are recorded by in the audit log.
+const importPrincipal = "cli-import"
+
+// importOptions holds the flags of the import command.
+type importOptions struct {
+	request imports.Request // Format, column mapping and dry run of the import.
+	report  string          // File the CSV report of the errors of the rows is written to, if any.
+	file    string          // File imported, or "-" for the standard input.
+}
+
+// parseImportFlags parses the arguments of the import command: its flags, then the file to import.
+func parseImportFlags(args []string) (*importOptions, error) {
+	o := &importOptions{}
+	fs := flag.NewFlagSet("vacancies import", flag.ContinueOnError)
+	fs.StringVar(&o.request.Format, "format", vacancy.ImportFormatCSV, "format of the file: csv or ndjson")
+	fs.StringVar(&o.request.Mapping, "mapping", "", "column of the file holding each field, as field=column,...")
+	fs.BoolVar(&o.request.DryRun, "dry-run", false, "validate the rows and report their errors without importing")
+	fs.StringVar(&o.report, "report", "", "file the CSV report of the errors of the rows is written to")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: api vacancies import [flags] FILE")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() != 1 {
+		err := errors.New("expected a single file to import")
+		fmt.Fprintln(fs.Output(), err)
+		fs.Usage()
+		return nil, err
+	}
+	o.file = fs.Arg(0)
+	return o, nil
+}
+
+// importVacancies imports the vacancies of a CSV or NDJSON file as the REST import does, with cross-organization
+// rights, reading the configuration from the environment and the configuration file. The report is printed on
+// stdout as JSON, including the rows read before the import failed, if it did. It returns the exit status: 2 if the
+// arguments or the configuration are invalid, 1 if the import failed or a row was not imported, 0 otherwise.
+func importVacancies(args []string) int {
+	o, err := parseImportFlags(args)
+	if err != nil {
+		return 2
+	}
+	loader := config.NewLoader(nil)
+	cfg, err := loader.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid configuration:\n%v\n", err)
+		return 2
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	container := application.NewContainer(loader, cfg)
+	supervisor := lifecycle.NewSupervisor(container.Errors.Get().Logger, cfg.Shutdown)
+	container.RegisterLifecycle(supervisor)
+	defer func() {
+		if err := supervisor.Stop(); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to stop: %v\n", err)
+		}
+	}()
+	if err = supervisor.Start(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to start: %v\n", err)
+		return 1
+	}
+
+	// Print the report, even of an import that failed after some rows were read
+	report, err := runImport(ctx, container, o)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Import failed: %v\n", err)
+	}
+	if report == nil {
+		return 1
+	}
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "\t")
+	if printErr := encoder.Encode(report); printErr != nil {
+		fmt.Fprintf(os.Stderr, "Failed to print the report: %v\n", printErr)
+		return 1
+	}
+	if err != nil || report.Failed > 0 {
+		return 1
+	}
+	return 0
+}
+
+// runImport imports the file of the options as importPrincipal and writes the CSV report, if asked to. If the file
+// cannot be read or a batch fails as a whole, it returns the report of the rows read with the error.
+func runImport(ctx context.Context, container *application.Container, o *importOptions) (*imports.Report, error) {
+	v := validators.NewRequestValidator()
+	defer v.Release()
+	if !v.ValidateImport(&o.request) {
+		return nil, fmt.Errorf("invalid flags: %v", v.Errors)
+	}
+
+	var file io.Reader = os.Stdin
+	if o.file != "-" {
+		f, err := os.Open(o.file)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		file = f
+	}
+
+	claims := entity.GetTokenClaims().SetIssuer(importPrincipal).SetSubject(importPrincipal).
+		SetScope([]string{entity.ScopeAdmin}).SetExpiresAt(time.Now().Add(time.Hour).Unix())
+	defer claims.Release()
+	imported, err := container.VacancyContainer.Get().VacancyService.Get().ImportVacancies(
+		auth.ContextWithClaims(ctx, claims), file, o.request.Options(container.Config.Get().Batch.MaxItems))
+	if imported == nil {
+		return nil, err
+	}
+	report := imports.FromImport(imported)
+	if o.report == "" {
+		return report, err
+	}
+	return report, errors.Join(err, writeImportReport(o.report, report))
+}
+
+// writeImportReport writes the errors of the rows of the report to the file as CSV.
+func writeImportReport(name string, report *imports.Report) error {
+	out, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	if err = report.WriteCSV(out); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
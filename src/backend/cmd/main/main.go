@@ -2,9 +2,13 @@ package main
 
 import (
 	"application"
+	"application/config"
+	"application/lifecycle"
+	"application/metrics"
 	"application/route"
+	"application/tracing"
 	"context"
-	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"os"
@@ -14,17 +18,28 @@ import (
 	"time"
 )
 
-// Server holds the main HTTP server, logger and DI container.
+// Component names of the servers of the process.
+const (
+	componentHTTP    = "http-server"
+	componentMetrics = "metrics-server"
+)
+
+// Server holds the main HTTP server, logger, DI container and the supervisor managing their lifecycle.
 type Server struct {
-	Container *application.Container
-	Logger    *slog.Logger
-	HTTP      *http.Server
+	Container  *application.Container
+	Logger     *slog.Logger
+	HTTP       *http.Server
+	Metrics    *http.Server // Server exposing the metrics on a separate port, if configured.
+	Supervisor *lifecycle.Supervisor
 }
 
-// NewServer initializes the Server with necessary configurations, DI container, and routes.
-func NewServer() *Server {
-	container := application.NewContainer()
-	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+// NewServer initializes the Server with the configuration loaded by the loader, the DI container, and routes.
+// The servers are registered with the supervisor after the shared resources, so they stop before them.
+func NewServer(loader *config.Loader, cfg *config.Configuration) *Server {
+	container := application.NewContainer(loader, cfg)
+	logger := container.Errors.Get().Logger
+	slog.SetDefault(logger) // Package level slog calls carry the request ID of their context as well
+	tracing.SetDefault(container.Tracer.Get())
 
 	server := &Server{
 		Container: container,
@@ -37,53 +52,68 @@ func NewServer() *Server {
 			IdleTimeout:  15 * time.Second,
 			ErrorLog:     slog.NewLogLogger(logger.Handler(), slog.LevelError),
 		},
+		Supervisor: lifecycle.NewSupervisor(logger, container.Config.Get().Shutdown),
+	}
+	if cfg.TLSConfig.HTTPEnabled {
+		// TLS is served with the certificate shared with the gRPC servers, reloaded without restarting
+		server.HTTP.TLSConfig = container.InfrastructureContainer.Get().Certificates.Get().ServerConfig()
+	}
+	if port := cfg.Metrics.Port; port > 0 {
+		server.Metrics = metrics.NewServer(port, container.Metrics.Get())
 	}
 
+	// Close the event streams on shutdown, as the server waits for the active connections to end
+	server.HTTP.RegisterOnShutdown(container.VacancyContainer.Get().ChangeStream.Get().Close)
+	container.RegisterLifecycle(server.Supervisor)
+	server.Supervisor.RegisterHTTPServer(componentHTTP, server.HTTP, application.ComponentCertificates,
+		application.ComponentTracer, application.ComponentDatabase, application.ComponentNats)
+	if server.Metrics != nil {
+		server.Supervisor.RegisterHTTPServer(componentMetrics, server.Metrics, application.ComponentDatabase)
+	}
 	return server
 }
 
-// Start runs the HTTP server and listens for shutdown signals for graceful stopping.
+// Start starts the servers and blocks until an interrupt signal or a server failure, then stops every component
+// in reverse order within the shutdown deadline.
 func (s *Server) Start() error {
-	s.Logger.Info("Starting server", "address", s.HTTP.Addr)
-	// Create a channel to capture errors from ListenAndServe
-	serverErr := make(chan error, 1)
-
-	// Start the server in a separate goroutine to enable graceful shutdown
-	go func() {
-		if err := s.HTTP.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
-			serverErr <- err
-		} else {
-			serverErr <- nil
-		}
-	}()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
-	// Handle graceful shutdown on interrupt signals in a separate goroutine
-	go s.gracefulShutdown()
-	// Wait for either an error from ListenAndServe or graceful shutdown to complete
-	return <-serverErr
+	err := s.Supervisor.Run(ctx)
+	if err == nil {
+		s.Logger.Info("Server stopped gracefully")
+	}
+	return err
 }
 
-// gracefulShutdown manages graceful shutdown of the server upon receiving an interrupt signal.
-func (s *Server) gracefulShutdown() {
-	stop := make(chan os.Signal, 1)
-	signal.Notify(stop, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
-	<-stop
-
-	s.Logger.Info("Shutting down server...")
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
-	if err := s.HTTP.Shutdown(ctx); err != nil {
-		s.Logger.Error("Server forced to shutdown", "error", err)
-	} else {
-		s.Logger.Info("Server stopped gracefully")
+// printConfig prints the configuration loaded from the given command line arguments with the secrets redacted, and
+// reports its problems on stderr. It returns the exit status: 1 if the configuration is invalid, 0 otherwise.
+func printConfig(args []string) int {
+	cfg, err := config.NewLoader(args).Load()
+	if err := cfg.Print(os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to print configuration: %v\n", err)
+		return 1
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid configuration:\n%v\n", err)
+		return 1
 	}
+	return 0
 }
 
 func main() {
-	server := NewServer()
+	// "config print [flags]" prints the effective configuration instead of starting the server
+	if len(os.Args) > 2 && os.Args[1] == "config" && os.Args[2] == "print" {
+		os.Exit(printConfig(os.Args[3:]))
+	}
+	// "vacancies import [flags] FILE" imports the vacancies of a CSV or NDJSON file instead of starting the server
+	if len(os.Args) > 2 && os.Args[1] == "vacancies" && os.Args[2] == "import" {
+		os.Exit(importVacancies(os.Args[3:]))
+	}
+
+	server := NewServer(config.MustLoad(os.Args[1:]))
 	if err := server.Start(); err != nil {
-		server.Logger.Error("Failed to start server", "error", err)
+		server.Logger.Error("Server stopped with errors", "error", err)
 		os.Exit(1)
 	}
 }
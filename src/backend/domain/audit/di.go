@@ -0,0 +1,48 @@
+package audit
+
+import (
+	"application/audit"
+	"application/dependency"
+	"domain/audit/repository"
+	infraAudit "infrastructure/audit"
+	apiHandlers "interfaces/api/audit/handlers"
+	"interfaces/api/audit/validators"
+	"interfaces/api/utils"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Container provides a lazily initialized set of dependencies for the audit domain.
+type Container struct {
+	AuditRepository dependency.LazyDependency[repository.AuditRepository]
+	AuditService    dependency.LazyDependency[*audit.Service]
+	AuditValidator  dependency.LazyDependency[*validators.RequestValidator]
+	ListHandler     dependency.LazyDependency[*apiHandlers.ListRecordHandler]
+}
+
+// NewContainer initializes and returns a new Container with lazy dependencies for the audit domain.
+// The options configure the audit service, e.g., to publish the records.
+func NewContainer(db *pgxpool.Pool, h *utils.Handler, e *utils.Errors, opts ...audit.Option) *Container {
+	c := &Container{
+		AuditRepository: dependency.LazyDependency[repository.AuditRepository]{
+			InitFunc: func() repository.AuditRepository {
+				return infraAudit.NewPgxAuditRepository(db)
+			},
+		},
+	}
+	c.AuditService = dependency.LazyDependency[*audit.Service]{
+		InitFunc: func() *audit.Service {
+			return audit.NewService(c.AuditRepository.Get(), opts...)
+		},
+	}
+	c.AuditValidator = dependency.LazyDependency[*validators.RequestValidator]{
+		InitFunc: validators.NewRequestValidator,
+	}
+	c.ListHandler = dependency.LazyDependency[*apiHandlers.ListRecordHandler]{
+		InitFunc: func() *apiHandlers.ListRecordHandler {
+			return apiHandlers.NewListRecordHandler(h, e, c.AuditService.Get(), c.AuditValidator.Get())
+		},
+	}
+
+	return c
+}
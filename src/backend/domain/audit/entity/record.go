@@ -0,0 +1,171 @@
+package entity
+
+import (
+	"sync"
+	"time"
+)
+
+// Outcomes of audited actions.
+const (
+	OutcomeSuccess = "success" // The action was performed.
+	OutcomeFailure = "failure" // The action failed, e.g., the presented credentials were invalid.
+	OutcomeDenied  = "denied"  // The principal lacked the rights to perform the action.
+)
+
+// recordInstance is the instance of the getRecordPool function to access the pool.
+var recordInstance = getRecordPool()
+
+// getRecordPool returns a singleton instance of sync.Pool used to manage Record entities.
+// It ensures efficient memory use by reusing Record instances.
+func getRecordPool() func() *sync.Pool {
+	var once sync.Once
+	var pool *sync.Pool
+
+	return func() *sync.Pool {
+		once.Do(func() {
+			pool = &sync.Pool{
+				New: func() interface{} {
+					return &Record{}
+				},
+			}
+		})
+		return pool
+	}
+}
+
+// Record represents a single entry of the audit log, describing who changed what and when.
+type Record struct {
+	id        int64          // Unique identifier for the record.
+	actor     string         // Principal that performed the action.
+	action    string         // Action performed (e.g., "role.create").
+	target    string         // Resource affected by the action (e.g., "role:1").
+	outcome   string         // Outcome of the action (e.g., OutcomeSuccess).
+	sourceIp  string         // IP address of the client that performed the action.
+	requestId string         // Identifier of the request that performed the action.
+	details   map[string]any // Additional structured information about the change.
+	createdAt time.Time      // The timestamp when the action was recorded.
+}
+
+// Reset resets the fields of the Record to their zero values and returns the updated Record.
+func (r *Record) Reset() *Record {
+	r.id = 0
+	r.actor = ""
+	r.action = ""
+	r.target = ""
+	r.outcome = ""
+	r.sourceIp = ""
+	r.requestId = ""
+	r.details = nil
+	r.createdAt = time.Time{}
+	return r
+}
+
+// Release releases the Record instance back to the pool after resetting it.
+func (r *Record) Release() {
+	recordInstance().Put(r.Reset())
+}
+
+// GetRecord retrieves a new or recycled Record instance from the pool.
+// It resets the fields to zero values before returning to ensure a clean instance.
+func GetRecord() *Record {
+	return recordInstance().Get().(*Record).Reset()
+}
+
+// GetId returns the unique identifier for the record.
+func (r *Record) GetId() int64 {
+	return r.id
+}
+
+// SetId sets the unique identifier for the record.
+func (r *Record) SetId(id int64) *Record {
+	r.id = id
+	return r
+}
+
+// GetActor returns the principal that performed the action.
+func (r *Record) GetActor() string {
+	return r.actor
+}
+
+// SetActor sets the principal that performed the action.
+func (r *Record) SetActor(actor string) *Record {
+	r.actor = actor
+	return r
+}
+
+// GetAction returns the action performed.
+func (r *Record) GetAction() string {
+	return r.action
+}
+
+// SetAction sets the action performed.
+func (r *Record) SetAction(action string) *Record {
+	r.action = action
+	return r
+}
+
+// GetTarget returns the resource affected by the action.
+func (r *Record) GetTarget() string {
+	return r.target
+}
+
+// SetTarget sets the resource affected by the action.
+func (r *Record) SetTarget(target string) *Record {
+	r.target = target
+	return r
+}
+
+// GetOutcome returns the outcome of the action.
+func (r *Record) GetOutcome() string {
+	return r.outcome
+}
+
+// SetOutcome sets the outcome of the action.
+func (r *Record) SetOutcome(outcome string) *Record {
+	r.outcome = outcome
+	return r
+}
+
+// GetSourceIp returns the IP address of the client that performed the action.
+func (r *Record) GetSourceIp() string {
+	return r.sourceIp
+}
+
+// SetSourceIp sets the IP address of the client that performed the action.
+func (r *Record) SetSourceIp(sourceIp string) *Record {
+	r.sourceIp = sourceIp
+	return r
+}
+
+// GetRequestId returns the identifier of the request that performed the action.
+func (r *Record) GetRequestId() string {
+	return r.requestId
+}
+
+// SetRequestId sets the identifier of the request that performed the action.
+func (r *Record) SetRequestId(requestId string) *Record {
+	r.requestId = requestId
+	return r
+}
+
+// GetDetails returns additional structured information about the change.
+func (r *Record) GetDetails() map[string]any {
+	return r.details
+}
+
+// SetDetails sets additional structured information about the change.
+func (r *Record) SetDetails(details map[string]any) *Record {
+	r.details = details
+	return r
+}
+
+// GetCreatedAt returns the timestamp when the action was recorded.
+func (r *Record) GetCreatedAt() time.Time {
+	return r.createdAt
+}
+
+// SetCreatedAt sets the timestamp when the action was recorded.
+func (r *Record) SetCreatedAt(createdAt time.Time) *Record {
+	r.createdAt = createdAt
+	return r
+}
@@ -0,0 +1,45 @@
+package events
+
+import (
+	"domain/audit/entity"
+	"time"
+)
+
+// AuditRecordedEvent represents the event that occurs when a record is appended to the audit log.
+// It carries a copy of the record, so subscribers such as a SIEM can ship it without reading the database.
+type AuditRecordedEvent struct {
+	RecordId  int64          // Unique identifier of the audit record.
+	Actor     string         // Principal that performed the action.
+	Action    string         // Action performed.
+	Target    string         // Resource affected by the action.
+	Outcome   string         // Outcome of the action.
+	SourceIp  string         // IP address of the client that performed the action.
+	RequestId string         // Identifier of the request that performed the action.
+	Details   map[string]any // Additional structured information about the action.
+	CreatedAt time.Time      // The timestamp when the action was recorded.
+}
+
+// NewAuditRecordedEvent initializes a new AuditRecordedEvent from a saved record.
+func NewAuditRecordedEvent(r *entity.Record) *AuditRecordedEvent {
+	return &AuditRecordedEvent{
+		RecordId:  r.GetId(),
+		Actor:     r.GetActor(),
+		Action:    r.GetAction(),
+		Target:    r.GetTarget(),
+		Outcome:   r.GetOutcome(),
+		SourceIp:  r.GetSourceIp(),
+		RequestId: r.GetRequestId(),
+		Details:   r.GetDetails(),
+		CreatedAt: r.GetCreatedAt(),
+	}
+}
+
+// EventType returns the type of the event.
+func (e *AuditRecordedEvent) EventType() string {
+	return string(AuditRecorded)
+}
+
+// AggregateId returns the unique identifier of the audit record associated with this event.
+func (e *AuditRecordedEvent) AggregateId() int64 {
+	return e.RecordId
+}
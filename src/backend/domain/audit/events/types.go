@@ -0,0 +1,9 @@
+package events
+
+// EventType represents the type of the event occurring in the system.
+type EventType string
+
+const (
+	// AuditRecorded indicates that a record has been appended to the audit log.
+	AuditRecorded EventType = "AuditRecorded"
+)
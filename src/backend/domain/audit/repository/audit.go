@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+	"domain/audit/entity"
+	"time"
+)
+
+// Filter narrows the audit records returned by GetList. Zero values leave a criterion unrestricted.
+type Filter struct {
+	Actor    string    // Principal that performed the action.
+	Action   string    // Action performed; a trailing "*" matches every action with the given prefix.
+	Target   string    // Resource affected by the action.
+	Outcome  string    // Outcome of the action.
+	From     time.Time // Earliest time, inclusive, the action was recorded.
+	To       time.Time // Latest time, exclusive, the action was recorded.
+	BeforeId int64     // Cursor: only records older than the record with this ID are returned.
+	Limit    int       // Maximum number of records returned.
+}
+
+// AuditRepository defines the interface for persisting audit log records.
+// The audit log is append-only: records are never updated.
+type AuditRepository interface {
+	// Save appends a new record to the audit log.
+	// Returns an error if the operation fails.
+	Save(ctx context.Context, record *entity.Record) error
+
+	// GetList retrieves the records matching the filter, newest first.
+	// Returns an error if the operation fails.
+	GetList(ctx context.Context, filter Filter) ([]*entity.Record, error)
+}
@@ -1,9 +1,11 @@
 package auth
 
 import (
+	"application/audit"
 	"application/auth"
 	"application/config"
 	"application/dependency"
+	"application/role"
 	"interfaces/api/auth/handlers"
 	"interfaces/api/utils"
 )
@@ -12,10 +14,17 @@ import (
 type Container struct {
 	JwtAuthService dependency.LazyDependency[*auth.Service]
 	JwtAuthHandler dependency.LazyDependency[*handlers.JwtTokenHandler]
+	FeedHandler    dependency.LazyDependency[*handlers.FeedTokenHandler]
 }
 
 // NewContainer initializes and returns a new Container with lazy dependencies for the auth domain.
-func NewContainer(cfg *config.Configuration, h *utils.Handler, e *utils.Errors) *Container {
+func NewContainer(
+	cfg *config.Configuration,
+	r *role.Service,
+	a *audit.Service,
+	h *utils.Handler,
+	e *utils.Errors,
+) *Container {
 	c := &Container{
 		JwtAuthService: dependency.LazyDependency[*auth.Service]{
 			InitFunc: func() *auth.Service { return auth.NewService(cfg) },
@@ -23,7 +32,12 @@ func NewContainer(cfg *config.Configuration, h *utils.Handler, e *utils.Errors)
 	}
 	c.JwtAuthHandler = dependency.LazyDependency[*handlers.JwtTokenHandler]{
 		InitFunc: func() *handlers.JwtTokenHandler {
-			return handlers.NewJwtTokenHandler(h, e, c.JwtAuthService.Get())
+			return handlers.NewJwtTokenHandler(h, e, c.JwtAuthService.Get(), r, a)
+		},
+	}
+	c.FeedHandler = dependency.LazyDependency[*handlers.FeedTokenHandler]{
+		InitFunc: func() *handlers.FeedTokenHandler {
+			return handlers.NewFeedTokenHandler(h, e, c.JwtAuthService.Get(), a, cfg.Feed.TokenTTL)
 		},
 	}
 
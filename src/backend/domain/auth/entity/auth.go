@@ -1,6 +1,9 @@
 package entity
 
-import "sync"
+import (
+	"slices"
+	"sync"
+)
 
 // tokenClaimsInstance is the instance of getTokenClaimsPool function to access the pool.
 var tokenClaimsInstance = getTokenClaimsPool()
@@ -26,6 +29,7 @@ func getTokenClaimsPool() func() *sync.Pool {
 // TokenClaims represents the claims included in a JWT token.
 type TokenClaims struct {
 	issuer    string   // The issuer of the token, typically the API name or identifier.
+	subject   string   // The subject (user or API client) the token was minted for.
 	scope     []string // The permissions or scope associated with the token.
 	expiresAt int64    // The expiration time of the token as a UNIX timestamp.
 }
@@ -33,6 +37,7 @@ type TokenClaims struct {
 // Reset resets the fields of the TokenClaims to their zero values and returns the updated TokenClaims.
 func (t *TokenClaims) Reset() *TokenClaims {
 	t.issuer = ""
+	t.subject = ""
 	t.scope = []string{}
 	t.expiresAt = 0
 	return t
@@ -60,6 +65,17 @@ func (t *TokenClaims) SetIssuer(issuer string) *TokenClaims {
 	return t
 }
 
+// GetSubject returns the subject the token was minted for.
+func (t *TokenClaims) GetSubject() string {
+	return t.subject
+}
+
+// SetSubject sets the subject the token was minted for.
+func (t *TokenClaims) SetSubject(subject string) *TokenClaims {
+	t.subject = subject
+	return t
+}
+
 // GetScope returns the scope associated with the token.
 func (t *TokenClaims) GetScope() []string {
 	return t.scope
@@ -71,6 +87,11 @@ func (t *TokenClaims) SetScope(scope []string) *TokenClaims {
 	return t
 }
 
+// HasScope reports whether the token grants the given scope.
+func (t *TokenClaims) HasScope(scope string) bool {
+	return slices.Contains(t.scope, scope)
+}
+
 // GetExpiresAt returns the expiration time of the token.
 func (t *TokenClaims) GetExpiresAt() int64 {
 	return t.expiresAt
@@ -0,0 +1,19 @@
+package entity
+
+const (
+	// ScopeRead allows reading vacancies.
+	ScopeRead = "read"
+	// ScopeWrite allows creating and updating vacancies.
+	ScopeWrite = "write"
+	// ScopeDelete allows deleting vacancies.
+	ScopeDelete = "delete"
+	// ScopePurge allows removing all vacancies at once.
+	ScopePurge = "purge"
+	// ScopeAdmin allows administrative operations such as role management.
+	ScopeAdmin = "admin"
+)
+
+// Scopes returns every scope known to the system.
+func Scopes() []string {
+	return []string{ScopeRead, ScopeWrite, ScopeDelete, ScopePurge, ScopeAdmin}
+}
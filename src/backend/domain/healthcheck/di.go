@@ -12,14 +12,22 @@ import (
 type Container struct {
 	HealthCheckService dependency.LazyDependency[*healthcheck.Service]
 	HealthCheckHandler dependency.LazyDependency[*handlers.HealthCheckHandler]
+	LivenessHandler    dependency.LazyDependency[*handlers.LivenessHandler]
+	ReadinessHandler   dependency.LazyDependency[*handlers.ReadinessHandler]
 }
 
 // NewContainer initializes and returns a new Container with lazy dependencies for the health check domain.
-func NewContainer(cfg *config.Configuration, h *utils.Handler, e *utils.Errors) *Container {
+// The checkers are run by the readiness checks.
+func NewContainer(
+	cfg *config.Configuration,
+	h *utils.Handler,
+	e *utils.Errors,
+	checkers ...healthcheck.Checker,
+) *Container {
 	c := &Container{
 		HealthCheckService: dependency.LazyDependency[*healthcheck.Service]{
 			InitFunc: func() *healthcheck.Service {
-				return healthcheck.NewService(cfg)
+				return healthcheck.NewService(cfg, checkers...)
 			},
 		},
 	}
@@ -28,5 +36,15 @@ func NewContainer(cfg *config.Configuration, h *utils.Handler, e *utils.Errors)
 			return handlers.NewHealthCheckHandler(h, e, c.HealthCheckService.Get())
 		},
 	}
+	c.LivenessHandler = dependency.LazyDependency[*handlers.LivenessHandler]{
+		InitFunc: func() *handlers.LivenessHandler {
+			return handlers.NewLivenessHandler(h, e, c.HealthCheckService.Get())
+		},
+	}
+	c.ReadinessHandler = dependency.LazyDependency[*handlers.ReadinessHandler]{
+		InitFunc: func() *handlers.ReadinessHandler {
+			return handlers.NewReadinessHandler(h, e, c.HealthCheckService.Get())
+		},
+	}
 	return c
 }
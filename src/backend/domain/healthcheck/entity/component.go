@@ -0,0 +1,73 @@
+package entity
+
+import "time"
+
+// Statuses of a checked component.
+const (
+	ComponentUp   = "up"   // The component responded to its check.
+	ComponentDown = "down" // The component failed its check or did not respond in time.
+)
+
+// Component represents the status of a dependency of the application, e.g., the database.
+type Component struct {
+	name      string
+	status    string
+	err       string
+	latency   time.Duration
+	checkedAt time.Time
+}
+
+// GetName returns the name of the component.
+func (e *Component) GetName() string {
+	return e.name
+}
+
+// SetName sets the name of the component.
+func (e *Component) SetName(name string) *Component {
+	e.name = name
+	return e
+}
+
+// GetStatus returns the status of the component, ComponentUp or ComponentDown.
+func (e *Component) GetStatus() string {
+	return e.status
+}
+
+// SetStatus sets the status of the component.
+func (e *Component) SetStatus(status string) *Component {
+	e.status = status
+	return e
+}
+
+// GetError returns the reason the component is down, if any.
+func (e *Component) GetError() string {
+	return e.err
+}
+
+// SetError sets the reason the component is down.
+func (e *Component) SetError(err string) *Component {
+	e.err = err
+	return e
+}
+
+// GetLatency returns the duration of the check.
+func (e *Component) GetLatency() time.Duration {
+	return e.latency
+}
+
+// SetLatency sets the duration of the check.
+func (e *Component) SetLatency(latency time.Duration) *Component {
+	e.latency = latency
+	return e
+}
+
+// GetCheckedAt returns the time the component was checked.
+func (e *Component) GetCheckedAt() time.Time {
+	return e.checkedAt
+}
+
+// SetCheckedAt sets the time the component was checked.
+func (e *Component) SetCheckedAt(checkedAt time.Time) *Component {
+	e.checkedAt = checkedAt
+	return e
+}
@@ -1,10 +1,20 @@
 package entity
 
+import "time"
+
+// Overall statuses of the application.
+const (
+	StatusAvailable   = "available"   // All checked components are up.
+	StatusUnavailable = "unavailable" // At least one checked component is down.
+)
+
 // HealthCheck represents the status of the application's health.
 type HealthCheck struct {
 	status      string
 	environment string
 	version     string
+	checkedAt   time.Time
+	components  []*Component
 }
 
 // GetStatus returns the health status as a string.
@@ -39,3 +49,33 @@ func (e *HealthCheck) SetVersion(version string) *HealthCheck {
 	e.version = version
 	return e
 }
+
+// GetCheckedAt returns the time the health check was performed.
+func (e *HealthCheck) GetCheckedAt() time.Time {
+	return e.checkedAt
+}
+
+// SetCheckedAt sets the time the health check was performed.
+func (e *HealthCheck) SetCheckedAt(checkedAt time.Time) *HealthCheck {
+	e.checkedAt = checkedAt
+	return e
+}
+
+// GetComponents returns the status of the checked components, in the order they were added.
+func (e *HealthCheck) GetComponents() []*Component {
+	return e.components
+}
+
+// AddComponent adds the status of a checked component. A component that is down makes the application unavailable.
+func (e *HealthCheck) AddComponent(c *Component) *HealthCheck {
+	e.components = append(e.components, c)
+	if c.GetStatus() != ComponentUp {
+		e.status = StatusUnavailable
+	}
+	return e
+}
+
+// IsAvailable reports whether the application is available.
+func (e *HealthCheck) IsAvailable() bool {
+	return e.status == StatusAvailable
+}
@@ -0,0 +1,73 @@
+package organization
+
+import (
+	"application/audit"
+	"application/dependency"
+	"application/organization"
+	"domain/organization/repository"
+	infraOrganization "infrastructure/organization"
+	apiHandlers "interfaces/api/organization/handlers"
+	apiValidators "interfaces/api/organization/validators"
+	"interfaces/api/utils"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Container provides a lazily initialized set of dependencies for the organization domain.
+type Container struct {
+	OrganizationRepository dependency.LazyDependency[repository.OrganizationRepository]
+	OrganizationService    dependency.LazyDependency[*organization.Service]
+	OrganizationValidator  dependency.LazyDependency[*apiValidators.RequestValidator]
+	ListHandler            dependency.LazyDependency[*apiHandlers.ListOrganizationHandler]
+	CreateHandler          dependency.LazyDependency[*apiHandlers.CreateOrganizationHandler]
+	ListMemberHandler      dependency.LazyDependency[*apiHandlers.ListMemberHandler]
+	InviteMemberHandler    dependency.LazyDependency[*apiHandlers.InviteMemberHandler]
+	AcceptHandler          dependency.LazyDependency[*apiHandlers.AcceptInvitationHandler]
+}
+
+// NewContainer initializes and returns a new Container with lazy dependencies for the organization domain.
+func NewContainer(db *pgxpool.Pool, a *audit.Service, h *utils.Handler, e *utils.Errors) *Container {
+	c := &Container{
+		OrganizationRepository: dependency.LazyDependency[repository.OrganizationRepository]{
+			InitFunc: func() repository.OrganizationRepository {
+				return infraOrganization.NewPgxOrganizationRepository(db)
+			},
+		},
+	}
+	c.OrganizationService = dependency.LazyDependency[*organization.Service]{
+		InitFunc: func() *organization.Service {
+			return organization.NewService(c.OrganizationRepository.Get(), a)
+		},
+	}
+	c.OrganizationValidator = dependency.LazyDependency[*apiValidators.RequestValidator]{
+		InitFunc: apiValidators.NewRequestValidator,
+	}
+	c.ListHandler = dependency.LazyDependency[*apiHandlers.ListOrganizationHandler]{
+		InitFunc: func() *apiHandlers.ListOrganizationHandler {
+			return apiHandlers.NewListOrganizationHandler(h, e, c.OrganizationService.Get())
+		},
+	}
+	c.CreateHandler = dependency.LazyDependency[*apiHandlers.CreateOrganizationHandler]{
+		InitFunc: func() *apiHandlers.CreateOrganizationHandler {
+			return apiHandlers.NewCreateOrganizationHandler(
+				h, e, c.OrganizationService.Get(), c.OrganizationValidator.Get())
+		},
+	}
+	c.ListMemberHandler = dependency.LazyDependency[*apiHandlers.ListMemberHandler]{
+		InitFunc: func() *apiHandlers.ListMemberHandler {
+			return apiHandlers.NewListMemberHandler(h, e, c.OrganizationService.Get())
+		},
+	}
+	c.InviteMemberHandler = dependency.LazyDependency[*apiHandlers.InviteMemberHandler]{
+		InitFunc: func() *apiHandlers.InviteMemberHandler {
+			return apiHandlers.NewInviteMemberHandler(h, e, c.OrganizationService.Get(), c.OrganizationValidator.Get())
+		},
+	}
+	c.AcceptHandler = dependency.LazyDependency[*apiHandlers.AcceptInvitationHandler]{
+		InitFunc: func() *apiHandlers.AcceptInvitationHandler {
+			return apiHandlers.NewAcceptInvitationHandler(h, e, c.OrganizationService.Get())
+		},
+	}
+
+	return c
+}
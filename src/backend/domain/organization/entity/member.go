@@ -0,0 +1,147 @@
+package entity
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// RoleOwner identifies members that may invite other members.
+	RoleOwner = "owner"
+	// RoleMember identifies regular members.
+	RoleMember = "member"
+
+	// StatusInvited identifies members that have not accepted their invitation yet.
+	StatusInvited = "invited"
+	// StatusActive identifies members that manage the vacancies of the organization.
+	StatusActive = "active"
+)
+
+// memberInstance is the instance of the getMemberPool function to access the pool.
+var memberInstance = getMemberPool()
+
+// getMemberPool returns a singleton instance of sync.Pool used to manage Member entities.
+// It ensures efficient memory use by reusing Member instances.
+func getMemberPool() func() *sync.Pool {
+	var once sync.Once
+	var pool *sync.Pool
+
+	return func() *sync.Pool {
+		once.Do(func() {
+			pool = &sync.Pool{
+				New: func() interface{} {
+					return &Member{}
+				},
+			}
+		})
+		return pool
+	}
+}
+
+// Member represents the membership of a principal in an organization.
+type Member struct {
+	organizationId int64     // Identifier of the organization.
+	memberId       string    // Principal of the member, i.e. the token subject.
+	role           string    // Role within the organization (RoleOwner or RoleMember).
+	status         string    // Membership status (StatusInvited or StatusActive).
+	invitedBy      string    // Principal that invited the member.
+	createdAt      time.Time // The timestamp when the member was invited.
+}
+
+// Reset resets the fields of the Member to their zero values and returns the updated Member.
+func (m *Member) Reset() *Member {
+	m.organizationId = 0
+	m.memberId = ""
+	m.role = ""
+	m.status = ""
+	m.invitedBy = ""
+	m.createdAt = time.Time{}
+	return m
+}
+
+// Release releases the Member instance back to the pool after resetting it.
+func (m *Member) Release() {
+	memberInstance().Put(m.Reset())
+}
+
+// GetMember retrieves a new or recycled Member instance from the pool.
+// It resets the fields to zero values before returning to ensure a clean instance.
+func GetMember() *Member {
+	return memberInstance().Get().(*Member).Reset()
+}
+
+// GetOrganizationId returns the identifier of the organization.
+func (m *Member) GetOrganizationId() int64 {
+	return m.organizationId
+}
+
+// SetOrganizationId sets the identifier of the organization.
+func (m *Member) SetOrganizationId(organizationId int64) *Member {
+	m.organizationId = organizationId
+	return m
+}
+
+// GetMemberId returns the principal of the member.
+func (m *Member) GetMemberId() string {
+	return m.memberId
+}
+
+// SetMemberId sets the principal of the member.
+func (m *Member) SetMemberId(memberId string) *Member {
+	m.memberId = memberId
+	return m
+}
+
+// GetRole returns the role of the member within the organization.
+func (m *Member) GetRole() string {
+	return m.role
+}
+
+// SetRole sets the role of the member within the organization.
+func (m *Member) SetRole(role string) *Member {
+	m.role = role
+	return m
+}
+
+// GetStatus returns the membership status.
+func (m *Member) GetStatus() string {
+	return m.status
+}
+
+// SetStatus sets the membership status.
+func (m *Member) SetStatus(status string) *Member {
+	m.status = status
+	return m
+}
+
+// GetInvitedBy returns the principal that invited the member.
+func (m *Member) GetInvitedBy() string {
+	return m.invitedBy
+}
+
+// SetInvitedBy sets the principal that invited the member.
+func (m *Member) SetInvitedBy(invitedBy string) *Member {
+	m.invitedBy = invitedBy
+	return m
+}
+
+// GetCreatedAt returns the timestamp when the member was invited.
+func (m *Member) GetCreatedAt() time.Time {
+	return m.createdAt
+}
+
+// SetCreatedAt sets the timestamp when the member was invited.
+func (m *Member) SetCreatedAt(createdAt time.Time) *Member {
+	m.createdAt = createdAt
+	return m
+}
+
+// IsActive reports whether the member has accepted the invitation.
+func (m *Member) IsActive() bool {
+	return m.status == StatusActive
+}
+
+// IsOwner reports whether the member is an active owner of the organization.
+func (m *Member) IsOwner() bool {
+	return m.IsActive() && m.role == RoleOwner
+}
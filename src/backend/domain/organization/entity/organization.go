@@ -0,0 +1,112 @@
+package entity
+
+import (
+	"sync"
+	"time"
+)
+
+// organizationInstance is the instance of the getOrganizationPool function to access the pool.
+var organizationInstance = getOrganizationPool()
+
+// getOrganizationPool returns a singleton instance of sync.Pool used to manage Organization entities.
+// It ensures efficient memory use by reusing Organization instances.
+func getOrganizationPool() func() *sync.Pool {
+	var once sync.Once
+	var pool *sync.Pool
+
+	return func() *sync.Pool {
+		once.Do(func() {
+			pool = &sync.Pool{
+				New: func() interface{} {
+					return &Organization{}
+				},
+			}
+		})
+		return pool
+	}
+}
+
+// Organization represents an employer that owns and manages its vacancies.
+type Organization struct {
+	id        int64     // Unique identifier for the organization.
+	name      string    // Unique name of the organization.
+	createdBy string    // Principal that registered the organization.
+	createdAt time.Time // The timestamp when the organization was registered.
+	version   int32     // The version number of the organization, useful for optimistic concurrency control.
+}
+
+// Reset resets the fields of the Organization to their zero values and returns the updated Organization.
+func (o *Organization) Reset() *Organization {
+	o.id = 0
+	o.name = ""
+	o.createdBy = ""
+	o.createdAt = time.Time{}
+	o.version = 0
+	return o
+}
+
+// Release releases the Organization instance back to the pool after resetting it.
+func (o *Organization) Release() {
+	organizationInstance().Put(o.Reset())
+}
+
+// GetOrganization retrieves a new or recycled Organization instance from the pool.
+// It resets the fields to zero values before returning to ensure a clean instance.
+func GetOrganization() *Organization {
+	return organizationInstance().Get().(*Organization).Reset()
+}
+
+// GetId returns the unique identifier for the organization.
+func (o *Organization) GetId() int64 {
+	return o.id
+}
+
+// SetId sets the unique identifier for the organization.
+func (o *Organization) SetId(id int64) *Organization {
+	o.id = id
+	return o
+}
+
+// GetName returns the name of the organization.
+func (o *Organization) GetName() string {
+	return o.name
+}
+
+// SetName sets the name of the organization.
+func (o *Organization) SetName(name string) *Organization {
+	o.name = name
+	return o
+}
+
+// GetCreatedBy returns the principal that registered the organization.
+func (o *Organization) GetCreatedBy() string {
+	return o.createdBy
+}
+
+// SetCreatedBy sets the principal that registered the organization.
+func (o *Organization) SetCreatedBy(createdBy string) *Organization {
+	o.createdBy = createdBy
+	return o
+}
+
+// GetCreatedAt returns the timestamp when the organization was registered.
+func (o *Organization) GetCreatedAt() time.Time {
+	return o.createdAt
+}
+
+// SetCreatedAt sets the timestamp when the organization was registered.
+func (o *Organization) SetCreatedAt(createdAt time.Time) *Organization {
+	o.createdAt = createdAt
+	return o
+}
+
+// GetVersion returns the version number of the organization.
+func (o *Organization) GetVersion() int32 {
+	return o.version
+}
+
+// SetVersion sets the version number of the organization.
+func (o *Organization) SetVersion(version int32) *Organization {
+	o.version = version
+	return o
+}
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+	"domain/organization/entity"
+	"errors"
+)
+
+var (
+	// ErrOrganizationNotFound is returned when the requested organization does not exist.
+	ErrOrganizationNotFound = errors.New("organization not found")
+	// ErrMemberNotFound is returned when the principal is neither a member nor invited to the organization.
+	ErrMemberNotFound = errors.New("member not found")
+	// ErrDuplicate is returned when an organization name or a membership already exists.
+	ErrDuplicate = errors.New("duplicate record")
+)
+
+// OrganizationRepository defines the interface for interacting with organizations and their members.
+type OrganizationRepository interface {
+	// Save persists a new organization together with its owner, who becomes an active member.
+	// Returns ErrDuplicate if an organization with the same name already exists.
+	Save(ctx context.Context, organization *entity.Organization, owner *entity.Member) error
+
+	// Get retrieves an organization by its unique ID.
+	// Returns ErrOrganizationNotFound if the organization does not exist.
+	Get(ctx context.Context, id int64) (*entity.Organization, error)
+
+	// GetListByMember retrieves the organizations the principal is an active member of, ordered by name.
+	GetListByMember(ctx context.Context, memberId string) ([]*entity.Organization, error)
+
+	// SaveMember persists a new membership, typically an invitation.
+	// Returns ErrDuplicate if the principal is already a member or invited, and ErrOrganizationNotFound if the
+	// organization does not exist.
+	SaveMember(ctx context.Context, member *entity.Member) error
+
+	// GetMember retrieves the membership of a principal in an organization.
+	// Returns ErrMemberNotFound if the principal is neither a member nor invited.
+	GetMember(ctx context.Context, organizationId int64, memberId string) (*entity.Member, error)
+
+	// UpdateMemberStatus changes the status of an existing membership.
+	// Returns ErrMemberNotFound if the membership does not exist.
+	UpdateMemberStatus(ctx context.Context, member *entity.Member) error
+
+	// GetMembers retrieves all members and pending invitations of an organization.
+	GetMembers(ctx context.Context, organizationId int64) ([]*entity.Member, error)
+}
@@ -0,0 +1,78 @@
+package role
+
+import (
+	"application/audit"
+	"application/dependency"
+	"application/role"
+	"domain/role/repository"
+	infraRole "infrastructure/role"
+	apiHandlers "interfaces/api/role/handlers"
+	apiValidators "interfaces/api/role/validators"
+	"interfaces/api/utils"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Container provides a lazily initialized set of dependencies for the role domain.
+type Container struct {
+	RoleRepository          dependency.LazyDependency[repository.RoleRepository]
+	RoleService             dependency.LazyDependency[*role.Service]
+	RoleValidator           dependency.LazyDependency[*apiValidators.RequestValidator]
+	ListHandler             dependency.LazyDependency[*apiHandlers.ListRoleHandler]
+	CreateHandler           dependency.LazyDependency[*apiHandlers.CreateRoleHandler]
+	UpdateHandler           dependency.LazyDependency[*apiHandlers.UpdateRoleHandler]
+	ListAssignmentHandler   dependency.LazyDependency[*apiHandlers.ListAssignmentHandler]
+	CreateAssignmentHandler dependency.LazyDependency[*apiHandlers.CreateAssignmentHandler]
+	DeleteAssignmentHandler dependency.LazyDependency[*apiHandlers.DeleteAssignmentHandler]
+}
+
+// NewContainer initializes and returns a new Container with lazy dependencies for the role domain.
+func NewContainer(db *pgxpool.Pool, a *audit.Service, h *utils.Handler, e *utils.Errors) *Container {
+	c := &Container{
+		RoleRepository: dependency.LazyDependency[repository.RoleRepository]{
+			InitFunc: func() repository.RoleRepository {
+				return infraRole.NewPgxRoleRepository(db)
+			},
+		},
+	}
+	c.RoleService = dependency.LazyDependency[*role.Service]{
+		InitFunc: func() *role.Service {
+			return role.NewService(c.RoleRepository.Get(), a)
+		},
+	}
+	c.RoleValidator = dependency.LazyDependency[*apiValidators.RequestValidator]{
+		InitFunc: apiValidators.NewRequestValidator,
+	}
+	c.ListHandler = dependency.LazyDependency[*apiHandlers.ListRoleHandler]{
+		InitFunc: func() *apiHandlers.ListRoleHandler {
+			return apiHandlers.NewListRoleHandler(h, e, c.RoleService.Get())
+		},
+	}
+	c.CreateHandler = dependency.LazyDependency[*apiHandlers.CreateRoleHandler]{
+		InitFunc: func() *apiHandlers.CreateRoleHandler {
+			return apiHandlers.NewCreateRoleHandler(h, e, c.RoleService.Get(), c.RoleValidator.Get())
+		},
+	}
+	c.UpdateHandler = dependency.LazyDependency[*apiHandlers.UpdateRoleHandler]{
+		InitFunc: func() *apiHandlers.UpdateRoleHandler {
+			return apiHandlers.NewUpdateRoleHandler(h, e, c.RoleService.Get(), c.RoleValidator.Get())
+		},
+	}
+	c.ListAssignmentHandler = dependency.LazyDependency[*apiHandlers.ListAssignmentHandler]{
+		InitFunc: func() *apiHandlers.ListAssignmentHandler {
+			return apiHandlers.NewListAssignmentHandler(h, e, c.RoleService.Get(), c.RoleValidator.Get())
+		},
+	}
+	c.CreateAssignmentHandler = dependency.LazyDependency[*apiHandlers.CreateAssignmentHandler]{
+		InitFunc: func() *apiHandlers.CreateAssignmentHandler {
+			return apiHandlers.NewCreateAssignmentHandler(h, e, c.RoleService.Get(), c.RoleValidator.Get())
+		},
+	}
+	c.DeleteAssignmentHandler = dependency.LazyDependency[*apiHandlers.DeleteAssignmentHandler]{
+		InitFunc: func() *apiHandlers.DeleteAssignmentHandler {
+			return apiHandlers.NewDeleteAssignmentHandler(h, e, c.RoleService.Get())
+		},
+	}
+
+	return c
+}
@@ -0,0 +1,132 @@
+package entity
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// SubjectUser identifies assignments made to an individual user.
+	SubjectUser = "user"
+	// SubjectClient identifies assignments made to an API client, such as the scraper bot.
+	SubjectClient = "client"
+)
+
+// assignmentInstance is the instance of the getAssignmentPool function to access the pool.
+var assignmentInstance = getAssignmentPool()
+
+// getAssignmentPool returns a singleton instance of sync.Pool used to manage Assignment entities.
+// It ensures efficient memory use by reusing Assignment instances.
+func getAssignmentPool() func() *sync.Pool {
+	var once sync.Once
+	var pool *sync.Pool
+
+	return func() *sync.Pool {
+		once.Do(func() {
+			pool = &sync.Pool{
+				New: func() interface{} {
+					return &Assignment{}
+				},
+			}
+		})
+		return pool
+	}
+}
+
+// Assignment grants a role to a subject, which is either a user or an API client.
+type Assignment struct {
+	id          int64     // Unique identifier for the assignment.
+	subjectType string    // Kind of subject (SubjectUser or SubjectClient).
+	subjectId   string    // Identifier of the subject.
+	roleId      int64     // Identifier of the assigned role.
+	roleName    string    // Name of the assigned role.
+	createdAt   time.Time // The timestamp when the role was assigned.
+}
+
+// Reset resets the fields of the Assignment to their zero values and returns the updated Assignment.
+func (a *Assignment) Reset() *Assignment {
+	a.id = 0
+	a.subjectType = ""
+	a.subjectId = ""
+	a.roleId = 0
+	a.roleName = ""
+	a.createdAt = time.Time{}
+	return a
+}
+
+// Release releases the Assignment instance back to the pool after resetting it.
+func (a *Assignment) Release() {
+	assignmentInstance().Put(a.Reset())
+}
+
+// GetAssignment retrieves a new or recycled Assignment instance from the pool.
+// It resets the fields to zero values before returning to ensure a clean instance.
+func GetAssignment() *Assignment {
+	return assignmentInstance().Get().(*Assignment).Reset()
+}
+
+// GetId returns the unique identifier for the assignment.
+func (a *Assignment) GetId() int64 {
+	return a.id
+}
+
+// SetId sets the unique identifier for the assignment.
+func (a *Assignment) SetId(id int64) *Assignment {
+	a.id = id
+	return a
+}
+
+// GetSubjectType returns the kind of subject holding the role.
+func (a *Assignment) GetSubjectType() string {
+	return a.subjectType
+}
+
+// SetSubjectType sets the kind of subject holding the role.
+func (a *Assignment) SetSubjectType(subjectType string) *Assignment {
+	a.subjectType = subjectType
+	return a
+}
+
+// GetSubjectId returns the identifier of the subject holding the role.
+func (a *Assignment) GetSubjectId() string {
+	return a.subjectId
+}
+
+// SetSubjectId sets the identifier of the subject holding the role.
+func (a *Assignment) SetSubjectId(subjectId string) *Assignment {
+	a.subjectId = subjectId
+	return a
+}
+
+// GetRoleId returns the identifier of the assigned role.
+func (a *Assignment) GetRoleId() int64 {
+	return a.roleId
+}
+
+// SetRoleId sets the identifier of the assigned role.
+func (a *Assignment) SetRoleId(roleId int64) *Assignment {
+	a.roleId = roleId
+	return a
+}
+
+// GetRoleName returns the name of the assigned role.
+func (a *Assignment) GetRoleName() string {
+	return a.roleName
+}
+
+// SetRoleName sets the name of the assigned role.
+func (a *Assignment) SetRoleName(roleName string) *Assignment {
+	a.roleName = roleName
+	return a
+}
+
+// GetCreatedAt returns the timestamp when the role was assigned.
+func (a *Assignment) GetCreatedAt() time.Time {
+	return a.createdAt
+}
+
+// SetCreatedAt sets the timestamp when the role was assigned.
+func (a *Assignment) SetCreatedAt(createdAt time.Time) *Assignment {
+	a.createdAt = createdAt
+	return a
+}
@@ -0,0 +1,138 @@
+package entity
+
+import (
+	"sync"
+	"time"
+)
+
+// roleInstance is the instance of the getRolePool function to access the pool.
+var roleInstance = getRolePool()
+
+// getRolePool returns a singleton instance of sync.Pool used to manage Role entities.
+// It ensures efficient memory use by reusing Role instances.
+func getRolePool() func() *sync.Pool {
+	var once sync.Once
+	var pool *sync.Pool
+
+	return func() *sync.Pool {
+		once.Do(func() {
+			pool = &sync.Pool{
+				New: func() interface{} {
+					return &Role{}
+				},
+			}
+		})
+		return pool
+	}
+}
+
+// Role represents a named set of scopes that can be assigned to users or API clients.
+type Role struct {
+	id          int64     // Unique identifier for the role.
+	name        string    // Unique name of the role (e.g., "viewer").
+	description string    // Human readable description of the role.
+	scopes      []string  // Scopes granted to every subject holding the role.
+	createdAt   time.Time // The timestamp when the role was created.
+	updatedAt   time.Time // The timestamp when the role was last updated.
+	version     int32     // The version number of the role, useful for optimistic concurrency control.
+}
+
+// Reset resets the fields of the Role to their zero values and returns the updated Role.
+func (r *Role) Reset() *Role {
+	r.id = 0
+	r.name = ""
+	r.description = ""
+	r.scopes = nil
+	r.createdAt = time.Time{}
+	r.updatedAt = time.Time{}
+	r.version = 0
+	return r
+}
+
+// Release releases the Role instance back to the pool after resetting it.
+func (r *Role) Release() {
+	roleInstance().Put(r.Reset())
+}
+
+// GetRole retrieves a new or recycled Role instance from the pool.
+// It resets the fields to zero values before returning to ensure a clean instance.
+func GetRole() *Role {
+	return roleInstance().Get().(*Role).Reset()
+}
+
+// GetId returns the unique identifier for the role.
+func (r *Role) GetId() int64 {
+	return r.id
+}
+
+// SetId sets the unique identifier for the role.
+func (r *Role) SetId(id int64) *Role {
+	r.id = id
+	return r
+}
+
+// GetName returns the name of the role.
+func (r *Role) GetName() string {
+	return r.name
+}
+
+// SetName sets the name of the role.
+func (r *Role) SetName(name string) *Role {
+	r.name = name
+	return r
+}
+
+// GetDescription returns the description of the role.
+func (r *Role) GetDescription() string {
+	return r.description
+}
+
+// SetDescription sets the description of the role.
+func (r *Role) SetDescription(description string) *Role {
+	r.description = description
+	return r
+}
+
+// GetScopes returns the scopes granted by the role.
+func (r *Role) GetScopes() []string {
+	return r.scopes
+}
+
+// SetScopes sets the scopes granted by the role.
+func (r *Role) SetScopes(scopes []string) *Role {
+	r.scopes = scopes
+	return r
+}
+
+// GetCreatedAt returns the timestamp when the role was created.
+func (r *Role) GetCreatedAt() time.Time {
+	return r.createdAt
+}
+
+// SetCreatedAt sets the timestamp when the role was created.
+func (r *Role) SetCreatedAt(createdAt time.Time) *Role {
+	r.createdAt = createdAt
+	return r
+}
+
+// GetUpdatedAt returns the timestamp when the role was last updated.
+func (r *Role) GetUpdatedAt() time.Time {
+	return r.updatedAt
+}
+
+// SetUpdatedAt sets the timestamp when the role was last updated.
+func (r *Role) SetUpdatedAt(updatedAt time.Time) *Role {
+	r.updatedAt = updatedAt
+	return r
+}
+
+// GetVersion returns the version number of the role.
+func (r *Role) GetVersion() int32 {
+	return r.version
+}
+
+// SetVersion sets the version number of the role.
+func (r *Role) SetVersion(version int32) *Role {
+	r.version = version
+	return r
+}
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"domain/role/entity"
+	"errors"
+)
+
+var (
+	// ErrRoleNotFound is returned when the requested role or assignment does not exist.
+	ErrRoleNotFound = errors.New("role not found")
+	// ErrEditConflict is returned when a role was modified concurrently and the version no longer matches.
+	ErrEditConflict = errors.New("edit conflict")
+	// ErrDuplicate is returned when a role name or a role assignment already exists.
+	ErrDuplicate = errors.New("duplicate record")
+)
+
+// RoleRepository defines the interface for interacting with roles and their assignments.
+type RoleRepository interface {
+	// SaveRole persists a new role into the data source.
+	// Returns ErrDuplicate if a role with the same name already exists.
+	SaveRole(ctx context.Context, role *entity.Role) error
+
+	// GetRole retrieves a role by its unique ID.
+	// Returns ErrRoleNotFound if the role does not exist.
+	GetRole(ctx context.Context, id int64) (*entity.Role, error)
+
+	// UpdateRole modifies an existing role using optimistic concurrency control.
+	// Returns ErrEditConflict if the role version does not match.
+	UpdateRole(ctx context.Context, role *entity.Role) error
+
+	// GetRoles retrieves all roles ordered by name.
+	GetRoles(ctx context.Context) ([]*entity.Role, error)
+
+	// SaveAssignment grants a role to a subject.
+	// Returns ErrDuplicate if the subject already holds the role and ErrRoleNotFound if the role does not exist.
+	SaveAssignment(ctx context.Context, assignment *entity.Assignment) error
+
+	// DeleteAssignment revokes a role assignment by its unique ID and returns the revoked assignment.
+	// Returns ErrRoleNotFound if the assignment does not exist.
+	DeleteAssignment(ctx context.Context, id int64) (*entity.Assignment, error)
+
+	// GetAssignments retrieves role assignments, optionally filtered by subject type and subject ID.
+	// Empty filter values match every assignment.
+	GetAssignments(ctx context.Context, subjectType, subjectId string) ([]*entity.Assignment, error)
+
+	// GetScopes returns the distinct, sorted set of scopes granted to a subject through all of its roles.
+	GetScopes(ctx context.Context, subjectType, subjectId string) ([]string, error)
+}
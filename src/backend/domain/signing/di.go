@@ -0,0 +1,82 @@
+package signing
+
+import (
+	"application/audit"
+	"application/config"
+	"application/dependency"
+	"application/role"
+	"application/signing"
+	"domain/signing/repository"
+	infraSigning "infrastructure/signing"
+	apiHandlers "interfaces/api/signing/handlers"
+	apiValidators "interfaces/api/signing/validators"
+	"interfaces/api/utils"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Container provides a lazily initialized set of dependencies for the signing domain.
+type Container struct {
+	KeyRepository dependency.LazyDependency[repository.KeyRepository]
+	KeyService    dependency.LazyDependency[*signing.Service]
+	NonceCache    dependency.LazyDependency[signing.NonceCache]
+	Verifier      dependency.LazyDependency[*signing.Verifier]
+	KeyValidator  dependency.LazyDependency[*apiValidators.RequestValidator]
+	ListHandler   dependency.LazyDependency[*apiHandlers.ListKeyHandler]
+	IssueHandler  dependency.LazyDependency[*apiHandlers.IssueKeyHandler]
+	RevokeHandler dependency.LazyDependency[*apiHandlers.RevokeKeyHandler]
+}
+
+// NewContainer initializes and returns a new Container with lazy dependencies for the signing domain.
+func NewContainer(
+	cfg *config.Configuration,
+	db *pgxpool.Pool,
+	a *audit.Service,
+	r *role.Service,
+	h *utils.Handler,
+	e *utils.Errors,
+) *Container {
+	c := &Container{
+		KeyRepository: dependency.LazyDependency[repository.KeyRepository]{
+			InitFunc: func() repository.KeyRepository {
+				return infraSigning.NewPgxKeyRepository(db)
+			},
+		},
+	}
+	c.KeyService = dependency.LazyDependency[*signing.Service]{
+		InitFunc: func() *signing.Service {
+			return signing.NewService(c.KeyRepository.Get(), a)
+		},
+	}
+	c.NonceCache = dependency.LazyDependency[signing.NonceCache]{
+		InitFunc: func() signing.NonceCache {
+			return signing.NewMemoryNonceCache(time.Minute)
+		},
+	}
+	c.Verifier = dependency.LazyDependency[*signing.Verifier]{
+		InitFunc: func() *signing.Verifier {
+			return signing.NewVerifier(c.KeyRepository.Get(), r, c.NonceCache.Get(), cfg.Signature.Window)
+		},
+	}
+	c.KeyValidator = dependency.LazyDependency[*apiValidators.RequestValidator]{
+		InitFunc: apiValidators.NewRequestValidator,
+	}
+	c.ListHandler = dependency.LazyDependency[*apiHandlers.ListKeyHandler]{
+		InitFunc: func() *apiHandlers.ListKeyHandler {
+			return apiHandlers.NewListKeyHandler(h, e, c.KeyService.Get())
+		},
+	}
+	c.IssueHandler = dependency.LazyDependency[*apiHandlers.IssueKeyHandler]{
+		InitFunc: func() *apiHandlers.IssueKeyHandler {
+			return apiHandlers.NewIssueKeyHandler(h, e, c.KeyService.Get(), c.KeyValidator.Get())
+		},
+	}
+	c.RevokeHandler = dependency.LazyDependency[*apiHandlers.RevokeKeyHandler]{
+		InitFunc: func() *apiHandlers.RevokeKeyHandler {
+			return apiHandlers.NewRevokeKeyHandler(h, e, c.KeyService.Get())
+		},
+	}
+
+	return c
+}
@@ -0,0 +1,130 @@
+package entity
+
+import (
+	"sync"
+	"time"
+)
+
+// keyInstance is the instance of the getKeyPool function to access the pool.
+var keyInstance = getKeyPool()
+
+// getKeyPool returns a singleton instance of sync.Pool used to manage Key entities.
+// It ensures efficient memory use by reusing Key instances.
+func getKeyPool() func() *sync.Pool {
+	var once sync.Once
+	var pool *sync.Pool
+
+	return func() *sync.Pool {
+		once.Do(func() {
+			pool = &sync.Pool{
+				New: func() interface{} {
+					return &Key{}
+				},
+			}
+		})
+		return pool
+	}
+}
+
+// Key represents a shared HMAC secret issued to an API client for signing its requests.
+type Key struct {
+	id        int64     // Unique identifier for the key.
+	keyId     string    // Public identifier sent by the client alongside each signature.
+	clientId  string    // API client the key was issued to.
+	secret    string    // Shared secret used to compute the signatures.
+	createdAt time.Time // The timestamp when the key was issued.
+	revokedAt time.Time // The timestamp when the key was revoked, zero while the key is active.
+}
+
+// Reset resets the fields of the Key to their zero values and returns the updated Key.
+func (k *Key) Reset() *Key {
+	k.id = 0
+	k.keyId = ""
+	k.clientId = ""
+	k.secret = ""
+	k.createdAt = time.Time{}
+	k.revokedAt = time.Time{}
+	return k
+}
+
+// Release releases the Key instance back to the pool after resetting it.
+func (k *Key) Release() {
+	keyInstance().Put(k.Reset())
+}
+
+// GetKey retrieves a new or recycled Key instance from the pool.
+// It resets the fields to zero values before returning to ensure a clean instance.
+func GetKey() *Key {
+	return keyInstance().Get().(*Key).Reset()
+}
+
+// GetId returns the unique identifier for the key.
+func (k *Key) GetId() int64 {
+	return k.id
+}
+
+// SetId sets the unique identifier for the key.
+func (k *Key) SetId(id int64) *Key {
+	k.id = id
+	return k
+}
+
+// GetKeyId returns the public identifier of the key.
+func (k *Key) GetKeyId() string {
+	return k.keyId
+}
+
+// SetKeyId sets the public identifier of the key.
+func (k *Key) SetKeyId(keyId string) *Key {
+	k.keyId = keyId
+	return k
+}
+
+// GetClientId returns the API client the key was issued to.
+func (k *Key) GetClientId() string {
+	return k.clientId
+}
+
+// SetClientId sets the API client the key was issued to.
+func (k *Key) SetClientId(clientId string) *Key {
+	k.clientId = clientId
+	return k
+}
+
+// GetSecret returns the shared secret of the key.
+func (k *Key) GetSecret() string {
+	return k.secret
+}
+
+// SetSecret sets the shared secret of the key.
+func (k *Key) SetSecret(secret string) *Key {
+	k.secret = secret
+	return k
+}
+
+// GetCreatedAt returns the timestamp when the key was issued.
+func (k *Key) GetCreatedAt() time.Time {
+	return k.createdAt
+}
+
+// SetCreatedAt sets the timestamp when the key was issued.
+func (k *Key) SetCreatedAt(createdAt time.Time) *Key {
+	k.createdAt = createdAt
+	return k
+}
+
+// GetRevokedAt returns the timestamp when the key was revoked, or the zero time while the key is active.
+func (k *Key) GetRevokedAt() time.Time {
+	return k.revokedAt
+}
+
+// SetRevokedAt sets the timestamp when the key was revoked.
+func (k *Key) SetRevokedAt(revokedAt time.Time) *Key {
+	k.revokedAt = revokedAt
+	return k
+}
+
+// IsRevoked reports whether the key has been revoked.
+func (k *Key) IsRevoked() bool {
+	return !k.revokedAt.IsZero()
+}
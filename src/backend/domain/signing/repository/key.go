@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"domain/signing/entity"
+	"errors"
+)
+
+// ErrKeyNotFound is returned when the requested signing key does not exist or has been revoked.
+var ErrKeyNotFound = errors.New("signing key not found")
+
+// KeyRepository defines the interface for interacting with the signing keys of API clients.
+type KeyRepository interface {
+	// Save persists a newly issued key, setting its ID and issue timestamp.
+	Save(ctx context.Context, key *entity.Key) error
+
+	// GetActive retrieves an active key by its public identifier.
+	// Returns ErrKeyNotFound if the key does not exist or has been revoked.
+	GetActive(ctx context.Context, keyId string) (*entity.Key, error)
+
+	// GetList retrieves the keys issued to an API client, or to every client if clientId is empty,
+	// ordered by ID. The secrets of the returned keys are not populated.
+	GetList(ctx context.Context, clientId string) ([]*entity.Key, error)
+
+	// Revoke marks the key with the given ID as revoked and returns it.
+	// Returns ErrKeyNotFound if the key does not exist or has already been revoked.
+	Revoke(ctx context.Context, id int64) (*entity.Key, error)
+}
@@ -1,14 +1,17 @@
 package vacancy
 
 import (
+	"application/audit"
+	"application/config"
 	"application/dependency"
 	"application/event"
+	"application/organization"
 	"application/vacancy"
 	"domain/vacancy/repository"
 	infraVacancy "infrastructure/vacancy"
 	"interfaces/api/utils"
 	apiHandlers "interfaces/api/vacancy/handlers"
-	apiValidators "interfaces/api/vacancy/validators"
+	"log"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 )
@@ -17,16 +20,30 @@ import (
 type Container struct {
 	VacancyRepository dependency.LazyDependency[repository.VacancyRepository]
 	VacancyService    dependency.LazyDependency[*vacancy.Service]
-	VacancyValidator  dependency.LazyDependency[*apiValidators.RequestValidator]
 	CreateHandler     dependency.LazyDependency[*apiHandlers.CreateVacancyHandler]
 	GetHandler        dependency.LazyDependency[*apiHandlers.GetVacancyHandler]
 	DeleteHandler     dependency.LazyDependency[*apiHandlers.DeleteVacancyHandler]
 	UpdateHandler     dependency.LazyDependency[*apiHandlers.UpdateVacancyHandler]
 	ListHandler       dependency.LazyDependency[*apiHandlers.ListVacancyHandler]
+	ExportHandler     dependency.LazyDependency[*apiHandlers.ExportVacancyHandler]
+	BatchHandler      dependency.LazyDependency[*apiHandlers.BatchVacancyHandler]
+	ImportHandler     dependency.LazyDependency[*apiHandlers.ImportVacancyHandler]
+	FeedHandler       dependency.LazyDependency[*apiHandlers.FeedVacancyHandler]
+	ChangeStream      dependency.LazyDependency[*vacancy.ChangeStream]
+	StreamHandler     dependency.LazyDependency[*apiHandlers.StreamVacancyHandler]
 }
 
 // NewContainer initializes and returns a new Container with lazy dependencies for the vacancy domain.
-func NewContainer(db *pgxpool.Pool, d event.Dispatcher, h *utils.Handler, e *utils.Errors) *Container {
+func NewContainer(
+	cfg *config.Configuration,
+	db *pgxpool.Pool,
+	d event.Dispatcher,
+	s event.Subscriber,
+	o *organization.Service,
+	a *audit.Service,
+	h *utils.Handler,
+	e *utils.Errors,
+) *Container {
 	c := &Container{
 		VacancyRepository: dependency.LazyDependency[repository.VacancyRepository]{
 			InitFunc: func() repository.VacancyRepository {
@@ -36,15 +53,12 @@ func NewContainer(db *pgxpool.Pool, d event.Dispatcher, h *utils.Handler, e *uti
 	}
 	c.VacancyService = dependency.LazyDependency[*vacancy.Service]{
 		InitFunc: func() *vacancy.Service {
-			return vacancy.NewService(c.VacancyRepository.Get(), d)
+			return vacancy.NewService(c.VacancyRepository.Get(), d, o, a)
 		},
 	}
-	c.VacancyValidator = dependency.LazyDependency[*apiValidators.RequestValidator]{
-		InitFunc: apiValidators.NewRequestValidator,
-	}
 	c.CreateHandler = dependency.LazyDependency[*apiHandlers.CreateVacancyHandler]{
 		InitFunc: func() *apiHandlers.CreateVacancyHandler {
-			return apiHandlers.NewCreateVacancyHandler(h, e, c.VacancyService.Get(), c.VacancyValidator.Get())
+			return apiHandlers.NewCreateVacancyHandler(h, e, c.VacancyService.Get())
 		},
 	}
 	c.GetHandler = dependency.LazyDependency[*apiHandlers.GetVacancyHandler]{
@@ -59,12 +73,48 @@ func NewContainer(db *pgxpool.Pool, d event.Dispatcher, h *utils.Handler, e *uti
 	}
 	c.UpdateHandler = dependency.LazyDependency[*apiHandlers.UpdateVacancyHandler]{
 		InitFunc: func() *apiHandlers.UpdateVacancyHandler {
-			return apiHandlers.NewUpdateVacancyHandler(h, e, c.VacancyService.Get(), c.VacancyValidator.Get())
+			return apiHandlers.NewUpdateVacancyHandler(h, e, c.VacancyService.Get())
 		},
 	}
 	c.ListHandler = dependency.LazyDependency[*apiHandlers.ListVacancyHandler]{
 		InitFunc: func() *apiHandlers.ListVacancyHandler {
-			return apiHandlers.NewListVacancyHandler(h, e, c.VacancyService.Get(), c.VacancyValidator.Get())
+			return apiHandlers.NewListVacancyHandler(h, e, c.VacancyService.Get())
+		},
+	}
+	c.ExportHandler = dependency.LazyDependency[*apiHandlers.ExportVacancyHandler]{
+		InitFunc: func() *apiHandlers.ExportVacancyHandler {
+			return apiHandlers.NewExportVacancyHandler(h, e, c.VacancyService.Get())
+		},
+	}
+	c.BatchHandler = dependency.LazyDependency[*apiHandlers.BatchVacancyHandler]{
+		InitFunc: func() *apiHandlers.BatchVacancyHandler {
+			return apiHandlers.NewBatchVacancyHandler(h, e, c.VacancyService.Get(),
+				cfg.Batch.MaxItems)
+		},
+	}
+	c.ImportHandler = dependency.LazyDependency[*apiHandlers.ImportVacancyHandler]{
+		InitFunc: func() *apiHandlers.ImportVacancyHandler {
+			return apiHandlers.NewImportVacancyHandler(h, e, c.VacancyService.Get(),
+				cfg.Batch.MaxItems, int64(cfg.Import.MaxBytes))
+		},
+	}
+	c.FeedHandler = dependency.LazyDependency[*apiHandlers.FeedVacancyHandler]{
+		InitFunc: func() *apiHandlers.FeedVacancyHandler {
+			return apiHandlers.NewFeedVacancyHandler(h, e, c.VacancyService.Get(), cfg.Feed.ItemLimit)
+		},
+	}
+	c.ChangeStream = dependency.LazyDependency[*vacancy.ChangeStream]{
+		InitFunc: func() *vacancy.ChangeStream {
+			stream, err := vacancy.NewChangeStream(c.VacancyService.Get(), s, cfg.Stream.ReplaySize)
+			if err != nil {
+				log.Fatalf("Failed to initialize vacancy change stream: %v", err)
+			}
+			return stream
+		},
+	}
+	c.StreamHandler = dependency.LazyDependency[*apiHandlers.StreamVacancyHandler]{
+		InitFunc: func() *apiHandlers.StreamVacancyHandler {
+			return apiHandlers.NewStreamVacancyHandler(h, e, c.ChangeStream.Get(), cfg.Stream.Heartbeat)
 		},
 	}
 
@@ -28,13 +28,14 @@ func getVacancyPool() func() *sync.Pool {
 
 // Vacancy represents a job vacancy with relevant details.
 type Vacancy struct {
-	id          int64     // Unique identifier for the vacancy.
-	title       string    // The job title.
-	company     string    // The company offering the job.
-	description string    // A description of the job.
-	postedAt    time.Time // The timestamp when the job was posted.
-	location    string    // The location of the job.
-	version     int32     // The version number of the job entry, useful for optimistic concurrency control.
+	id             int64     // Unique identifier for the vacancy.
+	title          string    // The job title.
+	company        string    // The company offering the job.
+	description    string    // A description of the job.
+	postedAt       time.Time // The timestamp when the job was posted.
+	location       string    // The location of the job.
+	version        int32     // The version number of the job entry, useful for optimistic concurrency control.
+	organizationId int64     // Identifier of the owning organization, zero if the vacancy has no owner.
 }
 
 // Reset resets the fields of the Vacancy to their zero values and returns the updated Vacancy.
@@ -46,6 +47,7 @@ func (v *Vacancy) Reset() *Vacancy {
 	v.postedAt = time.Time{}
 	v.location = ""
 	v.version = 0
+	v.organizationId = 0
 	return v
 }
 
@@ -136,3 +138,14 @@ func (v *Vacancy) SetVersion(version int32) *Vacancy {
 	v.version = version
 	return v
 }
+
+// GetOrganizationId returns the identifier of the organization owning the vacancy, or zero if it has no owner.
+func (v *Vacancy) GetOrganizationId() int64 {
+	return v.organizationId
+}
+
+// SetOrganizationId sets the identifier of the organization owning the vacancy.
+func (v *Vacancy) SetOrganizationId(organizationId int64) *Vacancy {
+	v.organizationId = organizationId
+	return v
+}
@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrPostedAtRequired is returned when the date a vacancy was posted at is missing.
+	ErrPostedAtRequired = errors.New("posted_at must be provided and cannot be empty or whitespace")
+
+	// ErrPostedAtFormat is returned when the date a vacancy was posted at is not a YYYY-MM-DD date.
+	ErrPostedAtFormat = errors.New("posted_at must be in the format YYYY-MM-DD. Example: 2006-01-02")
+
+	// ErrOrganizationId is returned when the ID of the organization owning a vacancy is not positive.
+	ErrOrganizationId = errors.New("organization_id must be greater than zero")
+)
+
+// ValidateText validates a required text field of a vacancy, e.g., its title, which must hold more than
+// whitespace. The field names the value in the error.
+func ValidateText(field, value string) error {
+	if strings.TrimSpace(value) == "" {
+		return errors.New(field + " must be provided and cannot be empty or whitespace")
+	}
+	return nil
+}
+
+// ParsePostedAt parses the date a vacancy was posted at, as YYYY-MM-DD, ignoring surrounding whitespace.
+// Returns ErrPostedAtRequired if the value is missing, or ErrPostedAtFormat if it is not such a date.
+func ParsePostedAt(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, ErrPostedAtRequired
+	}
+	postedAt, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, ErrPostedAtFormat
+	}
+	return postedAt, nil
+}
+
+// ValidateOrganizationId validates the ID of the organization owning a vacancy, which must be positive.
+func ValidateOrganizationId(organizationId int64) error {
+	if organizationId <= 0 {
+		return ErrOrganizationId
+	}
+	return nil
+}
@@ -3,6 +3,16 @@ package repository
 import (
 	"context"
 	"domain/vacancy/entity"
+	"errors"
+)
+
+var (
+	// ErrVacancyNotFound is returned when the requested vacancy does not exist.
+	ErrVacancyNotFound = errors.New("vacancy not found")
+	// ErrEditConflict is returned when a vacancy was modified concurrently and the version no longer matches.
+	ErrEditConflict = errors.New("edit conflict")
+	// ErrBatchAborted is returned for the items of an atomic batch rolled back because another item failed.
+	ErrBatchAborted = errors.New("batch aborted")
 )
 
 // VacancyRepository defines the interface for interacting with job vacancy data.
@@ -11,29 +21,63 @@ type VacancyRepository interface {
 	// Returns an error if the operation fails.
 	Save(ctx context.Context, vacancy *entity.Vacancy) error
 
-	// Get retrieves a job vacancy by its unique ID.
-	// Returns a pointer to the Vacancy entity and an error if retrieval fails.
-	Get(ctx context.Context, id int64) (*entity.Vacancy, error)
+	// Get retrieves a job vacancy by its unique ID, reading only the given fields, named after their columns, if
+	// any, and leaving the others unset.
+	// Returns a pointer to the Vacancy entity, or ErrVacancyNotFound if the vacancy does not exist.
+	Get(ctx context.Context, id int64, fields ...string) (*entity.Vacancy, error)
 
 	// Update modifies an existing job vacancy in the data source.
-	// Returns an error if the vacancy does not exist or if the operation fails.
+	// Returns ErrEditConflict if the vacancy does not exist or its version does not match.
 	Update(ctx context.Context, vacancy *entity.Vacancy) error
 
 	// Delete removes a job vacancy from the data source by its unique ID.
-	// Returns an error if the vacancy does not exist or if the deletion operation fails.
+	// Returns ErrVacancyNotFound if the vacancy does not exist, or an error if the deletion operation fails.
 	Delete(ctx context.Context, id int64) error
 
+	// GetBatch retrieves the job vacancies with the given IDs, skipping the ones that do not exist.
+	GetBatch(ctx context.Context, ids []int64) ([]*entity.Vacancy, error)
+
+	// SaveBatch persists new job vacancies in a single transaction, each in its own savepoint.
+	// Returns the error of each vacancy, nil if it was saved. In atomic mode, a failure rolls back every
+	// vacancy and the others get ErrBatchAborted. The error is returned if the transaction itself fails.
+	SaveBatch(ctx context.Context, vacancies []*entity.Vacancy, atomic bool) ([]error, error)
+
+	// UpdateBatch modifies existing job vacancies in a single transaction, as SaveBatch.
+	UpdateBatch(ctx context.Context, vacancies []*entity.Vacancy, atomic bool) ([]error, error)
+
+	// DeleteBatch removes job vacancies by their unique IDs in a single transaction, as SaveBatch.
+	DeleteBatch(ctx context.Context, ids []int64, atomic bool) ([]error, error)
+
 	// GetList retrieves a list of all job vacancies from the data source.
 	// Returns a slice of Vacancy pointers and an error if the operation fails.
 	GetList(ctx context.Context) ([]*entity.Vacancy, error)
 
-	// GetFilteredList retrieves a list of job vacancies from the data source based on filter criteria.
+	// GetFilteredList retrieves a list of job vacancies from the data source based on filter criteria, reading only
+	// the given fields, as Get.
 	// Returns a slice of Vacancy pointers and an error if the operation fails.
 	GetFilteredList(
 		ctx context.Context,
 		title, company string,
 		page, pageSize int,
-		sortField, sortOrder string) ([]*entity.Vacancy, error)
+		sortField, sortOrder string,
+		fields ...string) ([]*entity.Vacancy, error)
+
+	// GetFeed retrieves the most recently added job vacancies matching the filter criteria, newest first, up to
+	// limit. Returns a slice of Vacancy pointers and an error if the operation fails.
+	GetFeed(ctx context.Context, title, company, location string, limit int) ([]*entity.Vacancy, error)
+
+	// Export passes the job vacancies matching the filter criteria, sorted as given, to fn one at a time, reading
+	// them in batches so memory use does not depend on their number. The vacancy passed to fn is reused, so it must
+	// not be kept. Returns the error of fn, which stops the export, or an error if the operation fails.
+	Export(
+		ctx context.Context,
+		title, company string,
+		sortField, sortOrder string,
+		fn func(v *entity.Vacancy) error) error
+
+	// Count returns the number of job vacancies in the data source.
+	// Returns an error if the operation fails.
+	Count(ctx context.Context) (int64, error)
 
 	// Purge removes all job vacancies from the data source.
 	// Returns an error if the operation fails.
@@ -0,0 +1,150 @@
+package audit
+
+import (
+	"context"
+	"domain/audit/entity"
+	"domain/audit/repository"
+	"encoding/json"
+	"fmt"
+	"infrastructure/persistence/criteria"
+	"infrastructure/persistence/query"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// recordColumns lists the columns selected for an audit record, in the order expected by scanRecord.
+const recordColumns = `id, actor, action, target, outcome, source_ip, request_id, details, created_at`
+
+// PgxAuditRepository implements the AuditRepository interface using pgx.
+type PgxAuditRepository struct {
+	db *pgxpool.Pool // Connection pool for database interactions.
+}
+
+// NewPgxAuditRepository initializes a new instance of PgxAuditRepository with a database connection pool.
+func NewPgxAuditRepository(db *pgxpool.Pool) *PgxAuditRepository {
+	return &PgxAuditRepository{db: db}
+}
+
+// Save appends a record to the audit log and retrieves the generated ID and timestamp.
+func (r *PgxAuditRepository) Save(ctx context.Context, rec *entity.Record) error {
+	baseQuery := `
+		INSERT INTO audit_log (actor, action, target, outcome, source_ip, request_id, details)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at
+	`
+	if rec.GetDetails() == nil {
+		rec.SetDetails(map[string]any{})
+	}
+	if rec.GetOutcome() == "" {
+		rec.SetOutcome(entity.OutcomeSuccess)
+	}
+	details, err := json.Marshal(rec.GetDetails())
+	if err != nil {
+		return fmt.Errorf("failed to encode audit details: %w", err)
+	}
+
+	var id int64
+	var createdAt time.Time
+	if err = r.db.QueryRow(ctx, baseQuery, rec.GetActor(), rec.GetAction(), rec.GetTarget(), rec.GetOutcome(),
+		rec.GetSourceIp(), rec.GetRequestId(), details).Scan(&id, &createdAt); err != nil {
+		return fmt.Errorf("failed to save audit record: %w", err)
+	}
+	rec.SetId(id).SetCreatedAt(createdAt)
+	return nil
+}
+
+// GetList retrieves the records matching the filter, newest first.
+func (r *PgxAuditRepository) GetList(ctx context.Context, filter repository.Filter) ([]*entity.Record, error) {
+	baseQuery := `SELECT ` + recordColumns + ` FROM audit_log`
+	qb := query.GetBuilder(baseQuery)
+	defer qb.Release()
+
+	criteriaBuilder := criteria.GetSearchCriteriaBuilder()
+	defer criteriaBuilder.Release()
+
+	applyFilter(criteriaBuilder, filter)
+	criteriaBuilder.SetLogicalOperator("AND")
+	searchCriteria := criteriaBuilder.Build()
+
+	qb.ApplySearchCriteria(searchCriteria)
+	qb.SetOrderBy("id", "DESC")
+	qb.SetPagination(1, filter.Limit)
+
+	q, args := qb.Build(searchCriteria)
+	rows, err := r.db.Query(ctx, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch audit records: %w", err)
+	}
+	defer rows.Close()
+
+	var list []*entity.Record
+	for rows.Next() {
+		rec, err := scanRecord(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, rec)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate audit records: %w", err)
+	}
+	return list, nil
+}
+
+// applyFilter adds a search criterion for every restricted field of the filter.
+func applyFilter(b *criteria.SearchCriteriaBuilder, filter repository.Filter) {
+	if filter.Actor != "" {
+		b.AddFilter("actor", "=", filter.Actor)
+	}
+	if prefix, ok := strings.CutSuffix(filter.Action, "*"); ok {
+		b.AddFilter("action", "LIKE", escapeLike(prefix)+"%")
+	} else if filter.Action != "" {
+		b.AddFilter("action", "=", filter.Action)
+	}
+	if filter.Target != "" {
+		b.AddFilter("target", "=", filter.Target)
+	}
+	if filter.Outcome != "" {
+		b.AddFilter("outcome", "=", filter.Outcome)
+	}
+	if !filter.From.IsZero() {
+		b.AddFilter("created_at", ">=", filter.From)
+	}
+	if !filter.To.IsZero() {
+		b.AddFilter("created_at", "<", filter.To)
+	}
+	if filter.BeforeId > 0 {
+		b.AddFilter("id", "<", filter.BeforeId)
+	}
+}
+
+// escapeLike escapes the wildcard characters of a LIKE pattern.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// scanRecord scans a single row into a Record entity.
+func scanRecord(row pgx.Row) (*entity.Record, error) {
+	var id int64
+	var actor, action, target, outcome, sourceIp, requestId string
+	var details map[string]any
+	var createdAt time.Time
+
+	if err := row.Scan(&id, &actor, &action, &target, &outcome, &sourceIp, &requestId, &details,
+		&createdAt); err != nil {
+		return nil, fmt.Errorf("failed to scan audit record: %w", err)
+	}
+	return entity.GetRecord().
+		SetId(id).
+		SetActor(actor).
+		SetAction(action).
+		SetTarget(target).
+		SetOutcome(outcome).
+		SetSourceIp(sourceIp).
+		SetRequestId(requestId).
+		SetDetails(details).
+		SetCreatedAt(createdAt), nil
+}
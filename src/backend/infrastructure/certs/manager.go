@@ -0,0 +1,259 @@
+package certs
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Client certificate verification modes.
+const (
+	ModeRequire       = "require"         // Clients must present a certificate signed by the client CA.
+	ModeVerifyIfGiven = "verify_if_given" // Certificates are verified when presented; other clients may connect.
+)
+
+// Minimum TLS versions.
+const (
+	Version12 = "1.2"
+	Version13 = "1.3"
+)
+
+// state holds the certificate and client CA bundle loaded from disk, replaced as a whole on reload.
+type state struct {
+	cert    *tls.Certificate
+	clients *x509.CertPool // Client CA bundle, or nil if client certificates are not verified.
+	files   []fileVersion  // Versions of the loaded files, compared to detect changes.
+}
+
+// fileVersion identifies the content of a file by its modification time and size.
+type fileVersion struct {
+	modTime time.Time
+	size    int64
+}
+
+// Manager serves the TLS certificate of the servers and reloads it from disk when its files change or Reload is
+// called. Handshakes use the certificate loaded last, so established connections are kept while new ones get the
+// new certificate. A single Manager is shared by the HTTP and gRPC servers of a process.
+type Manager struct {
+	certFile     string
+	keyFile      string
+	clientCAFile string             // Path to the client CA bundle, or empty if clients are not verified.
+	clientAuth   tls.ClientAuthType // Client certificate policy, when a client CA bundle is given.
+	minVersion   uint16             // Minimum TLS version accepted.
+	interval     time.Duration      // Interval the files are checked for changes at; zero disables the checks.
+	current      atomic.Pointer[state]
+	mu           sync.Mutex         // Serializes the reloads.
+	cancel       context.CancelFunc // Stops watching the files.
+	done         chan struct{}      // Closed once watching has stopped.
+}
+
+// Option configures a Manager.
+type Option func(m *Manager) error
+
+// WithClientCA enables mutual TLS, verifying client certificates against the CA bundle according to the mode.
+// An empty file leaves client certificates unverified.
+func WithClientCA(file, mode string) Option {
+	return func(m *Manager) error {
+		clientAuth, err := ClientAuthType(mode)
+		if err != nil {
+			return err
+		}
+		m.clientCAFile, m.clientAuth = file, clientAuth
+		return nil
+	}
+}
+
+// WithMinVersion sets the minimum TLS version accepted: "1.2" (default) or "1.3".
+func WithMinVersion(version string) Option {
+	return func(m *Manager) error {
+		switch version {
+		case Version12, "":
+			m.minVersion = tls.VersionTLS12
+		case Version13:
+			m.minVersion = tls.VersionTLS13
+		default:
+			return fmt.Errorf("unsupported minimum TLS version %q; must be %q or %q", version, Version12, Version13)
+		}
+		return nil
+	}
+}
+
+// WithReloadInterval sets the interval the files are checked for changes at once Start is called.
+func WithReloadInterval(interval time.Duration) Option {
+	return func(m *Manager) error {
+		m.interval = interval
+		return nil
+	}
+}
+
+// NewManager creates a Manager serving the certificate of the given files, which are loaded at once.
+func NewManager(certFile, keyFile string, opts ...Option) (*Manager, error) {
+	m := &Manager{certFile: certFile, keyFile: keyFile, minVersion: tls.VersionTLS12}
+	for _, opt := range opts {
+		if err := opt(m); err != nil {
+			return nil, err
+		}
+	}
+	if err := m.Reload(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ClientAuthType converts a client certificate verification mode to its TLS policy.
+func ClientAuthType(mode string) (tls.ClientAuthType, error) {
+	switch mode {
+	case ModeRequire:
+		return tls.RequireAndVerifyClientCert, nil
+	case ModeVerifyIfGiven, "":
+		return tls.VerifyClientCertIfGiven, nil
+	default:
+		return tls.NoClientCert, fmt.Errorf("unsupported client auth mode %q; must be %q or %q",
+			mode, ModeRequire, ModeVerifyIfGiven)
+	}
+}
+
+// ServerConfig returns a TLS configuration serving the current certificate and verifying clients against the
+// current CA bundle. Each handshake uses the files loaded last.
+func (m *Manager) ServerConfig() *tls.Config {
+	config := &tls.Config{
+		MinVersion: m.minVersion,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return m.current.Load().cert, nil
+		},
+	}
+	if m.clientCAFile != "" {
+		// The configuration is cloned on each handshake to pick up the current CA bundle, keeping the settings
+		// added by the servers, e.g., the ALPN protocols
+		config.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			c := config.Clone()
+			c.GetConfigForClient = nil
+			c.ClientCAs, c.ClientAuth = m.current.Load().clients, m.clientAuth
+			return c, nil
+		}
+	}
+	return config
+}
+
+// Certificate returns the certificate currently served.
+func (m *Manager) Certificate() *tls.Certificate {
+	return m.current.Load().cert
+}
+
+// Reload loads the files from disk and serves them to new connections. If a file cannot be loaded, the current
+// certificate is kept and the error is returned.
+func (m *Manager) Reload() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	files, err := m.versions()
+	if err != nil {
+		return err
+	}
+	cert, err := tls.LoadX509KeyPair(m.certFile, m.keyFile)
+	if err != nil {
+		return fmt.Errorf("load key pair: %w", err)
+	}
+	s := &state{cert: &cert, files: files}
+
+	if m.clientCAFile != "" {
+		pem, err := os.ReadFile(m.clientCAFile)
+		if err != nil {
+			return fmt.Errorf("read client CA bundle: %w", err)
+		}
+		s.clients = x509.NewCertPool()
+		if !s.clients.AppendCertsFromPEM(pem) {
+			return errors.New("client CA bundle contains no certificates")
+		}
+	}
+	m.current.Store(s)
+	return nil
+}
+
+// Start checks the files for changes at the reload interval until Stop is called, reloading them when they change.
+// It matches lifecycle.Hook.Start; nothing is checked if the interval is zero.
+func (m *Manager) Start(context.Context) error {
+	if m.interval <= 0 {
+		return nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancel, m.done = cancel, make(chan struct{})
+
+	go func() {
+		defer close(m.done)
+		ticker := time.NewTicker(m.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				m.reloadIfChanged()
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop stops checking the files for changes. It matches lifecycle.Hook.Stop.
+func (m *Manager) Stop(ctx context.Context) error {
+	if m.cancel == nil {
+		return nil
+	}
+	m.cancel()
+	select {
+	case <-m.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// reloadIfChanged reloads the files if any of them changed since they were loaded. Files being replaced may be
+// incomplete, so a failed reload is retried at the next check.
+func (m *Manager) reloadIfChanged() {
+	files, err := m.versions()
+	if err != nil || !changed(m.current.Load().files, files) {
+		return
+	}
+	if err := m.Reload(); err != nil {
+		slog.Error("Failed to reload TLS certificate", "certificate", m.certFile, "error", err)
+		return
+	}
+	slog.Info("TLS certificate reloaded", "certificate", m.certFile)
+}
+
+// versions returns the versions of the certificate, key and client CA files.
+func (m *Manager) versions() ([]fileVersion, error) {
+	paths := []string{m.certFile, m.keyFile}
+	if m.clientCAFile != "" {
+		paths = append(paths, m.clientCAFile)
+	}
+
+	files := make([]fileVersion, 0, len(paths))
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, fileVersion{modTime: info.ModTime(), size: info.Size()})
+	}
+	return files, nil
+}
+
+// changed reports whether any file version differs between the two lists.
+func changed(loaded, current []fileVersion) bool {
+	for i := range current {
+		if !loaded[i].modTime.Equal(current[i].modTime) || loaded[i].size != current[i].size {
+			return true
+		}
+	}
+	return false
+}
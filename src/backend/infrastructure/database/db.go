@@ -8,12 +8,15 @@ import (
 )
 
 // NewPostgresDB initializes a new PostgreSQL connection pool using a DSN string.
+// Queries are traced as spans of the context they are run with.
 func NewPostgresDB(dsn string) (*pgxpool.Pool, error) {
 	config, err := pgxpool.ParseConfig(dsn)
 	if err != nil {
 		return nil, fmt.Errorf("unable to parse dsn: %w", err)
 	}
 
+	config.ConnConfig.Tracer = QueryTracer{}
+
 	pool, err := pgxpool.NewWithConfig(context.Background(), config)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create database pool: %w", err)
@@ -0,0 +1,48 @@
+package database
+
+import (
+	"application/healthcheck"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PingChecker returns a checker verifying that a connection of the pool reaches the database.
+func PingChecker(pool *pgxpool.Pool) healthcheck.Checker {
+	return healthcheck.Checker{
+		Name: "database",
+		Check: func(ctx context.Context) error {
+			if err := pool.Ping(ctx); err != nil {
+				return fmt.Errorf("failed to ping database: %w", err)
+			}
+			return nil
+		},
+	}
+}
+
+// MigrationChecker returns a checker verifying that the schema version recorded by golang-migrate is at least the
+// expected one and that the last migration did not fail halfway.
+func MigrationChecker(pool *pgxpool.Pool, expected uint) healthcheck.Checker {
+	return healthcheck.Checker{
+		Name: "migrations",
+		Check: func(ctx context.Context) error {
+			var version int64
+			var dirty bool
+			err := pool.QueryRow(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&version, &dirty)
+			switch {
+			case errors.Is(err, pgx.ErrNoRows):
+				return errors.New("no migration applied")
+			case err != nil:
+				return fmt.Errorf("failed to read schema version: %w", err)
+			case dirty:
+				return fmt.Errorf("migration %d failed and must be fixed manually", version)
+			case version < int64(expected):
+				return fmt.Errorf("schema version %d is behind the expected version %d", version, expected)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,43 @@
+package database
+
+import (
+	"application/metrics"
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// RegisterPoolMetrics exposes the statistics of the connection pool, labelled with the given pool name, in the
+// registry. The statistics are read on every scrape.
+func RegisterPoolMetrics(r *metrics.Registry, name string, pool *pgxpool.Pool) {
+	conns := r.NewGaugeVec("pgxpool_connections", "Number of connections in the pool by state.", "pool", "state")
+	maxConns := r.NewGaugeVec("pgxpool_max_connections", "Maximum size of the pool.", "pool")
+	acquires := r.NewCounterVec("pgxpool_acquires_total", "Number of successful connection acquisitions.", "pool")
+	acquireSeconds := r.NewCounterVec("pgxpool_acquire_duration_seconds_total",
+		"Total time spent acquiring connections.", "pool")
+	canceled := r.NewCounterVec("pgxpool_canceled_acquires_total",
+		"Number of connection acquisitions canceled by their context.", "pool")
+	empty := r.NewCounterVec("pgxpool_empty_acquires_total",
+		"Number of connection acquisitions that waited for a connection because the pool was empty.", "pool")
+
+	r.OnCollect(func(context.Context) {
+		s := pool.Stat()
+		conns.With(name, "acquired").Set(float64(s.AcquiredConns()))
+		conns.With(name, "idle").Set(float64(s.IdleConns()))
+		conns.With(name, "constructing").Set(float64(s.ConstructingConns()))
+		maxConns.With(name).Set(float64(s.MaxConns()))
+
+		// The pool keeps cumulative counts, so the counters advance by the difference since the last scrape
+		advance(acquires.With(name), float64(s.AcquireCount()))
+		advance(acquireSeconds.With(name), s.AcquireDuration().Seconds())
+		advance(canceled.With(name), float64(s.CanceledAcquireCount()))
+		advance(empty.With(name), float64(s.EmptyAcquireCount()))
+	})
+}
+
+// advance increases the counter to the given cumulative total.
+func advance(c *metrics.Counter, total float64) {
+	if delta := total - c.Value(); delta > 0 {
+		c.Add(delta)
+	}
+}
@@ -0,0 +1,43 @@
+package database
+
+import (
+	"application/tracing"
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// QueryTracer records a client span for every query, as a child of the span of the query context.
+// The statement is recorded without its arguments, so no user data ends up in the spans.
+type QueryTracer struct{}
+
+// TraceQueryStart starts the span of a query; it is called by pgx before the query is sent.
+func (QueryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	ctx, span := tracing.Start(ctx, operation(data.SQL), tracing.KindClient)
+	span.SetAttribute("db.system", "postgresql")
+	span.SetAttribute("db.statement", data.SQL)
+	return ctx
+}
+
+// TraceQueryEnd ends the span of a query; it is called by pgx once the query completed.
+func (QueryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	span := tracing.SpanFromContext(ctx)
+	if span == nil {
+		return
+	}
+	if data.Err != nil && !errors.Is(data.Err, pgx.ErrNoRows) {
+		span.RecordError(data.Err)
+	}
+	span.End()
+}
+
+// operation returns the span name of a statement, i.e., its leading keyword, e.g., "SELECT".
+func operation(sql string) string {
+	fields := strings.Fields(sql)
+	if len(fields) == 0 {
+		return "query"
+	}
+	return strings.ToUpper(fields[0])
+}
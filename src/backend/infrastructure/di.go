@@ -1,45 +1,87 @@
 package infrastructure
 
 import (
+	"application/audit"
 	"application/auth"
+	"application/certauth"
 	"application/config"
 	"application/dependency"
 	appEvent "application/event"
+	"application/healthcheck"
+	"application/idempotency"
+	"application/metrics"
+	"application/organization"
+	"application/ratelimit"
+	"application/role"
+	"application/signing"
 	"application/vacancy"
+	auditRepository "domain/audit/repository"
+	organizationRepository "domain/organization/repository"
+	roleRepository "domain/role/repository"
+	signingRepository "domain/signing/repository"
 	"domain/vacancy/repository"
+	infraAudit "infrastructure/audit"
+	"infrastructure/certs"
 	"infrastructure/database"
 	"infrastructure/event"
 	authHandler "infrastructure/grpc/auth/handler"
 	authServer "infrastructure/grpc/auth/server"
+	"infrastructure/grpc/health"
 	vacancyHandler "infrastructure/grpc/vacancy/handler"
 	vacancyServer "infrastructure/grpc/vacancy/server"
 	"infrastructure/grpc/vacancy/validators"
+	infraIdempotency "infrastructure/idempotency"
+	"infrastructure/migrations"
+	infraOrganization "infrastructure/organization"
+	authv1 "infrastructure/proto/auth/gen"
+	vacancyv1 "infrastructure/proto/vacancy/gen"
+	infraRateLimit "infrastructure/ratelimit"
+	infraRole "infrastructure/role"
+	infraSigning "infrastructure/signing"
 	infraVacancy "infrastructure/vacancy"
 	"log"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // Container provides a lazily initialized set of dependencies for the infrastructure layer.
 type Container struct {
-	EventDispatcher      dependency.LazyDependency[appEvent.Dispatcher]
-	JwtAuthService       dependency.LazyDependency[*auth.Service]
-	DB                   dependency.LazyDependency[*pgxpool.Pool]
-	VacancyRepository    dependency.LazyDependency[repository.VacancyRepository]
-	VacancyService       dependency.LazyDependency[*vacancy.Service]
-	AuthServiceServer    dependency.LazyDependency[*authHandler.Service]
-	AuthServer           dependency.LazyDependency[*authServer.AuthServer]
-	VacancyServiceServer dependency.LazyDependency[*vacancyHandler.VacancyService]
-	VacancyServer        dependency.LazyDependency[*vacancyServer.VacancyServer]
-	Validator            dependency.LazyDependency[validators.Validator]
+	NatsDispatcher         dependency.LazyDependency[*event.NatsEventDispatcher]
+	EventDispatcher        dependency.LazyDependency[appEvent.Dispatcher]
+	EventSubscriber        dependency.LazyDependency[appEvent.Subscriber]
+	JwtAuthService         dependency.LazyDependency[*auth.Service]
+	DB                     dependency.LazyDependency[*pgxpool.Pool]
+	VacancyRepository      dependency.LazyDependency[repository.VacancyRepository]
+	VacancyService         dependency.LazyDependency[*vacancy.Service]
+	AuditRepository        dependency.LazyDependency[auditRepository.AuditRepository]
+	AuditService           dependency.LazyDependency[*audit.Service]
+	RoleRepository         dependency.LazyDependency[roleRepository.RoleRepository]
+	RoleService            dependency.LazyDependency[*role.Service]
+	OrganizationRepository dependency.LazyDependency[organizationRepository.OrganizationRepository]
+	OrganizationService    dependency.LazyDependency[*organization.Service]
+	SigningKeyRepository   dependency.LazyDependency[signingRepository.KeyRepository]
+	SignatureVerifier      dependency.LazyDependency[*signing.Verifier]
+	CertificateMapper      dependency.LazyDependency[*certauth.Mapper]
+	Certificates           dependency.LazyDependency[*certs.Manager]
+	RateLimiter            dependency.LazyDependency[*ratelimit.Limiter]
+	Idempotency            dependency.LazyDependency[*idempotency.Service]
+	AuthServiceServer      dependency.LazyDependency[*authHandler.Service]
+	AuthServer             dependency.LazyDependency[*authServer.AuthServer]
+	VacancyServiceServer   dependency.LazyDependency[*vacancyHandler.VacancyService]
+	VacancyServer          dependency.LazyDependency[*vacancyServer.VacancyServer]
+	AuthHealthServer       dependency.LazyDependency[*health.Server]
+	VacancyHealthServer    dependency.LazyDependency[*health.Server]
+	Validator              dependency.LazyDependency[validators.Validator]
 }
 
 // NewContainer initializes and returns a new Container with lazy dependencies for the infrastructure layer.
-func NewContainer(cfg *config.Configuration) *Container {
+// The registry collects the metrics of the event dispatcher, the database pool and the gRPC servers.
+func NewContainer(cfg *config.Configuration, m *metrics.Registry) *Container {
 	c := &Container{
-		EventDispatcher: dependency.LazyDependency[appEvent.Dispatcher]{
-			InitFunc: func() appEvent.Dispatcher {
-				d, err := event.NewNatsEventDispatcher(cfg.Nats.URL)
+		NatsDispatcher: dependency.LazyDependency[*event.NatsEventDispatcher]{
+			InitFunc: func() *event.NatsEventDispatcher {
+				d, err := event.NewNatsEventDispatcher(cfg.Nats.URL, event.WithMetrics(m))
 				if err != nil {
 					log.Fatalf("Failed to initialize NATS event dispatcher: %v", err)
 				}
@@ -47,12 +89,23 @@ func NewContainer(cfg *config.Configuration) *Container {
 			},
 		},
 	}
+	c.EventDispatcher = dependency.LazyDependency[appEvent.Dispatcher]{
+		InitFunc: func() appEvent.Dispatcher {
+			return c.NatsDispatcher.Get()
+		},
+	}
+	c.EventSubscriber = dependency.LazyDependency[appEvent.Subscriber]{
+		InitFunc: func() appEvent.Subscriber {
+			return c.NatsDispatcher.Get()
+		},
+	}
 	c.DB = dependency.LazyDependency[*pgxpool.Pool]{
 		InitFunc: func() *pgxpool.Pool {
 			db, err := database.NewPostgresDB(cfg.DB.DSN)
 			if err != nil {
 				log.Fatalf("Failed to initialize database: %v", err)
 			}
+			database.RegisterPoolMetrics(m, "infrastructure", db)
 			return db
 		},
 	}
@@ -66,25 +119,105 @@ func NewContainer(cfg *config.Configuration) *Container {
 	}
 	c.VacancyService = dependency.LazyDependency[*vacancy.Service]{
 		InitFunc: func() *vacancy.Service {
-			return vacancy.NewService(c.VacancyRepository.Get(), c.EventDispatcher.Get())
+			return vacancy.NewService(c.VacancyRepository.Get(), c.EventDispatcher.Get(), c.OrganizationService.Get(),
+				c.AuditService.Get())
+		},
+	}
+	c.AuditRepository = dependency.LazyDependency[auditRepository.AuditRepository]{
+		InitFunc: func() auditRepository.AuditRepository {
+			return infraAudit.NewPgxAuditRepository(c.DB.Get())
+		},
+	}
+	c.AuditService = dependency.LazyDependency[*audit.Service]{
+		InitFunc: func() *audit.Service {
+			if cfg.Audit.Publish {
+				return audit.NewService(c.AuditRepository.Get(), audit.WithDispatcher(c.EventDispatcher.Get()))
+			}
+			return audit.NewService(c.AuditRepository.Get())
+		},
+	}
+	c.RoleRepository = dependency.LazyDependency[roleRepository.RoleRepository]{
+		InitFunc: func() roleRepository.RoleRepository {
+			return infraRole.NewPgxRoleRepository(c.DB.Get())
+		},
+	}
+	c.RoleService = dependency.LazyDependency[*role.Service]{
+		InitFunc: func() *role.Service {
+			return role.NewService(c.RoleRepository.Get(), c.AuditService.Get())
+		},
+	}
+	c.OrganizationRepository = dependency.LazyDependency[organizationRepository.OrganizationRepository]{
+		InitFunc: func() organizationRepository.OrganizationRepository {
+			return infraOrganization.NewPgxOrganizationRepository(c.DB.Get())
+		},
+	}
+	c.OrganizationService = dependency.LazyDependency[*organization.Service]{
+		InitFunc: func() *organization.Service {
+			return organization.NewService(c.OrganizationRepository.Get(), c.AuditService.Get())
+		},
+	}
+	c.SigningKeyRepository = dependency.LazyDependency[signingRepository.KeyRepository]{
+		InitFunc: func() signingRepository.KeyRepository {
+			return infraSigning.NewPgxKeyRepository(c.DB.Get())
+		},
+	}
+	c.SignatureVerifier = dependency.LazyDependency[*signing.Verifier]{
+		InitFunc: func() *signing.Verifier {
+			nonces := signing.NewMemoryNonceCache(time.Minute)
+			return signing.NewVerifier(c.SigningKeyRepository.Get(), c.RoleService.Get(), nonces, cfg.Signature.Window)
+		},
+	}
+	c.CertificateMapper = dependency.LazyDependency[*certauth.Mapper]{
+		InitFunc: func() *certauth.Mapper {
+			return certauth.NewMapper(cfg.GRPC.ClientIdentities, c.RoleService.Get())
+		},
+	}
+	c.Certificates = dependency.LazyDependency[*certs.Manager]{
+		InitFunc: func() *certs.Manager {
+			tls := cfg.TLSConfig
+			if tls.Certificate == "" {
+				return nil // TLS not configured
+			}
+			manager, err := certs.NewManager(tls.Certificate, tls.Key,
+				certs.WithClientCA(tls.ClientCA, tls.ClientAuth),
+				certs.WithMinVersion(tls.MinVersion),
+				certs.WithReloadInterval(tls.ReloadInterval))
+			if err != nil {
+				log.Fatalf("Failed to load TLS certificate: %v", err)
+			}
+			return manager
+		},
+	}
+	c.RateLimiter = dependency.LazyDependency[*ratelimit.Limiter]{
+		InitFunc: func() *ratelimit.Limiter {
+			policies, err := ratelimit.NewPolicies(cfg.RateLimit.Default, cfg.RateLimit.Policies)
+			if err != nil {
+				log.Fatalf("Failed to parse rate limit policies: %v", err)
+			}
+			return ratelimit.NewLimiter(c.rateLimitStore(cfg.RateLimit.Store), policies, ratelimit.WithMetrics(m))
+		},
+	}
+	c.Idempotency = dependency.LazyDependency[*idempotency.Service]{
+		InitFunc: func() *idempotency.Service {
+			return idempotency.NewService(infraIdempotency.NewPgxStore(c.DB.Get(), time.Minute), cfg.Idempotency.TTL)
 		},
 	}
 	c.Validator = dependency.LazyDependency[validators.Validator]{
 		InitFunc: func() validators.Validator {
-			return validators.NewVacancyValidator()
+			return validators.NewVacancyValidator(cfg.Batch.MaxItems)
 		},
 	}
 
 	// gRPC services
 	c.AuthServiceServer = dependency.LazyDependency[*authHandler.Service]{
 		InitFunc: func() *authHandler.Service {
-			return authHandler.NewService(c.JwtAuthService.Get())
+			return authHandler.NewService(c.JwtAuthService.Get(), c.RoleService.Get(), c.AuditService.Get())
 		},
 	}
 	c.AuthServer = dependency.LazyDependency[*authServer.AuthServer]{
 		InitFunc: func() *authServer.AuthServer {
-			var env, port, certFile, keyFile = cfg.Env, cfg.GRPC.AuthServerPort, cfg.TLSConfig.Certificate, cfg.TLSConfig.Key
-			instance, err := authServer.NewAuthServer(env, port, certFile, keyFile)
+			instance, err := authServer.NewAuthServer(cfg.Env, cfg.GRPC.AuthServerPort, c.Certificates.Get(),
+				c.SignatureVerifier.Get(), c.CertificateMapper.Get(), c.AuditService.Get(), c.RateLimiter.Get(), m)
 			if err != nil {
 				log.Fatalf("Failed to initialize gRPC Auth server: %v", err)
 			}
@@ -98,9 +231,9 @@ func NewContainer(cfg *config.Configuration) *Container {
 	}
 	c.VacancyServer = dependency.LazyDependency[*vacancyServer.VacancyServer]{
 		InitFunc: func() *vacancyServer.VacancyServer {
-			var env, port, certFile, keyFile = cfg.Env, cfg.GRPC.VacancyServerPort, cfg.TLSConfig.Certificate,
-				cfg.TLSConfig.Key
-			instance, err := vacancyServer.NewVacancyServer(env, port, certFile, keyFile, c.JwtAuthService.Get())
+			instance, err := vacancyServer.NewVacancyServer(cfg.Env, cfg.GRPC.VacancyServerPort, c.Certificates.Get(),
+				c.JwtAuthService.Get(), c.SignatureVerifier.Get(), c.CertificateMapper.Get(), c.AuditService.Get(),
+				c.RateLimiter.Get(), c.Idempotency.Get(), m)
 			if err != nil {
 				log.Fatalf("Failed to initialize gRPC Vacancy server: %v", err)
 			}
@@ -108,5 +241,48 @@ func NewContainer(cfg *config.Configuration) *Container {
 		},
 	}
 
+	// gRPC health services, checking the dependencies used by each server
+	c.AuthHealthServer = dependency.LazyDependency[*health.Server]{
+		InitFunc: func() *health.Server {
+			checkers := c.databaseCheckers()
+			if cfg.Audit.Publish {
+				checkers = append(checkers, c.NatsDispatcher.Get().Checker())
+			}
+			return health.NewServer(healthcheck.NewService(cfg, checkers...), cfg.Health.CacheTTL,
+				authv1.AuthService_ServiceDesc.ServiceName)
+		},
+	}
+	c.VacancyHealthServer = dependency.LazyDependency[*health.Server]{
+		InitFunc: func() *health.Server {
+			checkers := append(c.databaseCheckers(), c.NatsDispatcher.Get().Checker())
+			return health.NewServer(healthcheck.NewService(cfg, checkers...), cfg.Health.CacheTTL,
+				vacancyv1.VacancyService_ServiceDesc.ServiceName)
+		},
+	}
+
 	return c
 }
+
+// rateLimitStore returns the store of the rate limit buckets of the given kind.
+func (c *Container) rateLimitStore(kind string) ratelimit.Store {
+	switch kind {
+	case "postgres":
+		return infraRateLimit.NewPgxStore(c.DB.Get(), time.Minute)
+	case "nats":
+		store, err := infraRateLimit.NewNatsKvStore(c.NatsDispatcher.Get().Conn(), "rate_limits")
+		if err != nil {
+			log.Fatalf("Failed to initialize rate limit store: %v", err)
+		}
+		return store
+	default:
+		return ratelimit.NewMemoryStore(time.Minute)
+	}
+}
+
+// databaseCheckers returns the checkers of the database connection and schema version.
+func (c *Container) databaseCheckers() []healthcheck.Checker {
+	return []healthcheck.Checker{
+		database.PingChecker(c.DB.Get()),
+		database.MigrationChecker(c.DB.Get(), migrations.LatestVersion()),
+	}
+}
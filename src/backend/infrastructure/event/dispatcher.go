@@ -1,38 +1,170 @@
 package event
 
 import (
+	appEvent "application/event"
+	"application/healthcheck"
+	"application/metrics"
+	"application/requestid"
+	"application/tracing"
+	"context"
 	"domain"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/nats-io/nats.go"
 )
 
 // NatsEventDispatcher uses NATS as the message broker to publish events to subscribers.
 type NatsEventDispatcher struct {
-	nc *nats.Conn // NATS connection for publishing messages.
+	nc        *nats.Conn          // NATS connection for publishing messages.
+	published *metrics.CounterVec // Publish attempts by topic and result, if metrics are enabled.
+}
+
+// Option configures optional behavior of the NatsEventDispatcher.
+type Option func(*NatsEventDispatcher)
+
+// WithMetrics counts the publish attempts by topic and result in the given registry.
+func WithMetrics(r *metrics.Registry) Option {
+	return func(d *NatsEventDispatcher) {
+		d.published = r.NewCounterVec("nats_publish_total", "Number of events published to NATS by topic and result.",
+			"topic", "result")
+	}
 }
 
 // NewNatsEventDispatcher initializes a new NatsEventDispatcher with a NATS connection.
-func NewNatsEventDispatcher(url string) (*NatsEventDispatcher, error) {
+func NewNatsEventDispatcher(url string, opts ...Option) (*NatsEventDispatcher, error) {
 	nc, err := nats.Connect(url)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to NATS server: %w", err)
 	}
-	return &NatsEventDispatcher{nc: nc}, nil
+	d := &NatsEventDispatcher{nc: nc}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d, nil
 }
 
 // Dispatch publishes the specified event to a NATS topic based on the event type.
-func (d *NatsEventDispatcher) Dispatch(e domain.Event) error {
+// The request ID of the context, if any, is sent in the X-Request-Id message header, and the publish span in the
+// traceparent message header, so subscribers continue the trace.
+func (d *NatsEventDispatcher) Dispatch(ctx context.Context, e domain.Event) error {
+	topic := fmt.Sprintf("event.%s", e.EventType())
+	ctx, span := tracing.Start(ctx, "publish "+topic, tracing.KindProducer)
+	defer span.End()
+	span.SetAttribute("messaging.system", "nats")
+	span.SetAttribute("messaging.destination.name", topic)
+
+	if err := d.publish(ctx, e, span.SpanContext().Traceparent()); err != nil {
+		span.RecordError(err)
+		return err
+	}
+	return nil
+}
+
+// DispatchBatch publishes the specified events, each to the NATS topic of its type, under a single publish span,
+// then flushes the connection once, so the events of a batch operation are sent in one round trip.
+func (d *NatsEventDispatcher) DispatchBatch(ctx context.Context, events []domain.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+	ctx, span := tracing.Start(ctx, "publish batch", tracing.KindProducer)
+	defer span.End()
+	span.SetAttribute("messaging.system", "nats")
+	span.SetAttribute("messaging.batch.message_count", len(events))
+
+	var errs []error
+	for _, e := range events {
+		if err := d.publish(ctx, e, span.SpanContext().Traceparent()); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := d.nc.FlushWithContext(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("failed to flush events: %w", err))
+	}
+	if err := errors.Join(errs...); err != nil {
+		span.RecordError(err)
+		return err
+	}
+	return nil
+}
+
+// publish publishes the event to the NATS topic of its type, with the request ID of the context and the given
+// trace context in the message headers.
+func (d *NatsEventDispatcher) publish(ctx context.Context, e domain.Event, traceparent string) error {
 	topic := fmt.Sprintf("event.%s", e.EventType())
 	payload, err := json.Marshal(e)
 	if err != nil {
 		return fmt.Errorf("failed to marshal event: %w", err)
 	}
 
-	if err = d.nc.Publish(topic, payload); err != nil {
+	msg := nats.NewMsg(topic)
+	msg.Data = payload
+	if id := requestid.FromContext(ctx); id != "" {
+		msg.Header.Set(requestid.Header, id)
+	}
+	msg.Header.Set(tracing.Header, traceparent)
+	if err = d.nc.PublishMsg(msg); err != nil {
+		d.count(topic, "failure")
 		return fmt.Errorf("failed to publish event: %w", err)
 	}
+	d.count(topic, "success")
 	fmt.Printf("Published event to topic %s: %s\n", topic, string(payload))
 	return nil
 }
+
+// count records the result of a publish attempt, if metrics are enabled.
+func (d *NatsEventDispatcher) count(topic, result string) {
+	if d.published != nil {
+		d.published.With(topic, result).Inc()
+	}
+}
+
+// Subscribe subscribes to the NATS topics of all event types, calling the handler with the type of every event
+// published to them, by any instance, and its payload.
+func (d *NatsEventDispatcher) Subscribe(handler appEvent.Handler) (func() error, error) {
+	sub, err := d.nc.Subscribe("event.*", func(msg *nats.Msg) {
+		handler(strings.TrimPrefix(msg.Subject, "event."), msg.Data)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe to events: %w", err)
+	}
+	return sub.Unsubscribe, nil
+}
+
+// Conn returns the NATS connection of the dispatcher, shared with the other users of NATS, e.g., the key-value
+// store of the rate limits.
+func (d *NatsEventDispatcher) Conn() *nats.Conn {
+	return d.nc
+}
+
+// Checker returns a checker verifying that the connection to the NATS server is established.
+func (d *NatsEventDispatcher) Checker() healthcheck.Checker {
+	return healthcheck.Checker{
+		Name: "nats",
+		Check: func(context.Context) error {
+			if status := d.nc.Status(); status != nats.CONNECTED {
+				return errors.New("connection is " + strings.ToLower(status.String()))
+			}
+			return nil
+		},
+	}
+}
+
+// Close drains the connection, flushing the pending messages, and waits until it is closed or the context expires.
+func (d *NatsEventDispatcher) Close(ctx context.Context) error {
+	closed := make(chan struct{})
+	d.nc.SetClosedHandler(func(*nats.Conn) { close(closed) })
+	if err := d.nc.Drain(); err != nil {
+		return fmt.Errorf("failed to drain NATS connection: %w", err)
+	}
+
+	select {
+	case <-closed:
+		return nil
+	case <-ctx.Done():
+		d.nc.Close()
+		return ctx.Err()
+	}
+}
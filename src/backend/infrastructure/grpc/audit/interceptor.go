@@ -0,0 +1,46 @@
+package audit
+
+import (
+	appAudit "application/audit"
+	"context"
+	auditEntity "domain/audit/entity"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor stores the source of the request in the context for the audit log and records
+// requests rejected as unauthenticated. It must run before the authentication interceptors and after the
+// request ID interceptor.
+func UnaryServerInterceptor(service *appAudit.Service) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		ctx = appAudit.ContextWithSource(ctx, source(ctx))
+
+		resp, err := handler(ctx, req)
+		if status.Code(err) == codes.Unauthenticated {
+			service.Report(ctx, appAudit.ActionAuthFailure, info.FullMethod, auditEntity.OutcomeFailure,
+				map[string]any{"reason": status.Convert(err).Message()})
+		}
+		return resp, err
+	}
+}
+
+// source extracts the client IP address of a gRPC request.
+func source(ctx context.Context) appAudit.Source {
+	var s appAudit.Source
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		s.IP = p.Addr.String()
+		if host, _, err := net.SplitHostPort(s.IP); err == nil {
+			s.IP = host
+		}
+	}
+	return s
+}
@@ -1,10 +1,15 @@
 package handler
 
 import (
+	"application/audit"
 	"application/auth"
+	"application/role"
 	"context"
+	auditEntity "domain/audit/entity"
 	"domain/auth/entity"
+	roleEntity "domain/role/entity"
 	authv1 "infrastructure/proto/auth/gen"
+	"slices"
 	"time"
 
 	"google.golang.org/grpc/codes"
@@ -14,16 +19,20 @@ import (
 // Service implements the gRPC AuthServiceServer.
 // It handles requests related to JWT token generation.
 type Service struct {
-	authv1.UnimplementedAuthServiceServer               // Ensures forward compatibility with the gRPC interface.
-	authService                           *auth.Service // Dependency for handling JWT token operations.
+	authv1.UnimplementedAuthServiceServer                // Ensures forward compatibility with the gRPC interface.
+	authService                           *auth.Service  // Dependency for handling JWT token operations.
+	roleService                           *role.Service  // Dependency for resolving the issuer permissions.
+	auditService                          *audit.Service // Dependency for recording issued and denied tokens.
 }
 
 // NewService creates a new instance of the gRPC Service handler.
-func NewService(authService *auth.Service) *Service {
-	return &Service{authService: authService}
+func NewService(authService *auth.Service, roleService *role.Service, auditService *audit.Service) *Service {
+	return &Service{authService: authService, roleService: roleService, auditService: auditService}
 }
 
 // GenerateToken handles gRPC requests to generate a new JWT token.
+// The issuer must be the principal proven by the client certificate or the request signature, added to the context
+// by the mTLS and HMAC interceptors of the server; requests without either proof are refused.
 func (s *Service) GenerateToken(
 	ctx context.Context,
 	req *authv1.GenerateTokenRequest,
@@ -32,22 +41,35 @@ func (s *Service) GenerateToken(
 	case <-ctx.Done():
 		return nil, status.Error(codes.Canceled, "context canceled")
 	default:
-		return s.process(req)
+		return s.process(ctx, req)
 	}
 }
 
 // process performs validation and token generation.
-func (s *Service) process(req *authv1.GenerateTokenRequest) (*authv1.GenerateTokenResponse, error) {
+func (s *Service) process(ctx context.Context, req *authv1.GenerateTokenRequest) (*authv1.GenerateTokenResponse, error) {
 	if err := s.validateRequest(req); err != nil {
 		return nil, err
 	}
+	if err := s.authenticateIssuer(ctx, req); err != nil {
+		return nil, err
+	}
+
+	scopes, err := s.resolveScopes(ctx, req)
+	if status.Code(err) == codes.PermissionDenied {
+		s.auditService.Report(ctx, audit.ActionTokenIssue, clientTarget(req.GetIssuer()), auditEntity.OutcomeDenied,
+			map[string]any{"scopes": req.GetScopes()})
+	}
+	if err != nil {
+		return nil, err
+	}
 
 	// Generate token claims.
 	claims := entity.GetTokenClaims()
 	defer claims.Release()
 
 	claims.SetIssuer(req.GetIssuer())
-	claims.SetScope(req.GetScopes())
+	claims.SetSubject(req.GetIssuer())
+	claims.SetScope(scopes)
 	claims.SetExpiresAt(time.Now().Add(5 * time.Minute).Unix())
 
 	// Generate the token.
@@ -55,16 +77,66 @@ func (s *Service) process(req *authv1.GenerateTokenRequest) (*authv1.GenerateTok
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to generate token: %v", err)
 	}
+
+	// Record the issued token on behalf of the client it was issued to.
+	details := map[string]any{"scopes": scopes, "expires_at": claims.GetExpiresAt()}
+	if err = s.auditService.Record(auth.ContextWithClaims(ctx, claims), audit.ActionTokenIssue,
+		clientTarget(req.GetIssuer()), details); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record token: %v", err)
+	}
 	return &authv1.GenerateTokenResponse{Token: token}, nil
 }
 
+// clientTarget formats the audit target of an API client.
+func clientTarget(clientId string) string {
+	return "client:" + clientId
+}
+
 // validateRequest ensures the request has all necessary fields.
 func (s *Service) validateRequest(req *authv1.GenerateTokenRequest) error {
 	if req.GetIssuer() == "" {
 		return status.Errorf(codes.InvalidArgument, "issuer (iss) must not be empty")
 	}
-	if len(req.GetScopes()) == 0 {
-		return status.Errorf(codes.InvalidArgument, "scope must not be empty")
+	return nil
+}
+
+// authenticateIssuer ensures the issuer is the principal proven by the client certificate or the request signature.
+// Claims carried by a bearer token are not accepted as proof, so tokens cannot be renewed with themselves.
+func (s *Service) authenticateIssuer(ctx context.Context, req *authv1.GenerateTokenRequest) error {
+	proven, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return status.Errorf(codes.Unauthenticated,
+			"unauthenticated: a client certificate or a request signature is required to prove the issuer")
+	}
+	if proven.GetSubject() != req.GetIssuer() {
+		s.auditService.Report(ctx, audit.ActionTokenIssue, clientTarget(req.GetIssuer()), auditEntity.OutcomeDenied,
+			map[string]any{"scopes": req.GetScopes(), "principal": proven.GetSubject()})
+		return status.Errorf(codes.PermissionDenied, "issuer %q does not match the authenticated client %q",
+			req.GetIssuer(), proven.GetSubject())
 	}
 	return nil
 }
+
+// resolveScopes returns the scopes to embed in the token.
+// The issuer is treated as an API client and its effective scopes are derived from its role assignments.
+// Requested scopes may narrow the effective scopes but never extend them.
+func (s *Service) resolveScopes(ctx context.Context, req *authv1.GenerateTokenRequest) ([]string, error) {
+	effective, err := s.roleService.EffectiveScopes(ctx, roleEntity.SubjectClient, req.GetIssuer())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to resolve permissions: %v", err)
+	}
+	if len(effective) == 0 {
+		return nil, status.Errorf(codes.PermissionDenied, "issuer %q has no roles assigned", req.GetIssuer())
+	}
+	if len(req.GetScopes()) == 0 {
+		return effective, nil
+	}
+
+	for _, scope := range req.GetScopes() {
+		if !slices.Contains(effective, scope) {
+			return nil, status.Errorf(codes.PermissionDenied, "scope %q is not granted to issuer %q", scope,
+				req.GetIssuer())
+		}
+	}
+	return req.GetScopes(), nil
+}
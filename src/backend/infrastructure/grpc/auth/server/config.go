@@ -1,27 +1,30 @@
 package server
 
 import (
+	"errors"
+	"infrastructure/certs"
+	"infrastructure/grpc/mtls"
+
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials"
 )
 
 // Config holds the server configuration settings.
 type Config struct {
-	TLSEnabled bool   // Whether TLS is enabled
-	CertFile   string // Path to the TLS certificate file
-	KeyFile    string // Path to the TLS key file
-	Port       string // Port the server listens on
+	TLSEnabled   bool                // Whether TLS is enabled
+	Certificates *certs.Manager      // Manager serving the TLS certificate and the client CA bundle, if any
+	Port         string              // Port the server listens on
+	Interceptors []grpc.ServerOption // Interceptors and other gRPC server options
 }
 
 // Option defines a functional option for configuring the server.
 type Option func(*Config)
 
-// WithTLS enables TLS for the gRPC server and sets the certificate and key files.
-func WithTLS(certFile, keyFile string) Option {
+// WithTLS enables TLS for the gRPC server, serving the certificates of the manager. The manager also holds the
+// client CA bundle enabling mutual TLS and the minimum TLS version.
+func WithTLS(certificates *certs.Manager) Option {
 	return func(c *Config) {
 		c.TLSEnabled = true
-		c.CertFile = certFile
-		c.KeyFile = keyFile
+		c.Certificates = certificates
 	}
 }
 
@@ -32,10 +35,18 @@ func WithPort(port string) Option {
 	}
 }
 
+// WithInterceptors adds interceptors and other options for the gRPC server.
+func WithInterceptors(interceptors ...grpc.ServerOption) Option {
+	return func(c *Config) {
+		c.Interceptors = append(c.Interceptors, interceptors...)
+	}
+}
+
 // NewGRPCServer initializes a gRPC server with the provided options.
 func NewGRPCServer(opts ...Option) (*grpc.Server, *Config, error) {
 	config := &Config{
-		TLSEnabled: false,
+		TLSEnabled:   false,
+		Interceptors: []grpc.ServerOption{}, // Default to no interceptors
 	}
 
 	// Apply options to configure the server
@@ -43,17 +54,20 @@ func NewGRPCServer(opts ...Option) (*grpc.Server, *Config, error) {
 		opt(config)
 	}
 
-	// Create gRPC server with or without TLS
-	var grpcServer *grpc.Server
+	// gRPC server options
+	var serverOpts []grpc.ServerOption
+
+	// Add TLS credentials if enabled
 	if config.TLSEnabled {
-		cred, err := credentials.NewServerTLSFromFile(config.CertFile, config.KeyFile)
-		if err != nil {
-			return nil, nil, err
+		if config.Certificates == nil {
+			return nil, nil, errors.New("TLS enabled without a certificate")
 		}
-		grpcServer = grpc.NewServer(grpc.Creds(cred))
-	} else {
-		grpcServer = grpc.NewServer()
+		serverOpts = append(serverOpts, grpc.Creds(mtls.Credentials(config.Certificates)))
 	}
 
+	// Add interceptors if present
+	serverOpts = append(serverOpts, config.Interceptors...)
+
+	grpcServer := grpc.NewServer(serverOpts...)
 	return grpcServer, config, nil
 }
@@ -1,16 +1,28 @@
 package server
 
 import (
+	"application/audit"
+	"application/certauth"
+	"application/metrics"
+	"application/ratelimit"
+	"application/signing"
+	"context"
 	"errors"
 	"fmt"
+	"infrastructure/certs"
+	auditInterceptor "infrastructure/grpc/audit"
+	"infrastructure/grpc/authn"
+	"infrastructure/grpc/health"
+	grpcMetrics "infrastructure/grpc/metrics"
+	grpcRateLimit "infrastructure/grpc/ratelimit"
+	"infrastructure/grpc/requestid"
+	"infrastructure/grpc/tracing"
 	authv1 "infrastructure/proto/auth/gen"
 	"log"
 	"net"
-	"os"
-	"os/signal"
-	"syscall"
 
 	"google.golang.org/grpc"
+	healthv1 "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 // AuthServer is a high-level wrapper for the gRPC AuthService server.
@@ -23,24 +35,44 @@ type AuthServer struct {
 }
 
 // NewAuthServer creates a new instance of AuthServer based on the provided configuration.
-func NewAuthServer(env, port, certFile, keyFile string) (*AuthServer, error) {
+// In production, TLS is served with the certificates of the shared manager, whose client CA bundle enables mutual TLS.
+// Token requests are authenticated by the client certificate, mapped to a principal by the mapper, or by the request
+// signature, verified by the verifier, and rate limited by client.
+func NewAuthServer(
+	env, port string,
+	certificates *certs.Manager,
+	verifier *signing.Verifier,
+	mapper *certauth.Mapper,
+	auditService *audit.Service,
+	limiter *ratelimit.Limiter,
+	registry *metrics.Registry,
+) (*AuthServer, error) {
 	var (
 		grpcServer   *grpc.Server
 		serverConfig *Config
 		listener     net.Listener
 		err          error
 	)
+	serverInterceptors := grpc.ChainUnaryInterceptor(
+		grpcMetrics.UnaryServerInterceptor(registry),
+		requestid.UnaryServerInterceptor(),
+		tracing.UnaryServerInterceptor(),
+		auditInterceptor.UnaryServerInterceptor(auditService),
+		health.Bypass(authn.MtlsInterceptor(mapper)),
+		health.Bypass(authn.HmacInterceptor(verifier)),
+		health.Bypass(grpcRateLimit.UnaryServerInterceptor(limiter)))
 
 	switch env {
 	case "prod":
 		// Enable TLS in production
 		grpcServer, serverConfig, err = NewGRPCServer(
-			WithTLS(certFile, keyFile),
+			WithTLS(certificates),
 			WithPort(port),
-		)
+			WithInterceptors(serverInterceptors))
 	case "dev":
 		grpcServer, serverConfig, err = NewGRPCServer(
-			WithPort(port))
+			WithPort(port),
+			WithInterceptors(serverInterceptors))
 	default:
 		return nil, errors.New("unsupported environment; must be \"prod\" or \"dev\"")
 	}
@@ -68,24 +100,36 @@ func (s *AuthServer) RegisterService(service authv1.AuthServiceServer) {
 	authv1.RegisterAuthServiceServer(s.grpcServer, service)
 }
 
-// Start starts the gRPC server and begins listening for incoming requests.
-func (s *AuthServer) Start() {
-	log.Printf("Starting the Auth gRPC server on %s (env: %s)...", s.listener.Addr(), s.env)
-	go func() {
-		if err := s.grpcServer.Serve(s.listener); err != nil {
-			log.Printf("gRPC server failed to serve: %v", err)
-		}
-	}()
+// RegisterHealthService registers the grpc.health.v1 Health implementation with the gRPC server.
+func (s *AuthServer) RegisterHealthService(service healthv1.HealthServer) {
+	healthv1.RegisterHealthServer(s.grpcServer, service)
 }
 
-// WaitForShutdown gracefully shuts down the server upon receiving termination signals.
-func (s *AuthServer) WaitForShutdown() {
-	signalChan := make(chan os.Signal, 1)
-	signal.Notify(signalChan, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
+// Serve accepts incoming requests until the server is shut down. It returns nil once the server was stopped.
+func (s *AuthServer) Serve() error {
+	log.Printf("Starting the Auth gRPC server on %s (env: %s)...", s.listener.Addr(), s.env)
+	if err := s.grpcServer.Serve(s.listener); err != nil {
+		return fmt.Errorf("gRPC server failed to serve: %w", err)
+	}
+	return nil
+}
 
-	sig := <-signalChan
-	log.Printf("Received signal: %s. Initiating shutdown...", sig)
+// Shutdown stops accepting requests and waits for the pending ones to complete. If the context expires first, the
+// remaining requests are canceled and the connections closed at once.
+func (s *AuthServer) Shutdown(ctx context.Context) error {
+	stopped := make(chan struct{})
+	go func() {
+		s.grpcServer.GracefulStop()
+		close(stopped)
+	}()
 
-	s.grpcServer.GracefulStop()
-	log.Println("Auth gRPC server stopped gracefully.")
+	select {
+	case <-stopped:
+		log.Println("Auth gRPC server stopped gracefully.")
+		return nil
+	case <-ctx.Done():
+		s.grpcServer.Stop()
+		log.Println("Auth gRPC server stopped forcibly.")
+		return ctx.Err()
+	}
 }
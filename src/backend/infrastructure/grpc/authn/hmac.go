@@ -0,0 +1,132 @@
+package authn
+
+import (
+	"application/auth"
+	"application/signing"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// HmacInterceptor verifies the signature of requests carrying the signing metadata and adds the claims
+// of the signing client to the context. Requests without a signature are passed through unchanged, leaving
+// authentication to the interceptors after it, such as the bearer token interceptor of the vacancy server, or to
+// the handler.
+func HmacInterceptor(verifier *signing.Verifier) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok || len(md.Get(strings.ToLower(signing.HeaderSignature))) == 0 {
+			return handler(ctx, req)
+		}
+
+		// Verify the signature over the deterministic encoding of the request message.
+		body, err := marshalRequest(req)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "encode request: %v", err)
+		}
+		claims, err := verifier.Verify(ctx, signedRequest(md, info.FullMethod), body)
+		if err != nil {
+			return nil, signatureError(err)
+		}
+
+		// Add the claims to the context for downstream handlers.
+		return handler(auth.ContextWithClaims(ctx, claims), req)
+	}
+}
+
+// HmacClientInterceptor signs every outgoing request with the given signing key.
+// It is used by API clients, such as the scraper bot, in place of bearer tokens.
+func HmacClientInterceptor(keyId, secret string) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		body, err := marshalRequest(req)
+		if err != nil {
+			return err
+		}
+		nonce := make([]byte, 16)
+		if _, err = rand.Read(nonce); err != nil {
+			return err
+		}
+
+		r := &signing.SignedRequest{
+			KeyId:     keyId,
+			Method:    http.MethodPost,
+			Path:      method,
+			Timestamp: strconv.FormatInt(time.Now().Unix(), 10),
+			Nonce:     hex.EncodeToString(nonce),
+			Digest:    signing.Digest(body),
+		}
+		ctx = metadata.AppendToOutgoingContext(ctx,
+			strings.ToLower(signing.HeaderKeyId), r.KeyId,
+			strings.ToLower(signing.HeaderTimestamp), r.Timestamp,
+			strings.ToLower(signing.HeaderNonce), r.Nonce,
+			strings.ToLower(signing.HeaderDigest), r.Digest,
+			strings.ToLower(signing.HeaderSignature), signing.Sign(secret, r),
+		)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// marshalRequest returns the deterministic protobuf encoding of a request message, covered by its signature.
+func marshalRequest(req interface{}) ([]byte, error) {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil, errors.New("request is not a protobuf message")
+	}
+	return proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+}
+
+// signedRequest extracts the signed parts of a gRPC request from its metadata.
+func signedRequest(md metadata.MD, fullMethod string) *signing.SignedRequest {
+	first := func(key string) string {
+		if values := md.Get(strings.ToLower(key)); len(values) > 0 {
+			return values[0]
+		}
+		return ""
+	}
+	return &signing.SignedRequest{
+		KeyId:     first(signing.HeaderKeyId),
+		Method:    http.MethodPost,
+		Path:      fullMethod,
+		Timestamp: first(signing.HeaderTimestamp),
+		Nonce:     first(signing.HeaderNonce),
+		Digest:    first(signing.HeaderDigest),
+		Signature: first(signing.HeaderSignature),
+	}
+}
+
+// signatureError maps signature verification failures to Unauthenticated and other errors to Internal.
+func signatureError(err error) error {
+	switch {
+	case errors.Is(err, signing.ErrMissingSignature),
+		errors.Is(err, signing.ErrExpiredSignature),
+		errors.Is(err, signing.ErrDigestMismatch),
+		errors.Is(err, signing.ErrInvalidSignature),
+		errors.Is(err, signing.ErrReplayedNonce):
+		return status.Errorf(codes.Unauthenticated, "unauthenticated: %v", err)
+	default:
+		return status.Errorf(codes.Internal, "verify signature: %v", err)
+	}
+}
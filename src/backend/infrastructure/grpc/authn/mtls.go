@@ -0,0 +1,41 @@
+package authn
+
+import (
+	"application/auth"
+	"application/certauth"
+	"context"
+	"errors"
+	"infrastructure/grpc/mtls"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MtlsInterceptor authenticates requests by the verified client certificate of the connection and adds
+// the claims of the mapped principal to the context. Requests without a client certificate, or with one whose
+// identity is not mapped, are passed through to the interceptors after it, such as HmacInterceptor.
+func MtlsInterceptor(mapper *certauth.Mapper) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		cert, ok := mtls.PeerCertificate(ctx)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		claims, err := mapper.Claims(ctx, cert)
+		if errors.Is(err, certauth.ErrUnknownIdentity) {
+			return handler(ctx, req)
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "resolve certificate principal: %v", err)
+		}
+
+		// Add the claims to the context for downstream handlers.
+		return handler(auth.ContextWithClaims(ctx, claims), req)
+	}
+}
@@ -0,0 +1,97 @@
+package health
+
+import (
+	"application/healthcheck"
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthv1 "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// methodPrefix is the prefix of the full method names of the health service.
+var methodPrefix = "/" + healthv1.Health_ServiceDesc.ServiceName + "/"
+
+// Server implements the standard grpc.health.v1 protocol, reporting a service as serving while the readiness
+// checks of the health check service pass.
+type Server struct {
+	healthv1.UnimplementedHealthServer
+	service  *healthcheck.Service // Health check service running the readiness checks.
+	services map[string]bool      // Names of the services of the server; the empty name stands for the server.
+	interval time.Duration        // Interval between the checks of a watched service.
+}
+
+// NewServer creates a Server reporting the health of the named services, e.g., "vacancy.v1.VacancyService".
+// Watched services are checked at the given interval, which should match the cache duration of the checks.
+func NewServer(service *healthcheck.Service, interval time.Duration, services ...string) *Server {
+	names := map[string]bool{"": true}
+	for _, name := range services {
+		names[name] = true
+	}
+	return &Server{service: service, services: names, interval: interval}
+}
+
+// Check returns the serving status of the requested service, or a NOT_FOUND error if the service is unknown.
+func (s *Server) Check(ctx context.Context, req *healthv1.HealthCheckRequest) (*healthv1.HealthCheckResponse, error) {
+	if !s.services[req.GetService()] {
+		return nil, status.Errorf(codes.NotFound, "unknown service %q", req.GetService())
+	}
+	return &healthv1.HealthCheckResponse{Status: s.status(ctx)}, nil
+}
+
+// Watch sends the serving status of the requested service at once and then whenever it changes, until the client
+// cancels the stream. Unknown services are reported as SERVICE_UNKNOWN, as they may be registered later.
+func (s *Server) Watch(
+	req *healthv1.HealthCheckRequest,
+	stream grpc.ServerStreamingServer[healthv1.HealthCheckResponse],
+) error {
+	ctx := stream.Context()
+	if !s.services[req.GetService()] {
+		return stream.Send(&healthv1.HealthCheckResponse{Status: healthv1.HealthCheckResponse_SERVICE_UNKNOWN})
+	}
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	last := healthv1.HealthCheckResponse_UNKNOWN
+	for {
+		if current := s.status(ctx); current != last {
+			if err := stream.Send(&healthv1.HealthCheckResponse{Status: current}); err != nil {
+				return err
+			}
+			last = current
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// status returns the serving status according to the readiness checks.
+func (s *Server) status(ctx context.Context) healthv1.HealthCheckResponse_ServingStatus {
+	if s.service.Ready(ctx).IsAvailable() {
+		return healthv1.HealthCheckResponse_SERVING
+	}
+	return healthv1.HealthCheckResponse_NOT_SERVING
+}
+
+// Bypass wraps an authentication or authorization interceptor so requests to the health service skip it, letting
+// load balancers and orchestrators probe the server without credentials.
+func Bypass(interceptor grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if strings.HasPrefix(info.FullMethod, methodPrefix) {
+			return handler(ctx, req)
+		}
+		return interceptor(ctx, req, info, handler)
+	}
+}
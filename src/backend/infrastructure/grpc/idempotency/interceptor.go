@@ -0,0 +1,141 @@
+package idempotency
+
+import (
+	"application/auth"
+	"application/idempotency"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Metadata keys of idempotent requests, the lowercase idempotency headers.
+var (
+	metadataKey      = strings.ToLower(idempotency.HeaderKey)
+	metadataReplayed = strings.ToLower(idempotency.HeaderReplayed)
+)
+
+// transientCodes lists the status codes of the errors that are not kept, so the request may be retried.
+var transientCodes = map[codes.Code]bool{
+	codes.Canceled:          true,
+	codes.Unknown:           true,
+	codes.DeadlineExceeded:  true,
+	codes.ResourceExhausted: true,
+	codes.Aborted:           true,
+	codes.Internal:          true,
+	codes.Unavailable:       true,
+	codes.DataLoss:          true,
+}
+
+// UnaryServerInterceptor processes a request to one of the given methods sent with an idempotency-key in its
+// metadata once per key, method and client: its retries get the original response or error, with
+// idempotent-replayed set in the response header metadata. Reusing a key with another request fails with
+// InvalidArgument, a retry sent while the request is in progress with Aborted. Transient errors are not kept, so
+// the request may be retried. The methods map to a function creating an empty response message, into which the
+// kept responses are decoded. It must run after the authentication interceptors, as keys are scoped to the subject
+// of the client.
+func UnaryServerInterceptor(
+	service *idempotency.Service,
+	methods map[string]func() proto.Message,
+) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		newResponse, ok := methods[info.FullMethod]
+		key := metadata.ValueFromIncomingContext(ctx, metadataKey)
+		if !ok || len(key) == 0 {
+			return handler(ctx, req)
+		}
+		if !idempotency.ValidKey(key[0]) {
+			return nil, status.Errorf(codes.InvalidArgument, "%s must have 1 to %d printable ASCII characters",
+				metadataKey, idempotency.MaxKeyLength)
+		}
+		payload, err := proto.MarshalOptions{Deterministic: true}.Marshal(req.(proto.Message))
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to encode the request")
+		}
+
+		var subject string
+		if claims, ok := auth.ClaimsFromContext(ctx); ok {
+			subject = claims.GetSubject()
+		}
+		scoped := idempotency.Key(info.FullMethod, subject, key[0])
+		lock, stored, err := service.Begin(ctx, scoped, idempotency.Fingerprint(payload))
+		switch {
+		case errors.Is(err, idempotency.ErrKeyReused):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, idempotency.ErrInProgress):
+			return nil, status.Error(codes.Aborted, err.Error())
+		case err != nil:
+			slog.ErrorContext(ctx, "Failed to begin idempotent request", "error", err)
+			return nil, status.Error(codes.Internal, "failed to check the idempotency key")
+		case stored != nil:
+			return replay(ctx, stored, newResponse())
+		}
+		return process(ctx, req, handler, service, lock)
+	}
+}
+
+// process processes the request holding the lock, keeping its response unless it is a transient error, in which case
+// the request is released for retries. The response is kept even if the client is gone, as it is then most likely
+// to retry.
+func process(
+	ctx context.Context,
+	req interface{},
+	handler grpc.UnaryHandler,
+	service *idempotency.Service,
+	lock idempotency.Lock,
+) (interface{}, error) {
+	detached := context.WithoutCancel(ctx)
+	resp, err := handler(ctx, req)
+
+	s := status.Convert(err)
+	response := idempotency.Response{Status: int(s.Code())}
+	var keepErr error
+	if err == nil {
+		response.Body, keepErr = proto.Marshal(resp.(proto.Message))
+	} else {
+		response.Body, keepErr = proto.Marshal(s.Proto())
+	}
+	if keepErr == nil && !transientCodes[s.Code()] {
+		keepErr = service.Complete(detached, lock, response)
+		if keepErr == nil {
+			return resp, err
+		}
+	}
+
+	if keepErr != nil {
+		slog.ErrorContext(ctx, "Failed to keep idempotent response", "error", keepErr)
+	}
+	if releaseErr := service.Release(detached, lock); releaseErr != nil {
+		slog.ErrorContext(ctx, "Failed to release idempotent request", "error", releaseErr)
+	}
+	return resp, err
+}
+
+// replay returns the kept response or error of the original request, decoding a response into the given message.
+// Errors are kept as their status, so their details are replayed too.
+func replay(ctx context.Context, stored *idempotency.Response, message proto.Message) (interface{}, error) {
+	// The header is merely informative, so a failure to send it must not fail the request
+	_ = grpc.SetHeader(ctx, metadata.Pairs(metadataReplayed, "true"))
+	if codes.Code(stored.Status) != codes.OK {
+		message = &spb.Status{}
+	}
+	if err := proto.Unmarshal(stored.Body, message); err != nil {
+		return nil, status.Error(codes.Internal, "failed to decode the kept response")
+	}
+	if s, ok := message.(*spb.Status); ok {
+		return nil, status.ErrorProto(s)
+	}
+	return message, nil
+}
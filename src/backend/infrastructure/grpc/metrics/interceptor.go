@@ -0,0 +1,33 @@
+package metrics
+
+import (
+	"application/metrics"
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor counts the handled requests by method and status code and observes their latency
+// in the given registry. It should run first, so rejected requests are counted as well.
+func UnaryServerInterceptor(r *metrics.Registry) grpc.UnaryServerInterceptor {
+	handled := r.NewCounterVec("grpc_server_handled_total",
+		"Number of gRPC requests handled by method and status code.", "method", "code")
+	latency := r.NewHistogramVec("grpc_server_handling_seconds",
+		"Latency of gRPC requests by method.", metrics.DefaultBuckets, "method")
+
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		handled.With(info.FullMethod, status.Code(err).String()).Inc()
+		latency.With(info.FullMethod).Observe(time.Since(start).Seconds())
+		return resp, err
+	}
+}
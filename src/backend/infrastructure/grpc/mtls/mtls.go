@@ -0,0 +1,46 @@
+package mtls
+
+import (
+	"context"
+	"crypto/x509"
+	"infrastructure/certs"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// Client certificate verification modes.
+const (
+	ModeRequire       = certs.ModeRequire       // Clients must present a certificate signed by the client CA.
+	ModeVerifyIfGiven = certs.ModeVerifyIfGiven // Certificates are verified when presented; other clients may connect.
+)
+
+// ServerCredentials creates the TLS transport credentials of a gRPC server. When a client CA bundle is given,
+// client certificates are verified against it according to the mode. The files are loaded once; servers reloading
+// their certificate use Credentials with a shared certs.Manager instead.
+func ServerCredentials(certFile, keyFile, clientCAFile, mode string) (credentials.TransportCredentials, error) {
+	manager, err := certs.NewManager(certFile, keyFile, certs.WithClientCA(clientCAFile, mode))
+	if err != nil {
+		return nil, err
+	}
+	return Credentials(manager), nil
+}
+
+// Credentials creates the TLS transport credentials of a gRPC server serving the certificates of the manager,
+// so new connections use the certificate and client CA bundle loaded last.
+func Credentials(manager *certs.Manager) credentials.TransportCredentials {
+	return credentials.NewTLS(manager.ServerConfig())
+}
+
+// PeerCertificate returns the verified client certificate of the connection, if the client presented one.
+func PeerCertificate(ctx context.Context) (*x509.Certificate, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, false
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return nil, false
+	}
+	return info.State.VerifiedChains[0][0], true
+}
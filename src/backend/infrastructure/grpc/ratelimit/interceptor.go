@@ -0,0 +1,60 @@
+package ratelimit
+
+import (
+	"application/auth"
+	"application/ratelimit"
+	"context"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor limits the requests of each client to the policy of the method (e.g.,
+// "/vacancy.v1.VacancyService/CreateVacancy"). It must run after the authentication interceptors, so clients are
+// identified by the subject of their token or signing key rather than by their IP address. The RateLimit headers
+// are returned in the lowercase response header metadata; rejected requests fail with ResourceExhausted.
+func UnaryServerInterceptor(limiter *ratelimit.Limiter) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		var subject string
+		if claims, ok := auth.ClaimsFromContext(ctx); ok {
+			subject = claims.GetSubject()
+		}
+
+		d, limited := limiter.Allow(ctx, info.FullMethod, ratelimit.ClientKey(subject, peerIp(ctx)))
+		if limited {
+			md := metadata.MD{}
+			for name, value := range d.Headers() {
+				md.Set(strings.ToLower(name), value)
+			}
+			// The headers are merely informative, so a failure to send them must not fail the request
+			_ = grpc.SetHeader(ctx, md)
+		}
+		if !d.Allowed {
+			return nil, status.Errorf(codes.ResourceExhausted, "rate limit exceeded, retry after %s seconds",
+				d.Headers()[ratelimit.HeaderRetryAfter])
+		}
+		return handler(ctx, req)
+	}
+}
+
+// peerIp extracts the client IP address of a gRPC request.
+func peerIp(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+		return host
+	}
+	return p.Addr.String()
+}
@@ -0,0 +1,55 @@
+package requestid
+
+import (
+	"application/requestid"
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// metadataKey is the gRPC metadata key carrying the request ID.
+var metadataKey = strings.ToLower(requestid.Header)
+
+// UnaryServerInterceptor assigns every request an ID, stores it in the context and returns it in the response
+// header metadata. A valid ID sent by the client in the x-request-id metadata is kept; otherwise a new one is
+// generated. It must run before the other interceptors, so their logs and audit records carry the ID.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		_ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		var id string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(metadataKey); len(values) > 0 {
+				id = values[0]
+			}
+		}
+		id = requestid.Resolve(id)
+
+		// The header is merely informative, so a failure to send it must not fail the request
+		_ = grpc.SetHeader(ctx, metadata.Pairs(metadataKey, id))
+		return handler(requestid.ContextWithId(ctx, id), req)
+	}
+}
+
+// UnaryClientInterceptor propagates the request ID of the context, if any, in the outgoing x-request-id metadata,
+// so outbound calls made while handling a request carry its ID.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if id := requestid.FromContext(ctx); id != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, metadataKey, id)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,98 @@
+package stream
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FromUnary adapts a unary interceptor to server streaming methods, so streams are authenticated, authorized,
+// limited and observed by the same interceptors as unary methods. The interceptor is given the request message once
+// received, and its handler runs the whole stream: it sees the context of the stream, the error the stream ends
+// with, and its duration. Streams whose client sends several messages are rejected, as the interceptor could only
+// inspect the first one. A panic of the handler ends the interceptor with an Internal error before going on, and a
+// panic of the interceptor fails the stream with an Internal error.
+func FromUnary(interceptor grpc.UnaryServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if info.IsClientStream {
+			return status.Errorf(codes.Unimplemented, "client streaming method %s is not supported", info.FullMethod)
+		}
+		s := &interceptedStream{
+			ServerStream: ss,
+			ctx:          ss.Context(),
+			interceptor:  interceptor,
+			info:         &grpc.UnaryServerInfo{Server: srv, FullMethod: info.FullMethod},
+		}
+		defer func() {
+			if p := recover(); p != nil {
+				// End the interceptor, releasing what it holds for the stream, before the panic goes on
+				_ = s.finish(status.Errorf(codes.Internal, "panic in stream handler: %v", p))
+				panic(p)
+			}
+		}()
+		return s.finish(handler(srv, s))
+	}
+}
+
+// interceptedStream runs the interceptor around a stream once its request is received. The interceptor runs in a
+// goroutine whose handler waits for the stream to end, so it wraps the stream as it would wrap a unary call.
+type interceptedStream struct {
+	grpc.ServerStream
+	ctx         context.Context // Context of the stream, as passed on by the interceptor.
+	interceptor grpc.UnaryServerInterceptor
+	info        *grpc.UnaryServerInfo
+	started     bool       // Whether the interceptor called its handler, which waits for the stream to end.
+	ended       chan error // Error the stream ended with, returned by the handler of the interceptor.
+	result      chan error // Error returned by the interceptor.
+}
+
+// Context returns the context of the stream, as passed on by the interceptor once the request was received.
+func (s *interceptedStream) Context() context.Context {
+	return s.ctx
+}
+
+// RecvMsg receives the request message and runs the interceptor with it. It returns the error of the interceptor
+// if it rejects the request.
+func (s *interceptedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil || s.result != nil {
+		return err
+	}
+
+	started := make(chan context.Context, 1)
+	s.ended, s.result = make(chan error, 1), make(chan error, 1)
+	go func() {
+		defer func() {
+			if p := recover(); p != nil {
+				s.result <- status.Errorf(codes.Internal, "panic in stream interceptor: %v", p)
+			}
+		}()
+		_, err := s.interceptor(s.ctx, m, s.info, func(ctx context.Context, _ interface{}) (interface{}, error) {
+			started <- ctx
+			return nil, <-s.ended
+		})
+		s.result <- err
+	}()
+
+	select {
+	case ctx := <-started:
+		s.ctx, s.started = ctx, true
+		return nil
+	case err := <-s.result:
+		if err == nil {
+			err = status.Error(codes.Internal, "the request was answered without running the stream")
+		}
+		return err
+	}
+}
+
+// finish ends the interceptor with the error the stream ended with and returns the error of the interceptor, which
+// may replace it.
+func (s *interceptedStream) finish(err error) error {
+	if !s.started {
+		return err
+	}
+	s.ended <- err
+	return <-s.result
+}
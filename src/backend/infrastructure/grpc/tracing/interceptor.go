@@ -0,0 +1,69 @@
+package tracing
+
+import (
+	"application/tracing"
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor starts a server span for every request, continuing the trace of the traceparent metadata
+// sent by the client, if any. It should run right after the request ID interceptor, so the span covers the other
+// interceptors and log records of the request carry the trace.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(tracing.Header); len(values) > 0 {
+				if remote, ok := tracing.ParseTraceparent(values[0]); ok {
+					ctx = tracing.ContextWithRemote(ctx, remote)
+				}
+			}
+		}
+
+		ctx, span := tracing.Start(ctx, info.FullMethod, tracing.KindServer)
+		defer span.End()
+		span.SetAttribute("rpc.system", "grpc")
+		span.SetAttribute("rpc.method", info.FullMethod)
+
+		resp, err := handler(ctx, req)
+		code := status.Code(err)
+		span.SetAttribute("rpc.grpc.status_code", int(code))
+		if code != codes.OK {
+			span.RecordError(err)
+		}
+		return resp, err
+	}
+}
+
+// UnaryClientInterceptor starts a client span for every outbound call and propagates it in the traceparent
+// metadata, so the server continues the trace of the caller.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		ctx, span := tracing.Start(ctx, method, tracing.KindClient)
+		defer span.End()
+		span.SetAttribute("rpc.system", "grpc")
+		span.SetAttribute("rpc.method", method)
+
+		ctx = metadata.AppendToOutgoingContext(ctx, tracing.Header, span.SpanContext().Traceparent())
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if err != nil {
+			span.RecordError(err)
+		}
+		return err
+	}
+}
@@ -0,0 +1,231 @@
+package handler
+
+import (
+	"application/organization"
+	"context"
+	"domain/organization/repository"
+	"domain/vacancy/entity"
+	vacancyRepository "domain/vacancy/repository"
+	"errors"
+	vacancyv1 "infrastructure/proto/vacancy/gen"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BatchCreateVacancies handles the gRPC request to create job vacancies in a batch.
+func (s *VacancyService) BatchCreateVacancies(
+	ctx context.Context,
+	req *vacancyv1.BatchCreateVacanciesRequest,
+) (*vacancyv1.BatchVacanciesResponse, error) {
+	if err := s.validator.ValidateBatch(len(req.GetItems())); err != nil {
+		return nil, err
+	}
+	response := newBatchResponse(req.GetMode(), len(req.GetItems()))
+
+	var pending []int
+	var list []*entity.Vacancy
+	defer func() {
+		for _, v := range list {
+			v.Release()
+		}
+	}()
+	for i, item := range req.GetItems() {
+		if problems := s.validator.CreateVacancyProblems(item); len(problems) > 0 {
+			setItem(response, i, codes.InvalidArgument, 0, problems...)
+			continue
+		}
+		pending = append(pending, i)
+		list = append(list, s.toEntity(item))
+	}
+
+	return s.applyBatch(ctx, response, pending, func(k int) int64 { return list[k].GetId() },
+		func(atomic bool) ([]error, error) {
+			return s.service.CreateVacancies(ctx, list, atomic)
+		})
+}
+
+// BatchUpdateVacancies handles the gRPC request to update the given fields of job vacancies in a batch.
+func (s *VacancyService) BatchUpdateVacancies(
+	ctx context.Context,
+	req *vacancyv1.BatchUpdateVacanciesRequest,
+) (*vacancyv1.BatchVacanciesResponse, error) {
+	if err := s.validator.ValidateBatch(len(req.GetItems())); err != nil {
+		return nil, err
+	}
+	response := newBatchResponse(req.GetMode(), len(req.GetItems()))
+
+	var valid []int
+	var ids []int64
+	for i, item := range req.GetItems() {
+		if problems := s.validator.UpdateVacancyProblems(item); len(problems) > 0 {
+			setItem(response, i, codes.InvalidArgument, item.GetId(), problems...)
+			continue
+		}
+		valid = append(valid, i)
+		ids = append(ids, item.GetId())
+	}
+
+	// Apply the items to the stored vacancies
+	stored, err := s.service.GetVacancies(ctx, ids)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "update vacancies: %v", err)
+	}
+	byId := make(map[int64]*entity.Vacancy, len(stored))
+	for _, v := range stored {
+		byId[v.GetId()] = v
+	}
+	var pending []int
+	var list []*entity.Vacancy
+	for _, i := range valid {
+		item := req.GetItems()[i]
+		v, ok := byId[item.GetId()]
+		if !ok {
+			setItem(response, i, codes.NotFound, item.GetId(), vacancyRepository.ErrVacancyNotFound.Error())
+			continue
+		}
+		s.applyUpdate(item, v)
+		pending = append(pending, i)
+		list = append(list, v)
+	}
+
+	return s.applyBatch(ctx, response, pending, func(k int) int64 { return list[k].GetId() },
+		func(atomic bool) ([]error, error) {
+			return s.service.UpdateVacancies(ctx, list, atomic)
+		})
+}
+
+// BatchDeleteVacancies handles the gRPC request to delete job vacancies in a batch.
+func (s *VacancyService) BatchDeleteVacancies(
+	ctx context.Context,
+	req *vacancyv1.BatchDeleteVacanciesRequest,
+) (*vacancyv1.BatchVacanciesResponse, error) {
+	if err := s.validator.ValidateBatch(len(req.GetIds())); err != nil {
+		return nil, err
+	}
+	response := newBatchResponse(req.GetMode(), len(req.GetIds()))
+
+	var pending []int
+	var ids []int64
+	for i, id := range req.GetIds() {
+		if id <= 0 {
+			setItem(response, i, codes.InvalidArgument, id, "id must be positive")
+			continue
+		}
+		pending = append(pending, i)
+		ids = append(ids, id)
+	}
+
+	return s.applyBatch(ctx, response, pending, func(k int) int64 { return ids[k] },
+		func(atomic bool) ([]error, error) {
+			return s.service.DeleteVacancies(ctx, ids, atomic)
+		})
+}
+
+// applyBatch applies the pending items, at the given indexes of the request, with the operation and returns the
+// result of every item. The ID of an applied item is returned by the id function, given its index among the
+// pending items. In atomic mode, nothing is applied if any item already failed validation.
+func (s *VacancyService) applyBatch(
+	ctx context.Context,
+	response *vacancyv1.BatchVacanciesResponse,
+	pending []int,
+	id func(k int) int64,
+	operation func(atomic bool) ([]error, error),
+) (*vacancyv1.BatchVacanciesResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, status.Error(codes.Canceled, "request canceled")
+	}
+
+	atomic := response.Mode != vacancyv1.BatchMode_BATCH_MODE_BEST_EFFORT
+	errs := make([]error, len(pending))
+	switch {
+	case atomic && len(pending) < len(response.Items):
+		for k := range errs {
+			errs[k] = vacancyRepository.ErrBatchAborted
+		}
+	case len(pending) > 0:
+		var err error
+		if errs, err = operation(atomic); errs == nil {
+			return nil, status.Errorf(codes.Internal, "apply batch: %v", err)
+		}
+	}
+
+	for k, i := range pending {
+		setItem(response, i, batchCode(errs[k]), id(k), errorMessages(errs[k])...)
+	}
+	for _, item := range response.Items {
+		if codes.Code(item.Code) == codes.OK {
+			response.Succeeded++
+		} else {
+			response.Failed++
+		}
+	}
+	return response, nil
+}
+
+// applyUpdate applies the fields set in the UpdateVacancyRequest to the stored vacancy.
+func (s *VacancyService) applyUpdate(req *vacancyv1.UpdateVacancyRequest, v *entity.Vacancy) {
+	if req.Title != nil {
+		v.SetTitle(req.GetTitle())
+	}
+	if req.Company != nil {
+		v.SetCompany(req.GetCompany())
+	}
+	if req.Description != nil {
+		v.SetDescription(req.GetDescription())
+	}
+	if req.Location != nil {
+		v.SetLocation(req.GetLocation())
+	}
+	if postedAt, err := time.Parse(s.dateFormat, req.GetPostedAt()); req.PostedAt != nil && err == nil {
+		v.SetPostedAt(postedAt)
+	}
+}
+
+// newBatchResponse creates the response of a batch of n items applied in the given mode, atomic if unspecified.
+func newBatchResponse(mode vacancyv1.BatchMode, n int) *vacancyv1.BatchVacanciesResponse {
+	if mode == vacancyv1.BatchMode_BATCH_MODE_UNSPECIFIED {
+		mode = vacancyv1.BatchMode_BATCH_MODE_ATOMIC
+	}
+	response := &vacancyv1.BatchVacanciesResponse{Mode: mode, Items: make([]*vacancyv1.BatchItemResult, n)}
+	for i := range response.Items {
+		response.Items[i] = &vacancyv1.BatchItemResult{Index: int32(i)} // #nosec G115 -- bounded by the batch size
+	}
+	return response
+}
+
+// setItem records the result of the item at the given index.
+func setItem(response *vacancyv1.BatchVacanciesResponse, index int, code codes.Code, id int64, problems ...string) {
+	item := response.Items[index]
+	item.Code = int32(code) // #nosec G115 -- gRPC codes are small
+	item.Id = id
+	item.Errors = problems
+}
+
+// batchCode maps the error of an item to the gRPC status code it would get if requested alone.
+func batchCode(err error) codes.Code {
+	switch {
+	case err == nil:
+		return codes.OK
+	case errors.Is(err, organization.ErrNotPermitted):
+		return codes.PermissionDenied
+	case errors.Is(err, vacancyRepository.ErrVacancyNotFound):
+		return codes.NotFound
+	case errors.Is(err, vacancyRepository.ErrEditConflict):
+		return codes.FailedPrecondition
+	case errors.Is(err, repository.ErrOrganizationNotFound):
+		return codes.InvalidArgument
+	case errors.Is(err, vacancyRepository.ErrBatchAborted):
+		return codes.Aborted
+	}
+	return codes.Internal
+}
+
+// errorMessages returns the message of the error of an item, if any.
+func errorMessages(err error) []string {
+	if err == nil {
+		return nil
+	}
+	return []string{err.Error()}
+}
@@ -1,8 +1,11 @@
 package handler
 
 import (
+	"application/organization"
 	"context"
+	"domain/organization/repository"
 	"domain/vacancy/entity"
+	"errors"
 	vacancyv1 "infrastructure/proto/vacancy/gen"
 	"time"
 
@@ -39,6 +42,12 @@ func (s *VacancyService) handleCreateVacancy(
 
 	// Save vacancy
 	if err := s.service.CreateVacancy(ctx, v); err != nil {
+		switch {
+		case errors.Is(err, organization.ErrNotPermitted):
+			return nil, status.Errorf(codes.PermissionDenied, "create vacancy: %v", err)
+		case errors.Is(err, repository.ErrOrganizationNotFound):
+			return nil, status.Errorf(codes.InvalidArgument, "create vacancy: %v", err)
+		}
 		return nil, status.Errorf(codes.Internal, "create vacancy: %v", err)
 	}
 
@@ -52,7 +61,8 @@ func (s *VacancyService) toEntity(req *vacancyv1.CreateVacancyRequest) *entity.V
 	v.SetTitle(req.Title).
 		SetCompany(req.Company).
 		SetDescription(req.Description).
-		SetLocation(req.Location)
+		SetLocation(req.Location).
+		SetOrganizationId(req.OrganizationId)
 
 	if postedAt, err := time.Parse(s.dateFormat, req.PostedAt); err == nil {
 		v.SetPostedAt(postedAt)
@@ -69,5 +79,7 @@ func (s *VacancyService) toResponse(e *entity.Vacancy) *vacancyv1.CreateVacancyR
 		Description: e.GetDescription(),
 		PostedAt:    e.GetPostedAt().Format(s.dateFormat),
 		Location:    e.GetLocation(),
+
+		OrganizationId: e.GetOrganizationId(),
 	}
 }
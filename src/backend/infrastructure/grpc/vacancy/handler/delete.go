@@ -1,7 +1,9 @@
 package handler
 
 import (
+	"application/organization"
 	"context"
+	"errors"
 	"fmt"
 	vacancyv1 "infrastructure/proto/vacancy/gen"
 
@@ -32,6 +34,9 @@ func (s *VacancyService) handleDeleteVacancy(
 	}
 
 	if err := s.service.DeleteVacancy(ctx, req.GetId()); err != nil {
+		if errors.Is(err, organization.ErrNotPermitted) {
+			return nil, status.Errorf(codes.PermissionDenied, "delete vacancy: %v", err)
+		}
 		return nil, status.Errorf(codes.Internal, "internal error: %v", err)
 	}
 
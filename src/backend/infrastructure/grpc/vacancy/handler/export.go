@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"application/vacancy"
+	"domain/vacancy/entity"
+	vacancyv1 "infrastructure/proto/vacancy/gen"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ExportVacancies handles the gRPC request to export the job vacancies matching the filters.
+// It streams the vacancies as they are read from the database, one message per vacancy, with the requested columns
+// set.
+func (s *VacancyService) ExportVacancies(
+	req *vacancyv1.ExportVacanciesRequest,
+	stream grpc.ServerStreamingServer[vacancyv1.ExportedVacancy],
+) error {
+	if err := s.validator.ValidateExportVacanciesRequest(req); err != nil {
+		return err
+	}
+
+	selected := selection(req.Columns)
+	sortField, sortOrder := req.SortField, strings.ToUpper(req.SortOrder)
+	if sortField == "" {
+		sortField = vacancy.ColumnId
+	}
+	if sortOrder == "" {
+		sortOrder = "ASC"
+	}
+
+	ctx := stream.Context()
+	err := s.service.ExportVacancies(ctx, req.Title, req.Company, sortField, sortOrder, func(v *entity.Vacancy) error {
+		return stream.Send(s.toExported(v, selected))
+	})
+	switch {
+	case err == nil:
+		return nil
+	case ctx.Err() != nil:
+		return status.FromContextError(ctx.Err()).Err()
+	case status.Code(err) != codes.Unknown:
+		return err // The error of sending a message, already a status
+	}
+	return status.Errorf(codes.Internal, "export vacancies: %v", err)
+}
+
+// selection returns the set of the selected fields, or nil to select every field if none is.
+func selection(fields []string) map[string]bool {
+	if len(fields) == 0 {
+		return nil
+	}
+	selected := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		selected[field] = true
+	}
+	return selected
+}
+
+// toExported converts a domain Vacancy entity into a gRPC ExportedVacancy, keeping the fields of the selected
+// columns only, named after them, or every field if none is selected.
+func (s *VacancyService) toExported(e *entity.Vacancy, selected map[string]bool) *vacancyv1.ExportedVacancy {
+	m := &vacancyv1.ExportedVacancy{
+		Id:             e.GetId(),
+		Title:          e.GetTitle(),
+		Company:        e.GetCompany(),
+		Description:    e.GetDescription(),
+		Location:       e.GetLocation(),
+		OrganizationId: e.GetOrganizationId(),
+		Version:        e.GetVersion(),
+	}
+	if postedAt := e.GetPostedAt(); !postedAt.IsZero() {
+		m.PostedAt = postedAt.Format(s.dateFormat)
+	}
+	if selected == nil {
+		return m
+	}
+
+	r := m.ProtoReflect()
+	fields := r.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		if !selected[string(fields.Get(i).Name())] {
+			r.Clear(fields.Get(i))
+		}
+	}
+	return m
+}
@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"context"
+	"domain/vacancy/repository"
+	"errors"
+	vacancyv1 "infrastructure/proto/vacancy/gen"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetVacancy handles the gRPC request to retrieve a job vacancy by its ID.
+// Only the columns of the fields of the read mask are read from the database, and set on the vacancy.
+func (s *VacancyService) GetVacancy(
+	ctx context.Context,
+	req *vacancyv1.GetVacancyRequest,
+) (*vacancyv1.ExportedVacancy, error) {
+	if err := s.validator.ValidateGetVacancyRequest(req); err != nil {
+		return nil, err
+	}
+
+	fields := req.GetReadMask().GetPaths()
+	v, err := s.service.GetVacancy(ctx, req.GetId(), fields...)
+	switch {
+	case errors.Is(err, repository.ErrVacancyNotFound):
+		return nil, status.Errorf(codes.NotFound, "vacancy with ID %d not found", req.GetId())
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "get vacancy: %v", err)
+	}
+	return s.toExported(v, selection(fields)), nil
+}
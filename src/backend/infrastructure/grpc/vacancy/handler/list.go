@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"application/vacancy"
+	"context"
+	vacancyv1 "infrastructure/proto/vacancy/gen"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Defaults of a list, as on the REST API.
+const (
+	defaultPageSize  = 10
+	defaultSortOrder = "DESC"
+)
+
+// ListVacancies handles the gRPC request to list the job vacancies matching the filters, a page at a time.
+// Only the columns of the fields of the read mask are read from the database, and set on the vacancies.
+func (s *VacancyService) ListVacancies(
+	ctx context.Context,
+	req *vacancyv1.ListVacanciesRequest,
+) (*vacancyv1.ListVacanciesResponse, error) {
+	if err := s.validator.ValidateListVacanciesRequest(req); err != nil {
+		return nil, err
+	}
+
+	page, pageSize := max(int(req.Page), 1), int(req.PageSize)
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	sortField, sortOrder := req.SortField, strings.ToUpper(req.SortOrder)
+	if sortField == "" {
+		sortField = vacancy.ColumnId
+	}
+	if sortOrder == "" {
+		sortOrder = defaultSortOrder
+	}
+
+	fields := req.GetReadMask().GetPaths()
+	list, err := s.service.ListFilteredVacancies(ctx, req.Title, req.Company, page, pageSize, sortField, sortOrder,
+		fields...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list vacancies: %v", err)
+	}
+
+	selected := selection(fields)
+	response := &vacancyv1.ListVacanciesResponse{Vacancies: make([]*vacancyv1.ExportedVacancy, len(list))}
+	for i, v := range list {
+		response.Vacancies[i] = s.toExported(v, selected)
+	}
+	return response, nil
+}
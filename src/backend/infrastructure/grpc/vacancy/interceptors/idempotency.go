@@ -0,0 +1,17 @@
+package interceptors
+
+import (
+	vacancyv1 "infrastructure/proto/vacancy/gen"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// VacancyIdempotentMethods returns the methods of the VacancyService made idempotent by an idempotency key, each
+// mapped to a function creating its empty response message.
+func VacancyIdempotentMethods() map[string]func() proto.Message {
+	return map[string]func() proto.Message{
+		vacancyv1.VacancyService_CreateVacancy_FullMethodName: func() proto.Message {
+			return &vacancyv1.CreateVacancyResponse{}
+		},
+	}
+}
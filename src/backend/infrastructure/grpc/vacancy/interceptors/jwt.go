@@ -13,16 +13,13 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-// contextKey is a custom type to avoid collisions in context keys.
-type contextKey string
-
 const (
-	userClaimsKey       contextKey = "userClaims"
-	authorizationHeader string     = "authorization"
-	bearerPrefix        string     = "Bearer "
+	authorizationHeader string = "authorization"
+	bearerPrefix        string = "Bearer "
 )
 
 // JwtVacancyInterceptor validates JWT tokens and adds claims to the context.
+// Requests already authenticated by a preceding interceptor (e.g., authn.HmacInterceptor) are passed through.
 func JwtVacancyInterceptor(jwtService *auth.Service) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
@@ -30,6 +27,10 @@ func JwtVacancyInterceptor(jwtService *auth.Service) grpc.UnaryServerInterceptor
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
+		if _, ok := auth.ClaimsFromContext(ctx); ok {
+			return handler(ctx, req)
+		}
+
 		// Extract and validate the token.
 		claims, err := extractAndValidateToken(ctx, jwtService)
 		if err != nil {
@@ -37,7 +38,7 @@ func JwtVacancyInterceptor(jwtService *auth.Service) grpc.UnaryServerInterceptor
 		}
 
 		// Add the claims to the context for downstream handlers.
-		ctxWithClaims := context.WithValue(ctx, userClaimsKey, claims)
+		ctxWithClaims := auth.ContextWithClaims(ctx, claims)
 		return handler(ctxWithClaims, req)
 	}
 }
@@ -80,6 +81,5 @@ func extractBearerToken(md metadata.MD) (string, error) {
 
 // ClaimsFromContext retrieves the claims from the request context.
 func ClaimsFromContext(ctx context.Context) (*entity.TokenClaims, bool) {
-	claims, ok := ctx.Value(userClaimsKey).(*entity.TokenClaims)
-	return claims, ok
+	return auth.ClaimsFromContext(ctx)
 }
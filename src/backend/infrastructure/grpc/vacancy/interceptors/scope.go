@@ -0,0 +1,54 @@
+package interceptors
+
+import (
+	"application/audit"
+	"context"
+	auditEntity "domain/audit/entity"
+	"domain/auth/entity"
+	vacancyv1 "infrastructure/proto/vacancy/gen"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ScopeVacancyInterceptor ensures the authenticated principal holds the scope required by the invoked method.
+// The required map is keyed by the full gRPC method name; methods missing from the map are rejected.
+// It must run after an interceptor that stores the claims in the context (e.g., JwtVacancyInterceptor).
+// Denied requests are recorded in the audit log.
+func ScopeVacancyInterceptor(required map[string]string, auditService *audit.Service) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		scope, ok := required[info.FullMethod]
+		if !ok {
+			return nil, status.Errorf(codes.PermissionDenied, "method %s is not permitted", info.FullMethod)
+		}
+
+		claims, ok := ClaimsFromContext(ctx)
+		if !ok || !claims.HasScope(scope) {
+			auditService.Report(ctx, audit.ActionAuthDenied, info.FullMethod, auditEntity.OutcomeDenied,
+				map[string]any{"scope": scope})
+			return nil, status.Errorf(codes.PermissionDenied, "missing required scope %q", scope)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// VacancyMethodScopes returns the scope required by each method of the VacancyService.
+func VacancyMethodScopes() map[string]string {
+	return map[string]string{
+		vacancyv1.VacancyService_CreateVacancy_FullMethodName:        entity.ScopeWrite,
+		vacancyv1.VacancyService_DeleteVacancy_FullMethodName:        entity.ScopeDelete,
+		vacancyv1.VacancyService_PurgeVacancies_FullMethodName:       entity.ScopePurge,
+		vacancyv1.VacancyService_BatchCreateVacancies_FullMethodName: entity.ScopeWrite,
+		vacancyv1.VacancyService_BatchUpdateVacancies_FullMethodName: entity.ScopeWrite,
+		vacancyv1.VacancyService_BatchDeleteVacancies_FullMethodName: entity.ScopeDelete,
+		vacancyv1.VacancyService_ExportVacancies_FullMethodName:      entity.ScopeRead,
+		vacancyv1.VacancyService_GetVacancy_FullMethodName:           entity.ScopeRead,
+		vacancyv1.VacancyService_ListVacancies_FullMethodName:        entity.ScopeRead,
+	}
+}
@@ -1,15 +1,17 @@
 package server
 
 import (
+	"errors"
+	"infrastructure/certs"
+	"infrastructure/grpc/mtls"
+
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials"
 )
 
 // Config holds the server configuration settings.
 type Config struct {
 	TLSEnabled   bool                // Whether TLS is enabled
-	CertFile     string              // Path to the TLS certificate file
-	KeyFile      string              // Path to the TLS key file
+	Certificates *certs.Manager      // Manager serving the TLS certificate and the client CA bundle, if any
 	Port         string              // Port the server listens on
 	Interceptors []grpc.ServerOption // Interceptors and other gRPC server options
 }
@@ -17,12 +19,12 @@ type Config struct {
 // Option defines a functional option for configuring the server.
 type Option func(*Config)
 
-// WithTLS enables TLS for the gRPC server and sets the certificate and key files.
-func WithTLS(certFile, keyFile string) Option {
+// WithTLS enables TLS for the gRPC server, serving the certificates of the manager. The manager also holds the
+// client CA bundle enabling mutual TLS and the minimum TLS version.
+func WithTLS(certificates *certs.Manager) Option {
 	return func(c *Config) {
 		c.TLSEnabled = true
-		c.CertFile = certFile
-		c.KeyFile = keyFile
+		c.Certificates = certificates
 	}
 }
 
@@ -57,11 +59,10 @@ func NewGRPCServer(opts ...Option) (*grpc.Server, *Config, error) {
 
 	// Add TLS credentials if enabled
 	if config.TLSEnabled {
-		cred, err := credentials.NewServerTLSFromFile(config.CertFile, config.KeyFile)
-		if err != nil {
-			return nil, nil, err
+		if config.Certificates == nil {
+			return nil, nil, errors.New("TLS enabled without a certificate")
 		}
-		serverOpts = append(serverOpts, grpc.Creds(cred))
+		serverOpts = append(serverOpts, grpc.Creds(mtls.Credentials(config.Certificates)))
 	}
 
 	// Add interceptors if present
@@ -1,18 +1,34 @@
 package server
 
 import (
+	"application/audit"
 	"application/auth"
+	"application/certauth"
+	"application/idempotency"
+	"application/metrics"
+	"application/ratelimit"
+	"application/signing"
+	"context"
 	"errors"
 	"fmt"
+	"infrastructure/certs"
+	auditInterceptor "infrastructure/grpc/audit"
+	"infrastructure/grpc/authn"
+	"infrastructure/grpc/health"
+	grpcIdempotency "infrastructure/grpc/idempotency"
+	grpcMetrics "infrastructure/grpc/metrics"
+	grpcRateLimit "infrastructure/grpc/ratelimit"
+	"infrastructure/grpc/requestid"
+	"infrastructure/grpc/stream"
+	"infrastructure/grpc/tracing"
 	"infrastructure/grpc/vacancy/interceptors"
 	vacancyv1 "infrastructure/proto/vacancy/gen"
 	"log"
 	"net"
-	"os"
-	"os/signal"
-	"syscall"
 
 	"google.golang.org/grpc"
+	_ "google.golang.org/grpc/encoding/gzip" // Registers the gzip compressor, so clients may compress the responses
+	healthv1 "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 // VacancyServer is a high-level wrapper for the gRPC VacancyService server.
@@ -25,26 +41,61 @@ type VacancyServer struct {
 }
 
 // NewVacancyServer creates a new instance of VacancyServer based on the provided configuration.
-func NewVacancyServer(env, port, certFile, keyFile string, jwtService *auth.Service) (*VacancyServer, error) {
+// In production, TLS is served with the certificates of the shared manager, whose client CA bundle enables mutual
+// TLS, authenticating clients by their mapped certificates. Authenticated requests are rate limited by subject, and
+// vacancies created with an idempotency key are created once. Streams, such as exports, are authenticated and limited
+// as unary requests, and clients may request gzip compressed responses.
+func NewVacancyServer(
+	env, port string,
+	certificates *certs.Manager,
+	jwtService *auth.Service,
+	verifier *signing.Verifier,
+	mapper *certauth.Mapper,
+	auditService *audit.Service,
+	limiter *ratelimit.Limiter,
+	idempotencyService *idempotency.Service,
+	registry *metrics.Registry,
+) (*VacancyServer, error) {
 	var (
 		grpcServer   *grpc.Server
 		serverConfig *Config
 		listener     net.Listener
 		err          error
 	)
-	jwtInterceptor := grpc.UnaryInterceptor(interceptors.JwtVacancyInterceptor(jwtService))
+	unary := []grpc.UnaryServerInterceptor{
+		grpcMetrics.UnaryServerInterceptor(registry),
+		requestid.UnaryServerInterceptor(),
+		tracing.UnaryServerInterceptor(),
+		auditInterceptor.UnaryServerInterceptor(auditService),
+		health.Bypass(authn.MtlsInterceptor(mapper)),
+		health.Bypass(authn.HmacInterceptor(verifier)),
+		health.Bypass(interceptors.JwtVacancyInterceptor(jwtService)),
+		health.Bypass(interceptors.ScopeVacancyInterceptor(interceptors.VacancyMethodScopes(), auditService)),
+		health.Bypass(grpcRateLimit.UnaryServerInterceptor(limiter)),
+	}
+	// Streams run the same interceptors, but for idempotency, which only applies to unary methods
+	streams := make([]grpc.StreamServerInterceptor, len(unary))
+	for i, interceptor := range unary {
+		streams[i] = stream.FromUnary(interceptor)
+	}
+	unary = append(unary, health.Bypass(grpcIdempotency.UnaryServerInterceptor(idempotencyService,
+		interceptors.VacancyIdempotentMethods())))
+	authInterceptors := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(streams...),
+	}
 
 	switch env {
 	case "prod":
 		// Enable TLS in production
 		grpcServer, serverConfig, err = NewGRPCServer(
-			WithTLS(certFile, keyFile),
+			WithTLS(certificates),
 			WithPort(port),
-			WithInterceptors(jwtInterceptor))
+			WithInterceptors(authInterceptors...))
 	case "dev":
 		grpcServer, serverConfig, err = NewGRPCServer(
 			WithPort(port),
-			WithInterceptors(jwtInterceptor))
+			WithInterceptors(authInterceptors...))
 	default:
 		return nil, errors.New("unsupported environment; must be \"prod\" or \"dev\"")
 	}
@@ -72,24 +123,36 @@ func (s *VacancyServer) RegisterService(service vacancyv1.VacancyServiceServer)
 	vacancyv1.RegisterVacancyServiceServer(s.grpcServer, service)
 }
 
-// Start starts the gRPC server and begins listening for incoming requests.
-func (s *VacancyServer) Start() {
-	log.Printf("Starting the Vacancy gRPC server on %s (env: %s)...", s.listener.Addr(), s.env)
-	go func() {
-		if err := s.grpcServer.Serve(s.listener); err != nil {
-			log.Printf("gRPC server failed to serve: %v", err)
-		}
-	}()
+// RegisterHealthService registers the grpc.health.v1 Health implementation with the gRPC server.
+func (s *VacancyServer) RegisterHealthService(service healthv1.HealthServer) {
+	healthv1.RegisterHealthServer(s.grpcServer, service)
 }
 
-// WaitForShutdown gracefully shuts down the server upon receiving termination signals.
-func (s *VacancyServer) WaitForShutdown() {
-	signalChan := make(chan os.Signal, 1)
-	signal.Notify(signalChan, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
+// Serve accepts incoming requests until the server is shut down. It returns nil once the server was stopped.
+func (s *VacancyServer) Serve() error {
+	log.Printf("Starting the Vacancy gRPC server on %s (env: %s)...", s.listener.Addr(), s.env)
+	if err := s.grpcServer.Serve(s.listener); err != nil {
+		return fmt.Errorf("gRPC server failed to serve: %w", err)
+	}
+	return nil
+}
 
-	sig := <-signalChan
-	log.Printf("Received signal: %s. Initiating shutdown...", sig)
+// Shutdown stops accepting requests and waits for the pending ones to complete. If the context expires first, the
+// remaining requests are canceled and the connections closed at once.
+func (s *VacancyServer) Shutdown(ctx context.Context) error {
+	stopped := make(chan struct{})
+	go func() {
+		s.grpcServer.GracefulStop()
+		close(stopped)
+	}()
 
-	s.grpcServer.GracefulStop()
-	log.Println("Vacancy gRPC server stopped gracefully.")
+	select {
+	case <-stopped:
+		log.Println("Vacancy gRPC server stopped gracefully.")
+		return nil
+	case <-ctx.Done():
+		s.grpcServer.Stop()
+		log.Println("Vacancy gRPC server stopped forcibly.")
+		return ctx.Err()
+	}
 }
@@ -1,32 +1,170 @@
 package validators
 
 import (
+	"application/vacancy"
 	"fmt"
 	vacancyv1 "infrastructure/proto/vacancy/gen"
+	"slices"
 	"strings"
 	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// Bounds of the pagination of a list, as on the REST API.
+const (
+	maxPage     = 1_000
+	maxPageSize = 750
 )
 
 // Validator defines the interface for validating gRPC requests.
 type Validator interface {
 	ValidateCreateVacancyRequest(req *vacancyv1.CreateVacancyRequest) error
+
+	// ValidateBatch validates the number of items of a batch request.
+	ValidateBatch(n int) error
+
+	// CreateVacancyProblems returns the problems of an item of a batch creation, one message per invalid field.
+	CreateVacancyProblems(req *vacancyv1.CreateVacancyRequest) []string
+
+	// UpdateVacancyProblems returns the problems of an item of a batch update, one message per invalid field.
+	UpdateVacancyProblems(req *vacancyv1.UpdateVacancyRequest) []string
+
+	// ValidateExportVacanciesRequest validates the sorting and the columns of an export.
+	ValidateExportVacanciesRequest(req *vacancyv1.ExportVacanciesRequest) error
+
+	// ValidateGetVacancyRequest validates the ID and the read mask of a retrieval.
+	ValidateGetVacancyRequest(req *vacancyv1.GetVacancyRequest) error
+
+	// ValidateListVacanciesRequest validates the pagination, the sorting and the read mask of a list.
+	ValidateListVacanciesRequest(req *vacancyv1.ListVacanciesRequest) error
 }
 
 // VacancyValidator implements validation rules for gRPC vacancy requests.
 type VacancyValidator struct {
 	dateFormat string
+	maxItems   int // Maximum number of items of a batch.
 }
 
-// NewVacancyValidator creates a new instance of VacancyValidator.
-func NewVacancyValidator() *VacancyValidator {
-	return &VacancyValidator{dateFormat: "2006-01-02"}
+// NewVacancyValidator creates a new instance of VacancyValidator accepting batches of up to maxItems items.
+func NewVacancyValidator(maxItems int) *VacancyValidator {
+	return &VacancyValidator{dateFormat: "2006-01-02", maxItems: maxItems}
 }
 
 // ValidateCreateVacancyRequest validates the CreateVacancyRequest fields.
 func (v *VacancyValidator) ValidateCreateVacancyRequest(req *vacancyv1.CreateVacancyRequest) error {
+	return combineErrors(v.createErrors(req))
+}
+
+// ValidateBatch validates the number of items of a batch request.
+func (v *VacancyValidator) ValidateBatch(n int) error {
+	switch {
+	case n == 0:
+		return status.Error(codes.InvalidArgument, "a batch must hold at least one item")
+	case n > v.maxItems:
+		return status.Errorf(codes.InvalidArgument, "a batch must hold at most %d items", v.maxItems)
+	}
+	return nil
+}
+
+// CreateVacancyProblems returns the problems of the CreateVacancyRequest fields.
+func (v *VacancyValidator) CreateVacancyProblems(req *vacancyv1.CreateVacancyRequest) []string {
+	return messages(v.createErrors(req))
+}
+
+// UpdateVacancyProblems returns the problems of the UpdateVacancyRequest fields set.
+func (v *VacancyValidator) UpdateVacancyProblems(req *vacancyv1.UpdateVacancyRequest) []string {
+	var validationErrors []error
+
+	if req.Id <= 0 {
+		validationErrors = append(validationErrors, status.Errorf(codes.InvalidArgument, "id must be positive"))
+	}
+	for _, f := range []struct {
+		value *string
+		name  string
+	}{{req.Title, "title"}, {req.Company, "company"}, {req.Description, "description"}, {req.Location, "location"}} {
+		if f.value != nil {
+			validationErrors = appendError(validationErrors, validateStringField(*f.value, f.name))
+		}
+	}
+	if req.PostedAt != nil {
+		validationErrors = appendError(validationErrors, validateDateField(*req.PostedAt, "posted_at", v.dateFormat))
+	}
+
+	return messages(validationErrors)
+}
+
+// ValidateExportVacanciesRequest validates the ExportVacanciesRequest fields, which may all be left empty.
+func (v *VacancyValidator) ValidateExportVacanciesRequest(req *vacancyv1.ExportVacanciesRequest) error {
+	validationErrors := sortingErrors(req.SortField, req.SortOrder)
+	if !vacancy.ValidExportColumns(req.Columns) {
+		validationErrors = append(validationErrors, status.Errorf(codes.InvalidArgument,
+			"columns must list distinct columns among %s", strings.Join(vacancy.ExportColumns(), ", ")))
+	}
+
+	return combineErrors(validationErrors)
+}
+
+// ValidateGetVacancyRequest validates the GetVacancyRequest fields, the read mask listing fields of ExportedVacancy.
+func (v *VacancyValidator) ValidateGetVacancyRequest(req *vacancyv1.GetVacancyRequest) error {
+	var validationErrors []error
+
+	if req.GetId() <= 0 {
+		validationErrors = append(validationErrors, status.Errorf(codes.InvalidArgument, "id must be positive"))
+	}
+	validationErrors = appendError(validationErrors, validateReadMask(req.GetReadMask()))
+
+	return combineErrors(validationErrors)
+}
+
+// ValidateListVacanciesRequest validates the ListVacanciesRequest fields, which may all be left empty.
+func (v *VacancyValidator) ValidateListVacanciesRequest(req *vacancyv1.ListVacanciesRequest) error {
+	validationErrors := sortingErrors(req.SortField, req.SortOrder)
+
+	if req.Page < 0 || req.Page > maxPage {
+		validationErrors = append(validationErrors,
+			status.Errorf(codes.InvalidArgument, "page must be between 1 and %d", maxPage))
+	}
+	if req.PageSize < 0 || req.PageSize > maxPageSize {
+		validationErrors = append(validationErrors,
+			status.Errorf(codes.InvalidArgument, "page_size must be between 1 and %d", maxPageSize))
+	}
+	validationErrors = appendError(validationErrors, validateReadMask(req.GetReadMask()))
+
+	return combineErrors(validationErrors)
+}
+
+// sortingErrors returns the errors of the field and the order a list or an export is sorted by, which may be left
+// empty.
+func sortingErrors(field, order string) []error {
+	var validationErrors []error
+
+	if field != "" && !slices.Contains(vacancy.ExportSortFields(), field) {
+		validationErrors = append(validationErrors, status.Errorf(codes.InvalidArgument,
+			"sort_field must be one of %s", strings.Join(vacancy.ExportSortFields(), ", ")))
+	}
+	if order = strings.ToLower(order); order != "" && order != "asc" && order != "desc" {
+		validationErrors = append(validationErrors,
+			status.Errorf(codes.InvalidArgument, "sort_order must be \"asc\" or \"desc\""))
+	}
+
+	return validationErrors
+}
+
+// validateReadMask checks that the paths of the read mask, if any, are distinct fields of ExportedVacancy, named
+// after the columns of an export.
+func validateReadMask(mask *fieldmaskpb.FieldMask) error {
+	if !vacancy.ValidExportColumns(mask.GetPaths()) {
+		return status.Errorf(codes.InvalidArgument, "read_mask must list distinct fields among %s",
+			strings.Join(vacancy.ExportColumns(), ", "))
+	}
+	return nil
+}
+
+// createErrors returns the errors of the CreateVacancyRequest fields.
+func (v *VacancyValidator) createErrors(req *vacancyv1.CreateVacancyRequest) []error {
 	var validationErrors []error
 
 	if err := validateStringField(req.Title, "title"); err != nil {
@@ -44,8 +182,29 @@ func (v *VacancyValidator) ValidateCreateVacancyRequest(req *vacancyv1.CreateVac
 	if err := validateStringField(req.Location, "location"); err != nil {
 		validationErrors = append(validationErrors, err)
 	}
+	if req.OrganizationId < 0 {
+		validationErrors = append(validationErrors,
+			status.Errorf(codes.InvalidArgument, "organization_id must not be negative"))
+	}
 
-	return combineErrors(validationErrors)
+	return validationErrors
+}
+
+// appendError appends the error to the list if it is not nil.
+func appendError(errs []error, err error) []error {
+	if err != nil {
+		return append(errs, err)
+	}
+	return errs
+}
+
+// messages returns the messages of the gRPC errors.
+func messages(errs []error) []string {
+	list := make([]string, 0, len(errs))
+	for _, err := range errs {
+		list = append(list, status.Convert(err).Message())
+	}
+	return list
 }
 
 // validateStringField checks if a string field is provided and non-empty.
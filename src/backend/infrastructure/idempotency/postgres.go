@@ -0,0 +1,129 @@
+package idempotency
+
+import (
+	"application/idempotency"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// beginAttempts is the number of attempts to record a request, as the request holding its key may be released
+// between recording it and reading the request holding the key.
+const beginAttempts = 3
+
+// PgxStore is an idempotency.Store keeping the requests in Postgres, recognizing the retries sent to any API
+// instance. Expired keys are deleted at most once per interval.
+type PgxStore struct {
+	db        *pgxpool.Pool // Connection pool for database interactions.
+	interval  time.Duration
+	mu        sync.Mutex // Guards lastSweep.
+	lastSweep time.Time
+}
+
+// NewPgxStore initializes a new instance of PgxStore with a database connection pool.
+func NewPgxStore(db *pgxpool.Pool, interval time.Duration) *PgxStore {
+	return &PgxStore{db: db, interval: interval}
+}
+
+// Begin records the request with the key as in progress, held by the owner of the lock, unless the key holds a
+// request that has not expired, which is returned instead. Concurrent requests with the same key are recorded once,
+// as the insert either creates the key, replaces its expired request or leaves it alone.
+func (s *PgxStore) Begin(
+	ctx context.Context, lock idempotency.Lock, fingerprint string, now, expiresAt time.Time,
+) (*idempotency.Request, error) {
+	insertQuery := `
+		INSERT INTO idempotency_keys (key, owner, fingerprint, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (key) DO UPDATE
+		SET owner = EXCLUDED.owner, fingerprint = EXCLUDED.fingerprint, status = NULL, headers = NULL, body = NULL,
+			created_at = EXCLUDED.created_at, expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= EXCLUDED.created_at
+	`
+	selectQuery := `
+		SELECT fingerprint, status, headers, body
+		FROM idempotency_keys
+		WHERE key = $1
+	`
+
+	for range beginAttempts {
+		tag, err := s.db.Exec(ctx, insertQuery, lock.Key, lock.Owner, fingerprint, now, expiresAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to record idempotent request: %w", err)
+		}
+		if tag.RowsAffected() == 1 {
+			// The request is recorded, so failing to delete the expired keys must not fail it
+			if err = s.sweep(ctx, now); err != nil {
+				slog.ErrorContext(ctx, "Failed to sweep idempotency keys", "error", err)
+			}
+			return nil, nil
+		}
+
+		var (
+			r       idempotency.Request
+			status  *int
+			headers map[string]string
+			body    []byte
+		)
+		err = s.db.QueryRow(ctx, selectQuery, lock.Key).Scan(&r.Fingerprint, &status, &headers, &body)
+		if errors.Is(err, pgx.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch idempotent request: %w", err)
+		}
+		if status != nil {
+			r.Response = &idempotency.Response{Status: *status, Headers: headers, Body: body}
+		}
+		return &r, nil
+	}
+	return nil, errors.New("failed to record idempotent request: the key is released concurrently")
+}
+
+// Complete stores the response of the request in progress with the key until expiresAt, unless another owner took
+// the key over.
+func (s *PgxStore) Complete(
+	ctx context.Context, lock idempotency.Lock, response idempotency.Response, expiresAt time.Time,
+) error {
+	query := `
+		UPDATE idempotency_keys
+		SET status = $3, headers = $4, body = $5, expires_at = $6
+		WHERE key = $1 AND owner = $2 AND status IS NULL
+	`
+
+	_, err := s.db.Exec(ctx, query, lock.Key, lock.Owner, response.Status, response.Headers, response.Body, expiresAt)
+	if err != nil {
+		return fmt.Errorf("failed to store idempotent response: %w", err)
+	}
+	return nil
+}
+
+// Release deletes the request in progress with the key, unless another owner took the key over.
+func (s *PgxStore) Release(ctx context.Context, lock idempotency.Lock) error {
+	query := `DELETE FROM idempotency_keys WHERE key = $1 AND owner = $2 AND status IS NULL`
+	if _, err := s.db.Exec(ctx, query, lock.Key, lock.Owner); err != nil {
+		return fmt.Errorf("failed to release idempotent request: %w", err)
+	}
+	return nil
+}
+
+// sweep deletes the expired keys if the sweep interval has elapsed.
+func (s *PgxStore) sweep(ctx context.Context, now time.Time) error {
+	s.mu.Lock()
+	if now.Sub(s.lastSweep) < s.interval {
+		s.mu.Unlock()
+		return nil
+	}
+	s.lastSweep = now
+	s.mu.Unlock()
+
+	if _, err := s.db.Exec(ctx, `DELETE FROM idempotency_keys WHERE expires_at <= $1`, now); err != nil {
+		return fmt.Errorf("failed to delete expired idempotency keys: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,10 @@
+-- Drop the `audit_log` table together with its index, if it exists.
+DROP INDEX IF EXISTS audit_log_created_at_idx;
+DROP TABLE IF EXISTS audit_log;
+
+-- Drop the `role_assignments` table together with its index, if it exists.
+DROP INDEX IF EXISTS role_assignments_subject_idx;
+DROP TABLE IF EXISTS role_assignments;
+
+-- Drop the `roles` table, if it exists.
+DROP TABLE IF EXISTS roles;
@@ -0,0 +1,77 @@
+-- Create the `roles` table if it does not exist.
+-- A role is a named set of scopes that can be assigned to users or API clients.
+-- The table contains fields such as:
+-- - `id`: Auto-incrementing primary key (unique identifier for each role).
+-- - `name`: Unique name of the role, e.g., "viewer".
+-- - `description`: Human readable description of the role.
+-- - `scopes`: Scopes granted to every subject holding the role, e.g., '{read,write}'.
+-- - `created_at`: Timestamp for when the role was created.
+-- - `updated_at`: Timestamp for when the role was last updated.
+-- - `version`: Version field for optimistic concurrency control. Defaults to 1.
+
+CREATE TABLE IF NOT EXISTS roles (
+    id BIGSERIAL PRIMARY KEY,                         -- Unique identifier for the role.
+    name TEXT NOT NULL UNIQUE,                        -- Unique name of the role.
+    description TEXT NOT NULL DEFAULT '',             -- Description of the role.
+    scopes TEXT[] NOT NULL DEFAULT '{}',              -- Scopes granted by the role.
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),    -- Timestamp when the role was created.
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),    -- Timestamp when the role was last updated.
+    version INTEGER NOT NULL DEFAULT 1                -- Version for optimistic concurrency control.
+);
+
+-- Create the `role_assignments` table if it does not exist.
+-- An assignment grants a role to a subject, which is either a user or an API client.
+-- - `subject_type`: Kind of subject, either 'user' or 'client'.
+-- - `subject_id`: Identifier of the subject (user id or client id, e.g., the token issuer of the scraper bot).
+-- - `role_id`: Reference to the assigned role. Assignments are removed together with the role.
+
+CREATE TABLE IF NOT EXISTS role_assignments (
+    id BIGSERIAL PRIMARY KEY,                                           -- Unique identifier for the assignment.
+    subject_type TEXT NOT NULL CHECK (subject_type IN ('user', 'client')), -- Kind of subject.
+    subject_id TEXT NOT NULL,                                           -- Identifier of the subject.
+    role_id BIGINT NOT NULL REFERENCES roles (id) ON DELETE CASCADE,    -- Assigned role.
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),                      -- Timestamp when the role was assigned.
+    UNIQUE (subject_type, subject_id, role_id)
+);
+
+-- Index assignments by subject, used to resolve the effective scopes when a token is minted.
+CREATE INDEX IF NOT EXISTS role_assignments_subject_idx ON role_assignments (subject_type, subject_id);
+
+-- Create the `audit_log` table if it does not exist.
+-- Every administrative change (e.g., creating a role or assigning it) is recorded here.
+-- - `actor`: Subject that performed the action, taken from the access token.
+-- - `action`: Name of the action, e.g., 'role.create'.
+-- - `target`: Resource the action was applied to, e.g., 'role:1'.
+-- - `details`: Additional information about the change.
+
+CREATE TABLE IF NOT EXISTS audit_log (
+    id BIGSERIAL PRIMARY KEY,                         -- Unique identifier for the audit record.
+    actor TEXT NOT NULL,                              -- Subject that performed the action.
+    action TEXT NOT NULL,                             -- Name of the action.
+    target TEXT NOT NULL,                             -- Resource the action was applied to.
+    details JSONB NOT NULL DEFAULT '{}'::jsonb,       -- Additional information about the change.
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()     -- Timestamp when the action was recorded.
+);
+
+CREATE INDEX IF NOT EXISTS audit_log_created_at_idx ON audit_log (created_at);
+
+-- Seed the built-in roles.
+INSERT INTO roles (name, description, scopes)
+VALUES
+    ('viewer', 'Read-only access to vacancies.', '{read}'),
+    ('editor', 'Create, update and delete vacancies.', '{read,write,delete}'),
+    ('ingestor', 'Vacancy ingestion for scraper bots, including purging the table.', '{read,write,delete,purge}'),
+    ('admin', 'Full access, including role management.', '{read,write,delete,purge,admin}')
+ON CONFLICT (name) DO NOTHING;
+
+-- Seed the built-in assignments:
+-- - the public web client (tokens issued by `GET /v1/jwt`) is a viewer;
+-- - the Pulse Finder Bot is an ingestor.
+-- Administrators are assigned explicitly by operators.
+INSERT INTO role_assignments (subject_type, subject_id, role_id)
+SELECT 'client', 'api.pulse-finder', id FROM roles WHERE name = 'viewer'
+ON CONFLICT DO NOTHING;
+
+INSERT INTO role_assignments (subject_type, subject_id, role_id)
+SELECT 'client', 'pulse-finder-bot', id FROM roles WHERE name = 'ingestor'
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,10 @@
+-- Drop vacancy ownership from `job_vacancies`.
+DROP INDEX IF EXISTS job_vacancies_organization_id_idx;
+ALTER TABLE job_vacancies DROP COLUMN IF EXISTS organization_id;
+
+-- Drop the `organization_members` table together with its index, if it exists.
+DROP INDEX IF EXISTS organization_members_member_idx;
+DROP TABLE IF EXISTS organization_members;
+
+-- Drop the `organizations` table, if it exists.
+DROP TABLE IF EXISTS organizations;
@@ -0,0 +1,45 @@
+-- Create the `organizations` table if it does not exist.
+-- An organization represents an employer that owns and manages its vacancies.
+-- The table contains fields such as:
+-- - `id`: Auto-incrementing primary key (unique identifier for each organization).
+-- - `name`: Unique name of the organization, e.g., "Tech Corp".
+-- - `created_by`: Principal that registered the organization and became its first owner.
+-- - `created_at`: Timestamp for when the organization was registered.
+-- - `version`: Version field for optimistic concurrency control. Defaults to 1.
+
+CREATE TABLE IF NOT EXISTS organizations (
+    id BIGSERIAL PRIMARY KEY,                         -- Unique identifier for the organization.
+    name TEXT NOT NULL UNIQUE,                        -- Unique name of the organization.
+    created_by TEXT NOT NULL,                         -- Principal that registered the organization.
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),    -- Timestamp when the organization was registered.
+    version INTEGER NOT NULL DEFAULT 1                -- Version for optimistic concurrency control.
+);
+
+-- Create the `organization_members` table if it does not exist.
+-- A member is a principal (the subject of an access token) belonging to an organization.
+-- - `organization_id`: Reference to the organization. Members are removed together with the organization.
+-- - `member_id`: Principal of the member, e.g., the token subject.
+-- - `role`: Either 'owner' (may invite members) or 'member'.
+-- - `status`: Either 'invited' (pending acceptance) or 'active'. Only active members manage vacancies.
+-- - `invited_by`: Principal that invited the member.
+
+CREATE TABLE IF NOT EXISTS organization_members (
+    organization_id BIGINT NOT NULL REFERENCES organizations (id) ON DELETE CASCADE, -- Owning organization.
+    member_id TEXT NOT NULL,                                                      -- Principal of the member.
+    role TEXT NOT NULL CHECK (role IN ('owner', 'member')),                       -- Role within the organization.
+    status TEXT NOT NULL CHECK (status IN ('invited', 'active')),                 -- Membership status.
+    invited_by TEXT NOT NULL,                                                     -- Principal that invited the member.
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),                                -- Timestamp of the invitation.
+    PRIMARY KEY (organization_id, member_id)
+);
+
+-- Index memberships by principal, used to list the organizations of the caller.
+CREATE INDEX IF NOT EXISTS organization_members_member_idx ON organization_members (member_id);
+
+-- Store vacancy ownership on `job_vacancies`.
+-- Vacancies without an organization (e.g., ingested by the scraper bot) can only be managed by principals with
+-- cross-organization rights. Deleting an organization keeps its vacancies but clears their owner.
+ALTER TABLE job_vacancies
+    ADD COLUMN IF NOT EXISTS organization_id BIGINT REFERENCES organizations (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS job_vacancies_organization_id_idx ON job_vacancies (organization_id);
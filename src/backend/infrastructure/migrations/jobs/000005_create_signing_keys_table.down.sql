@@ -0,0 +1,3 @@
+-- Drop the `signing_keys` table together with its index, if it exists.
+DROP INDEX IF EXISTS signing_keys_client_id_idx;
+DROP TABLE IF EXISTS signing_keys;
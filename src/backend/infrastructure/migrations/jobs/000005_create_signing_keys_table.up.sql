@@ -0,0 +1,22 @@
+-- Create the `signing_keys` table if it does not exist.
+-- A signing key is a shared HMAC secret issued to an API client (e.g., the scraper bot) to sign its requests,
+-- as an alternative to short-lived bearer tokens. Each client may hold several keys to allow rotation.
+-- The table contains fields such as:
+-- - `id`: Auto-incrementing primary key (unique identifier for each key).
+-- - `key_id`: Public identifier sent by the client alongside each signature.
+-- - `client_id`: API client the key was issued to. Its role assignments determine the granted scopes.
+-- - `secret`: Shared secret used to compute the HMAC-SHA256 signatures. It is only returned when issued.
+-- - `created_at`: Timestamp for when the key was issued.
+-- - `revoked_at`: Timestamp for when the key was revoked, NULL while the key is active.
+
+CREATE TABLE IF NOT EXISTS signing_keys (
+    id BIGSERIAL PRIMARY KEY,                         -- Unique identifier for the key.
+    key_id TEXT NOT NULL UNIQUE,                      -- Public identifier of the key.
+    client_id TEXT NOT NULL,                          -- API client the key was issued to.
+    secret TEXT NOT NULL,                             -- Shared HMAC secret.
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),    -- Timestamp when the key was issued.
+    revoked_at TIMESTAMPTZ                            -- Timestamp when the key was revoked.
+);
+
+-- Index keys by client, used to list the keys issued to a client.
+CREATE INDEX IF NOT EXISTS signing_keys_client_id_idx ON signing_keys (client_id);
@@ -0,0 +1,11 @@
+-- Drop the append-only trigger, the indexes and the columns of the security audit trail.
+DROP TRIGGER IF EXISTS audit_log_append_only ON audit_log;
+DROP FUNCTION IF EXISTS audit_log_reject_update();
+
+DROP INDEX IF EXISTS audit_log_action_idx;
+DROP INDEX IF EXISTS audit_log_actor_idx;
+
+ALTER TABLE audit_log
+    DROP COLUMN IF EXISTS request_id,
+    DROP COLUMN IF EXISTS source_ip,
+    DROP COLUMN IF EXISTS outcome;
@@ -0,0 +1,25 @@
+-- Extend the `audit_log` table to a security audit trail of authentication and administrative actions.
+-- - `outcome`: Result of the action: 'success', 'failure' (e.g., an invalid token) or 'denied' (missing rights).
+-- - `source_ip`: IP address of the client that performed the action.
+-- - `request_id`: Identifier of the request that performed the action, correlating the record with logs.
+
+ALTER TABLE audit_log
+    ADD COLUMN IF NOT EXISTS outcome TEXT NOT NULL DEFAULT 'success',
+    ADD COLUMN IF NOT EXISTS source_ip TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS request_id TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS audit_log_actor_idx ON audit_log (actor);
+CREATE INDEX IF NOT EXISTS audit_log_action_idx ON audit_log (action);
+
+-- Audit records are append-only: once written, a record can no longer be changed.
+-- Deleting records remains possible to enforce retention periods.
+CREATE OR REPLACE FUNCTION audit_log_reject_update() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log records are append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS audit_log_append_only ON audit_log;
+CREATE TRIGGER audit_log_append_only
+    BEFORE UPDATE ON audit_log
+    FOR EACH ROW EXECUTE FUNCTION audit_log_reject_update();
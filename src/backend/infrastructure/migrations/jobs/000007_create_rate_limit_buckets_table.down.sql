@@ -0,0 +1,3 @@
+-- Drop the `rate_limit_buckets` table together with its index, if it exists.
+DROP INDEX IF EXISTS rate_limit_buckets_full_at_idx;
+DROP TABLE IF EXISTS rate_limit_buckets;
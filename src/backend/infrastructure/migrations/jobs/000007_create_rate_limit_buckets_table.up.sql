@@ -0,0 +1,18 @@
+-- Create the `rate_limit_buckets` table if it does not exist.
+-- A bucket holds the tokens left to a client under a rate limit policy, shared by all API instances so the limits
+-- hold across them. Buckets are deleted once full again, as a missing bucket is treated as full.
+-- The table contains fields such as:
+-- - `key`: Policy and client the bucket belongs to (e.g., `GET /v1/vacancies|subject:pulse-finder-bot`).
+-- - `tokens`: Tokens left when the bucket was last updated.
+-- - `updated_at`: Timestamp for when the bucket was last updated, from which the tokens are refilled.
+-- - `full_at`: Timestamp for when the bucket is full again and may be deleted.
+
+CREATE TABLE IF NOT EXISTS rate_limit_buckets (
+    key TEXT PRIMARY KEY,                 -- Policy and client the bucket belongs to.
+    tokens DOUBLE PRECISION NOT NULL,     -- Tokens left at the last update.
+    updated_at TIMESTAMPTZ NOT NULL,      -- Timestamp of the last update.
+    full_at TIMESTAMPTZ NOT NULL          -- Timestamp when the bucket is full again.
+);
+
+-- Index buckets by the time they are full, used to delete them.
+CREATE INDEX IF NOT EXISTS rate_limit_buckets_full_at_idx ON rate_limit_buckets (full_at);
@@ -0,0 +1,3 @@
+-- Drop the `idempotency_keys` table together with its index, if it exists.
+DROP INDEX IF EXISTS idempotency_keys_expires_at_idx;
+DROP TABLE IF EXISTS idempotency_keys;
@@ -0,0 +1,27 @@
+-- Create the `idempotency_keys` table if it does not exist.
+-- A key holds a request sent with an `Idempotency-Key`, shared by all API instances so retries sent to any of them
+-- get the response of the original request instead of applying it again. Keys are deleted once expired.
+-- The table contains fields such as:
+-- - `key`: Operation, client and key of the request (e.g., `POST /v1/vacancies|subject:pulse-finder-bot|<key>`).
+-- - `owner`: Random token of the request holding the key, so a request whose lock expired cannot complete or release
+--   the key taken over by a retry.
+-- - `fingerprint`: Hash of the request, telling its retries from other requests reusing the key.
+-- - `status`: HTTP status or gRPC status code of the response, NULL while the request is in progress.
+-- - `headers`: Headers of the response replayed with it (e.g., `Location`).
+-- - `body`: Body of the response, or encoded status of a gRPC error.
+-- - `created_at`: Timestamp for when the request was received.
+-- - `expires_at`: Timestamp for when the key expires and may be deleted.
+
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    key TEXT PRIMARY KEY,                 -- Operation, client and key of the request.
+    owner TEXT NOT NULL,                  -- Token of the request holding the key.
+    fingerprint TEXT NOT NULL,            -- Hash of the request.
+    status INTEGER,                       -- Status of the response, NULL while in progress.
+    headers JSONB,                        -- Headers of the response.
+    body BYTEA,                           -- Body of the response.
+    created_at TIMESTAMPTZ NOT NULL,      -- Timestamp of the request.
+    expires_at TIMESTAMPTZ NOT NULL       -- Timestamp when the key expires.
+);
+
+-- Index keys by the time they expire, used to delete them.
+CREATE INDEX IF NOT EXISTS idempotency_keys_expires_at_idx ON idempotency_keys (expires_at);
@@ -0,0 +1,30 @@
+// Package migrations holds the SQL migrations of the jobs database, applied by golang-migrate.
+package migrations
+
+import (
+	"embed"
+	"strconv"
+	"strings"
+)
+
+// files holds the up migrations, named "<version>_<description>.up.sql".
+//
+//go:embed jobs/*.up.sql
+var files embed.FS
+
+// LatestVersion returns the version of the newest migration, i.e., the version of a fully migrated database.
+func LatestVersion() uint {
+	entries, err := files.ReadDir("jobs")
+	if err != nil {
+		return 0
+	}
+
+	var latest uint
+	for _, entry := range entries {
+		prefix, _, _ := strings.Cut(entry.Name(), "_")
+		if version, err := strconv.ParseUint(prefix, 10, 64); err == nil && uint(version) > latest {
+			latest = uint(version)
+		}
+	}
+	return latest
+}
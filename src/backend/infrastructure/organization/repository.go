@@ -0,0 +1,273 @@
+package organization
+
+import (
+	"application/tracing"
+	"context"
+	"domain/organization/entity"
+	"domain/organization/repository"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	uniqueViolation     = "23505" // PostgreSQL error code for unique constraint violations.
+	foreignKeyViolation = "23503" // PostgreSQL error code for foreign key violations.
+)
+
+// PgxOrganizationRepository implements the OrganizationRepository interface using pgx.
+type PgxOrganizationRepository struct {
+	db *pgxpool.Pool // Connection pool for database interactions.
+}
+
+// NewPgxOrganizationRepository initializes a new instance of PgxOrganizationRepository with a database connection pool.
+func NewPgxOrganizationRepository(db *pgxpool.Pool) *PgxOrganizationRepository {
+	return &PgxOrganizationRepository{db: db}
+}
+
+// Save inserts a new organization and its owner membership within a single transaction.
+func (r *PgxOrganizationRepository) Save(ctx context.Context, o *entity.Organization, owner *entity.Member) error {
+	organizationQuery := `
+		INSERT INTO organizations (name, created_by)
+		VALUES ($1, $2)
+		RETURNING id, created_at, version
+	`
+	memberQuery := `
+		INSERT INTO organization_members (organization_id, member_id, role, status, invited_by)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING created_at
+	`
+
+	return r.withTransaction(ctx, func(tx pgx.Tx) error {
+		var id int64
+		var createdAt time.Time
+		var version int32
+
+		err := tx.QueryRow(ctx, organizationQuery, o.GetName(), o.GetCreatedBy()).Scan(&id, &createdAt, &version)
+		if err != nil {
+			return fmt.Errorf("failed to save organization: %w", mapError(err))
+		}
+		o.SetId(id).SetCreatedAt(createdAt).SetVersion(version)
+
+		owner.SetOrganizationId(id)
+		args := []any{id, owner.GetMemberId(), owner.GetRole(), owner.GetStatus(), owner.GetInvitedBy()}
+		if err = tx.QueryRow(ctx, memberQuery, args...).Scan(&createdAt); err != nil {
+			return fmt.Errorf("failed to save organization owner: %w", mapError(err))
+		}
+		owner.SetCreatedAt(createdAt)
+		return nil
+	})
+}
+
+// Get retrieves an organization from the database by its ID.
+func (r *PgxOrganizationRepository) Get(ctx context.Context, id int64) (*entity.Organization, error) {
+	baseQuery := `SELECT id, name, created_by, created_at, version FROM organizations WHERE id = $1`
+
+	o, err := scanOrganization(r.db.QueryRow(ctx, baseQuery, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("failed to fetch organization: %w", repository.ErrOrganizationNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch organization: %w", err)
+	}
+	return o, nil
+}
+
+// GetListByMember retrieves the organizations the principal is an active member of.
+func (r *PgxOrganizationRepository) GetListByMember(
+	ctx context.Context,
+	memberId string,
+) ([]*entity.Organization, error) {
+	baseQuery := `
+		SELECT o.id, o.name, o.created_by, o.created_at, o.version
+		FROM organizations o
+		JOIN organization_members m ON m.organization_id = o.id
+		WHERE m.member_id = $1 AND m.status = $2
+		ORDER BY o.name
+	`
+	rows, err := r.db.Query(ctx, baseQuery, memberId, entity.StatusActive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch organizations: %w", err)
+	}
+	defer rows.Close()
+
+	var list []*entity.Organization
+	for rows.Next() {
+		o, err := scanOrganization(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan organization: %w", err)
+		}
+		list = append(list, o)
+	}
+
+	// Check for row iteration errors.
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return list, nil
+}
+
+// SaveMember inserts a new membership into the database.
+func (r *PgxOrganizationRepository) SaveMember(ctx context.Context, m *entity.Member) error {
+	baseQuery := `
+		INSERT INTO organization_members (organization_id, member_id, role, status, invited_by)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING created_at
+	`
+	args := []any{m.GetOrganizationId(), m.GetMemberId(), m.GetRole(), m.GetStatus(), m.GetInvitedBy()}
+
+	return r.withTransaction(ctx, func(tx pgx.Tx) error {
+		var createdAt time.Time
+		if err := tx.QueryRow(ctx, baseQuery, args...).Scan(&createdAt); err != nil {
+			return fmt.Errorf("failed to save organization member: %w", mapError(err))
+		}
+		m.SetCreatedAt(createdAt)
+		return nil
+	})
+}
+
+// GetMember retrieves the membership of a principal in an organization.
+func (r *PgxOrganizationRepository) GetMember(
+	ctx context.Context,
+	organizationId int64,
+	memberId string,
+) (*entity.Member, error) {
+	baseQuery := `
+		SELECT organization_id, member_id, role, status, invited_by, created_at
+		FROM organization_members
+		WHERE organization_id = $1 AND member_id = $2
+	`
+
+	m, err := scanMember(r.db.QueryRow(ctx, baseQuery, organizationId, memberId))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("failed to fetch organization member: %w", repository.ErrMemberNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch organization member: %w", err)
+	}
+	return m, nil
+}
+
+// UpdateMemberStatus changes the status of an existing membership.
+func (r *PgxOrganizationRepository) UpdateMemberStatus(ctx context.Context, m *entity.Member) error {
+	baseQuery := `
+		UPDATE organization_members
+		SET status = $1
+		WHERE organization_id = $2 AND member_id = $3
+	`
+
+	return r.withTransaction(ctx, func(tx pgx.Tx) error {
+		commandTag, err := tx.Exec(ctx, baseQuery, m.GetStatus(), m.GetOrganizationId(), m.GetMemberId())
+		if err != nil {
+			return fmt.Errorf("failed to update organization member: %w", err)
+		}
+		if commandTag.RowsAffected() == 0 {
+			return fmt.Errorf("failed to update organization member: %w", repository.ErrMemberNotFound)
+		}
+		return nil
+	})
+}
+
+// GetMembers retrieves all members and pending invitations of an organization.
+func (r *PgxOrganizationRepository) GetMembers(ctx context.Context, organizationId int64) ([]*entity.Member, error) {
+	baseQuery := `
+		SELECT organization_id, member_id, role, status, invited_by, created_at
+		FROM organization_members
+		WHERE organization_id = $1
+		ORDER BY member_id
+	`
+	rows, err := r.db.Query(ctx, baseQuery, organizationId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch organization members: %w", err)
+	}
+	defer rows.Close()
+
+	var list []*entity.Member
+	for rows.Next() {
+		m, err := scanMember(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan organization member: %w", err)
+		}
+		list = append(list, m)
+	}
+
+	// Check for row iteration errors.
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return list, nil
+}
+
+// withTransaction manages database transactions, allowing rollback on errors and commit on success.
+func (r *PgxOrganizationRepository) withTransaction(ctx context.Context, fn func(tx pgx.Tx) error) error {
+	ctx, span := tracing.Start(ctx, "organization transaction", tracing.KindInternal)
+	defer span.End()
+
+	// Start a transaction.
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		span.RecordError(err)
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	// Execute the function within the transaction.
+	if err = fn(tx); err != nil {
+		span.RecordError(err)
+		if rbErr := tx.Rollback(ctx); rbErr != nil {
+			return fmt.Errorf("transaction rollback failed: %w, original error: %v", rbErr, err)
+		}
+		return err
+	}
+
+	// Commit the transaction on success.
+	if err = tx.Commit(ctx); err != nil {
+		span.RecordError(err)
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// scanOrganization scans a single row into an Organization entity.
+func scanOrganization(row pgx.Row) (*entity.Organization, error) {
+	var id int64
+	var name, createdBy string
+	var createdAt time.Time
+	var version int32
+
+	if err := row.Scan(&id, &name, &createdBy, &createdAt, &version); err != nil {
+		return nil, err
+	}
+	return entity.GetOrganization().SetId(id).SetName(name).SetCreatedBy(createdBy).SetCreatedAt(createdAt).
+		SetVersion(version), nil
+}
+
+// scanMember scans a single row into a Member entity.
+func scanMember(row pgx.Row) (*entity.Member, error) {
+	var organizationId int64
+	var memberId, role, status, invitedBy string
+	var createdAt time.Time
+
+	if err := row.Scan(&organizationId, &memberId, &role, &status, &invitedBy, &createdAt); err != nil {
+		return nil, err
+	}
+	return entity.GetMember().SetOrganizationId(organizationId).SetMemberId(memberId).SetRole(role).
+		SetStatus(status).SetInvitedBy(invitedBy).SetCreatedAt(createdAt), nil
+}
+
+// mapError translates driver errors into the domain errors of the organization repository.
+func mapError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case uniqueViolation:
+			return repository.ErrDuplicate
+		case foreignKeyViolation:
+			return repository.ErrOrganizationNotFound
+		}
+	}
+	return err
+}
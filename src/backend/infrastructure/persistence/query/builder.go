@@ -30,6 +30,8 @@ func getQueryPool() func() *sync.Pool {
 // Builder assists in building dynamic SQL queries based on criteria.SearchCriteria.
 type Builder struct {
 	baseQuery  string   // The base SQL query (e.g., "SELECT * FROM table_name")
+	table      string   // Table selected from, when the base query is built from the selected columns
+	columns    []string // Columns selected from the table
 	conditions []string // SQL conditions to apply
 	args       []any    // Arguments for SQL placeholders
 	orderBy    string   // Sorting clause
@@ -45,6 +47,15 @@ func GetBuilder(baseQuery string) *Builder {
 	return b
 }
 
+// GetSelectBuilder retrieves a Builder object from the pool selecting the given columns from the table, so the
+// columns of the query can be projected to the ones the caller needs.
+func GetSelectBuilder(table string, columns ...string) *Builder {
+	b := queryPoolInstance().Get().(*Builder).Reset()
+	b.table = table
+	b.columns = append(b.columns, columns...)
+	return b
+}
+
 // ApplySearchCriteria adds filters from criteria.SearchCriteria to Builder.
 func (b *Builder) ApplySearchCriteria(c criteria.SearchCriteria) {
 	for _, filter := range c.Filters {
@@ -69,6 +80,9 @@ func (b *Builder) SetPagination(page, pageSize int) {
 // Build constructs the final query with applied conditions, sorting and pagination.
 func (b *Builder) Build(c criteria.SearchCriteria) (query string, args []any) {
 	query = b.baseQuery
+	if b.table != "" {
+		query = fmt.Sprintf("SELECT %s FROM %s", strings.Join(b.columns, ", "), b.table)
+	}
 
 	// Combine conditions with the specified logical operator (e.g., "AND", "OR")
 	if len(b.conditions) > 0 {
@@ -91,6 +105,8 @@ func (b *Builder) Build(c criteria.SearchCriteria) (query string, args []any) {
 
 // Reset resets the fields of the Builder.
 func (b *Builder) Reset() *Builder {
+	b.table = ""
+	b.columns = b.columns[:0]
 	b.conditions = b.conditions[:0]
 	b.args = b.args[:0]
 	b.orderBy = ""
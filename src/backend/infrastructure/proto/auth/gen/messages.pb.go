@@ -24,9 +24,10 @@ const (
 type GenerateTokenRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// issuer is the entity that issues the token (commonly "iss" in JWT claims).
+	// It identifies the API client whose role assignments determine the token permissions.
 	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
-	// scopes define the set of permissions or roles associated with the token.
-	// This can be a list of strings that represent different scopes.
+	// scopes optionally narrow the token to a subset of the permissions granted to the issuer
+	// through its roles. When empty, the token carries all effective scopes of the issuer.
 	Scopes        []string `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
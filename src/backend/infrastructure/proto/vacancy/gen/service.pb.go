@@ -28,7 +28,7 @@ var file_infrastructure_proto_vacancy_service_proto_rawDesc = []byte{
 	0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x76, 0x31, 0x1a, 0x2b, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x73,
 	0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76,
 	0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x95, 0x02, 0x0a, 0x0e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xba, 0x06, 0x0a, 0x0e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63,
 	0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x54, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61,
 	0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x12, 0x20, 0x2e, 0x76, 0x61, 0x63, 0x61,
 	0x6e, 0x63, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x63,
@@ -40,38 +40,93 @@ var file_infrastructure_proto_vacancy_service_proto_rawDesc = []byte{
 	0x65, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x1a, 0x21, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44,
 	0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x56, 0x61, 0x63,
-	0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76, 0x61, 0x63, 0x61,
-	0x6e, 0x63, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x56, 0x61, 0x63, 0x61,
-	0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2c, 0x5a,
-	0x2a, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2f, 0x67, 0x65,
-	0x6e, 0x3b, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x76,
+	0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x14, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65,
+	0x73, 0x12, 0x27, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76, 0x61, 0x63,
+	0x61, 0x6e, 0x63, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x56, 0x61, 0x63,
+	0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63,
+	0x0a, 0x14, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x61, 0x63,
+	0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56,
+	0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0f, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x61, 0x63,
+	0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x76, 0x61, 0x63,
+	0x61, 0x6e, 0x63, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x30, 0x01, 0x12, 0x48, 0x0a, 0x0a, 0x47, 0x65, 0x74,
+	0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x12, 0x1d, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x56, 0x61, 0x63, 0x61,
+	0x6e, 0x63, 0x79, 0x12, 0x54, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e,
+	0x63, 0x69, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0e, 0x50, 0x75, 0x72,
+	0x67, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x76, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x56, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x67,
+	0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x2c, 0x5a, 0x2a, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x61, 0x63, 0x61, 0x6e,
+	0x63, 0x79, 0x2f, 0x67, 0x65, 0x6e, 0x3b, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x76, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_infrastructure_proto_vacancy_service_proto_goTypes = []any{
-	(*CreateVacancyRequest)(nil),   // 0: vacancy.v1.CreateVacancyRequest
-	(*DeleteVacancyRequest)(nil),   // 1: vacancy.v1.DeleteVacancyRequest
-	(*PurgeVacanciesRequest)(nil),  // 2: vacancy.v1.PurgeVacanciesRequest
-	(*CreateVacancyResponse)(nil),  // 3: vacancy.v1.CreateVacancyResponse
-	(*DeleteVacancyResponse)(nil),  // 4: vacancy.v1.DeleteVacancyResponse
-	(*PurgeVacanciesResponse)(nil), // 5: vacancy.v1.PurgeVacanciesResponse
+	(*CreateVacancyRequest)(nil),        // 0: vacancy.v1.CreateVacancyRequest
+	(*DeleteVacancyRequest)(nil),        // 1: vacancy.v1.DeleteVacancyRequest
+	(*BatchCreateVacanciesRequest)(nil), // 2: vacancy.v1.BatchCreateVacanciesRequest
+	(*BatchUpdateVacanciesRequest)(nil), // 3: vacancy.v1.BatchUpdateVacanciesRequest
+	(*BatchDeleteVacanciesRequest)(nil), // 4: vacancy.v1.BatchDeleteVacanciesRequest
+	(*ExportVacanciesRequest)(nil),      // 5: vacancy.v1.ExportVacanciesRequest
+	(*GetVacancyRequest)(nil),           // 6: vacancy.v1.GetVacancyRequest
+	(*ListVacanciesRequest)(nil),        // 7: vacancy.v1.ListVacanciesRequest
+	(*PurgeVacanciesRequest)(nil),       // 8: vacancy.v1.PurgeVacanciesRequest
+	(*CreateVacancyResponse)(nil),       // 9: vacancy.v1.CreateVacancyResponse
+	(*DeleteVacancyResponse)(nil),       // 10: vacancy.v1.DeleteVacancyResponse
+	(*BatchVacanciesResponse)(nil),      // 11: vacancy.v1.BatchVacanciesResponse
+	(*ExportedVacancy)(nil),             // 12: vacancy.v1.ExportedVacancy
+	(*ListVacanciesResponse)(nil),       // 13: vacancy.v1.ListVacanciesResponse
+	(*PurgeVacanciesResponse)(nil),      // 14: vacancy.v1.PurgeVacanciesResponse
 }
 var file_infrastructure_proto_vacancy_service_proto_depIdxs = []int32{
-	0, // 0: vacancy.v1.VacancyService.CreateVacancy:input_type -> vacancy.v1.CreateVacancyRequest
-	1, // 1: vacancy.v1.VacancyService.DeleteVacancy:input_type -> vacancy.v1.DeleteVacancyRequest
-	2, // 2: vacancy.v1.VacancyService.PurgeVacancies:input_type -> vacancy.v1.PurgeVacanciesRequest
-	3, // 3: vacancy.v1.VacancyService.CreateVacancy:output_type -> vacancy.v1.CreateVacancyResponse
-	4, // 4: vacancy.v1.VacancyService.DeleteVacancy:output_type -> vacancy.v1.DeleteVacancyResponse
-	5, // 5: vacancy.v1.VacancyService.PurgeVacancies:output_type -> vacancy.v1.PurgeVacanciesResponse
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: vacancy.v1.VacancyService.CreateVacancy:input_type -> vacancy.v1.CreateVacancyRequest
+	1,  // 1: vacancy.v1.VacancyService.DeleteVacancy:input_type -> vacancy.v1.DeleteVacancyRequest
+	2,  // 2: vacancy.v1.VacancyService.BatchCreateVacancies:input_type -> vacancy.v1.BatchCreateVacanciesRequest
+	3,  // 3: vacancy.v1.VacancyService.BatchUpdateVacancies:input_type -> vacancy.v1.BatchUpdateVacanciesRequest
+	4,  // 4: vacancy.v1.VacancyService.BatchDeleteVacancies:input_type -> vacancy.v1.BatchDeleteVacanciesRequest
+	5,  // 5: vacancy.v1.VacancyService.ExportVacancies:input_type -> vacancy.v1.ExportVacanciesRequest
+	6,  // 6: vacancy.v1.VacancyService.GetVacancy:input_type -> vacancy.v1.GetVacancyRequest
+	7,  // 7: vacancy.v1.VacancyService.ListVacancies:input_type -> vacancy.v1.ListVacanciesRequest
+	8,  // 8: vacancy.v1.VacancyService.PurgeVacancies:input_type -> vacancy.v1.PurgeVacanciesRequest
+	9,  // 9: vacancy.v1.VacancyService.CreateVacancy:output_type -> vacancy.v1.CreateVacancyResponse
+	10, // 10: vacancy.v1.VacancyService.DeleteVacancy:output_type -> vacancy.v1.DeleteVacancyResponse
+	11, // 11: vacancy.v1.VacancyService.BatchCreateVacancies:output_type -> vacancy.v1.BatchVacanciesResponse
+	11, // 12: vacancy.v1.VacancyService.BatchUpdateVacancies:output_type -> vacancy.v1.BatchVacanciesResponse
+	11, // 13: vacancy.v1.VacancyService.BatchDeleteVacancies:output_type -> vacancy.v1.BatchVacanciesResponse
+	12, // 14: vacancy.v1.VacancyService.ExportVacancies:output_type -> vacancy.v1.ExportedVacancy
+	12, // 15: vacancy.v1.VacancyService.GetVacancy:output_type -> vacancy.v1.ExportedVacancy
+	13, // 16: vacancy.v1.VacancyService.ListVacancies:output_type -> vacancy.v1.ListVacanciesResponse
+	14, // 17: vacancy.v1.VacancyService.PurgeVacancies:output_type -> vacancy.v1.PurgeVacanciesResponse
+	9,  // [9:18] is the sub-list for method output_type
+	0,  // [0:9] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_infrastructure_proto_vacancy_service_proto_init() }
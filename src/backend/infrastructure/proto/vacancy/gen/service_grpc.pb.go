@@ -19,9 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	VacancyService_CreateVacancy_FullMethodName  = "/vacancy.v1.VacancyService/CreateVacancy"
-	VacancyService_DeleteVacancy_FullMethodName  = "/vacancy.v1.VacancyService/DeleteVacancy"
-	VacancyService_PurgeVacancies_FullMethodName = "/vacancy.v1.VacancyService/PurgeVacancies"
+	VacancyService_CreateVacancy_FullMethodName        = "/vacancy.v1.VacancyService/CreateVacancy"
+	VacancyService_DeleteVacancy_FullMethodName        = "/vacancy.v1.VacancyService/DeleteVacancy"
+	VacancyService_BatchCreateVacancies_FullMethodName = "/vacancy.v1.VacancyService/BatchCreateVacancies"
+	VacancyService_BatchUpdateVacancies_FullMethodName = "/vacancy.v1.VacancyService/BatchUpdateVacancies"
+	VacancyService_BatchDeleteVacancies_FullMethodName = "/vacancy.v1.VacancyService/BatchDeleteVacancies"
+	VacancyService_ExportVacancies_FullMethodName      = "/vacancy.v1.VacancyService/ExportVacancies"
+	VacancyService_GetVacancy_FullMethodName           = "/vacancy.v1.VacancyService/GetVacancy"
+	VacancyService_ListVacancies_FullMethodName        = "/vacancy.v1.VacancyService/ListVacancies"
+	VacancyService_PurgeVacancies_FullMethodName       = "/vacancy.v1.VacancyService/PurgeVacancies"
 )
 
 // VacancyServiceClient is the client API for VacancyService service.
@@ -34,6 +40,20 @@ type VacancyServiceClient interface {
 	CreateVacancy(ctx context.Context, in *CreateVacancyRequest, opts ...grpc.CallOption) (*CreateVacancyResponse, error)
 	// DeleteVacancy deletes an existing job vacancy by its ID.
 	DeleteVacancy(ctx context.Context, in *DeleteVacancyRequest, opts ...grpc.CallOption) (*DeleteVacancyResponse, error)
+	// BatchCreateVacancies creates job vacancies in a batch, returning the result of every item.
+	BatchCreateVacancies(ctx context.Context, in *BatchCreateVacanciesRequest, opts ...grpc.CallOption) (*BatchVacanciesResponse, error)
+	// BatchUpdateVacancies updates the given fields of job vacancies in a batch, returning the result of every item.
+	BatchUpdateVacancies(ctx context.Context, in *BatchUpdateVacanciesRequest, opts ...grpc.CallOption) (*BatchVacanciesResponse, error)
+	// BatchDeleteVacancies deletes job vacancies in a batch, returning the result of every item.
+	BatchDeleteVacancies(ctx context.Context, in *BatchDeleteVacanciesRequest, opts ...grpc.CallOption) (*BatchVacanciesResponse, error)
+	// ExportVacancies streams the job vacancies matching the filters, one message per vacancy, without a limit on
+	// their number.
+	ExportVacancies(ctx context.Context, in *ExportVacanciesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportedVacancy], error)
+	// GetVacancy retrieves a job vacancy by its ID, with the fields of the read mask set.
+	GetVacancy(ctx context.Context, in *GetVacancyRequest, opts ...grpc.CallOption) (*ExportedVacancy, error)
+	// ListVacancies lists the job vacancies matching the filters, a page at a time, with the fields of the read mask
+	// set.
+	ListVacancies(ctx context.Context, in *ListVacanciesRequest, opts ...grpc.CallOption) (*ListVacanciesResponse, error)
 	// PurgeVacancies removes all job vacancies from the database.
 	PurgeVacancies(ctx context.Context, in *PurgeVacanciesRequest, opts ...grpc.CallOption) (*PurgeVacanciesResponse, error)
 }
@@ -66,6 +86,75 @@ func (c *vacancyServiceClient) DeleteVacancy(ctx context.Context, in *DeleteVaca
 	return out, nil
 }
 
+func (c *vacancyServiceClient) BatchCreateVacancies(ctx context.Context, in *BatchCreateVacanciesRequest, opts ...grpc.CallOption) (*BatchVacanciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchVacanciesResponse)
+	err := c.cc.Invoke(ctx, VacancyService_BatchCreateVacancies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vacancyServiceClient) BatchUpdateVacancies(ctx context.Context, in *BatchUpdateVacanciesRequest, opts ...grpc.CallOption) (*BatchVacanciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchVacanciesResponse)
+	err := c.cc.Invoke(ctx, VacancyService_BatchUpdateVacancies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vacancyServiceClient) BatchDeleteVacancies(ctx context.Context, in *BatchDeleteVacanciesRequest, opts ...grpc.CallOption) (*BatchVacanciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchVacanciesResponse)
+	err := c.cc.Invoke(ctx, VacancyService_BatchDeleteVacancies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vacancyServiceClient) ExportVacancies(ctx context.Context, in *ExportVacanciesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportedVacancy], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VacancyService_ServiceDesc.Streams[0], VacancyService_ExportVacancies_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportVacanciesRequest, ExportedVacancy]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VacancyService_ExportVacanciesClient = grpc.ServerStreamingClient[ExportedVacancy]
+
+func (c *vacancyServiceClient) GetVacancy(ctx context.Context, in *GetVacancyRequest, opts ...grpc.CallOption) (*ExportedVacancy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportedVacancy)
+	err := c.cc.Invoke(ctx, VacancyService_GetVacancy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vacancyServiceClient) ListVacancies(ctx context.Context, in *ListVacanciesRequest, opts ...grpc.CallOption) (*ListVacanciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVacanciesResponse)
+	err := c.cc.Invoke(ctx, VacancyService_ListVacancies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vacancyServiceClient) PurgeVacancies(ctx context.Context, in *PurgeVacanciesRequest, opts ...grpc.CallOption) (*PurgeVacanciesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(PurgeVacanciesResponse)
@@ -86,6 +175,20 @@ type VacancyServiceServer interface {
 	CreateVacancy(context.Context, *CreateVacancyRequest) (*CreateVacancyResponse, error)
 	// DeleteVacancy deletes an existing job vacancy by its ID.
 	DeleteVacancy(context.Context, *DeleteVacancyRequest) (*DeleteVacancyResponse, error)
+	// BatchCreateVacancies creates job vacancies in a batch, returning the result of every item.
+	BatchCreateVacancies(context.Context, *BatchCreateVacanciesRequest) (*BatchVacanciesResponse, error)
+	// BatchUpdateVacancies updates the given fields of job vacancies in a batch, returning the result of every item.
+	BatchUpdateVacancies(context.Context, *BatchUpdateVacanciesRequest) (*BatchVacanciesResponse, error)
+	// BatchDeleteVacancies deletes job vacancies in a batch, returning the result of every item.
+	BatchDeleteVacancies(context.Context, *BatchDeleteVacanciesRequest) (*BatchVacanciesResponse, error)
+	// ExportVacancies streams the job vacancies matching the filters, one message per vacancy, without a limit on
+	// their number.
+	ExportVacancies(*ExportVacanciesRequest, grpc.ServerStreamingServer[ExportedVacancy]) error
+	// GetVacancy retrieves a job vacancy by its ID, with the fields of the read mask set.
+	GetVacancy(context.Context, *GetVacancyRequest) (*ExportedVacancy, error)
+	// ListVacancies lists the job vacancies matching the filters, a page at a time, with the fields of the read mask
+	// set.
+	ListVacancies(context.Context, *ListVacanciesRequest) (*ListVacanciesResponse, error)
 	// PurgeVacancies removes all job vacancies from the database.
 	PurgeVacancies(context.Context, *PurgeVacanciesRequest) (*PurgeVacanciesResponse, error)
 	mustEmbedUnimplementedVacancyServiceServer()
@@ -104,6 +207,24 @@ func (UnimplementedVacancyServiceServer) CreateVacancy(context.Context, *CreateV
 func (UnimplementedVacancyServiceServer) DeleteVacancy(context.Context, *DeleteVacancyRequest) (*DeleteVacancyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteVacancy not implemented")
 }
+func (UnimplementedVacancyServiceServer) BatchCreateVacancies(context.Context, *BatchCreateVacanciesRequest) (*BatchVacanciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateVacancies not implemented")
+}
+func (UnimplementedVacancyServiceServer) BatchUpdateVacancies(context.Context, *BatchUpdateVacanciesRequest) (*BatchVacanciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateVacancies not implemented")
+}
+func (UnimplementedVacancyServiceServer) BatchDeleteVacancies(context.Context, *BatchDeleteVacanciesRequest) (*BatchVacanciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteVacancies not implemented")
+}
+func (UnimplementedVacancyServiceServer) ExportVacancies(*ExportVacanciesRequest, grpc.ServerStreamingServer[ExportedVacancy]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportVacancies not implemented")
+}
+func (UnimplementedVacancyServiceServer) GetVacancy(context.Context, *GetVacancyRequest) (*ExportedVacancy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVacancy not implemented")
+}
+func (UnimplementedVacancyServiceServer) ListVacancies(context.Context, *ListVacanciesRequest) (*ListVacanciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVacancies not implemented")
+}
 func (UnimplementedVacancyServiceServer) PurgeVacancies(context.Context, *PurgeVacanciesRequest) (*PurgeVacanciesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PurgeVacancies not implemented")
 }
@@ -164,6 +285,107 @@ func _VacancyService_DeleteVacancy_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VacancyService_BatchCreateVacancies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateVacanciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).BatchCreateVacancies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_BatchCreateVacancies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).BatchCreateVacancies(ctx, req.(*BatchCreateVacanciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VacancyService_BatchUpdateVacancies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateVacanciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).BatchUpdateVacancies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_BatchUpdateVacancies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).BatchUpdateVacancies(ctx, req.(*BatchUpdateVacanciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VacancyService_BatchDeleteVacancies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteVacanciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).BatchDeleteVacancies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_BatchDeleteVacancies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).BatchDeleteVacancies(ctx, req.(*BatchDeleteVacanciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VacancyService_ExportVacancies_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportVacanciesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VacancyServiceServer).ExportVacancies(m, &grpc.GenericServerStream[ExportVacanciesRequest, ExportedVacancy]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VacancyService_ExportVacanciesServer = grpc.ServerStreamingServer[ExportedVacancy]
+
+func _VacancyService_GetVacancy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVacancyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).GetVacancy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_GetVacancy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).GetVacancy(ctx, req.(*GetVacancyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VacancyService_ListVacancies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVacanciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).ListVacancies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_ListVacancies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).ListVacancies(ctx, req.(*ListVacanciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VacancyService_PurgeVacancies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PurgeVacanciesRequest)
 	if err := dec(in); err != nil {
@@ -197,11 +419,37 @@ var VacancyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteVacancy",
 			Handler:    _VacancyService_DeleteVacancy_Handler,
 		},
+		{
+			MethodName: "BatchCreateVacancies",
+			Handler:    _VacancyService_BatchCreateVacancies_Handler,
+		},
+		{
+			MethodName: "BatchUpdateVacancies",
+			Handler:    _VacancyService_BatchUpdateVacancies_Handler,
+		},
+		{
+			MethodName: "BatchDeleteVacancies",
+			Handler:    _VacancyService_BatchDeleteVacancies_Handler,
+		},
+		{
+			MethodName: "GetVacancy",
+			Handler:    _VacancyService_GetVacancy_Handler,
+		},
+		{
+			MethodName: "ListVacancies",
+			Handler:    _VacancyService_ListVacancies_Handler,
+		},
 		{
 			MethodName: "PurgeVacancies",
 			Handler:    _VacancyService_PurgeVacancies_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportVacancies",
+			Handler:       _VacancyService_ExportVacancies_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "infrastructure/proto/vacancy/service.proto",
 }
@@ -0,0 +1,85 @@
+package ratelimit
+
+import (
+	"application/ratelimit"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nats-io/nats.go"
+)
+
+const (
+	// kvTTL is the time a bucket is kept after its last update. Buckets are full again well before, unless a
+	// policy takes longer to refill, in which case the bucket is reset early.
+	kvTTL = 7 * 24 * time.Hour
+	// maxAttempts is the number of times an update conflicting with a concurrent one is retried.
+	maxAttempts = 5
+)
+
+// NatsKvStore is a ratelimit.Store keeping the buckets in a NATS JetStream key-value bucket, enforcing the limits
+// across all API instances. Concurrent updates of a bucket are detected by its revision and retried.
+type NatsKvStore struct {
+	kv nats.KeyValue
+}
+
+// NewNatsKvStore opens the key-value bucket with the given name, creating it if it does not exist.
+func NewNatsKvStore(nc *nats.Conn, bucket string) (*NatsKvStore, error) {
+	js, err := nc.JetStream()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open JetStream context: %w", err)
+	}
+	kv, err := js.KeyValue(bucket)
+	if errors.Is(err, nats.ErrBucketNotFound) {
+		kv, err = js.CreateKeyValue(&nats.KeyValueConfig{Bucket: bucket, History: 1, TTL: kvTTL})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open key-value bucket %s: %w", bucket, err)
+	}
+	return &NatsKvStore{kv: kv}, nil
+}
+
+// Take takes a token from the bucket of the key under the policy at the given time.
+func (s *NatsKvStore) Take(
+	_ context.Context, key string, policy ratelimit.Policy, now time.Time,
+) (ratelimit.Decision, error) {
+	// Keys may only hold a restricted set of characters, unlike subjects and IP addresses
+	kvKey := base64.RawURLEncoding.EncodeToString([]byte(key))
+
+	for range maxAttempts {
+		var b ratelimit.Bucket
+		var revision uint64
+		entry, err := s.kv.Get(kvKey)
+		switch {
+		case errors.Is(err, nats.ErrKeyNotFound):
+		case err != nil:
+			return ratelimit.Decision{}, fmt.Errorf("failed to get rate limit bucket: %w", err)
+		default:
+			if err = json.Unmarshal(entry.Value(), &b); err != nil {
+				return ratelimit.Decision{}, fmt.Errorf("failed to decode rate limit bucket: %w", err)
+			}
+			revision = entry.Revision()
+		}
+
+		d := policy.Take(&b, now)
+		value, err := json.Marshal(b)
+		if err != nil {
+			return ratelimit.Decision{}, fmt.Errorf("failed to encode rate limit bucket: %w", err)
+		}
+		if revision == 0 {
+			_, err = s.kv.Create(kvKey, value)
+		} else {
+			_, err = s.kv.Update(kvKey, value, revision)
+		}
+		if err == nil {
+			return d, nil
+		}
+		if !errors.Is(err, nats.ErrKeyExists) {
+			return ratelimit.Decision{}, fmt.Errorf("failed to update rate limit bucket: %w", err)
+		}
+	}
+	return ratelimit.Decision{}, errors.New("failed to update rate limit bucket: too many concurrent updates")
+}
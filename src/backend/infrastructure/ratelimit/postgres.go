@@ -0,0 +1,80 @@
+package ratelimit
+
+import (
+	"application/ratelimit"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PgxStore is a ratelimit.Store keeping the buckets in Postgres, enforcing the limits across all API instances.
+// Full buckets are deleted at most once per interval.
+type PgxStore struct {
+	db        *pgxpool.Pool // Connection pool for database interactions.
+	interval  time.Duration
+	mu        sync.Mutex // Guards lastSweep.
+	lastSweep time.Time
+}
+
+// NewPgxStore initializes a new instance of PgxStore with a database connection pool.
+func NewPgxStore(db *pgxpool.Pool, interval time.Duration) *PgxStore {
+	return &PgxStore{db: db, interval: interval}
+}
+
+// Take takes a token from the bucket of the key under the policy at the given time. The bucket is locked for the
+// duration of the update, so concurrent requests of a client are counted once each; only the first requests of a
+// client creating its bucket concurrently may be counted as one.
+func (s *PgxStore) Take(
+	ctx context.Context, key string, policy ratelimit.Policy, now time.Time,
+) (ratelimit.Decision, error) {
+	selectQuery := `
+		SELECT tokens, updated_at
+		FROM rate_limit_buckets
+		WHERE key = $1
+		FOR UPDATE
+	`
+	upsertQuery := `
+		INSERT INTO rate_limit_buckets (key, tokens, updated_at, full_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (key) DO UPDATE
+		SET tokens = EXCLUDED.tokens, updated_at = EXCLUDED.updated_at, full_at = EXCLUDED.full_at
+	`
+
+	var d ratelimit.Decision
+	err := pgx.BeginFunc(ctx, s.db, func(tx pgx.Tx) error {
+		var b ratelimit.Bucket
+		err := tx.QueryRow(ctx, selectQuery, key).Scan(&b.Tokens, &b.UpdatedAt)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+
+		d = policy.Take(&b, now)
+		_, err = tx.Exec(ctx, upsertQuery, key, b.Tokens, b.UpdatedAt, b.FullAt(policy))
+		return err
+	})
+	if err != nil {
+		return ratelimit.Decision{}, fmt.Errorf("failed to update rate limit bucket: %w", err)
+	}
+	return d, s.sweep(ctx, now)
+}
+
+// sweep deletes the full buckets if the sweep interval has elapsed.
+func (s *PgxStore) sweep(ctx context.Context, now time.Time) error {
+	s.mu.Lock()
+	if now.Sub(s.lastSweep) < s.interval {
+		s.mu.Unlock()
+		return nil
+	}
+	s.lastSweep = now
+	s.mu.Unlock()
+
+	if _, err := s.db.Exec(ctx, `DELETE FROM rate_limit_buckets WHERE full_at <= $1`, now); err != nil {
+		return fmt.Errorf("failed to delete full rate limit buckets: %w", err)
+	}
+	return nil
+}
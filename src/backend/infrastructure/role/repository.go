@@ -0,0 +1,289 @@
+package role
+
+import (
+	"application/tracing"
+	"context"
+	"domain/role/entity"
+	"domain/role/repository"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	uniqueViolation     = "23505" // PostgreSQL error code for unique constraint violations.
+	foreignKeyViolation = "23503" // PostgreSQL error code for foreign key violations.
+)
+
+// PgxRoleRepository implements the RoleRepository interface using pgx.
+type PgxRoleRepository struct {
+	db *pgxpool.Pool // Connection pool for database interactions.
+}
+
+// NewPgxRoleRepository initializes a new instance of PgxRoleRepository with a database connection pool.
+func NewPgxRoleRepository(db *pgxpool.Pool) *PgxRoleRepository {
+	return &PgxRoleRepository{db: db}
+}
+
+// SaveRole inserts a new role into the database and retrieves the generated fields.
+func (r *PgxRoleRepository) SaveRole(ctx context.Context, role *entity.Role) error {
+	baseQuery := `
+		INSERT INTO roles (name, description, scopes)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_at, updated_at, version
+	`
+	args := []any{role.GetName(), role.GetDescription(), role.GetScopes()}
+
+	return r.withTransaction(ctx, func(tx pgx.Tx) error {
+		var id int64
+		var createdAt, updatedAt time.Time
+		var version int32
+
+		if err := tx.QueryRow(ctx, baseQuery, args...).Scan(&id, &createdAt, &updatedAt, &version); err != nil {
+			return fmt.Errorf("failed to save role: %w", mapError(err))
+		}
+		role.SetId(id).SetCreatedAt(createdAt).SetUpdatedAt(updatedAt).SetVersion(version)
+		return nil
+	})
+}
+
+// GetRole retrieves a role from the database by its ID.
+func (r *PgxRoleRepository) GetRole(ctx context.Context, id int64) (*entity.Role, error) {
+	baseQuery := `SELECT id, name, description, scopes, created_at, updated_at, version FROM roles WHERE id = $1`
+
+	role, err := scanRole(r.db.QueryRow(ctx, baseQuery, id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch role: %w", mapError(err))
+	}
+	return role, nil
+}
+
+// UpdateRole modifies an existing role in the database, using optimistic concurrency control.
+func (r *PgxRoleRepository) UpdateRole(ctx context.Context, role *entity.Role) error {
+	baseQuery := `
+		UPDATE roles
+		SET name = $1, description = $2, scopes = $3, updated_at = NOW(), version = version + 1
+		WHERE id = $4 AND version = $5
+		RETURNING updated_at, version
+	`
+	args := []any{role.GetName(), role.GetDescription(), role.GetScopes(), role.GetId(), role.GetVersion()}
+
+	return r.withTransaction(ctx, func(tx pgx.Tx) error {
+		var updatedAt time.Time
+		var version int32
+
+		err := tx.QueryRow(ctx, baseQuery, args...).Scan(&updatedAt, &version)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("failed to update role: %w", repository.ErrEditConflict)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to update role: %w", mapError(err))
+		}
+		role.SetUpdatedAt(updatedAt).SetVersion(version)
+		return nil
+	})
+}
+
+// GetRoles retrieves all roles from the database ordered by name.
+func (r *PgxRoleRepository) GetRoles(ctx context.Context) ([]*entity.Role, error) {
+	baseQuery := `SELECT id, name, description, scopes, created_at, updated_at, version FROM roles ORDER BY name`
+	rows, err := r.db.Query(ctx, baseQuery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch roles: %w", err)
+	}
+	defer rows.Close()
+
+	var list []*entity.Role
+	for rows.Next() {
+		role, err := scanRole(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan role: %w", err)
+		}
+		list = append(list, role)
+	}
+
+	// Check for row iteration errors.
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return list, nil
+}
+
+// SaveAssignment grants a role to a subject and retrieves the generated fields.
+func (r *PgxRoleRepository) SaveAssignment(ctx context.Context, a *entity.Assignment) error {
+	baseQuery := `
+		WITH inserted AS (
+			INSERT INTO role_assignments (subject_type, subject_id, role_id)
+			VALUES ($1, $2, $3)
+			RETURNING id, role_id, created_at
+		)
+		SELECT inserted.id, roles.name, inserted.created_at
+		FROM inserted JOIN roles ON roles.id = inserted.role_id
+	`
+	args := []any{a.GetSubjectType(), a.GetSubjectId(), a.GetRoleId()}
+
+	return r.withTransaction(ctx, func(tx pgx.Tx) error {
+		var id int64
+		var roleName string
+		var createdAt time.Time
+
+		if err := tx.QueryRow(ctx, baseQuery, args...).Scan(&id, &roleName, &createdAt); err != nil {
+			return fmt.Errorf("failed to save role assignment: %w", mapError(err))
+		}
+		a.SetId(id).SetRoleName(roleName).SetCreatedAt(createdAt)
+		return nil
+	})
+}
+
+// DeleteAssignment revokes a role assignment by its ID and returns the revoked assignment.
+func (r *PgxRoleRepository) DeleteAssignment(ctx context.Context, id int64) (*entity.Assignment, error) {
+	baseQuery := `
+		WITH deleted AS (
+			DELETE FROM role_assignments WHERE id = $1
+			RETURNING id, subject_type, subject_id, role_id, created_at
+		)
+		SELECT deleted.id, deleted.subject_type, deleted.subject_id, deleted.role_id, roles.name, deleted.created_at
+		FROM deleted JOIN roles ON roles.id = deleted.role_id
+	`
+
+	var a *entity.Assignment
+	err := r.withTransaction(ctx, func(tx pgx.Tx) error {
+		var err error
+		if a, err = scanAssignment(tx.QueryRow(ctx, baseQuery, id)); err != nil {
+			return fmt.Errorf("failed to delete role assignment: %w", mapError(err))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// GetAssignments retrieves role assignments, optionally filtered by subject.
+func (r *PgxRoleRepository) GetAssignments(
+	ctx context.Context,
+	subjectType, subjectId string,
+) ([]*entity.Assignment, error) {
+	baseQuery := `
+		SELECT a.id, a.subject_type, a.subject_id, a.role_id, roles.name, a.created_at
+		FROM role_assignments a JOIN roles ON roles.id = a.role_id
+		WHERE ($1 = '' OR a.subject_type = $1) AND ($2 = '' OR a.subject_id = $2)
+		ORDER BY a.subject_type, a.subject_id, roles.name
+	`
+	rows, err := r.db.Query(ctx, baseQuery, subjectType, subjectId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch role assignments: %w", err)
+	}
+	defer rows.Close()
+
+	var list []*entity.Assignment
+	for rows.Next() {
+		a, err := scanAssignment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan role assignment: %w", err)
+		}
+		list = append(list, a)
+	}
+
+	// Check for row iteration errors.
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return list, nil
+}
+
+// GetScopes returns the union of the scopes granted to a subject by all of its roles.
+func (r *PgxRoleRepository) GetScopes(ctx context.Context, subjectType, subjectId string) ([]string, error) {
+	baseQuery := `
+		SELECT COALESCE(array_agg(DISTINCT scope ORDER BY scope), '{}')
+		FROM role_assignments a
+		JOIN roles ON roles.id = a.role_id
+		CROSS JOIN LATERAL unnest(roles.scopes) AS scope
+		WHERE a.subject_type = $1 AND a.subject_id = $2
+	`
+
+	var scopes []string
+	if err := r.db.QueryRow(ctx, baseQuery, subjectType, subjectId).Scan(&scopes); err != nil {
+		return nil, fmt.Errorf("failed to fetch scopes: %w", err)
+	}
+	return scopes, nil
+}
+
+// withTransaction manages database transactions, allowing rollback on errors and commit on success.
+func (r *PgxRoleRepository) withTransaction(ctx context.Context, fn func(tx pgx.Tx) error) error {
+	ctx, span := tracing.Start(ctx, "role transaction", tracing.KindInternal)
+	defer span.End()
+
+	// Start a transaction.
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		span.RecordError(err)
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	// Execute the function within the transaction.
+	if err = fn(tx); err != nil {
+		span.RecordError(err)
+		if rbErr := tx.Rollback(ctx); rbErr != nil {
+			return fmt.Errorf("transaction rollback failed: %w, original error: %v", rbErr, err)
+		}
+		return err
+	}
+
+	// Commit the transaction on success.
+	if err = tx.Commit(ctx); err != nil {
+		span.RecordError(err)
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// scanRole scans a single row into a Role entity.
+func scanRole(row pgx.Row) (*entity.Role, error) {
+	var id int64
+	var name, description string
+	var scopes []string
+	var createdAt, updatedAt time.Time
+	var version int32
+
+	if err := row.Scan(&id, &name, &description, &scopes, &createdAt, &updatedAt, &version); err != nil {
+		return nil, err
+	}
+	return entity.GetRole().SetId(id).SetName(name).SetDescription(description).SetScopes(scopes).
+		SetCreatedAt(createdAt).SetUpdatedAt(updatedAt).SetVersion(version), nil
+}
+
+// scanAssignment scans a single row into an Assignment entity.
+func scanAssignment(row pgx.Row) (*entity.Assignment, error) {
+	var id, roleId int64
+	var subjectType, subjectId, roleName string
+	var createdAt time.Time
+
+	if err := row.Scan(&id, &subjectType, &subjectId, &roleId, &roleName, &createdAt); err != nil {
+		return nil, err
+	}
+	return entity.GetAssignment().SetId(id).SetSubjectType(subjectType).SetSubjectId(subjectId).
+		SetRoleId(roleId).SetRoleName(roleName).SetCreatedAt(createdAt), nil
+}
+
+// mapError translates driver errors into the domain errors of the role repository.
+func mapError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return repository.ErrRoleNotFound
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case uniqueViolation:
+			return repository.ErrDuplicate
+		case foreignKeyViolation:
+			return repository.ErrRoleNotFound
+		}
+	}
+	return err
+}
@@ -0,0 +1,127 @@
+package signing
+
+import (
+	"context"
+	"domain/signing/entity"
+	"domain/signing/repository"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PgxKeyRepository implements the KeyRepository interface using pgx.
+type PgxKeyRepository struct {
+	db *pgxpool.Pool // Connection pool for database interactions.
+}
+
+// NewPgxKeyRepository initializes a new instance of PgxKeyRepository with a database connection pool.
+func NewPgxKeyRepository(db *pgxpool.Pool) *PgxKeyRepository {
+	return &PgxKeyRepository{db: db}
+}
+
+// Save inserts a newly issued key into the database and retrieves the generated ID and issue timestamp.
+func (r *PgxKeyRepository) Save(ctx context.Context, k *entity.Key) error {
+	baseQuery := `
+		INSERT INTO signing_keys (key_id, client_id, secret)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_at
+	`
+
+	var id int64
+	var createdAt time.Time
+	if err := r.db.QueryRow(ctx, baseQuery, k.GetKeyId(), k.GetClientId(), k.GetSecret()).
+		Scan(&id, &createdAt); err != nil {
+		return fmt.Errorf("failed to save signing key: %w", err)
+	}
+	k.SetId(id).SetCreatedAt(createdAt)
+	return nil
+}
+
+// GetActive retrieves an active key, including its secret, by its public identifier.
+func (r *PgxKeyRepository) GetActive(ctx context.Context, keyId string) (*entity.Key, error) {
+	baseQuery := `
+		SELECT id, key_id, client_id, secret, created_at, revoked_at
+		FROM signing_keys
+		WHERE key_id = $1 AND revoked_at IS NULL
+	`
+
+	k, err := scanKey(r.db.QueryRow(ctx, baseQuery, keyId))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("failed to fetch signing key: %w", repository.ErrKeyNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch signing key: %w", err)
+	}
+	return k, nil
+}
+
+// GetList retrieves the keys issued to an API client, or to every client if clientId is empty.
+// The secrets are not selected.
+func (r *PgxKeyRepository) GetList(ctx context.Context, clientId string) ([]*entity.Key, error) {
+	baseQuery := `
+		SELECT id, key_id, client_id, '', created_at, revoked_at
+		FROM signing_keys
+		WHERE $1 = '' OR client_id = $1
+		ORDER BY id
+	`
+	rows, err := r.db.Query(ctx, baseQuery, clientId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch signing keys: %w", err)
+	}
+	defer rows.Close()
+
+	var list []*entity.Key
+	for rows.Next() {
+		k, err := scanKey(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan signing key: %w", err)
+		}
+		list = append(list, k)
+	}
+
+	// Check for row iteration errors.
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return list, nil
+}
+
+// Revoke marks an active key as revoked and returns it without its secret.
+func (r *PgxKeyRepository) Revoke(ctx context.Context, id int64) (*entity.Key, error) {
+	baseQuery := `
+		UPDATE signing_keys
+		SET revoked_at = NOW()
+		WHERE id = $1 AND revoked_at IS NULL
+		RETURNING id, key_id, client_id, '', created_at, revoked_at
+	`
+
+	k, err := scanKey(r.db.QueryRow(ctx, baseQuery, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("failed to revoke signing key: %w", repository.ErrKeyNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to revoke signing key: %w", err)
+	}
+	return k, nil
+}
+
+// scanKey scans a single row into a Key entity.
+func scanKey(row pgx.Row) (*entity.Key, error) {
+	var id int64
+	var keyId, clientId, secret string
+	var createdAt time.Time
+	var revokedAt *time.Time
+
+	if err := row.Scan(&id, &keyId, &clientId, &secret, &createdAt, &revokedAt); err != nil {
+		return nil, err
+	}
+
+	k := entity.GetKey().SetId(id).SetKeyId(keyId).SetClientId(clientId).SetSecret(secret).SetCreatedAt(createdAt)
+	if revokedAt != nil {
+		k.SetRevokedAt(*revokedAt)
+	}
+	return k, nil
+}
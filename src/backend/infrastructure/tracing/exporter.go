@@ -0,0 +1,34 @@
+package tracing
+
+import (
+	"application/tracing"
+	"fmt"
+)
+
+// Exporter kinds selectable in the configuration.
+const (
+	ExporterNone  = ""      // Spans are propagated but not recorded.
+	ExporterJsonl = "jsonl" // Spans are appended to a JSON-lines file.
+	ExporterOtlp  = "otlp"  // Spans are sent to an OpenTelemetry collector over OTLP/HTTP.
+)
+
+// NewExporter creates the exporter of the given kind, writing to the file or sending to the endpoint.
+// It returns a nil exporter for ExporterNone.
+func NewExporter(kind, file, endpoint string) (tracing.Exporter, error) {
+	switch kind {
+	case ExporterNone:
+		return nil, nil
+	case ExporterJsonl:
+		if file == "" {
+			return nil, fmt.Errorf("tracing: the %s exporter requires a file", kind)
+		}
+		return NewJsonlExporter(file)
+	case ExporterOtlp:
+		if endpoint == "" {
+			return nil, fmt.Errorf("tracing: the %s exporter requires an endpoint", kind)
+		}
+		return NewOtlpExporter(endpoint), nil
+	default:
+		return nil, fmt.Errorf("tracing: unsupported exporter %q; must be %q or %q", kind, ExporterJsonl, ExporterOtlp)
+	}
+}
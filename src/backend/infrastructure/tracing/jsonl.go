@@ -0,0 +1,44 @@
+package tracing
+
+import (
+	"application/tracing"
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// JsonlExporter appends spans to a file, one JSON object per line.
+type JsonlExporter struct {
+	file *os.File
+}
+
+// NewJsonlExporter opens, or creates, the file the spans are appended to.
+func NewJsonlExporter(path string) (*JsonlExporter, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open span file: %w", err)
+	}
+	return &JsonlExporter{file: file}, nil
+}
+
+// Export appends the spans to the file.
+func (e *JsonlExporter) Export(_ context.Context, spans []tracing.SpanData) error {
+	w := bufio.NewWriter(e.file)
+	encoder := json.NewEncoder(w)
+	for i := range spans {
+		if err := encoder.Encode(&spans[i]); err != nil {
+			return fmt.Errorf("failed to encode span: %w", err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("failed to write spans: %w", err)
+	}
+	return nil
+}
+
+// Shutdown closes the file.
+func (e *JsonlExporter) Shutdown(context.Context) error {
+	return e.file.Close()
+}
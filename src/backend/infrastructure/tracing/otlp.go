@@ -0,0 +1,138 @@
+package tracing
+
+import (
+	"application/tracing"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// otlpKinds maps span kinds to their OTLP enumeration values.
+var otlpKinds = map[tracing.SpanKind]int{
+	tracing.KindInternal: 1,
+	tracing.KindServer:   2,
+	tracing.KindClient:   3,
+	tracing.KindProducer: 4,
+}
+
+// otlpStatusError is the OTLP status code of failed spans.
+const otlpStatusError = 2
+
+// OtlpExporter sends spans to an OpenTelemetry collector using the OTLP/HTTP protocol with JSON encoding.
+type OtlpExporter struct {
+	endpoint string       // URL of the traces endpoint, e.g., "http://collector:4318/v1/traces".
+	client   *http.Client // HTTP client sending the requests.
+}
+
+// NewOtlpExporter creates an OtlpExporter sending spans to the given traces endpoint.
+func NewOtlpExporter(endpoint string) *OtlpExporter {
+	return &OtlpExporter{endpoint: endpoint, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+// Export sends the spans to the collector, grouped by the service that recorded them.
+func (e *OtlpExporter) Export(ctx context.Context, spans []tracing.SpanData) error {
+	body, err := json.Marshal(otlpRequest(spans))
+	if err != nil {
+		return fmt.Errorf("failed to encode spans: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create export request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send spans: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("collector responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Shutdown releases the idle connections to the collector.
+func (e *OtlpExporter) Shutdown(context.Context) error {
+	e.client.CloseIdleConnections()
+	return nil
+}
+
+// otlpRequest builds the body of an OTLP ExportTraceServiceRequest in its JSON encoding.
+func otlpRequest(spans []tracing.SpanData) map[string]any {
+	byService := make(map[string][]any)
+	var services []string
+	for i := range spans {
+		s := &spans[i]
+		if _, ok := byService[s.Service]; !ok {
+			services = append(services, s.Service)
+		}
+		byService[s.Service] = append(byService[s.Service], otlpSpan(s))
+	}
+
+	resourceSpans := make([]any, 0, len(services))
+	for _, service := range services {
+		resourceSpans = append(resourceSpans, map[string]any{
+			"resource": map[string]any{
+				"attributes": otlpAttributes(map[string]any{"service.name": service}),
+			},
+			"scopeSpans": []any{map[string]any{
+				"scope": map[string]any{"name": "pulse-finder"},
+				"spans": byService[service],
+			}},
+		})
+	}
+	return map[string]any{"resourceSpans": resourceSpans}
+}
+
+// otlpSpan converts a span to its OTLP JSON representation.
+func otlpSpan(s *tracing.SpanData) map[string]any {
+	span := map[string]any{
+		"traceId":           s.TraceId,
+		"spanId":            s.SpanId,
+		"name":              s.Name,
+		"kind":              otlpKinds[s.Kind],
+		"startTimeUnixNano": strconv.FormatInt(s.Start.UnixNano(), 10),
+		"endTimeUnixNano":   strconv.FormatInt(s.End.UnixNano(), 10),
+		"attributes":        otlpAttributes(s.Attributes),
+	}
+	if s.ParentSpanId != "" {
+		span["parentSpanId"] = s.ParentSpanId
+	}
+	if s.Status == tracing.StatusError {
+		span["status"] = map[string]any{"code": otlpStatusError, "message": s.StatusMessage}
+	}
+	return span
+}
+
+// otlpAttributes converts attributes to OTLP key-value pairs.
+// Values other than strings, booleans and numbers are sent as their string representation.
+func otlpAttributes(attributes map[string]any) []any {
+	list := make([]any, 0, len(attributes))
+	for key, value := range attributes {
+		var v map[string]any
+		switch typed := value.(type) {
+		case string:
+			v = map[string]any{"stringValue": typed}
+		case bool:
+			v = map[string]any{"boolValue": typed}
+		case int:
+			v = map[string]any{"intValue": strconv.Itoa(typed)}
+		case int64:
+			v = map[string]any{"intValue": strconv.FormatInt(typed, 10)}
+		case float64:
+			v = map[string]any{"doubleValue": typed}
+		default:
+			v = map[string]any{"stringValue": fmt.Sprint(typed)}
+		}
+		list = append(list, map[string]any{"key": key, "value": v})
+	}
+	return list
+}
@@ -1,17 +1,39 @@
 package vacancy
 
 import (
+	"application/tracing"
 	"context"
+	organizationRepository "domain/organization/repository"
 	"domain/vacancy/entity"
+	"domain/vacancy/repository"
+	"errors"
 	"fmt"
 	"infrastructure/persistence/criteria"
 	"infrastructure/persistence/query"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// foreignKeyViolation is the PostgreSQL error code raised when the owning organization does not exist.
+const foreignKeyViolation = "23503"
+
+// errBatchItem aborts the transaction of an atomic batch when one of its items fails.
+var errBatchItem = errors.New("batch item failed")
+
+// exportBatchSize is the number of rows fetched at a time from the cursor of an export.
+const exportBatchSize = 500
+
+// vacancyColumns lists the columns selected for a vacancy, in the order expected by the scan functions.
+const vacancyColumns = `id, title, company, description, posted_at, location, version, organization_id`
+
+// allColumns lists the columns of vacancyColumns one by one, to be projected to the fields read by a query.
+var allColumns = strings.Split(vacancyColumns, ", ")
+
 // PgxVacancyRepository implements the VacancyRepository interface using pgx.
 type PgxVacancyRepository struct {
 	db *pgxpool.Pool // Connection pool for database interactions.
@@ -24,88 +46,129 @@ func NewPgxVacancyRepository(db *pgxpool.Pool) *PgxVacancyRepository {
 
 // Save inserts a new item into the database and retrieves the generated ID and version.
 func (r *PgxVacancyRepository) Save(ctx context.Context, v *entity.Vacancy) error {
-	baseQuery := `
-		INSERT INTO job_vacancies (title, company, description, posted_at, location)
-		VALUES ($1, $2, $3, $4, $5)
-		RETURNING id, version
-	`
-	args := []any{v.GetTitle(), v.GetCompany(), v.GetDescription(), v.GetPostedAt(), v.GetLocation()}
-
 	return r.withTransaction(ctx, func(tx pgx.Tx) error {
-		var id int64
-		var version int32
-
-		// Execute the insert query and retrieve the generated id and version.
-		if err := tx.QueryRow(ctx, baseQuery, args...).Scan(&id, &version); err != nil {
-			return fmt.Errorf("failed to save vacancy: %w", err)
-		}
-		v.SetId(id).SetVersion(version)
-		return nil
+		return insertVacancy(ctx, tx, v)
 	})
 }
 
-// Get retrieves an item from the database by its ID.
-func (r *PgxVacancyRepository) Get(ctx context.Context, id int64) (*entity.Vacancy, error) {
-	baseQuery := `SELECT * FROM job_vacancies WHERE id = $1`
+// Get retrieves an item from the database by its ID, selecting the columns of the given fields only, if any.
+func (r *PgxVacancyRepository) Get(ctx context.Context, id int64, fields ...string) (*entity.Vacancy, error) {
+	columns := projection(fields)
+	baseQuery := `SELECT ` + strings.Join(columns, ", ") + ` FROM job_vacancies WHERE id = $1`
 	row := r.db.QueryRow(ctx, baseQuery, id)
 	v := &entity.Vacancy{}
 
-	var itemId int64
-	var title, company, description, location string
-	var postedAt time.Time
-	var version int32
-
 	// Scan the row into vacancy fields.
-	if err := row.Scan(&itemId, &title, &company, &description, &postedAt, &location, &version); err != nil {
+	if err := scanColumns(row, v, columns); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			err = repository.ErrVacancyNotFound
+		}
 		return nil, fmt.Errorf("failed to fetch vacancy: %w", err)
 	}
-
-	v.SetId(itemId).SetTitle(title).SetCompany(company).SetDescription(description).SetPostedAt(postedAt).
-		SetLocation(location).SetVersion(version)
 	return v, nil
 }
 
 // Update modifies an existing item in the database with new data, using optimistic concurrency control.
 func (r *PgxVacancyRepository) Update(ctx context.Context, v *entity.Vacancy) error {
-	baseQuery := `
-		UPDATE job_vacancies
-		SET title = $1, company = $2, description = $3, posted_at = $4, location = $5, version = version + 1
-		WHERE id = $6 AND version = $7
-		RETURNING version
-	`
-	args := []any{v.GetTitle(), v.GetCompany(), v.GetDescription(), v.GetPostedAt(), v.GetLocation(), v.GetId(),
-		v.GetVersion()}
-
 	return r.withTransaction(ctx, func(tx pgx.Tx) error {
-		var version int32
-		// Execute the update query and retrieve the new version.
-		if err := tx.QueryRow(ctx, baseQuery, args...).Scan(&version); err != nil {
-			return fmt.Errorf("failed to update vacancy: %w", err)
-		}
-		v.SetVersion(version)
-		return nil
+		return updateVacancy(ctx, tx, v)
 	})
 }
 
 // Delete removes an item from the database by its ID.
 func (r *PgxVacancyRepository) Delete(ctx context.Context, id int64) error {
-	baseQuery := `DELETE FROM job_vacancies WHERE id = $1`
-
 	return r.withTransaction(ctx, func(tx pgx.Tx) error {
-		commandTag, err := tx.Exec(ctx, baseQuery, id)
+		return deleteVacancy(ctx, tx, id)
+	})
+}
+
+// GetBatch retrieves the items with the given IDs from the database.
+func (r *PgxVacancyRepository) GetBatch(ctx context.Context, ids []int64) ([]*entity.Vacancy, error) {
+	baseQuery := `SELECT ` + vacancyColumns + ` FROM job_vacancies WHERE id = ANY($1)`
+	rows, err := r.db.Query(ctx, baseQuery, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch vacancies: %w", err)
+	}
+	defer rows.Close()
+
+	var list []*entity.Vacancy
+	for rows.Next() {
+		var v entity.Vacancy
+		if err = scanVacancy(rows, &v); err != nil {
+			return nil, fmt.Errorf("failed to scan vacancy: %w", err)
+		}
+		list = append(list, &v)
+	}
+
+	// Check for row iteration errors.
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return list, nil
+}
+
+// Export streams the items matching the filter criteria to fn through a server-side cursor, fetching
+// exportBatchSize rows at a time in a read-only transaction, so every item belongs to the same snapshot.
+func (r *PgxVacancyRepository) Export(
+	ctx context.Context,
+	title, company string,
+	sortField, sortOrder string,
+	fn func(v *entity.Vacancy) error,
+) error {
+	ctx, span := tracing.Start(ctx, "vacancy export", tracing.KindInternal)
+	defer span.End()
+
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		span.RecordError(err)
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	// The transaction only reads, so it is rolled back once done, which also closes the cursor.
+	defer func() { _ = tx.Rollback(context.WithoutCancel(ctx)) }()
+
+	q, args := filteredQuery(allColumns, title, company, "", sortField, sortOrder, 0, 0)
+	if _, err = tx.Exec(ctx, `DECLARE vacancy_export NO SCROLL CURSOR FOR `+q, args...); err != nil {
+		span.RecordError(err)
+		return fmt.Errorf("failed to declare cursor: %w", err)
+	}
+
+	v := &entity.Vacancy{}
+	for {
+		n, err := fetchExport(ctx, tx, v, fn)
 		if err != nil {
-			return fmt.Errorf("failed to delete vacancy: %w", err)
+			span.RecordError(err)
+			return err
 		}
-		if commandTag.RowsAffected() == 0 {
-			return fmt.Errorf("vacancy with id %d does not exist", id)
+		if n < exportBatchSize {
+			return nil
 		}
-		return nil
+	}
+}
+
+// SaveBatch inserts new items into the database in a single transaction.
+func (r *PgxVacancyRepository) SaveBatch(ctx context.Context, list []*entity.Vacancy, atomic bool) ([]error, error) {
+	return r.withBatch(ctx, len(list), atomic, func(tx pgx.Tx, i int) error {
+		return insertVacancy(ctx, tx, list[i])
+	})
+}
+
+// UpdateBatch modifies existing items in the database in a single transaction.
+func (r *PgxVacancyRepository) UpdateBatch(ctx context.Context, list []*entity.Vacancy, atomic bool) ([]error, error) {
+	return r.withBatch(ctx, len(list), atomic, func(tx pgx.Tx, i int) error {
+		return updateVacancy(ctx, tx, list[i])
+	})
+}
+
+// DeleteBatch removes items from the database by their IDs in a single transaction.
+func (r *PgxVacancyRepository) DeleteBatch(ctx context.Context, ids []int64, atomic bool) ([]error, error) {
+	return r.withBatch(ctx, len(ids), atomic, func(tx pgx.Tx, i int) error {
+		return deleteVacancy(ctx, tx, ids[i])
 	})
 }
 
 // GetList retrieves a list of items from the database.
 func (r *PgxVacancyRepository) GetList(ctx context.Context) ([]*entity.Vacancy, error) {
-	baseQuery := `SELECT * FROM job_vacancies`
+	baseQuery := `SELECT ` + vacancyColumns + ` FROM job_vacancies`
 	rows, err := r.db.Query(ctx, baseQuery)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch vacancies: %w", err)
@@ -115,18 +178,11 @@ func (r *PgxVacancyRepository) GetList(ctx context.Context) ([]*entity.Vacancy,
 	var list []*entity.Vacancy
 	for rows.Next() {
 		var v entity.Vacancy
-		var itemId int64
-		var title, company, description, location string
-		var postedAt time.Time
-		var version int32
 
 		// Scan the current row into vacancy fields.
-		if err = rows.Scan(&itemId, &title, &company, &description, &postedAt, &location, &version); err != nil {
+		if err = scanVacancy(rows, &v); err != nil {
 			return nil, fmt.Errorf("failed to scan vacancy: %w", err)
 		}
-
-		v.SetId(itemId).SetTitle(title).SetCompany(company).SetDescription(description).SetPostedAt(postedAt).
-			SetLocation(location).SetVersion(version)
 		list = append(list, &v)
 	}
 
@@ -137,49 +193,37 @@ func (r *PgxVacancyRepository) GetList(ctx context.Context) ([]*entity.Vacancy,
 	return list, nil
 }
 
-// GetFilteredList retrieves a list of items based on the specified filter criteria.
+// GetFilteredList retrieves a list of items based on the specified filter criteria, selecting the columns of the
+// given fields only, if any.
 func (r *PgxVacancyRepository) GetFilteredList(
 	ctx context.Context,
 	title, company string,
 	page, pageSize int,
 	sortField, sortOrder string,
+	fields ...string,
 ) ([]*entity.Vacancy, error) {
-	baseQuery := `SELECT * FROM job_vacancies`
-	qb := query.GetBuilder(baseQuery)
-	defer qb.Release()
-
-	criteriaBuilder := criteria.GetSearchCriteriaBuilder()
-	defer criteriaBuilder.Release()
-
-	// Add filters based on provided parameters
-	if title != "" {
-		criteriaBuilder.AddFilter("title", "ILIKE", fmt.Sprintf("%%%s%%", title))
-	}
-	if company != "" {
-		criteriaBuilder.AddFilter("company", "ILIKE", fmt.Sprintf("%%%s%%", company))
-	}
-
-	// Set logical operator for combining filters, default to "AND"
-	criteriaBuilder.SetLogicalOperator("AND")
-	searchCriteria := criteriaBuilder.Build()
-
-	// Apply search criteria to the QueryBuilder
-	qb.ApplySearchCriteria(searchCriteria)
-
-	// Set sorting and pagination
-	if sortField != "" {
-		if sortOrder == "" {
-			sortOrder = "ASC"
-		}
-		qb.SetOrderBy(sortField, sortOrder)
-	} else {
-		qb.SetOrderBy("title", "ASC") // Default sorting by title
-	}
-	qb.SetPagination(page, pageSize)
+	columns := projection(fields)
+	q, args := filteredQuery(columns, title, company, "", sortField, sortOrder, page, pageSize)
+	return r.query(ctx, q, args, columns)
+}
 
-	// Build the final query and arguments
-	q, args := qb.Build(searchCriteria)
+// GetFeed retrieves the items matching the filter criteria with the highest IDs, the most recently added ones.
+func (r *PgxVacancyRepository) GetFeed(
+	ctx context.Context,
+	title, company, location string,
+	limit int,
+) ([]*entity.Vacancy, error) {
+	q, args := filteredQuery(allColumns, title, company, location, "id", "DESC", 1, limit)
+	return r.query(ctx, q, args, allColumns)
+}
 
+// query retrieves the items selected by the query, which selects the given columns.
+func (r *PgxVacancyRepository) query(
+	ctx context.Context,
+	q string,
+	args []any,
+	columns []string,
+) ([]*entity.Vacancy, error) {
 	// Execute the query
 	rows, err := r.db.Query(ctx, q, args...)
 	if err != nil {
@@ -191,19 +235,11 @@ func (r *PgxVacancyRepository) GetFilteredList(
 	var list []*entity.Vacancy
 	for rows.Next() {
 		var v entity.Vacancy
-		var id int64
-		var title, company, description, location string
-		var postedAt time.Time
-		var version int32
 
 		// Scan the row into vacancy fields
-		if err = rows.Scan(&id, &title, &company, &description, &postedAt, &location, &version); err != nil {
+		if err = scanColumns(rows, &v, columns); err != nil {
 			return nil, fmt.Errorf("failed to scan vacancy: %w", err)
 		}
-
-		// Map scanned values
-		v.SetId(id).SetTitle(title).SetCompany(company).SetDescription(description).SetPostedAt(postedAt).
-			SetLocation(location).SetVersion(version)
 		list = append(list, &v)
 	}
 
@@ -214,6 +250,17 @@ func (r *PgxVacancyRepository) GetFilteredList(
 	return list, nil
 }
 
+// Count returns the number of job vacancies in the database.
+func (r *PgxVacancyRepository) Count(ctx context.Context) (int64, error) {
+	baseQuery := `SELECT COUNT(*) FROM job_vacancies`
+
+	var count int64
+	if err := r.db.QueryRow(ctx, baseQuery).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count vacancies: %w", err)
+	}
+	return count, nil
+}
+
 // Purge removes all job vacancies from the database by truncating the table.
 func (r *PgxVacancyRepository) Purge(ctx context.Context) error {
 	baseQuery := `TRUNCATE TABLE job_vacancies RESTART IDENTITY CASCADE`
@@ -229,14 +276,19 @@ func (r *PgxVacancyRepository) Purge(ctx context.Context) error {
 
 // withTransaction manages database transactions, allowing rollback on errors and commit on success.
 func (r *PgxVacancyRepository) withTransaction(ctx context.Context, fn func(tx pgx.Tx) error) error {
+	ctx, span := tracing.Start(ctx, "vacancy transaction", tracing.KindInternal)
+	defer span.End()
+
 	// Start a transaction.
 	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
+		span.RecordError(err)
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
 
 	// Execute the function within the transaction.
 	if err = fn(tx); err != nil {
+		span.RecordError(err)
 		if rbErr := tx.Rollback(ctx); rbErr != nil {
 			return fmt.Errorf("transaction rollback failed: %w, original error: %v", rbErr, err)
 		}
@@ -245,7 +297,254 @@ func (r *PgxVacancyRepository) withTransaction(ctx context.Context, fn func(tx p
 
 	// Commit the transaction on success.
 	if err = tx.Commit(ctx); err != nil {
+		span.RecordError(err)
 		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
 	return nil
 }
+
+// withBatch applies fn to the n items of a batch in a single transaction, each item in its own savepoint so a
+// failing item does not affect the others. In atomic mode, the first failure rolls back the transaction and the
+// other items get repository.ErrBatchAborted.
+func (r *PgxVacancyRepository) withBatch(
+	ctx context.Context,
+	n int,
+	atomic bool,
+	fn func(tx pgx.Tx, i int) error,
+) ([]error, error) {
+	errs := make([]error, n)
+	err := r.withTransaction(ctx, func(tx pgx.Tx) error {
+		for i := range n {
+			var err error
+			if errs[i], err = withSavepoint(ctx, tx, func(sp pgx.Tx) error { return fn(sp, i) }); err != nil {
+				return err
+			}
+			if errs[i] != nil && atomic {
+				return errBatchItem
+			}
+		}
+		return nil
+	})
+	switch {
+	case errors.Is(err, errBatchItem):
+		for i := range errs {
+			if errs[i] == nil {
+				errs[i] = repository.ErrBatchAborted
+			}
+		}
+	case err != nil:
+		return nil, err
+	}
+	return errs, nil
+}
+
+// withSavepoint runs fn within a savepoint of the transaction, rolled back if fn fails. Returns the error of fn,
+// and an error if the savepoint itself fails.
+func withSavepoint(ctx context.Context, tx pgx.Tx, fn func(tx pgx.Tx) error) (error, error) {
+	savepoint, err := tx.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create savepoint: %w", err)
+	}
+	if fnErr := fn(savepoint); fnErr != nil {
+		if err = savepoint.Rollback(ctx); err != nil {
+			return fnErr, fmt.Errorf("failed to roll back savepoint: %w", err)
+		}
+		return fnErr, nil
+	}
+	if err = savepoint.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to release savepoint: %w", err)
+	}
+	return nil, nil
+}
+
+// insertVacancy inserts a new vacancy within the transaction and sets its generated ID and version.
+func insertVacancy(ctx context.Context, tx pgx.Tx, v *entity.Vacancy) error {
+	baseQuery := `
+		INSERT INTO job_vacancies (title, company, description, posted_at, location, organization_id)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, version
+	`
+	args := []any{v.GetTitle(), v.GetCompany(), v.GetDescription(), v.GetPostedAt(), v.GetLocation(),
+		nullableId(v.GetOrganizationId())}
+
+	var id int64
+	var version int32
+
+	// Execute the insert query and retrieve the generated id and version.
+	if err := tx.QueryRow(ctx, baseQuery, args...).Scan(&id, &version); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+			return organizationRepository.ErrOrganizationNotFound
+		}
+		return fmt.Errorf("failed to save vacancy: %w", err)
+	}
+	v.SetId(id).SetVersion(version)
+	return nil
+}
+
+// updateVacancy updates a vacancy within the transaction if its version matches, and sets its new version.
+func updateVacancy(ctx context.Context, tx pgx.Tx, v *entity.Vacancy) error {
+	baseQuery := `
+		UPDATE job_vacancies
+		SET title = $1, company = $2, description = $3, posted_at = $4, location = $5, version = version + 1
+		WHERE id = $6 AND version = $7
+		RETURNING version
+	`
+	args := []any{v.GetTitle(), v.GetCompany(), v.GetDescription(), v.GetPostedAt(), v.GetLocation(), v.GetId(),
+		v.GetVersion()}
+
+	var version int32
+	// Execute the update query and retrieve the new version.
+	err := tx.QueryRow(ctx, baseQuery, args...).Scan(&version)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("failed to update vacancy: %w", repository.ErrEditConflict)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update vacancy: %w", err)
+	}
+	v.SetVersion(version)
+	return nil
+}
+
+// deleteVacancy deletes a vacancy by its ID within the transaction.
+func deleteVacancy(ctx context.Context, tx pgx.Tx, id int64) error {
+	commandTag, err := tx.Exec(ctx, `DELETE FROM job_vacancies WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete vacancy: %w", err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return fmt.Errorf("vacancy with id %d does not exist: %w", id, repository.ErrVacancyNotFound)
+	}
+	return nil
+}
+
+// scanVacancy scans a row selected with vacancyColumns into the given Vacancy entity.
+func scanVacancy(row pgx.Row, v *entity.Vacancy) error {
+	return scanColumns(row, v, allColumns)
+}
+
+// scanColumns scans a row selecting the given columns, among allColumns, into the given Vacancy entity, leaving
+// the fields of the other columns to their zero values.
+func scanColumns(row pgx.Row, v *entity.Vacancy, columns []string) error {
+	var id int64
+	var title, company, description, location string
+	var postedAt time.Time
+	var version int32
+	var organizationId *int64
+
+	targets := map[string]any{
+		"id": &id, "title": &title, "company": &company, "description": &description, "posted_at": &postedAt,
+		"location": &location, "version": &version, "organization_id": &organizationId,
+	}
+	dest := make([]any, len(columns))
+	for i, column := range columns {
+		dest[i] = targets[column]
+	}
+	if err := row.Scan(dest...); err != nil {
+		return err
+	}
+
+	v.SetId(id).SetTitle(title).SetCompany(company).SetDescription(description).SetPostedAt(postedAt).
+		SetLocation(location).SetVersion(version)
+	if organizationId != nil {
+		v.SetOrganizationId(*organizationId)
+	}
+	return nil
+}
+
+// nullableId maps a zero identifier to SQL NULL.
+func nullableId(id int64) *int64 {
+	if id == 0 {
+		return nil
+	}
+	return &id
+}
+
+// projection returns the columns selecting the given fields, in the order of allColumns, or every column if none
+// of the fields is known, so a query always selects at least one column.
+func projection(fields []string) []string {
+	var columns []string
+	for _, column := range allColumns {
+		if slices.Contains(fields, column) {
+			columns = append(columns, column)
+		}
+	}
+	if len(columns) == 0 {
+		return allColumns
+	}
+	return columns
+}
+
+// filteredQuery builds the query selecting the given columns of the items matching the filter criteria, sorted by
+// the given field and order, by title if none, and paginated unless the page size is 0.
+func filteredQuery(
+	columns []string,
+	title, company, location, sortField, sortOrder string,
+	page, pageSize int,
+) (string, []any) {
+	qb := query.GetSelectBuilder("job_vacancies", columns...)
+	defer qb.Release()
+
+	criteriaBuilder := criteria.GetSearchCriteriaBuilder()
+	defer criteriaBuilder.Release()
+
+	// Add filters based on provided parameters
+	if title != "" {
+		criteriaBuilder.AddFilter("title", "ILIKE", fmt.Sprintf("%%%s%%", title))
+	}
+	if company != "" {
+		criteriaBuilder.AddFilter("company", "ILIKE", fmt.Sprintf("%%%s%%", company))
+	}
+	if location != "" {
+		criteriaBuilder.AddFilter("location", "ILIKE", fmt.Sprintf("%%%s%%", location))
+	}
+
+	// Set logical operator for combining filters, default to "AND"
+	criteriaBuilder.SetLogicalOperator("AND")
+	searchCriteria := criteriaBuilder.Build()
+
+	// Apply search criteria to the QueryBuilder
+	qb.ApplySearchCriteria(searchCriteria)
+
+	// Set sorting and pagination
+	if sortField != "" {
+		if sortOrder == "" {
+			sortOrder = "ASC"
+		}
+		qb.SetOrderBy(sortField, sortOrder)
+	} else {
+		qb.SetOrderBy("title", "ASC") // Default sorting by title
+	}
+	if pageSize > 0 {
+		qb.SetPagination(page, pageSize)
+	}
+
+	// Build the final query, copying the arguments out of the pooled builder
+	q, args := qb.Build(searchCriteria)
+	return q, append([]any(nil), args...)
+}
+
+// fetchExport fetches the next rows of the export cursor, passing each to fn in the reused vacancy, and returns
+// the number of rows fetched.
+func fetchExport(ctx context.Context, tx pgx.Tx, v *entity.Vacancy, fn func(v *entity.Vacancy) error) (int, error) {
+	rows, err := tx.Query(ctx, fmt.Sprintf(`FETCH %d FROM vacancy_export`, exportBatchSize))
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch vacancies: %w", err)
+	}
+	defer rows.Close()
+
+	n := 0
+	for rows.Next() {
+		n++
+		if err = scanVacancy(rows, v.Reset()); err != nil {
+			return n, fmt.Errorf("failed to scan vacancy: %w", err)
+		}
+		if err = fn(v); err != nil {
+			return n, err
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return n, fmt.Errorf("row iteration error: %w", err)
+	}
+	return n, nil
+}
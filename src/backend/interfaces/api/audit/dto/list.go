@@ -0,0 +1,34 @@
+package dto
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+)
+
+// ErrInvalidCursor is returned when a pagination cursor cannot be decoded.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// ListResponse represents the data transfer object for a page of audit records.
+type ListResponse struct {
+	Records    []RecordResponse `json:"records"`               // Records is the page of audit records, newest first.
+	NextCursor *string          `json:"next_cursor,omitempty"` // NextCursor requests the following page, if any.
+}
+
+// EncodeCursor returns the opaque pagination cursor pointing after the record with the given ID.
+func EncodeCursor(id int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(id, 10)))
+}
+
+// DecodeCursor returns the record ID of an opaque pagination cursor.
+func DecodeCursor(cursor string) (int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, ErrInvalidCursor
+	}
+	id, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil || id <= 0 {
+		return 0, ErrInvalidCursor
+	}
+	return id, nil
+}
@@ -0,0 +1,97 @@
+package dto
+
+import (
+	"domain/audit/entity"
+	"sync"
+	"time"
+)
+
+// recordResponsePoolInstance is the instance of the getRecordResponsePool function to access the pool.
+var recordResponsePoolInstance = getRecordResponsePool()
+
+// getRecordResponsePool returns a singleton instance of sync.Pool used to manage RecordResponse objects.
+func getRecordResponsePool() func() *sync.Pool {
+	var once sync.Once
+	var pool *sync.Pool
+
+	return func() *sync.Pool {
+		once.Do(func() {
+			pool = &sync.Pool{
+				New: func() interface{} {
+					return &RecordResponse{}
+				},
+			}
+		})
+		return pool
+	}
+}
+
+// RecordResponse represents the data transfer object for an audit record response.
+type RecordResponse struct {
+	ID        *int64          `json:"id,omitempty"`         // ID is the unique identifier of the record.
+	Actor     *string         `json:"actor,omitempty"`      // Actor is the principal that performed the action.
+	Action    *string         `json:"action,omitempty"`     // Action is the action performed.
+	Target    *string         `json:"target,omitempty"`     // Target is the resource affected by the action.
+	Outcome   *string         `json:"outcome,omitempty"`    // Outcome is the outcome of the action.
+	SourceIp  *string         `json:"source_ip,omitempty"`  // SourceIp is the IP address of the client.
+	RequestId *string         `json:"request_id,omitempty"` // RequestId is the identifier of the request.
+	Details   *map[string]any `json:"details,omitempty"`    // Details is additional information about the action.
+	CreatedAt *string         `json:"created_at,omitempty"` // CreatedAt is the timestamp when it was recorded.
+}
+
+// Reset resets the fields of the RecordResponse to their zero values and returns the updated RecordResponse.
+func (r *RecordResponse) Reset() *RecordResponse {
+	r.ID = nil
+	r.Actor = nil
+	r.Action = nil
+	r.Target = nil
+	r.Outcome = nil
+	r.SourceIp = nil
+	r.RequestId = nil
+	r.Details = nil
+	r.CreatedAt = nil
+	return r
+}
+
+// Release releases the RecordResponse instance back to the pool after resetting it.
+func (r *RecordResponse) Release() {
+	recordResponsePoolInstance().Put(r.Reset())
+}
+
+// GetRecordResponse retrieves a RecordResponse object from the pool, resetting it before use.
+func GetRecordResponse() *RecordResponse {
+	return recordResponsePoolInstance().Get().(*RecordResponse).Reset()
+}
+
+// FromEntity maps the Record entity fields to the RecordResponse fields.
+func (r *RecordResponse) FromEntity(e *entity.Record) *RecordResponse {
+	id, actor, action, target := e.GetId(), e.GetActor(), e.GetAction(), e.GetTarget()
+	outcome, sourceIp, requestId, details := e.GetOutcome(), e.GetSourceIp(), e.GetRequestId(), e.GetDetails()
+	createdAt := e.GetCreatedAt().Format(time.RFC3339)
+
+	r.ID = &id
+	r.Actor = &actor
+	r.Action = &action
+	r.Target = &target
+	r.Outcome = &outcome
+	if sourceIp != "" {
+		r.SourceIp = &sourceIp
+	}
+	if requestId != "" {
+		r.RequestId = &requestId
+	}
+	if len(details) > 0 {
+		r.Details = &details
+	}
+	r.CreatedAt = &createdAt
+	return r
+}
+
+// ToList converts a slice of Record entities to a slice of RecordResponse objects.
+func (r *RecordResponse) ToList(list []*entity.Record) []RecordResponse {
+	items := make([]RecordResponse, len(list))
+	for i, item := range list {
+		items[i] = *r.Reset().FromEntity(item)
+	}
+	return items
+}
@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"application/audit"
+	"domain/audit/entity"
+	"domain/audit/repository"
+	"interfaces/api/audit/dto"
+	"interfaces/api/audit/validators"
+	"interfaces/api/utils"
+	"net/http"
+)
+
+// ListRecordHandler handles the HTTP requests for querying the audit log.
+type ListRecordHandler struct {
+	*utils.Handler               // HTTP handler utility.
+	*utils.Errors                // Error handler for standardized error responses.
+	*audit.Service               // Audit service for business logic.
+	*validators.RequestValidator // Audit request validator.
+}
+
+// NewListRecordHandler creates and returns a new instance of ListRecordHandler.
+func NewListRecordHandler(
+	handler *utils.Handler,
+	errors *utils.Errors,
+	service *audit.Service,
+	validator *validators.RequestValidator,
+) *ListRecordHandler {
+	return &ListRecordHandler{
+		Handler:          handler,
+		Errors:           errors,
+		Service:          service,
+		RequestValidator: validator,
+	}
+}
+
+// Execute processes the HTTP request to query the audit log, newest records first.
+// The optional actor, action, target, outcome, from and to query parameters narrow the result, the limit and cursor
+// query parameters page through it.
+func (h *ListRecordHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	filter := repository.Filter{
+		Actor:  h.GetQueryString(q, "actor", ""),
+		Action: h.GetQueryString(q, "action", ""),
+		Target: h.GetQueryString(q, "target", ""),
+	}
+
+	// Validate
+	if !h.RequestValidator.ValidateQuery(
+		h.GetQueryString(q, "outcome", ""),
+		h.GetQueryString(q, "from", ""),
+		h.GetQueryString(q, "to", ""),
+		h.GetQueryString(q, "cursor", ""),
+		h.GetQueryInt(q, "limit", 0),
+		&filter,
+	) {
+		h.FailedQueryValidationResponse(w, r, h.RequestValidator.Errors)
+		h.RequestValidator.ClearErrors()
+		return
+	}
+
+	items, next, err := h.Service.ListRecords(r.Context(), filter)
+	if err != nil {
+		h.ServerErrorResponse(w, r, err)
+		return
+	}
+	defer releaseRecords(items)
+
+	// Send success response
+	h.sendSuccessResponse(w, r, items, next)
+}
+
+// sendSuccessResponse sends a success response with the page of audit records and the cursor of the next page.
+func (h *ListRecordHandler) sendSuccessResponse(w http.ResponseWriter, r *http.Request, data []*entity.Record, next int64) {
+	response := dto.GetRecordResponse()
+	defer response.Release()
+
+	page := dto.ListResponse{Records: response.ToList(data)}
+	if next > 0 {
+		cursor := dto.EncodeCursor(next)
+		page.NextCursor = &cursor
+	}
+	if err := h.Write(w, r, http.StatusOK, page, nil); err != nil {
+		h.WriteErrorResponse(w, r, err)
+	}
+}
+
+// releaseRecords returns the given Record entities to the pool.
+func releaseRecords(list []*entity.Record) {
+	for _, item := range list {
+		item.Release()
+	}
+}
@@ -0,0 +1,68 @@
+package validators
+
+import (
+	"domain/audit/entity"
+	"domain/audit/repository"
+	"interfaces/api/audit/dto"
+	"interfaces/api/utils/validators"
+	"time"
+)
+
+const (
+	defaultLimit = 50  // Number of records returned when no limit is given.
+	maxLimit     = 100 // Maximum number of records returned in a single page.
+)
+
+// RequestValidator is responsible for validating audit log query parameters.
+type RequestValidator struct {
+	*validators.Validator          // Embeds the general Validator to leverage its validation functions.
+	outcomes              []string // Outcomes a record can be filtered by.
+}
+
+// NewRequestValidator creates and returns a new instance of RequestValidator.
+// It retrieves a Validator instance from the pool for efficient memory usage.
+func NewRequestValidator() *RequestValidator {
+	return &RequestValidator{
+		Validator: validators.GetValidator(),
+		outcomes:  []string{entity.OutcomeSuccess, entity.OutcomeFailure, entity.OutcomeDenied},
+	}
+}
+
+// ValidateQuery validates the raw query parameters of the audit log and fills the given filter with them.
+// A zero limit is replaced by the default page size.
+func (v *RequestValidator) ValidateQuery(outcome, from, to, cursor string, limit int, f *repository.Filter) bool {
+	if outcome != "" {
+		v.Check(v.PermittedValue(outcome, v.outcomes...), "outcome",
+			"outcome must be one of success, failure or denied")
+	}
+	f.Outcome = outcome
+	f.From = v.parseTime("from", from)
+	f.To = v.parseTime("to", to)
+	if !f.From.IsZero() && !f.To.IsZero() {
+		v.Check(!f.To.Before(f.From), "to", "to must not be before from")
+	}
+
+	if limit == 0 {
+		limit = defaultLimit
+	}
+	v.Check(limit > 0 && limit <= maxLimit, "limit", "limit must be between 1 and 100")
+	f.Limit = limit
+
+	if cursor != "" {
+		id, err := dto.DecodeCursor(cursor)
+		v.Check(err == nil, "cursor", "cursor must be a value returned as next_cursor")
+		f.BeforeId = id
+	}
+
+	return v.Valid()
+}
+
+// parseTime parses an optional RFC 3339 timestamp and records an error for the given key if it is malformed.
+func (v *RequestValidator) parseTime(key, value string) time.Time {
+	if value == "" {
+		return time.Time{}
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	v.Check(err == nil, key, key+" must be a valid RFC 3339 timestamp")
+	return t
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"application/audit"
+	"application/auth"
+	"domain/auth/entity"
+	"interfaces/api/auth/dto"
+	"interfaces/api/utils"
+	"net/http"
+	"time"
+)
+
+// FeedTokenHandler handles HTTP requests issuing the tokens carried by the URLs of the feeds, for feed readers
+// that cannot send an Authorization header.
+type FeedTokenHandler struct {
+	*utils.Handler // HTTP handler utility
+	*utils.Errors  // Error handling utility
+	*auth.Service  // Jwt auth service
+	audit          *audit.Service
+	ttl            time.Duration
+}
+
+// NewFeedTokenHandler creates a new FeedTokenHandler instance issuing tokens valid for ttl.
+func NewFeedTokenHandler(
+	handler *utils.Handler,
+	errors *utils.Errors,
+	service *auth.Service,
+	audit *audit.Service,
+	ttl time.Duration,
+) *FeedTokenHandler {
+	return &FeedTokenHandler{
+		Handler: handler,
+		Errors:  errors,
+		Service: service,
+		audit:   audit,
+		ttl:     ttl,
+	}
+}
+
+// Execute processes a request to issue a feed token to the authenticated caller. The token only grants the read
+// scope and is only accepted by the feeds, so a leaked feed URL does not give access to the rest of the API.
+func (h *FeedTokenHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	claims := entity.GetTokenClaims()
+	defer claims.Release()
+
+	// Set up token claims
+	claims.SetIssuer(clientId)
+	claims.SetSubject(auth.PrincipalFromContext(r.Context()))
+	claims.SetScope([]string{entity.ScopeRead})
+	claims.SetExpiresAt(time.Now().Add(h.ttl).Unix())
+
+	token, err := h.Service.GenerateFeed(claims)
+	if err != nil {
+		h.ServerErrorResponse(w, r, err)
+		return
+	}
+
+	// Record the issued token on behalf of the caller
+	details := map[string]any{"scopes": claims.GetScope(), "audience": auth.FeedAudience,
+		"expires_at": claims.GetExpiresAt()}
+	if err = h.audit.Record(r.Context(), audit.ActionTokenIssue, "feed:"+claims.GetSubject(), details); err != nil {
+		h.ServerErrorResponse(w, r, err)
+		return
+	}
+
+	response := dto.GetResponse()
+	defer response.Release()
+	response.FromToken(token)
+	if err = h.Write(w, r, http.StatusOK, response, nil); err != nil {
+		h.WriteErrorResponse(w, r, err)
+	}
+}
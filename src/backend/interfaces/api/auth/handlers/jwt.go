@@ -1,19 +1,27 @@
 package handlers
 
 import (
+	"application/audit"
 	"application/auth"
+	"application/role"
 	"domain/auth/entity"
+	roleEntity "domain/role/entity"
 	"interfaces/api/auth/dto"
 	"interfaces/api/utils"
 	"net/http"
 	"time"
 )
 
+// clientId identifies the public web client for which tokens are issued.
+const clientId = "api.pulse-finder"
+
 // JwtTokenHandler handles HTTP requests for JWT token operations.
 type JwtTokenHandler struct {
 	*utils.Handler // HTTP handler utility
 	*utils.Errors  // Error handling utility
 	*auth.Service  // Jwt auth service
+	roles          *role.Service
+	audit          *audit.Service
 }
 
 // NewJwtTokenHandler creates a new JwtTokenHandler instance.
@@ -21,22 +29,34 @@ func NewJwtTokenHandler(
 	handler *utils.Handler,
 	errors *utils.Errors,
 	service *auth.Service,
+	roles *role.Service,
+	audit *audit.Service,
 ) *JwtTokenHandler {
 	return &JwtTokenHandler{
 		Handler: handler,
 		Errors:  errors,
 		Service: service,
+		roles:   roles,
+		audit:   audit,
 	}
 }
 
 // Execute processes a request to issue a JWT token.
 func (h *JwtTokenHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	// Resolve the permissions granted to the web client through its roles
+	scopes, err := h.roles.EffectiveScopes(r.Context(), roleEntity.SubjectClient, clientId)
+	if err != nil {
+		h.ServerErrorResponse(w, r, err)
+		return
+	}
+
 	claims := entity.GetTokenClaims()
 	defer claims.Release()
 
 	// Set up token claims
-	claims.SetIssuer("api.pulse-finder")
-	claims.SetScope([]string{"read"})
+	claims.SetIssuer(clientId)
+	claims.SetSubject(clientId)
+	claims.SetScope(scopes)
 	claims.SetExpiresAt(time.Now().Add(24 * time.Hour).Unix())
 
 	token, err := h.Service.Generate(claims)
@@ -45,10 +65,18 @@ func (h *JwtTokenHandler) Execute(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Record the issued token on behalf of the web client
+	details := map[string]any{"scopes": scopes, "expires_at": claims.GetExpiresAt()}
+	ctx := auth.ContextWithClaims(r.Context(), claims)
+	if err = h.audit.Record(ctx, audit.ActionTokenIssue, "client:"+clientId, details); err != nil {
+		h.ServerErrorResponse(w, r, err)
+		return
+	}
+
 	response := dto.GetResponse()
 	defer response.Release()
 	response.FromToken(token)
-	if err = h.WriteJson(w, http.StatusOK, response, nil); err != nil {
-		h.ServerErrorResponse(w, r, err)
+	if err = h.Write(w, r, http.StatusOK, response, nil); err != nil {
+		h.WriteErrorResponse(w, r, err)
 	}
 }
@@ -1,6 +1,10 @@
 package dto
 
-import "sync"
+import (
+	"domain/healthcheck/entity"
+	"sync"
+	"time"
+)
 
 // responsePoolInstance is the instance of the getResponsePool function to access the pool.
 var responsePoolInstance = getResponsePool()
@@ -24,11 +28,21 @@ func getResponsePool() func() *sync.Pool {
 }
 
 // Response defines the structure of the JSON response for health check operation.
-// It contains fields for status, system information and a timestamp.
+// It contains fields for status, system information, the status of each checked component and a timestamp.
 type Response struct {
-	Status     *string    `json:"status,omitempty"`      // Status represents the health status of the system.
-	SystemInfo SystemInfo `json:"system_info,omitempty"` // SystemInfo contains environment and version of the system.
-	Timestamp  *string    `json:"timestamp,omitempty"`   // Timestamp is the time of the health check.
+	Status     *string     `json:"status,omitempty"`      // Status represents the health status of the system.
+	SystemInfo SystemInfo  `json:"system_info,omitempty"` // SystemInfo contains environment and version of the system.
+	Components []Component `json:"components,omitempty"`  // Components holds the status of each checked dependency.
+	Timestamp  *string     `json:"timestamp,omitempty"`   // Timestamp is the time of the health check.
+}
+
+// Component holds the status of a dependency of the system.
+type Component struct {
+	Name      string  `json:"name"`            // Name of the component.
+	Status    string  `json:"status"`          // Status of the component, "up" or "down".
+	Error     *string `json:"error,omitempty"` // Error is the reason the component is down.
+	LatencyMs float64 `json:"latency_ms"`      // LatencyMs is the duration of the check in milliseconds.
+	CheckedAt string  `json:"checked_at"`      // CheckedAt is the time of the check, which may have been cached.
 }
 
 // SystemInfo holds information about the environment and version of the system.
@@ -43,6 +57,7 @@ func (r *Response) Reset() *Response {
 	r.Status = nil
 	r.SystemInfo.Environment = nil
 	r.SystemInfo.Version = nil
+	r.Components = nil
 	r.Timestamp = nil
 	return r
 }
@@ -57,3 +72,27 @@ func (r *Response) Release() {
 func GetResponse() *Response {
 	return responsePoolInstance().Get().(*Response).Reset()
 }
+
+// FromEntity maps the HealthCheck entity fields to the Response fields.
+func (r *Response) FromEntity(e *entity.HealthCheck) *Response {
+	status, environment, version := e.GetStatus(), e.GetEnvironment(), e.GetVersion()
+	timestamp := e.GetCheckedAt().Format(time.DateTime)
+
+	r.Status = &status
+	r.SystemInfo.Environment = &environment
+	r.SystemInfo.Version = &version
+	r.Timestamp = &timestamp
+	for _, c := range e.GetComponents() {
+		component := Component{
+			Name:      c.GetName(),
+			Status:    c.GetStatus(),
+			LatencyMs: float64(c.GetLatency().Microseconds()) / 1000,
+			CheckedAt: c.GetCheckedAt().Format(time.RFC3339),
+		}
+		if err := c.GetError(); err != "" {
+			component.Error = &err
+		}
+		r.Components = append(r.Components, component)
+	}
+	return r
+}
@@ -27,13 +27,13 @@ func NewHealthCheckHandler(
 	}
 }
 
-// Execute processes a health check request and writes the JSON response.
+// Execute processes a health check request and writes the JSON response, including the status of each dependency.
 func (h *HealthCheckHandler) Execute(w http.ResponseWriter, r *http.Request) {
 	response := dto.GetResponse()
 	defer response.Release()
 
-	h.Service.Process(response)
-	if err := h.WriteJson(w, http.StatusOK, response, nil); err != nil {
-		h.ServerErrorResponse(w, r, err)
+	response.FromEntity(h.Service.Ready(r.Context()))
+	if err := h.Write(w, r, http.StatusOK, response, nil); err != nil {
+		h.WriteErrorResponse(w, r, err)
 	}
 }
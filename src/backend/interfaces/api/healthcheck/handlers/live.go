@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"application/healthcheck"
+	"interfaces/api/healthcheck/dto"
+	"interfaces/api/utils"
+	"net/http"
+)
+
+// LivenessHandler handles HTTP requests of liveness probes.
+type LivenessHandler struct {
+	*utils.Handler       // HTTP handler utility
+	*utils.Errors        // Error handling utility
+	*healthcheck.Service // Health check service
+}
+
+// NewLivenessHandler creates a new LivenessHandler instance.
+func NewLivenessHandler(
+	handler *utils.Handler,
+	errors *utils.Errors,
+	service *healthcheck.Service,
+) *LivenessHandler {
+	return &LivenessHandler{
+		Handler: handler,
+		Errors:  errors,
+		Service: service,
+	}
+}
+
+// Execute reports that the process is running and able to serve requests. The dependencies are not checked,
+// so an outage of the database does not get the process restarted.
+func (h *LivenessHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	response := dto.GetResponse()
+	defer response.Release()
+
+	response.FromEntity(h.Service.Live())
+	if err := h.Write(w, r, http.StatusOK, response, nil); err != nil {
+		h.WriteErrorResponse(w, r, err)
+	}
+}
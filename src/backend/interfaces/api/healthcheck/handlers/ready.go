@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"application/healthcheck"
+	"interfaces/api/healthcheck/dto"
+	"interfaces/api/utils"
+	"net/http"
+)
+
+// ReadinessHandler handles HTTP requests of readiness probes.
+type ReadinessHandler struct {
+	*utils.Handler       // HTTP handler utility
+	*utils.Errors        // Error handling utility
+	*healthcheck.Service // Health check service
+}
+
+// NewReadinessHandler creates a new ReadinessHandler instance.
+func NewReadinessHandler(
+	handler *utils.Handler,
+	errors *utils.Errors,
+	service *healthcheck.Service,
+) *ReadinessHandler {
+	return &ReadinessHandler{
+		Handler: handler,
+		Errors:  errors,
+		Service: service,
+	}
+}
+
+// Execute checks the dependencies and writes their status, with 503 Service Unavailable if any of them is down,
+// so the instance is taken out of load balancing until it recovers.
+func (h *ReadinessHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	response := dto.GetResponse()
+	defer response.Release()
+
+	health := h.Service.Ready(r.Context())
+	status := http.StatusOK
+	if !health.IsAvailable() {
+		status = http.StatusServiceUnavailable
+	}
+
+	response.FromEntity(health)
+	if err := h.Write(w, r, status, response, nil); err != nil {
+		h.WriteErrorResponse(w, r, err)
+	}
+}
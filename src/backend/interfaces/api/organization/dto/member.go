@@ -0,0 +1,130 @@
+package dto
+
+import (
+	"domain/organization/entity"
+	"sync"
+	"time"
+)
+
+// memberRequestPoolInstance is the instance of the getMemberRequestPool function to access the pool.
+var memberRequestPoolInstance = getMemberRequestPool()
+
+// memberResponsePoolInstance is the instance of the getMemberResponsePool function to access the pool.
+var memberResponsePoolInstance = getMemberResponsePool()
+
+// getMemberRequestPool returns a singleton instance of sync.Pool used to manage MemberRequest objects.
+func getMemberRequestPool() func() *sync.Pool {
+	var once sync.Once
+	var pool *sync.Pool
+
+	return func() *sync.Pool {
+		once.Do(func() {
+			pool = &sync.Pool{
+				New: func() interface{} {
+					return &MemberRequest{}
+				},
+			}
+		})
+		return pool
+	}
+}
+
+// getMemberResponsePool returns a singleton instance of sync.Pool used to manage MemberResponse objects.
+func getMemberResponsePool() func() *sync.Pool {
+	var once sync.Once
+	var pool *sync.Pool
+
+	return func() *sync.Pool {
+		once.Do(func() {
+			pool = &sync.Pool{
+				New: func() interface{} {
+					return &MemberResponse{}
+				},
+			}
+		})
+		return pool
+	}
+}
+
+// MemberRequest represents the data transfer object for an organization invitation request.
+type MemberRequest struct {
+	MemberId *string `json:"member_id,omitempty"` // MemberId identifies the invited user or client.
+}
+
+// Reset resets the fields of the MemberRequest to their zero values and returns the updated MemberRequest.
+func (r *MemberRequest) Reset() *MemberRequest {
+	r.MemberId = nil
+	return r
+}
+
+// Release releases the MemberRequest instance back to the pool after resetting it.
+func (r *MemberRequest) Release() {
+	memberRequestPoolInstance().Put(r.Reset())
+}
+
+// GetMemberRequest retrieves a MemberRequest object from the pool, resetting it before use.
+func GetMemberRequest() *MemberRequest {
+	return memberRequestPoolInstance().Get().(*MemberRequest).Reset()
+}
+
+// ToEntity maps the MemberRequest fields to the provided Member entity.
+func (r *MemberRequest) ToEntity(e *entity.Member) {
+	if r.MemberId != nil {
+		e.SetMemberId(*r.MemberId)
+	}
+}
+
+// MemberResponse represents the data transfer object for an organization member response.
+type MemberResponse struct {
+	OrganizationId *int64  `json:"organization_id,omitempty"` // OrganizationId is the identifier of the organization.
+	MemberId       *string `json:"member_id,omitempty"`       // MemberId identifies the user or client.
+	Role           *string `json:"role,omitempty"`            // Role is either "owner" or "member".
+	Status         *string `json:"status,omitempty"`          // Status is either "invited" or "active".
+	InvitedBy      *string `json:"invited_by,omitempty"`      // InvitedBy is the principal that sent the invitation.
+	CreatedAt      *string `json:"created_at,omitempty"`      // CreatedAt is the timestamp of the invitation.
+}
+
+// Reset resets the fields of the MemberResponse to their zero values and returns the updated MemberResponse.
+func (r *MemberResponse) Reset() *MemberResponse {
+	r.OrganizationId = nil
+	r.MemberId = nil
+	r.Role = nil
+	r.Status = nil
+	r.InvitedBy = nil
+	r.CreatedAt = nil
+	return r
+}
+
+// Release releases the MemberResponse instance back to the pool after resetting it.
+func (r *MemberResponse) Release() {
+	memberResponsePoolInstance().Put(r.Reset())
+}
+
+// GetMemberResponse retrieves a MemberResponse object from the pool, resetting it before use.
+func GetMemberResponse() *MemberResponse {
+	return memberResponsePoolInstance().Get().(*MemberResponse).Reset()
+}
+
+// FromEntity maps the Member entity fields to the MemberResponse fields.
+func (r *MemberResponse) FromEntity(e *entity.Member) *MemberResponse {
+	organizationId, memberId, role, status, invitedBy := e.GetOrganizationId(), e.GetMemberId(), e.GetRole(),
+		e.GetStatus(), e.GetInvitedBy()
+	createdAt := e.GetCreatedAt().Format(time.RFC3339)
+
+	r.OrganizationId = &organizationId
+	r.MemberId = &memberId
+	r.Role = &role
+	r.Status = &status
+	r.InvitedBy = &invitedBy
+	r.CreatedAt = &createdAt
+	return r
+}
+
+// ToList converts a slice of Member entities to a slice of MemberResponse objects.
+func (r *MemberResponse) ToList(list []*entity.Member) *[]MemberResponse {
+	items := make([]MemberResponse, len(list))
+	for i, item := range list {
+		items[i] = *r.Reset().FromEntity(item)
+	}
+	return &items
+}
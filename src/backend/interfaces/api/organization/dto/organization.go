@@ -0,0 +1,123 @@
+package dto
+
+import (
+	"domain/organization/entity"
+	"sync"
+	"time"
+)
+
+// organizationRequestPoolInstance is the instance of the getOrganizationRequestPool function to access the pool.
+var organizationRequestPoolInstance = getOrganizationRequestPool()
+
+// organizationResponsePoolInstance is the instance of the getOrganizationResponsePool function to access the pool.
+var organizationResponsePoolInstance = getOrganizationResponsePool()
+
+// getOrganizationRequestPool returns a singleton instance of sync.Pool used to manage OrganizationRequest objects.
+func getOrganizationRequestPool() func() *sync.Pool {
+	var once sync.Once
+	var pool *sync.Pool
+
+	return func() *sync.Pool {
+		once.Do(func() {
+			pool = &sync.Pool{
+				New: func() interface{} {
+					return &OrganizationRequest{}
+				},
+			}
+		})
+		return pool
+	}
+}
+
+// getOrganizationResponsePool returns a singleton instance of sync.Pool used to manage OrganizationResponse objects.
+func getOrganizationResponsePool() func() *sync.Pool {
+	var once sync.Once
+	var pool *sync.Pool
+
+	return func() *sync.Pool {
+		once.Do(func() {
+			pool = &sync.Pool{
+				New: func() interface{} {
+					return &OrganizationResponse{}
+				},
+			}
+		})
+		return pool
+	}
+}
+
+// OrganizationRequest represents the data transfer object for an organization request.
+type OrganizationRequest struct {
+	Name *string `json:"name,omitempty"` // Name of the organization.
+}
+
+// Reset resets the fields of the OrganizationRequest to their zero values and returns the updated request.
+func (r *OrganizationRequest) Reset() *OrganizationRequest {
+	r.Name = nil
+	return r
+}
+
+// Release releases the OrganizationRequest instance back to the pool after resetting it.
+func (r *OrganizationRequest) Release() {
+	organizationRequestPoolInstance().Put(r.Reset())
+}
+
+// GetOrganizationRequest retrieves an OrganizationRequest object from the pool, resetting it before use.
+func GetOrganizationRequest() *OrganizationRequest {
+	return organizationRequestPoolInstance().Get().(*OrganizationRequest).Reset()
+}
+
+// ToEntity maps the OrganizationRequest fields to the provided Organization entity.
+func (r *OrganizationRequest) ToEntity(e *entity.Organization) {
+	if r.Name != nil {
+		e.SetName(*r.Name)
+	}
+}
+
+// OrganizationResponse represents the data transfer object for an organization response.
+type OrganizationResponse struct {
+	ID        *int64  `json:"id,omitempty"`         // ID is the unique identifier of the organization.
+	Name      *string `json:"name,omitempty"`       // Name of the organization.
+	CreatedBy *string `json:"created_by,omitempty"` // CreatedBy is the principal that registered the organization.
+	CreatedAt *string `json:"created_at,omitempty"` // CreatedAt is the timestamp when the organization was registered.
+}
+
+// Reset resets the fields of the OrganizationResponse to their zero values and returns the updated response.
+func (r *OrganizationResponse) Reset() *OrganizationResponse {
+	r.ID = nil
+	r.Name = nil
+	r.CreatedBy = nil
+	r.CreatedAt = nil
+	return r
+}
+
+// Release releases the OrganizationResponse instance back to the pool after resetting it.
+func (r *OrganizationResponse) Release() {
+	organizationResponsePoolInstance().Put(r.Reset())
+}
+
+// GetOrganizationResponse retrieves an OrganizationResponse object from the pool, resetting it before use.
+func GetOrganizationResponse() *OrganizationResponse {
+	return organizationResponsePoolInstance().Get().(*OrganizationResponse).Reset()
+}
+
+// FromEntity maps the Organization entity fields to the OrganizationResponse fields.
+func (r *OrganizationResponse) FromEntity(e *entity.Organization) *OrganizationResponse {
+	id, name, createdBy := e.GetId(), e.GetName(), e.GetCreatedBy()
+	createdAt := e.GetCreatedAt().Format(time.RFC3339)
+
+	r.ID = &id
+	r.Name = &name
+	r.CreatedBy = &createdBy
+	r.CreatedAt = &createdAt
+	return r
+}
+
+// ToList converts a slice of Organization entities to a slice of OrganizationResponse objects.
+func (r *OrganizationResponse) ToList(list []*entity.Organization) *[]OrganizationResponse {
+	items := make([]OrganizationResponse, len(list))
+	for i, item := range list {
+		items[i] = *r.Reset().FromEntity(item)
+	}
+	return &items
+}
@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"application/organization"
+	"domain/organization/entity"
+	"domain/organization/repository"
+	"errors"
+	"fmt"
+	"interfaces/api/organization/dto"
+	"interfaces/api/organization/validators"
+	"interfaces/api/utils"
+	"net/http"
+)
+
+// CreateOrganizationHandler handles the HTTP requests for registering a new organization.
+type CreateOrganizationHandler struct {
+	*utils.Handler               // HTTP handler utility.
+	*utils.Errors                // Error handler for standardized error responses.
+	*organization.Service        // Organization service for business logic.
+	*validators.RequestValidator // Organization request validator.
+}
+
+// NewCreateOrganizationHandler creates and returns a new instance of CreateOrganizationHandler.
+func NewCreateOrganizationHandler(
+	handler *utils.Handler,
+	errors *utils.Errors,
+	service *organization.Service,
+	validator *validators.RequestValidator,
+) *CreateOrganizationHandler {
+	return &CreateOrganizationHandler{
+		Handler:          handler,
+		Errors:           errors,
+		Service:          service,
+		RequestValidator: validator,
+	}
+}
+
+// Execute processes the HTTP request to register a new organization owned by the caller.
+func (h *CreateOrganizationHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	// Parse and validate request
+	request, err := h.parseAndValidateRequest(w, r)
+	if err != nil {
+		return
+	}
+	defer request.Release()
+
+	// Map request DTO to Organization entity
+	e := entity.GetOrganization()
+	defer e.Release()
+	request.ToEntity(e)
+
+	// Save organization
+	if err = h.Service.CreateOrganization(r.Context(), e); err != nil {
+		if errors.Is(err, repository.ErrDuplicate) {
+			h.ErrorResponse(w, r, http.StatusConflict, "an organization with this name already exists")
+			return
+		}
+		h.ServerErrorResponse(w, r, err)
+		return
+	}
+
+	// Send success response
+	h.sendSuccessResponse(w, r, e)
+}
+
+// parseAndValidateRequest reads, parses, and validates the incoming JSON request body.
+// Returns the validated request or an error if validation fails.
+func (h *CreateOrganizationHandler) parseAndValidateRequest(
+	w http.ResponseWriter,
+	r *http.Request,
+) (*dto.OrganizationRequest, error) {
+	request := dto.GetOrganizationRequest()
+	if err := h.ReadJson(w, r, &request); err != nil {
+		h.ErrorResponse(w, r, http.StatusBadRequest, err.Error())
+		return nil, err
+	}
+
+	if !h.RequestValidator.ValidateOrganization(request) {
+		h.FailedValidationResponse(w, r, h.RequestValidator.Errors)
+		h.RequestValidator.ClearErrors()
+		return nil, fmt.Errorf("validation failed")
+	}
+
+	return request, nil
+}
+
+// sendSuccessResponse sends a success response with the created organization's data.
+func (h *CreateOrganizationHandler) sendSuccessResponse(
+	w http.ResponseWriter,
+	r *http.Request,
+	e *entity.Organization,
+) {
+	response := dto.GetOrganizationResponse().FromEntity(e)
+	defer response.Release()
+
+	w.Header().Add("Location", fmt.Sprintf("/v1/organizations/%d", e.GetId()))
+	if err := h.Write(w, r, http.StatusCreated, response, nil); err != nil {
+		h.WriteErrorResponse(w, r, err)
+	}
+}
@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"application/organization"
+	"domain/organization/entity"
+	"interfaces/api/organization/dto"
+	"interfaces/api/utils"
+	"net/http"
+)
+
+// ListOrganizationHandler handles the HTTP requests for listing the organizations of the caller.
+type ListOrganizationHandler struct {
+	*utils.Handler        // HTTP handler utility.
+	*utils.Errors         // Error handler for standardized error responses.
+	*organization.Service // Organization service for business logic.
+}
+
+// NewListOrganizationHandler creates and returns a new instance of ListOrganizationHandler.
+func NewListOrganizationHandler(
+	handler *utils.Handler,
+	errors *utils.Errors,
+	service *organization.Service,
+) *ListOrganizationHandler {
+	return &ListOrganizationHandler{
+		Handler: handler,
+		Errors:  errors,
+		Service: service,
+	}
+}
+
+// Execute processes the HTTP request to list the organizations the caller is an active member of.
+func (h *ListOrganizationHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	items, err := h.Service.ListOrganizations(r.Context())
+	if err != nil {
+		h.ServerErrorResponse(w, r, err)
+		return
+	}
+	defer releaseOrganizations(items)
+
+	// Send success response
+	h.sendSuccessResponse(w, r, items)
+}
+
+// sendSuccessResponse sends a success response with the list of organizations.
+func (h *ListOrganizationHandler) sendSuccessResponse(
+	w http.ResponseWriter,
+	r *http.Request,
+	data []*entity.Organization,
+) {
+	response := dto.GetOrganizationResponse()
+	defer response.Release()
+
+	items := response.ToList(data)
+	if err := h.Write(w, r, http.StatusOK, items, nil); err != nil {
+		h.WriteErrorResponse(w, r, err)
+	}
+}
+
+// releaseOrganizations returns the given Organization entities to the pool.
+func releaseOrganizations(list []*entity.Organization) {
+	for _, item := range list {
+		item.Release()
+	}
+}
@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"application/organization"
+	"domain/organization/entity"
+	"domain/organization/repository"
+	"errors"
+	"interfaces/api/organization/dto"
+	"interfaces/api/utils"
+	"net/http"
+)
+
+// AcceptInvitationHandler handles the HTTP requests for accepting an invitation to an organization.
+type AcceptInvitationHandler struct {
+	*utils.Handler        // HTTP handler utility.
+	*utils.Errors         // Error handler for standardized error responses.
+	*organization.Service // Organization service for business logic.
+}
+
+// NewAcceptInvitationHandler creates and returns a new instance of AcceptInvitationHandler.
+func NewAcceptInvitationHandler(
+	handler *utils.Handler,
+	errors *utils.Errors,
+	service *organization.Service,
+) *AcceptInvitationHandler {
+	return &AcceptInvitationHandler{
+		Handler: handler,
+		Errors:  errors,
+		Service: service,
+	}
+}
+
+// Execute processes the HTTP request to accept the pending invitation of the caller.
+func (h *AcceptInvitationHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	id, err := h.ExtractId(r)
+	if err != nil {
+		h.NotFoundResponse(w, r)
+		return
+	}
+
+	e, err := h.Service.AcceptInvitation(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, repository.ErrMemberNotFound) {
+			h.NotFoundResponse(w, r)
+			return
+		}
+		h.ServerErrorResponse(w, r, err)
+		return
+	}
+	defer e.Release()
+
+	// Send success response
+	h.sendSuccessResponse(w, r, e)
+}
+
+// sendSuccessResponse sends a success response with the activated membership.
+func (h *AcceptInvitationHandler) sendSuccessResponse(w http.ResponseWriter, r *http.Request, e *entity.Member) {
+	response := dto.GetMemberResponse().FromEntity(e)
+	defer response.Release()
+
+	if err := h.Write(w, r, http.StatusOK, response, nil); err != nil {
+		h.WriteErrorResponse(w, r, err)
+	}
+}
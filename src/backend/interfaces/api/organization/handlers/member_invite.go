@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"application/organization"
+	"domain/organization/entity"
+	"domain/organization/repository"
+	"errors"
+	"fmt"
+	"interfaces/api/organization/dto"
+	"interfaces/api/organization/validators"
+	"interfaces/api/utils"
+	"net/http"
+)
+
+// InviteMemberHandler handles the HTTP requests for inviting a member to an organization.
+type InviteMemberHandler struct {
+	*utils.Handler               // HTTP handler utility.
+	*utils.Errors                // Error handler for standardized error responses.
+	*organization.Service        // Organization service for business logic.
+	*validators.RequestValidator // Organization request validator.
+}
+
+// NewInviteMemberHandler creates and returns a new instance of InviteMemberHandler.
+func NewInviteMemberHandler(
+	handler *utils.Handler,
+	errors *utils.Errors,
+	service *organization.Service,
+	validator *validators.RequestValidator,
+) *InviteMemberHandler {
+	return &InviteMemberHandler{
+		Handler:          handler,
+		Errors:           errors,
+		Service:          service,
+		RequestValidator: validator,
+	}
+}
+
+// Execute processes the HTTP request to invite a member to an organization.
+func (h *InviteMemberHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	// Parse and validate request
+	id, request, err := h.parseAndValidateRequest(w, r)
+	if err != nil {
+		return
+	}
+	defer request.Release()
+
+	// Map request DTO to Member entity
+	e := entity.GetMember().SetOrganizationId(id)
+	defer e.Release()
+	request.ToEntity(e)
+
+	// Save invitation
+	if err = h.Service.InviteMember(r.Context(), e); err != nil {
+		h.handleInviteError(w, r, err)
+		return
+	}
+
+	// Send success response
+	h.sendSuccessResponse(w, r, e)
+}
+
+// parseAndValidateRequest extracts the organization ID and reads, parses, and validates the JSON request body.
+// Returns the organization ID and the validated request or an error if validation fails.
+func (h *InviteMemberHandler) parseAndValidateRequest(
+	w http.ResponseWriter,
+	r *http.Request,
+) (int64, *dto.MemberRequest, error) {
+	id, err := h.ExtractId(r)
+	if err != nil {
+		h.NotFoundResponse(w, r)
+		return 0, nil, err
+	}
+
+	request := dto.GetMemberRequest()
+	if err = h.ReadJson(w, r, &request); err != nil {
+		h.ErrorResponse(w, r, http.StatusBadRequest, err.Error())
+		return 0, nil, err
+	}
+
+	if !h.RequestValidator.ValidateMember(request) {
+		h.FailedValidationResponse(w, r, h.RequestValidator.Errors)
+		h.RequestValidator.ClearErrors()
+		return 0, nil, fmt.Errorf("validation failed")
+	}
+
+	return id, request, nil
+}
+
+// handleInviteError maps the errors returned by the organization service to HTTP responses.
+func (h *InviteMemberHandler) handleInviteError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, repository.ErrOrganizationNotFound):
+		h.NotFoundResponse(w, r)
+	case errors.Is(err, organization.ErrNotPermitted):
+		h.NotPermittedResponse(w, r)
+	case errors.Is(err, repository.ErrDuplicate):
+		h.ErrorResponse(w, r, http.StatusConflict, "the member already belongs to or is invited to this organization")
+	default:
+		h.ServerErrorResponse(w, r, err)
+	}
+}
+
+// sendSuccessResponse sends a success response with the created invitation's data.
+func (h *InviteMemberHandler) sendSuccessResponse(w http.ResponseWriter, r *http.Request, e *entity.Member) {
+	response := dto.GetMemberResponse().FromEntity(e)
+	defer response.Release()
+
+	if err := h.Write(w, r, http.StatusCreated, response, nil); err != nil {
+		h.WriteErrorResponse(w, r, err)
+	}
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"application/organization"
+	"domain/organization/entity"
+	"errors"
+	"interfaces/api/organization/dto"
+	"interfaces/api/utils"
+	"net/http"
+)
+
+// ListMemberHandler handles the HTTP requests for listing the members of an organization.
+type ListMemberHandler struct {
+	*utils.Handler        // HTTP handler utility.
+	*utils.Errors         // Error handler for standardized error responses.
+	*organization.Service // Organization service for business logic.
+}
+
+// NewListMemberHandler creates and returns a new instance of ListMemberHandler.
+func NewListMemberHandler(
+	handler *utils.Handler,
+	errors *utils.Errors,
+	service *organization.Service,
+) *ListMemberHandler {
+	return &ListMemberHandler{
+		Handler: handler,
+		Errors:  errors,
+		Service: service,
+	}
+}
+
+// Execute processes the HTTP request to list the members and pending invitations of an organization.
+func (h *ListMemberHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	id, err := h.ExtractId(r)
+	if err != nil {
+		h.NotFoundResponse(w, r)
+		return
+	}
+
+	items, err := h.Service.ListMembers(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, organization.ErrNotPermitted) {
+			h.NotPermittedResponse(w, r)
+			return
+		}
+		h.ServerErrorResponse(w, r, err)
+		return
+	}
+	defer releaseMembers(items)
+
+	// Send success response
+	h.sendSuccessResponse(w, r, items)
+}
+
+// sendSuccessResponse sends a success response with the list of members.
+func (h *ListMemberHandler) sendSuccessResponse(w http.ResponseWriter, r *http.Request, data []*entity.Member) {
+	response := dto.GetMemberResponse()
+	defer response.Release()
+
+	items := response.ToList(data)
+	if err := h.Write(w, r, http.StatusOK, items, nil); err != nil {
+		h.WriteErrorResponse(w, r, err)
+	}
+}
+
+// releaseMembers returns the given Member entities to the pool.
+func releaseMembers(list []*entity.Member) {
+	for _, item := range list {
+		item.Release()
+	}
+}
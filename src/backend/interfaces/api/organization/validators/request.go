@@ -0,0 +1,40 @@
+package validators
+
+import (
+	"interfaces/api/organization/dto"
+	"interfaces/api/utils/validators"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxNameLength is the maximum number of characters in an organization name.
+const maxNameLength = 200
+
+// RequestValidator is responsible for validating organization and membership request DTOs.
+type RequestValidator struct {
+	*validators.Validator // Embeds the general Validator to leverage its validation functions.
+}
+
+// NewRequestValidator creates and returns a new instance of RequestValidator.
+// It retrieves a Validator instance from the pool for efficient memory usage.
+func NewRequestValidator() *RequestValidator {
+	return &RequestValidator{Validator: validators.GetValidator()}
+}
+
+// ValidateOrganization performs validation on the provided organization request DTO.
+func (v *RequestValidator) ValidateOrganization(r *dto.OrganizationRequest) bool {
+	if r.Name == nil || strings.TrimSpace(*r.Name) == "" {
+		v.AddError("name", "name must be provided and cannot be empty or whitespace")
+	} else {
+		v.Check(utf8.RuneCountInString(*r.Name) <= maxNameLength, "name", "name must not exceed 200 characters")
+	}
+	return v.Valid()
+}
+
+// ValidateMember performs validation on the provided invitation request DTO.
+func (v *RequestValidator) ValidateMember(r *dto.MemberRequest) bool {
+	if r.MemberId == nil || strings.TrimSpace(*r.MemberId) == "" {
+		v.AddError("member_id", "member_id must be provided and cannot be empty or whitespace")
+	}
+	return v.Valid()
+}
@@ -0,0 +1,140 @@
+package dto
+
+import (
+	"domain/role/entity"
+	"sync"
+	"time"
+)
+
+// assignmentRequestPoolInstance is the instance of the getAssignmentRequestPool function to access the pool.
+var assignmentRequestPoolInstance = getAssignmentRequestPool()
+
+// assignmentResponsePoolInstance is the instance of the getAssignmentResponsePool function to access the pool.
+var assignmentResponsePoolInstance = getAssignmentResponsePool()
+
+// getAssignmentRequestPool returns a singleton instance of sync.Pool used to manage AssignmentRequest objects.
+func getAssignmentRequestPool() func() *sync.Pool {
+	var once sync.Once
+	var pool *sync.Pool
+
+	return func() *sync.Pool {
+		once.Do(func() {
+			pool = &sync.Pool{
+				New: func() interface{} {
+					return &AssignmentRequest{}
+				},
+			}
+		})
+		return pool
+	}
+}
+
+// getAssignmentResponsePool returns a singleton instance of sync.Pool used to manage AssignmentResponse objects.
+func getAssignmentResponsePool() func() *sync.Pool {
+	var once sync.Once
+	var pool *sync.Pool
+
+	return func() *sync.Pool {
+		once.Do(func() {
+			pool = &sync.Pool{
+				New: func() interface{} {
+					return &AssignmentResponse{}
+				},
+			}
+		})
+		return pool
+	}
+}
+
+// AssignmentRequest represents the data transfer object for a role assignment request.
+type AssignmentRequest struct {
+	SubjectType *string `json:"subject_type,omitempty"` // SubjectType is either "user" or "client".
+	SubjectId   *string `json:"subject_id,omitempty"`   // SubjectId identifies the user or client.
+	RoleId      *int64  `json:"role_id,omitempty"`      // RoleId is the identifier of the assigned role.
+}
+
+// Reset resets the fields of the AssignmentRequest to their zero values and returns the updated AssignmentRequest.
+func (r *AssignmentRequest) Reset() *AssignmentRequest {
+	r.SubjectType = nil
+	r.SubjectId = nil
+	r.RoleId = nil
+	return r
+}
+
+// Release releases the AssignmentRequest instance back to the pool after resetting it.
+func (r *AssignmentRequest) Release() {
+	assignmentRequestPoolInstance().Put(r.Reset())
+}
+
+// GetAssignmentRequest retrieves an AssignmentRequest object from the pool, resetting it before use.
+func GetAssignmentRequest() *AssignmentRequest {
+	return assignmentRequestPoolInstance().Get().(*AssignmentRequest).Reset()
+}
+
+// ToEntity maps the AssignmentRequest fields to the provided Assignment entity.
+func (r *AssignmentRequest) ToEntity(e *entity.Assignment) {
+	if r.SubjectType != nil {
+		e.SetSubjectType(*r.SubjectType)
+	}
+	if r.SubjectId != nil {
+		e.SetSubjectId(*r.SubjectId)
+	}
+	if r.RoleId != nil {
+		e.SetRoleId(*r.RoleId)
+	}
+}
+
+// AssignmentResponse represents the data transfer object for a role assignment response.
+type AssignmentResponse struct {
+	ID          *int64  `json:"id,omitempty"`           // ID is the unique identifier of the assignment.
+	SubjectType *string `json:"subject_type,omitempty"` // SubjectType is either "user" or "client".
+	SubjectId   *string `json:"subject_id,omitempty"`   // SubjectId identifies the user or client.
+	RoleId      *int64  `json:"role_id,omitempty"`      // RoleId is the identifier of the assigned role.
+	Role        *string `json:"role,omitempty"`         // Role is the name of the assigned role.
+	CreatedAt   *string `json:"created_at,omitempty"`   // CreatedAt is the timestamp when the role was assigned.
+}
+
+// Reset resets the fields of the AssignmentResponse to their zero values and returns the updated AssignmentResponse.
+func (r *AssignmentResponse) Reset() *AssignmentResponse {
+	r.ID = nil
+	r.SubjectType = nil
+	r.SubjectId = nil
+	r.RoleId = nil
+	r.Role = nil
+	r.CreatedAt = nil
+	return r
+}
+
+// Release releases the AssignmentResponse instance back to the pool after resetting it.
+func (r *AssignmentResponse) Release() {
+	assignmentResponsePoolInstance().Put(r.Reset())
+}
+
+// GetAssignmentResponse retrieves an AssignmentResponse object from the pool, resetting it before use.
+func GetAssignmentResponse() *AssignmentResponse {
+	return assignmentResponsePoolInstance().Get().(*AssignmentResponse).Reset()
+}
+
+// FromEntity maps the Assignment entity fields to the AssignmentResponse fields.
+func (r *AssignmentResponse) FromEntity(e *entity.Assignment) *AssignmentResponse {
+	id, subjectType, subjectId, roleId, role := e.GetId(), e.GetSubjectType(), e.GetSubjectId(), e.GetRoleId(),
+		e.GetRoleName()
+	createdAt := e.GetCreatedAt().Format(time.RFC3339)
+
+	r.ID = &id
+	r.SubjectType = &subjectType
+	r.SubjectId = &subjectId
+	r.RoleId = &roleId
+	r.Role = &role
+	r.CreatedAt = &createdAt
+	return r
+}
+
+// ToList converts a slice of Assignment entities to a slice of AssignmentResponse objects.
+func (r *AssignmentResponse) ToList(list []*entity.Assignment) *[]AssignmentResponse {
+	items := make([]AssignmentResponse, len(list))
+	for i, item := range list {
+		items[i] = *r.Reset().FromEntity(item)
+	}
+	return &items
+}
@@ -0,0 +1,152 @@
+package dto
+
+import (
+	"domain/role/entity"
+	"sync"
+	"time"
+)
+
+// roleRequestPoolInstance is the instance of the getRoleRequestPool function to access the pool.
+var roleRequestPoolInstance = getRoleRequestPool()
+
+// roleResponsePoolInstance is the instance of the getRoleResponsePool function to access the pool.
+var roleResponsePoolInstance = getRoleResponsePool()
+
+// getRoleRequestPool returns a singleton instance of sync.Pool used to manage RoleRequest objects.
+func getRoleRequestPool() func() *sync.Pool {
+	var once sync.Once
+	var pool *sync.Pool
+
+	return func() *sync.Pool {
+		once.Do(func() {
+			pool = &sync.Pool{
+				New: func() interface{} {
+					return &RoleRequest{}
+				},
+			}
+		})
+		return pool
+	}
+}
+
+// getRoleResponsePool returns a singleton instance of sync.Pool used to manage RoleResponse objects.
+func getRoleResponsePool() func() *sync.Pool {
+	var once sync.Once
+	var pool *sync.Pool
+
+	return func() *sync.Pool {
+		once.Do(func() {
+			pool = &sync.Pool{
+				New: func() interface{} {
+					return &RoleResponse{}
+				},
+			}
+		})
+		return pool
+	}
+}
+
+// RoleRequest represents the data transfer object for a role request.
+type RoleRequest struct {
+	ID          *int64    `json:"id,omitempty"`          // ID is the unique identifier of the role.
+	Name        *string   `json:"name,omitempty"`        // Name of the role.
+	Description *string   `json:"description,omitempty"` // Description of the role.
+	Scopes      *[]string `json:"scopes,omitempty"`      // Scopes granted by the role.
+	Version     *int32    `json:"version,omitempty"`     // Version the client expects to update.
+}
+
+// Reset resets the fields of the RoleRequest to their zero values and returns the updated RoleRequest.
+func (r *RoleRequest) Reset() *RoleRequest {
+	r.ID = nil
+	r.Name = nil
+	r.Description = nil
+	r.Scopes = nil
+	r.Version = nil
+	return r
+}
+
+// Release releases the RoleRequest instance back to the pool after resetting it.
+func (r *RoleRequest) Release() {
+	roleRequestPoolInstance().Put(r.Reset())
+}
+
+// GetRoleRequest retrieves a RoleRequest object from the pool, resetting it before use.
+func GetRoleRequest() *RoleRequest {
+	return roleRequestPoolInstance().Get().(*RoleRequest).Reset()
+}
+
+// ToEntity maps the RoleRequest fields to the provided Role entity.
+func (r *RoleRequest) ToEntity(e *entity.Role) {
+	if r.Name != nil {
+		e.SetName(*r.Name)
+	}
+	if r.Description != nil {
+		e.SetDescription(*r.Description)
+	}
+	if r.Scopes != nil {
+		e.SetScopes(*r.Scopes)
+	}
+	if r.Version != nil {
+		e.SetVersion(*r.Version)
+	}
+}
+
+// RoleResponse represents the data transfer object for a role response.
+type RoleResponse struct {
+	ID          *int64   `json:"id,omitempty"`         // ID is the unique identifier of the role.
+	Name        *string  `json:"name,omitempty"`       // Name of the role.
+	Description *string  `json:"description"`          // Description of the role.
+	Scopes      []string `json:"scopes"`               // Scopes granted by the role.
+	CreatedAt   *string  `json:"created_at,omitempty"` // CreatedAt is the timestamp when the role was created.
+	UpdatedAt   *string  `json:"updated_at,omitempty"` // UpdatedAt is the timestamp of the last update.
+	Version     *int32   `json:"version,omitempty"`    // Version of the role.
+}
+
+// Reset resets the fields of the RoleResponse to their zero values and returns the updated RoleResponse.
+func (r *RoleResponse) Reset() *RoleResponse {
+	r.ID = nil
+	r.Name = nil
+	r.Description = nil
+	r.Scopes = nil
+	r.CreatedAt = nil
+	r.UpdatedAt = nil
+	r.Version = nil
+	return r
+}
+
+// Release releases the RoleResponse instance back to the pool after resetting it.
+func (r *RoleResponse) Release() {
+	roleResponsePoolInstance().Put(r.Reset())
+}
+
+// GetRoleResponse retrieves a RoleResponse object from the pool, resetting it before use.
+func GetRoleResponse() *RoleResponse {
+	return roleResponsePoolInstance().Get().(*RoleResponse).Reset()
+}
+
+// FromEntity maps the Role entity fields to the RoleResponse fields.
+func (r *RoleResponse) FromEntity(e *entity.Role) *RoleResponse {
+	id, name, description, version := e.GetId(), e.GetName(), e.GetDescription(), e.GetVersion()
+	createdAt, updatedAt := e.GetCreatedAt().Format(time.RFC3339), e.GetUpdatedAt().Format(time.RFC3339)
+
+	r.ID = &id
+	r.Name = &name
+	r.Description = &description
+	r.Scopes = e.GetScopes()
+	if r.Scopes == nil {
+		r.Scopes = []string{}
+	}
+	r.CreatedAt = &createdAt
+	r.UpdatedAt = &updatedAt
+	r.Version = &version
+	return r
+}
+
+// ToList converts a slice of Role entities to a slice of RoleResponse objects.
+func (r *RoleResponse) ToList(list []*entity.Role) *[]RoleResponse {
+	items := make([]RoleResponse, len(list))
+	for i, item := range list {
+		items[i] = *r.Reset().FromEntity(item)
+	}
+	return &items
+}
@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"application/role"
+	"domain/role/entity"
+	"domain/role/repository"
+	"errors"
+	"fmt"
+	"interfaces/api/role/dto"
+	"interfaces/api/role/validators"
+	"interfaces/api/utils"
+	"net/http"
+)
+
+// CreateAssignmentHandler handles the HTTP requests for assigning a role to a subject.
+type CreateAssignmentHandler struct {
+	*utils.Handler               // HTTP handler utility.
+	*utils.Errors                // Error handler for standardized error responses.
+	*role.Service                // Role service for business logic.
+	*validators.RequestValidator // Role request validator.
+}
+
+// NewCreateAssignmentHandler creates and returns a new instance of CreateAssignmentHandler.
+func NewCreateAssignmentHandler(
+	handler *utils.Handler,
+	errors *utils.Errors,
+	service *role.Service,
+	validator *validators.RequestValidator,
+) *CreateAssignmentHandler {
+	return &CreateAssignmentHandler{
+		Handler:          handler,
+		Errors:           errors,
+		Service:          service,
+		RequestValidator: validator,
+	}
+}
+
+// Execute processes the HTTP request to assign a role to a subject.
+func (h *CreateAssignmentHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	// Parse and validate request
+	request, err := h.parseAndValidateRequest(w, r)
+	if err != nil {
+		return
+	}
+	defer request.Release()
+
+	// Map request DTO to Assignment entity
+	e := entity.GetAssignment()
+	defer e.Release()
+	request.ToEntity(e)
+
+	// Save assignment
+	if err = h.Service.AssignRole(r.Context(), e); err != nil {
+		h.handleCreateError(w, r, err)
+		return
+	}
+
+	// Send success response
+	h.sendSuccessResponse(w, r, e)
+}
+
+// parseAndValidateRequest reads, parses, and validates the incoming JSON request body.
+// Returns the validated request or an error if validation fails.
+func (h *CreateAssignmentHandler) parseAndValidateRequest(
+	w http.ResponseWriter,
+	r *http.Request,
+) (*dto.AssignmentRequest, error) {
+	request := dto.GetAssignmentRequest()
+	if err := h.ReadJson(w, r, &request); err != nil {
+		h.ErrorResponse(w, r, http.StatusBadRequest, err.Error())
+		return nil, err
+	}
+
+	if !h.RequestValidator.ValidateAssignment(request) {
+		h.FailedValidationResponse(w, r, h.RequestValidator.Errors)
+		h.RequestValidator.ClearErrors()
+		return nil, fmt.Errorf("validation failed")
+	}
+
+	return request, nil
+}
+
+// handleCreateError maps the errors returned by the role service to HTTP responses.
+func (h *CreateAssignmentHandler) handleCreateError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, repository.ErrRoleNotFound):
+		h.FailedValidationResponse(w, r, map[string]string{"role_id": "role does not exist"})
+	case errors.Is(err, repository.ErrDuplicate):
+		h.ErrorResponse(w, r, http.StatusConflict, "the subject already holds this role")
+	default:
+		h.ServerErrorResponse(w, r, err)
+	}
+}
+
+// sendSuccessResponse sends a success response with the created assignment's data.
+func (h *CreateAssignmentHandler) sendSuccessResponse(w http.ResponseWriter, r *http.Request, e *entity.Assignment) {
+	response := dto.GetAssignmentResponse().FromEntity(e)
+	defer response.Release()
+
+	w.Header().Add("Location", fmt.Sprintf("/v1/admin/role-assignments/%d", e.GetId()))
+	if err := h.Write(w, r, http.StatusCreated, response, nil); err != nil {
+		h.WriteErrorResponse(w, r, err)
+	}
+}
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"application/role"
+	"domain/role/repository"
+	"errors"
+	"interfaces/api/utils"
+	"net/http"
+)
+
+// DeleteAssignmentHandler handles HTTP requests for revoking a role assignment by its unique identifier.
+type DeleteAssignmentHandler struct {
+	*utils.Handler // HTTP handler utility.
+	*utils.Errors  // Error handler for standardized error responses.
+	*role.Service  // Role service for business logic.
+}
+
+// NewDeleteAssignmentHandler creates and returns a new instance of DeleteAssignmentHandler.
+func NewDeleteAssignmentHandler(
+	handler *utils.Handler,
+	errors *utils.Errors,
+	service *role.Service,
+) *DeleteAssignmentHandler {
+	return &DeleteAssignmentHandler{
+		Handler: handler,
+		Errors:  errors,
+		Service: service,
+	}
+}
+
+// Execute processes the HTTP request to revoke a role assignment by its ID.
+func (h *DeleteAssignmentHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	id, err := h.ExtractId(r)
+	if err != nil {
+		h.NotFoundResponse(w, r)
+		return
+	}
+
+	if err = h.Service.RevokeAssignment(r.Context(), id); err != nil {
+		if errors.Is(err, repository.ErrRoleNotFound) {
+			h.NotFoundResponse(w, r)
+			return
+		}
+		h.ServerErrorResponse(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
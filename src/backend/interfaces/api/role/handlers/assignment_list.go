@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"application/role"
+	"domain/role/entity"
+	"interfaces/api/role/dto"
+	"interfaces/api/role/validators"
+	"interfaces/api/utils"
+	"net/http"
+)
+
+// ListAssignmentHandler handles the HTTP requests for listing role assignments.
+type ListAssignmentHandler struct {
+	*utils.Handler               // HTTP handler utility.
+	*utils.Errors                // Error handler for standardized error responses.
+	*role.Service                // Role service for business logic.
+	*validators.RequestValidator // Role request validator.
+}
+
+// NewListAssignmentHandler creates and returns a new instance of ListAssignmentHandler.
+func NewListAssignmentHandler(
+	handler *utils.Handler,
+	errors *utils.Errors,
+	service *role.Service,
+	validator *validators.RequestValidator,
+) *ListAssignmentHandler {
+	return &ListAssignmentHandler{
+		Handler:          handler,
+		Errors:           errors,
+		Service:          service,
+		RequestValidator: validator,
+	}
+}
+
+// Execute processes the HTTP request to list role assignments.
+// The optional subject_type and subject_id query parameters narrow the result to a single subject.
+func (h *ListAssignmentHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	subjectType := h.GetQueryString(q, "subject_type", "")
+	subjectId := h.GetQueryString(q, "subject_id", "")
+
+	// Validate
+	if !h.RequestValidator.ValidateSubjectFilter(subjectType) {
+		h.FailedQueryValidationResponse(w, r, h.RequestValidator.Errors)
+		h.RequestValidator.ClearErrors()
+		return
+	}
+
+	items, err := h.Service.ListAssignments(r.Context(), subjectType, subjectId)
+	if err != nil {
+		h.ServerErrorResponse(w, r, err)
+		return
+	}
+	defer releaseAssignments(items)
+
+	// Send success response
+	h.sendSuccessResponse(w, r, items)
+}
+
+// sendSuccessResponse sends a success response with the list of role assignments.
+func (h *ListAssignmentHandler) sendSuccessResponse(w http.ResponseWriter, r *http.Request, data []*entity.Assignment) {
+	response := dto.GetAssignmentResponse()
+	defer response.Release()
+
+	items := response.ToList(data)
+	if err := h.Write(w, r, http.StatusOK, items, nil); err != nil {
+		h.WriteErrorResponse(w, r, err)
+	}
+}
+
+// releaseAssignments returns the given Assignment entities to the pool.
+func releaseAssignments(list []*entity.Assignment) {
+	for _, item := range list {
+		item.Release()
+	}
+}
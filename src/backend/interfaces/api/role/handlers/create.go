@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"application/role"
+	"domain/role/entity"
+	"domain/role/repository"
+	"errors"
+	"fmt"
+	"interfaces/api/role/dto"
+	"interfaces/api/role/validators"
+	"interfaces/api/utils"
+	"net/http"
+)
+
+// CreateRoleHandler handles the HTTP requests for creating a new role.
+type CreateRoleHandler struct {
+	*utils.Handler               // HTTP handler utility.
+	*utils.Errors                // Error handler for standardized error responses.
+	*role.Service                // Role service for business logic.
+	*validators.RequestValidator // Role request validator.
+}
+
+// NewCreateRoleHandler creates and returns a new instance of CreateRoleHandler.
+func NewCreateRoleHandler(
+	handler *utils.Handler,
+	errors *utils.Errors,
+	service *role.Service,
+	validator *validators.RequestValidator,
+) *CreateRoleHandler {
+	return &CreateRoleHandler{
+		Handler:          handler,
+		Errors:           errors,
+		Service:          service,
+		RequestValidator: validator,
+	}
+}
+
+// Execute processes the HTTP request to create a new role.
+func (h *CreateRoleHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	// Parse and validate request
+	request, err := h.parseAndValidateRequest(w, r)
+	if err != nil {
+		return
+	}
+	defer request.Release()
+
+	// Map request DTO to Role entity
+	e := entity.GetRole()
+	defer e.Release()
+	request.ToEntity(e)
+
+	// Save role
+	if err = h.Service.CreateRole(r.Context(), e); err != nil {
+		if errors.Is(err, repository.ErrDuplicate) {
+			h.FailedValidationResponse(w, r, map[string]string{"name": "a role with this name already exists"})
+			return
+		}
+		h.ServerErrorResponse(w, r, err)
+		return
+	}
+
+	// Send success response
+	h.sendSuccessResponse(w, r, e)
+}
+
+// parseAndValidateRequest reads, parses, and validates the incoming JSON request body.
+// Returns the validated request or an error if validation fails.
+func (h *CreateRoleHandler) parseAndValidateRequest(w http.ResponseWriter, r *http.Request) (*dto.RoleRequest, error) {
+	request := dto.GetRoleRequest()
+	if err := h.ReadJson(w, r, &request); err != nil {
+		h.ErrorResponse(w, r, http.StatusBadRequest, err.Error())
+		return nil, err
+	}
+
+	if !h.RequestValidator.ValidateRole(request) {
+		h.FailedValidationResponse(w, r, h.RequestValidator.Errors)
+		h.RequestValidator.ClearErrors()
+		return nil, fmt.Errorf("validation failed")
+	}
+
+	return request, nil
+}
+
+// sendSuccessResponse sends a success response with the created role's data.
+func (h *CreateRoleHandler) sendSuccessResponse(w http.ResponseWriter, r *http.Request, e *entity.Role) {
+	response := dto.GetRoleResponse().FromEntity(e)
+	defer response.Release()
+
+	w.Header().Add("Location", fmt.Sprintf("/v1/admin/roles/%d", e.GetId()))
+	if err := h.Write(w, r, http.StatusCreated, response, nil); err != nil {
+		h.WriteErrorResponse(w, r, err)
+	}
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"application/role"
+	"domain/role/entity"
+	"interfaces/api/role/dto"
+	"interfaces/api/utils"
+	"net/http"
+)
+
+// ListRoleHandler handles the HTTP requests for listing roles.
+type ListRoleHandler struct {
+	*utils.Handler // HTTP handler utility.
+	*utils.Errors  // Error handler for standardized error responses.
+	*role.Service  // Role service for business logic.
+}
+
+// NewListRoleHandler creates and returns a new instance of ListRoleHandler.
+func NewListRoleHandler(handler *utils.Handler, errors *utils.Errors, service *role.Service) *ListRoleHandler {
+	return &ListRoleHandler{
+		Handler: handler,
+		Errors:  errors,
+		Service: service,
+	}
+}
+
+// Execute processes the HTTP request to list roles.
+func (h *ListRoleHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	items, err := h.Service.ListRoles(r.Context())
+	if err != nil {
+		h.ServerErrorResponse(w, r, err)
+		return
+	}
+	defer releaseRoles(items)
+
+	// Send success response
+	h.sendSuccessResponse(w, r, items)
+}
+
+// sendSuccessResponse sends a success response with the list of roles.
+func (h *ListRoleHandler) sendSuccessResponse(w http.ResponseWriter, r *http.Request, data []*entity.Role) {
+	response := dto.GetRoleResponse()
+	defer response.Release()
+
+	items := response.ToList(data)
+	if err := h.Write(w, r, http.StatusOK, items, nil); err != nil {
+		h.WriteErrorResponse(w, r, err)
+	}
+}
+
+// releaseRoles returns the given Role entities to the pool.
+func releaseRoles(list []*entity.Role) {
+	for _, item := range list {
+		item.Release()
+	}
+}
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"application/role"
+	"domain/role/entity"
+	"domain/role/repository"
+	"errors"
+	"fmt"
+	"interfaces/api/role/dto"
+	"interfaces/api/role/validators"
+	"interfaces/api/utils"
+	"net/http"
+)
+
+// UpdateRoleHandler handles HTTP requests for updating an existing role by its ID.
+type UpdateRoleHandler struct {
+	*utils.Handler               // HTTP handler utility.
+	*utils.Errors                // Error handler for standardized error responses.
+	*role.Service                // Role service for business logic.
+	*validators.RequestValidator // Role request validator.
+}
+
+// NewUpdateRoleHandler creates and returns a new instance of UpdateRoleHandler.
+func NewUpdateRoleHandler(
+	handler *utils.Handler,
+	errors *utils.Errors,
+	service *role.Service,
+	validator *validators.RequestValidator,
+) *UpdateRoleHandler {
+	return &UpdateRoleHandler{
+		Handler:          handler,
+		Errors:           errors,
+		Service:          service,
+		RequestValidator: validator,
+	}
+}
+
+// Execute processes the HTTP request to update an existing role.
+// When the request carries a version, the update only succeeds if it matches the stored version.
+func (h *UpdateRoleHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	// Parse and validate request
+	request, err := h.parseAndValidateRequest(w, r)
+	if err != nil {
+		return
+	}
+	defer request.Release()
+
+	// Retrieve role by ID
+	e, err := h.Service.GetRole(r.Context(), *request.ID)
+	if err != nil {
+		h.NotFoundResponse(w, r)
+		return
+	}
+	defer e.Release()
+	request.ToEntity(e)
+
+	// Save role
+	if err = h.Service.UpdateRole(r.Context(), e); err != nil {
+		h.handleUpdateError(w, r, err)
+		return
+	}
+
+	// Send success response
+	h.sendSuccessResponse(w, r, e)
+}
+
+// parseAndValidateRequest parses the incoming request and validates it for updating a role.
+// Returns the validated request or an error if parsing/validation fails.
+func (h *UpdateRoleHandler) parseAndValidateRequest(w http.ResponseWriter, r *http.Request) (*dto.RoleRequest, error) {
+	id, err := h.ExtractId(r)
+	if err != nil {
+		h.NotFoundResponse(w, r)
+		return nil, err
+	}
+
+	request := dto.GetRoleRequest()
+	if err = h.ReadJson(w, r, &request); err != nil {
+		h.ErrorResponse(w, r, http.StatusUnprocessableEntity, err.Error())
+		h.RequestValidator.ClearErrors()
+		return nil, err
+	}
+
+	// Assign the extracted ID to the request
+	request.ID = &id
+	if !h.RequestValidator.ValidateRoleForUpdate(request) {
+		h.FailedValidationResponse(w, r, h.RequestValidator.Errors)
+		h.RequestValidator.ClearErrors()
+		return nil, fmt.Errorf("validation failed")
+	}
+
+	return request, nil
+}
+
+// handleUpdateError maps the errors returned by the role service to HTTP responses.
+func (h *UpdateRoleHandler) handleUpdateError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, repository.ErrEditConflict):
+		h.EditConflictResponse(w, r)
+	case errors.Is(err, repository.ErrDuplicate):
+		h.FailedValidationResponse(w, r, map[string]string{"name": "a role with this name already exists"})
+	default:
+		h.ServerErrorResponse(w, r, err)
+	}
+}
+
+// sendSuccessResponse sends a success response with the updated role data.
+func (h *UpdateRoleHandler) sendSuccessResponse(w http.ResponseWriter, r *http.Request, e *entity.Role) {
+	response := dto.GetRoleResponse().FromEntity(e)
+	defer response.Release()
+
+	w.Header().Add("Location", fmt.Sprintf("/v1/admin/roles/%d", e.GetId()))
+	if err := h.Write(w, r, http.StatusOK, response, nil); err != nil {
+		h.WriteErrorResponse(w, r, err)
+	}
+}
@@ -0,0 +1,101 @@
+package validators
+
+import (
+	authEntity "domain/auth/entity"
+	"domain/role/entity"
+	"interfaces/api/role/dto"
+	"interfaces/api/utils/validators"
+	"strings"
+)
+
+// RequestValidator is responsible for validating role and role assignment request DTOs.
+type RequestValidator struct {
+	*validators.Validator          // Embeds the general Validator to leverage its validation functions.
+	subjectTypes          []string // Subject types a role can be assigned to.
+}
+
+// NewRequestValidator creates and returns a new instance of RequestValidator.
+// It retrieves a Validator instance from the pool for efficient memory usage.
+func NewRequestValidator() *RequestValidator {
+	return &RequestValidator{
+		Validator:    validators.GetValidator(),
+		subjectTypes: []string{entity.SubjectUser, entity.SubjectClient},
+	}
+}
+
+// ValidateRole performs validation on the provided role request DTO for creating a new role.
+func (v *RequestValidator) ValidateRole(r *dto.RoleRequest) bool {
+	return v.performRoleValidation(r, true)
+}
+
+// ValidateRoleForUpdate performs validation on the provided role request DTO for updating an existing role.
+// It checks only the non-nil fields, allowing partial updates.
+func (v *RequestValidator) ValidateRoleForUpdate(r *dto.RoleRequest) bool {
+	return v.performRoleValidation(r, false)
+}
+
+// ValidateAssignment performs validation on the provided role assignment request DTO.
+func (v *RequestValidator) ValidateAssignment(r *dto.AssignmentRequest) bool {
+	if r.SubjectType == nil {
+		v.AddError("subject_type", "subject_type must be provided")
+	} else {
+		v.validateSubjectType(*r.SubjectType)
+	}
+	if r.SubjectId == nil || strings.TrimSpace(*r.SubjectId) == "" {
+		v.AddError("subject_id", "subject_id must be provided and cannot be empty or whitespace")
+	}
+	v.Check(r.RoleId != nil && *r.RoleId > 0, "role_id", "role_id must be a positive integer")
+
+	return v.Valid()
+}
+
+// ValidateSubjectFilter validates the optional subject type filter of the assignment list.
+func (v *RequestValidator) ValidateSubjectFilter(subjectType string) bool {
+	if subjectType != "" {
+		v.validateSubjectType(subjectType)
+	}
+	return v.Valid()
+}
+
+// performRoleValidation performs the common validation logic for both creation and update scenarios.
+func (v *RequestValidator) performRoleValidation(r *dto.RoleRequest, checkRequired bool) bool {
+	if checkRequired || r.Name != nil {
+		if r.Name == nil || strings.TrimSpace(*r.Name) == "" {
+			v.AddError("name", "name must be provided and cannot be empty or whitespace")
+		}
+	}
+	if checkRequired || r.Scopes != nil {
+		if r.Scopes == nil || len(*r.Scopes) == 0 {
+			v.AddError("scopes", "scopes must contain at least one scope")
+		} else {
+			v.validateScopes(*r.Scopes)
+		}
+	}
+	if r.Version != nil {
+		v.Check(*r.Version > 0, "version", "version must be a positive integer")
+	}
+
+	return v.Valid()
+}
+
+// validateScopes checks that every scope is known and listed only once.
+func (v *RequestValidator) validateScopes(scopes []string) {
+	seen := make(map[string]struct{}, len(scopes))
+	for _, scope := range scopes {
+		if !v.PermittedValue(scope, authEntity.Scopes()...) {
+			v.AddError("scopes", "scopes must only contain: "+strings.Join(authEntity.Scopes(), ", "))
+			return
+		}
+		if _, ok := seen[scope]; ok {
+			v.AddError("scopes", "scopes must not contain duplicate values")
+			return
+		}
+		seen[scope] = struct{}{}
+	}
+}
+
+// validateSubjectType checks that the subject type is one of the supported values.
+func (v *RequestValidator) validateSubjectType(subjectType string) {
+	v.Check(v.PermittedValue(subjectType, v.subjectTypes...), "subject_type",
+		"subject_type must be one of: "+strings.Join(v.subjectTypes, ", "))
+}
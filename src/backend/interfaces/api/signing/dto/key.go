@@ -0,0 +1,127 @@
+package dto
+
+import (
+	"domain/signing/entity"
+	"sync"
+	"time"
+)
+
+// keyRequestPoolInstance is the instance of the getKeyRequestPool function to access the pool.
+var keyRequestPoolInstance = getKeyRequestPool()
+
+// keyResponsePoolInstance is the instance of the getKeyResponsePool function to access the pool.
+var keyResponsePoolInstance = getKeyResponsePool()
+
+// getKeyRequestPool returns a singleton instance of sync.Pool used to manage KeyRequest objects.
+func getKeyRequestPool() func() *sync.Pool {
+	var once sync.Once
+	var pool *sync.Pool
+
+	return func() *sync.Pool {
+		once.Do(func() {
+			pool = &sync.Pool{
+				New: func() interface{} {
+					return &KeyRequest{}
+				},
+			}
+		})
+		return pool
+	}
+}
+
+// getKeyResponsePool returns a singleton instance of sync.Pool used to manage KeyResponse objects.
+func getKeyResponsePool() func() *sync.Pool {
+	var once sync.Once
+	var pool *sync.Pool
+
+	return func() *sync.Pool {
+		once.Do(func() {
+			pool = &sync.Pool{
+				New: func() interface{} {
+					return &KeyResponse{}
+				},
+			}
+		})
+		return pool
+	}
+}
+
+// KeyRequest represents the data transfer object for a signing key request.
+type KeyRequest struct {
+	ClientId *string `json:"client_id,omitempty"` // ClientId is the API client the key is issued to.
+}
+
+// Reset resets the fields of the KeyRequest to their zero values and returns the updated KeyRequest.
+func (r *KeyRequest) Reset() *KeyRequest {
+	r.ClientId = nil
+	return r
+}
+
+// Release releases the KeyRequest instance back to the pool after resetting it.
+func (r *KeyRequest) Release() {
+	keyRequestPoolInstance().Put(r.Reset())
+}
+
+// GetKeyRequest retrieves a KeyRequest object from the pool, resetting it before use.
+func GetKeyRequest() *KeyRequest {
+	return keyRequestPoolInstance().Get().(*KeyRequest).Reset()
+}
+
+// KeyResponse represents the data transfer object for a signing key response.
+type KeyResponse struct {
+	ID        *int64  `json:"id,omitempty"`         // ID is the unique identifier of the key.
+	KeyId     *string `json:"key_id,omitempty"`     // KeyId is the public identifier sent with each signature.
+	ClientId  *string `json:"client_id,omitempty"`  // ClientId is the API client the key was issued to.
+	Secret    *string `json:"secret,omitempty"`     // Secret is only returned when the key is issued.
+	CreatedAt *string `json:"created_at,omitempty"` // CreatedAt is the timestamp when the key was issued.
+	RevokedAt *string `json:"revoked_at,omitempty"` // RevokedAt is the timestamp when the key was revoked.
+}
+
+// Reset resets the fields of the KeyResponse to their zero values and returns the updated KeyResponse.
+func (r *KeyResponse) Reset() *KeyResponse {
+	r.ID = nil
+	r.KeyId = nil
+	r.ClientId = nil
+	r.Secret = nil
+	r.CreatedAt = nil
+	r.RevokedAt = nil
+	return r
+}
+
+// Release releases the KeyResponse instance back to the pool after resetting it.
+func (r *KeyResponse) Release() {
+	keyResponsePoolInstance().Put(r.Reset())
+}
+
+// GetKeyResponse retrieves a KeyResponse object from the pool, resetting it before use.
+func GetKeyResponse() *KeyResponse {
+	return keyResponsePoolInstance().Get().(*KeyResponse).Reset()
+}
+
+// FromEntity maps the Key entity fields to the KeyResponse fields. The secret is included only if populated.
+func (r *KeyResponse) FromEntity(e *entity.Key) *KeyResponse {
+	id, keyId, clientId := e.GetId(), e.GetKeyId(), e.GetClientId()
+	createdAt := e.GetCreatedAt().Format(time.RFC3339)
+
+	r.ID = &id
+	r.KeyId = &keyId
+	r.ClientId = &clientId
+	if secret := e.GetSecret(); secret != "" {
+		r.Secret = &secret
+	}
+	r.CreatedAt = &createdAt
+	if e.IsRevoked() {
+		revokedAt := e.GetRevokedAt().Format(time.RFC3339)
+		r.RevokedAt = &revokedAt
+	}
+	return r
+}
+
+// ToList converts a slice of Key entities to a slice of KeyResponse objects.
+func (r *KeyResponse) ToList(list []*entity.Key) *[]KeyResponse {
+	items := make([]KeyResponse, len(list))
+	for i, item := range list {
+		items[i] = *r.Reset().FromEntity(item)
+	}
+	return &items
+}
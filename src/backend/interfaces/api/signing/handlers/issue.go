@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"application/signing"
+	"domain/signing/entity"
+	"fmt"
+	"interfaces/api/signing/dto"
+	"interfaces/api/signing/validators"
+	"interfaces/api/utils"
+	"net/http"
+)
+
+// IssueKeyHandler handles the HTTP requests for issuing a signing key to an API client.
+type IssueKeyHandler struct {
+	*utils.Handler               // HTTP handler utility.
+	*utils.Errors                // Error handler for standardized error responses.
+	*signing.Service             // Signing key service for business logic.
+	*validators.RequestValidator // Signing key request validator.
+}
+
+// NewIssueKeyHandler creates and returns a new instance of IssueKeyHandler.
+func NewIssueKeyHandler(
+	handler *utils.Handler,
+	errors *utils.Errors,
+	service *signing.Service,
+	validator *validators.RequestValidator,
+) *IssueKeyHandler {
+	return &IssueKeyHandler{
+		Handler:          handler,
+		Errors:           errors,
+		Service:          service,
+		RequestValidator: validator,
+	}
+}
+
+// Execute processes the HTTP request to issue a signing key. The response is the only one carrying the secret.
+func (h *IssueKeyHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	// Parse and validate request
+	request, err := h.parseAndValidateRequest(w, r)
+	if err != nil {
+		return
+	}
+	defer request.Release()
+
+	// Issue key
+	e, err := h.Service.IssueKey(r.Context(), *request.ClientId)
+	if err != nil {
+		h.ServerErrorResponse(w, r, err)
+		return
+	}
+	defer e.Release()
+
+	// Send success response
+	h.sendSuccessResponse(w, r, e)
+}
+
+// parseAndValidateRequest reads, parses, and validates the incoming JSON request body.
+// Returns the validated request or an error if validation fails.
+func (h *IssueKeyHandler) parseAndValidateRequest(w http.ResponseWriter, r *http.Request) (*dto.KeyRequest, error) {
+	request := dto.GetKeyRequest()
+	if err := h.ReadJson(w, r, &request); err != nil {
+		h.ErrorResponse(w, r, http.StatusBadRequest, err.Error())
+		return nil, err
+	}
+
+	if !h.RequestValidator.ValidateKey(request) {
+		h.FailedValidationResponse(w, r, h.RequestValidator.Errors)
+		h.RequestValidator.ClearErrors()
+		return nil, fmt.Errorf("validation failed")
+	}
+
+	return request, nil
+}
+
+// sendSuccessResponse sends a success response with the issued key, including its secret.
+func (h *IssueKeyHandler) sendSuccessResponse(w http.ResponseWriter, r *http.Request, e *entity.Key) {
+	response := dto.GetKeyResponse().FromEntity(e)
+	defer response.Release()
+
+	headers := http.Header{"Cache-Control": []string{"no-store"}}
+	if err := h.Write(w, r, http.StatusCreated, response, headers); err != nil {
+		h.WriteErrorResponse(w, r, err)
+	}
+}
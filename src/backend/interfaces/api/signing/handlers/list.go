@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"application/signing"
+	"domain/signing/entity"
+	"interfaces/api/signing/dto"
+	"interfaces/api/utils"
+	"net/http"
+)
+
+// ListKeyHandler handles the HTTP requests for listing signing keys.
+type ListKeyHandler struct {
+	*utils.Handler   // HTTP handler utility.
+	*utils.Errors    // Error handler for standardized error responses.
+	*signing.Service // Signing key service for business logic.
+}
+
+// NewListKeyHandler creates and returns a new instance of ListKeyHandler.
+func NewListKeyHandler(
+	handler *utils.Handler,
+	errors *utils.Errors,
+	service *signing.Service,
+) *ListKeyHandler {
+	return &ListKeyHandler{
+		Handler: handler,
+		Errors:  errors,
+		Service: service,
+	}
+}
+
+// Execute processes the HTTP request to list signing keys, without their secrets.
+// The optional client_id query parameter narrows the result to a single API client.
+func (h *ListKeyHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	clientId := h.GetQueryString(r.URL.Query(), "client_id", "")
+
+	items, err := h.Service.ListKeys(r.Context(), clientId)
+	if err != nil {
+		h.ServerErrorResponse(w, r, err)
+		return
+	}
+	defer releaseKeys(items)
+
+	// Send success response
+	h.sendSuccessResponse(w, r, items)
+}
+
+// sendSuccessResponse sends a success response with the list of signing keys.
+func (h *ListKeyHandler) sendSuccessResponse(w http.ResponseWriter, r *http.Request, data []*entity.Key) {
+	response := dto.GetKeyResponse()
+	defer response.Release()
+
+	items := response.ToList(data)
+	if err := h.Write(w, r, http.StatusOK, items, nil); err != nil {
+		h.WriteErrorResponse(w, r, err)
+	}
+}
+
+// releaseKeys returns the given Key entities to the pool.
+func releaseKeys(list []*entity.Key) {
+	for _, item := range list {
+		item.Release()
+	}
+}
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"application/signing"
+	"domain/signing/repository"
+	"errors"
+	"interfaces/api/utils"
+	"net/http"
+)
+
+// RevokeKeyHandler handles HTTP requests for revoking a signing key by its unique identifier.
+type RevokeKeyHandler struct {
+	*utils.Handler   // HTTP handler utility.
+	*utils.Errors    // Error handler for standardized error responses.
+	*signing.Service // Signing key service for business logic.
+}
+
+// NewRevokeKeyHandler creates and returns a new instance of RevokeKeyHandler.
+func NewRevokeKeyHandler(
+	handler *utils.Handler,
+	errors *utils.Errors,
+	service *signing.Service,
+) *RevokeKeyHandler {
+	return &RevokeKeyHandler{
+		Handler: handler,
+		Errors:  errors,
+		Service: service,
+	}
+}
+
+// Execute processes the HTTP request to revoke a signing key by its ID.
+func (h *RevokeKeyHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	id, err := h.ExtractId(r)
+	if err != nil {
+		h.NotFoundResponse(w, r)
+		return
+	}
+
+	if err = h.Service.RevokeKey(r.Context(), id); err != nil {
+		if errors.Is(err, repository.ErrKeyNotFound) {
+			h.NotFoundResponse(w, r)
+			return
+		}
+		h.ServerErrorResponse(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,26 @@
+package validators
+
+import (
+	"interfaces/api/signing/dto"
+	"interfaces/api/utils/validators"
+	"strings"
+)
+
+// RequestValidator is responsible for validating signing key request DTOs.
+type RequestValidator struct {
+	*validators.Validator // Embeds the general Validator to leverage its validation functions.
+}
+
+// NewRequestValidator creates and returns a new instance of RequestValidator.
+// It retrieves a Validator instance from the pool for efficient memory usage.
+func NewRequestValidator() *RequestValidator {
+	return &RequestValidator{Validator: validators.GetValidator()}
+}
+
+// ValidateKey performs validation on the provided signing key request DTO.
+func (v *RequestValidator) ValidateKey(r *dto.KeyRequest) bool {
+	if r.ClientId == nil || strings.TrimSpace(*r.ClientId) == "" {
+		v.AddError("client_id", "client_id must be provided and cannot be empty or whitespace")
+	}
+	return v.Valid()
+}
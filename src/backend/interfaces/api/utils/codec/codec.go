@@ -0,0 +1,30 @@
+package codec
+
+import (
+	"errors"
+	"io"
+)
+
+// ErrUnsupported is returned by the codecs for values they cannot represent, e.g., nested objects in CSV, so
+// another media type accepted by the client may be tried.
+var ErrUnsupported = errors.New("value not supported by the media type")
+
+// Codec encodes response bodies in a media type.
+type Codec interface {
+	// MediaType returns the media type of the codec, e.g., "application/json", matched against the Accept header.
+	MediaType() string
+
+	// ContentType returns the Content-Type header of the bodies encoded by the codec, with their parameters.
+	ContentType() string
+
+	// Encode writes the value in the media type of the codec.
+	// Returns ErrUnsupported if the value cannot be represented in it.
+	Encode(w io.Writer, v any) error
+}
+
+// Decoder is implemented by the codecs decoding request bodies as well.
+type Decoder interface {
+	// Decode reads a single value in the media type of the codec from the reader into v, a pointer.
+	// Returns ErrUnsupported if the value cannot be decoded from it.
+	Decode(r io.Reader, v any) error
+}
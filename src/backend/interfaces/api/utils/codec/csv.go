@@ -0,0 +1,206 @@
+package codec
+
+import (
+	"encoding"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// CSV encodes flat objects, or lists of them, as CSV: a header row naming the properties after their JSON names,
+// then a row per object. Nested objects and lists are not supported.
+type CSV struct{}
+
+// column is a property of the encoded objects.
+type column struct {
+	name  string // JSON name of the property.
+	index []int  // Index of the field holding the property, through the embedded structs.
+}
+
+// textMarshaler is the type of the values formatting themselves, e.g., time.Time.
+var textMarshaler = reflect.TypeFor[encoding.TextMarshaler]()
+
+// MediaType returns the media type of CSV.
+func (c CSV) MediaType() string { return "text/csv" }
+
+// ContentType returns the Content-Type header of CSV bodies.
+func (c CSV) ContentType() string { return "text/csv; charset=utf-8" }
+
+// Encode writes the object, or the list of objects, as CSV.
+// Returns ErrUnsupported for other values and for objects with nested objects or lists.
+func (c CSV) Encode(w io.Writer, v any) error {
+	value := indirect(reflect.ValueOf(v))
+	var objects []reflect.Value
+	switch value.Kind() {
+	case reflect.Struct:
+		objects = []reflect.Value{value}
+	case reflect.Slice, reflect.Array:
+		for i := range value.Len() {
+			objects = append(objects, indirect(value.Index(i)))
+		}
+	default:
+		return fmt.Errorf("%w: %T is not an object or a list", ErrUnsupported, v)
+	}
+
+	columns, err := columnsOf(elemType(reflect.TypeOf(v)))
+	if err != nil {
+		return err
+	}
+	writer := csv.NewWriter(w)
+	if err = writer.Write(header(columns)); err != nil {
+		return err
+	}
+	for _, object := range objects {
+		if err = writer.Write(record(object, columns)); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// elemType returns the type of the objects of a value, the elements of a list or the value itself.
+func elemType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = t.Elem()
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+// columnsOf returns the columns of the objects of the type, a struct with scalar fields, flattening the embedded
+// structs as encoding/json does.
+func columnsOf(t reflect.Type) ([]column, error) {
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: %s is not an object", ErrUnsupported, t)
+	}
+	var columns []column
+	for _, f := range reflect.VisibleFields(t) {
+		name, ok := jsonName(f)
+		if !ok || (f.Anonymous && name == "") {
+			continue
+		}
+		if !scalar(f.Type) {
+			return nil, fmt.Errorf("%w: property %s is not a scalar", ErrUnsupported, name)
+		}
+		columns = append(columns, column{name: name, index: f.Index})
+	}
+	return columns, nil
+}
+
+// jsonName returns the JSON name of the field, empty for embedded structs without one, and whether it is encoded.
+func jsonName(f reflect.StructField) (string, bool) {
+	tag := f.Tag.Get("json")
+	name, _, _ := strings.Cut(tag, ",")
+	switch {
+	case !f.IsExported() && !f.Anonymous, tag == "-":
+		return "", false
+	case name != "":
+		return name, true
+	case f.Anonymous && indirectType(f.Type).Kind() == reflect.Struct:
+		return "", true
+	default:
+		return f.Name, true
+	}
+}
+
+// scalar reports whether values of the type are written as a single CSV field.
+func scalar(t reflect.Type) bool {
+	t = indirectType(t)
+	if t.Implements(textMarshaler) || reflect.PointerTo(t).Implements(textMarshaler) {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Bool, reflect.Float32, reflect.Float64,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	default:
+		return false
+	}
+}
+
+// header returns the header row of the columns.
+func header(columns []column) []string {
+	names := make([]string, 0, len(columns))
+	for _, c := range columns {
+		names = append(names, c.name)
+	}
+	return names
+}
+
+// record returns the row of the object, with empty fields for its nil values.
+func record(object reflect.Value, columns []column) []string {
+	row := make([]string, 0, len(columns))
+	for _, c := range columns {
+		if !object.IsValid() {
+			row = append(row, "")
+			continue
+		}
+		field, err := object.FieldByIndexErr(c.index)
+		if err != nil {
+			row = append(row, "")
+			continue
+		}
+		row = append(row, format(indirect(field)))
+	}
+	return row
+}
+
+// format formats a scalar value, empty if nil.
+func format(v reflect.Value) string {
+	if !v.IsValid() {
+		return ""
+	}
+	if m, ok := textMarshalerOf(v); ok {
+		text, _ := m.MarshalText()
+		return string(text)
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64)
+	default:
+		return v.String()
+	}
+}
+
+// textMarshalerOf returns the value, or its address, as a TextMarshaler if it is one.
+func textMarshalerOf(v reflect.Value) (encoding.TextMarshaler, bool) {
+	if v.CanAddr() {
+		if m, ok := v.Addr().Interface().(encoding.TextMarshaler); ok {
+			return m, true
+		}
+	}
+	m, ok := v.Interface().(encoding.TextMarshaler)
+	return m, ok
+}
+
+// indirect returns the value pointers point to, or the zero Value for nil pointers.
+func indirect(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	return v
+}
+
+// indirectType returns the type pointers of the type point to.
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
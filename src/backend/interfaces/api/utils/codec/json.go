@@ -0,0 +1,42 @@
+package codec
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+// JSON encodes and decodes bodies as JSON, compact unless indented.
+type JSON struct {
+	Indent string // Indentation of the nested values, none for compact JSON.
+}
+
+// MediaType returns the media type of JSON.
+func (c JSON) MediaType() string { return "application/json" }
+
+// ContentType returns the Content-Type header of JSON bodies.
+func (c JSON) ContentType() string { return "application/json; charset=utf-8" }
+
+// Encode writes the value as JSON, followed by a newline.
+func (c JSON) Encode(w io.Writer, v any) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", c.Indent)
+	return encoder.Encode(v)
+}
+
+// Decode reads a single JSON value into v, disallowing unknown fields. The errors of the JSON decoder are returned
+// as is.
+func (c JSON) Decode(r io.Reader, v any) error {
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(v); err != nil {
+		return err
+	}
+
+	// Check for any additional, unexpected data in the body.
+	if err := decoder.Decode(&struct{}{}); !errors.Is(err, io.EOF) {
+		return errors.New("body must contain a single JSON object")
+	}
+	return nil
+}
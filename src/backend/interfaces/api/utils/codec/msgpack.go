@@ -0,0 +1,367 @@
+package codec
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+)
+
+// maxDepth is the deepest nesting of arrays and maps decoded from MessagePack.
+const maxDepth = 64
+
+// errMalformed is returned for MessagePack bodies that are truncated or hold values JSON cannot represent.
+var errMalformed = errors.New("body contains badly-formed MessagePack")
+
+// MessagePack encodes and decodes bodies as MessagePack. Values are converted through their JSON representation, so
+// they get the same properties, and decoded bodies are checked as strictly as JSON ones.
+//
+// The codec is written here rather than taken from a library: it only needs the formats of the specification
+// (https://github.com/msgpack/msgpack/blob/master/spec.md) a JSON value can take, and it must bound the nesting of
+// the bodies it decodes, which the maintained libraries do not, and reject extension types, which they decode.
+type MessagePack struct{}
+
+// MediaType returns the media type of MessagePack.
+func (c MessagePack) MediaType() string { return "application/msgpack" }
+
+// ContentType returns the Content-Type header of MessagePack bodies.
+func (c MessagePack) ContentType() string { return "application/msgpack" }
+
+// Encode writes the JSON representation of the value as MessagePack.
+func (c MessagePack) Encode(w io.Writer, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value any
+	if err = decoder.Decode(&value); err != nil {
+		return err
+	}
+	_, err = w.Write(appendValue(nil, value))
+	return err
+}
+
+// Decode reads a single MessagePack value into v as if it were sent as JSON.
+func (c MessagePack) Decode(r io.Reader, v any) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if len(data) == 0 {
+		return io.EOF
+	}
+	d := &decoder{data: data}
+	value, err := d.value(0)
+	if err != nil {
+		return err
+	}
+	if d.pos != len(data) {
+		return errors.New("body must contain a single MessagePack value")
+	}
+	if data, err = json.Marshal(value); err != nil {
+		return errMalformed
+	}
+	return JSON{}.Decode(bytes.NewReader(data), v)
+}
+
+// appendValue appends the MessagePack encoding of a JSON value decoded with numbers to the buffer.
+func appendValue(b []byte, v any) []byte {
+	switch v := v.(type) {
+	case bool:
+		if v {
+			return append(b, 0xc3)
+		}
+		return append(b, 0xc2)
+	case json.Number:
+		return appendNumber(b, v)
+	case string:
+		return append(appendLength(b, len(v), 0xa0, 32, 0xd9, 0xda), v...)
+	case []any:
+		b = appendLength(b, len(v), 0x90, 16, 0, 0xdc)
+		for _, item := range v {
+			b = appendValue(b, item)
+		}
+		return b
+	case map[string]any:
+		return appendMap(b, v)
+	default:
+		return append(b, 0xc0)
+	}
+}
+
+// appendMap appends the MessagePack encoding of a JSON object, with its keys sorted.
+func appendMap(b []byte, m map[string]any) []byte {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	b = appendLength(b, len(m), 0x80, 16, 0, 0xde)
+	for _, key := range keys {
+		b = appendValue(appendValue(b, key), m[key])
+	}
+	return b
+}
+
+// appendLength appends the header of a string, array or map of length n: the fix format if n is below fixMax,
+// else the 8-bit format if there is one (format8 is not 0) and n fits, else the 16-bit format or the 32-bit one,
+// which follows it.
+func appendLength(b []byte, n int, fix byte, fixMax int, format8, format16 byte) []byte {
+	switch {
+	case n < fixMax:
+		return append(b, fix|byte(n))
+	case format8 != 0 && n <= math.MaxUint8:
+		return append(b, format8, byte(n))
+	case n <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(b, format16), uint16(n))
+	default:
+		return binary.BigEndian.AppendUint32(append(b, format16+1), uint32(n))
+	}
+}
+
+// appendNumber appends the MessagePack encoding of a JSON number: the smallest integer format holding it, or a
+// 64-bit float.
+func appendNumber(b []byte, n json.Number) []byte {
+	if i, err := n.Int64(); err == nil {
+		return appendInt(b, i)
+	}
+	if u, err := strconv.ParseUint(n.String(), 10, 64); err == nil {
+		return appendUint(b, u)
+	}
+	f, _ := n.Float64()
+	return binary.BigEndian.AppendUint64(append(b, 0xcb), math.Float64bits(f))
+}
+
+// appendInt appends the MessagePack encoding of a signed integer.
+func appendInt(b []byte, i int64) []byte {
+	switch {
+	case i >= 0:
+		return appendUint(b, uint64(i))
+	case i >= -32:
+		return append(b, byte(i))
+	case i >= math.MinInt8:
+		return append(b, 0xd0, byte(i))
+	case i >= math.MinInt16:
+		return binary.BigEndian.AppendUint16(append(b, 0xd1), uint16(i))
+	case i >= math.MinInt32:
+		return binary.BigEndian.AppendUint32(append(b, 0xd2), uint32(i))
+	default:
+		return binary.BigEndian.AppendUint64(append(b, 0xd3), uint64(i))
+	}
+}
+
+// appendUint appends the MessagePack encoding of an unsigned integer.
+func appendUint(b []byte, u uint64) []byte {
+	switch {
+	case u <= math.MaxInt8:
+		return append(b, byte(u))
+	case u <= math.MaxUint8:
+		return append(b, 0xcc, byte(u))
+	case u <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(b, 0xcd), uint16(u))
+	case u <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(b, 0xce), uint32(u))
+	default:
+		return binary.BigEndian.AppendUint64(append(b, 0xcf), u)
+	}
+}
+
+// decoder decodes MessagePack values into JSON values.
+type decoder struct {
+	data []byte // Encoded values.
+	pos  int    // Position of the next byte to decode.
+}
+
+// decodeFunc decodes the value of the format of its first byte, already read.
+type decodeFunc func(d *decoder, b byte, depth int) (any, error)
+
+// formats maps the first byte of the values to the function decoding them, nil for the unsupported extension
+// types.
+var formats [256]decodeFunc
+
+func init() {
+	for b := 0x00; b <= 0x7f; b++ {
+		formats[b] = func(_ *decoder, b byte, _ int) (any, error) { return int64(b), nil }
+	}
+	for b := 0xe0; b <= 0xff; b++ {
+		formats[b] = func(_ *decoder, b byte, _ int) (any, error) { return int64(int8(b)), nil }
+	}
+	for b := 0x80; b <= 0x8f; b++ {
+		formats[b] = func(d *decoder, b byte, depth int) (any, error) { return d.mapOf(int(b&0x0f), depth) }
+	}
+	for b := 0x90; b <= 0x9f; b++ {
+		formats[b] = func(d *decoder, b byte, depth int) (any, error) { return d.arrayOf(int(b&0x0f), depth) }
+	}
+	for b := 0xa0; b <= 0xbf; b++ {
+		formats[b] = func(d *decoder, b byte, _ int) (any, error) { return d.str(int(b & 0x1f)) }
+	}
+	formats[0xc0] = func(*decoder, byte, int) (any, error) { return nil, nil }
+	formats[0xc2] = func(*decoder, byte, int) (any, error) { return false, nil }
+	formats[0xc3] = func(*decoder, byte, int) (any, error) { return true, nil }
+	for i, width := range []int{1, 2, 4} {
+		formats[0xc4+i] = sized(width, (*decoder).bin)
+		formats[0xd9+i] = sized(width, (*decoder).str)
+	}
+	for i, width := range []int{1, 2, 4, 8} {
+		formats[0xcc+i] = unsigned(width)
+		formats[0xd0+i] = signed(width)
+	}
+	formats[0xca] = float(4)
+	formats[0xcb] = float(8)
+	formats[0xdc] = nested(2, (*decoder).arrayOf)
+	formats[0xdd] = nested(4, (*decoder).arrayOf)
+	formats[0xde] = nested(2, (*decoder).mapOf)
+	formats[0xdf] = nested(4, (*decoder).mapOf)
+}
+
+// value decodes the next value, nested at the given depth.
+func (d *decoder) value(depth int) (any, error) {
+	if depth > maxDepth {
+		return nil, fmt.Errorf("body must not nest values deeper than %d levels", maxDepth)
+	}
+	header, err := d.read(1)
+	if err != nil {
+		return nil, err
+	}
+	decode := formats[header[0]]
+	if decode == nil {
+		return nil, fmt.Errorf("body contains an unsupported MessagePack type 0x%02x", header[0])
+	}
+	return decode(d, header[0], depth)
+}
+
+// read returns the next n bytes.
+func (d *decoder) read(n int) ([]byte, error) {
+	if n < 0 || n > len(d.data)-d.pos {
+		return nil, errMalformed
+	}
+	b := d.data[d.pos : d.pos+n]
+	d.pos += n
+	return b, nil
+}
+
+// integer decodes a big-endian unsigned integer of the given width in bytes.
+func (d *decoder) integer(width int) (uint64, error) {
+	b, err := d.read(width)
+	if err != nil {
+		return 0, err
+	}
+	var u uint64
+	for _, c := range b {
+		u = u<<8 | uint64(c)
+	}
+	return u, nil
+}
+
+// str decodes a string of n bytes.
+func (d *decoder) str(n int) (any, error) {
+	b, err := d.read(n)
+	return string(b), err
+}
+
+// bin decodes binary data of n bytes, represented in JSON as a base64 string.
+func (d *decoder) bin(n int) (any, error) {
+	b, err := d.read(n)
+	return bytes.Clone(b), err
+}
+
+// arrayOf decodes an array of n values.
+func (d *decoder) arrayOf(n, depth int) (any, error) {
+	if n > len(d.data)-d.pos {
+		return nil, errMalformed
+	}
+	array := make([]any, 0, n)
+	for range n {
+		item, err := d.value(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		array = append(array, item)
+	}
+	return array, nil
+}
+
+// mapOf decodes a map of n string keys and their values.
+func (d *decoder) mapOf(n, depth int) (any, error) {
+	if 2*n > len(d.data)-d.pos {
+		return nil, errMalformed
+	}
+	m := make(map[string]any, n)
+	for range n {
+		key, err := d.value(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		name, ok := key.(string)
+		if !ok {
+			return nil, errors.New("body must only have string keys")
+		}
+		if m[name], err = d.value(depth + 1); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// sized returns the decoder of the strings or binary data prefixed by their length of the given width in bytes.
+func sized(width int, decode func(d *decoder, n int) (any, error)) decodeFunc {
+	return func(d *decoder, _ byte, _ int) (any, error) {
+		n, err := d.integer(width)
+		if err != nil {
+			return nil, err
+		}
+		return decode(d, int(n))
+	}
+}
+
+// nested returns the decoder of the arrays or maps prefixed by their length of the given width in bytes.
+func nested(width int, decode func(d *decoder, n, depth int) (any, error)) decodeFunc {
+	return func(d *decoder, _ byte, depth int) (any, error) {
+		n, err := d.integer(width)
+		if err != nil {
+			return nil, err
+		}
+		return decode(d, int(n), depth)
+	}
+}
+
+// unsigned returns the decoder of the unsigned integers of the given width in bytes.
+func unsigned(width int) decodeFunc {
+	return func(d *decoder, _ byte, _ int) (any, error) {
+		return d.integer(width)
+	}
+}
+
+// signed returns the decoder of the signed integers of the given width in bytes.
+func signed(width int) decodeFunc {
+	return func(d *decoder, _ byte, _ int) (any, error) {
+		u, err := d.integer(width)
+		if err != nil {
+			return nil, err
+		}
+		shift := 64 - 8*width
+		return int64(u<<shift) >> shift, nil
+	}
+}
+
+// float returns the decoder of the floats of the given width in bytes, 4 or 8.
+func float(width int) decodeFunc {
+	return func(d *decoder, _ byte, _ int) (any, error) {
+		u, err := d.integer(width)
+		if err != nil {
+			return nil, err
+		}
+		if width == 4 {
+			return float64(math.Float32frombits(uint32(u))), nil
+		}
+		return math.Float64frombits(u), nil
+	}
+}
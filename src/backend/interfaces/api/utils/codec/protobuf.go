@@ -0,0 +1,100 @@
+package codec
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// ProtoMarshaler is implemented by the bodies with a protobuf representation, e.g., a generated message.
+type ProtoMarshaler interface {
+	// MarshalProto returns the body encoded as its protobuf message.
+	MarshalProto() ([]byte, error)
+}
+
+// ProtoUnmarshaler is implemented by the bodies decoded from a protobuf message.
+type ProtoUnmarshaler interface {
+	// UnmarshalProto decodes the body from its protobuf message.
+	UnmarshalProto(data []byte) error
+}
+
+// Protobuf encodes the bodies implementing ProtoMarshaler as their protobuf message, and lists of them as a
+// sequence of messages, each prefixed by its size as a varint, and decodes the bodies implementing
+// ProtoUnmarshaler.
+type Protobuf struct{}
+
+// MediaType returns the media type of protobuf messages.
+func (c Protobuf) MediaType() string { return "application/x-protobuf" }
+
+// ContentType returns the Content-Type header of protobuf bodies.
+func (c Protobuf) ContentType() string { return "application/x-protobuf" }
+
+// Encode writes the message of the value, or the size-delimited messages of a list of values.
+// Returns ErrUnsupported for values without a protobuf representation.
+func (c Protobuf) Encode(w io.Writer, v any) error {
+	if m, ok := protoMarshaler(reflect.ValueOf(v)); ok {
+		data, err := m.MarshalProto()
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+	}
+
+	list := indirect(reflect.ValueOf(v))
+	if list.Kind() != reflect.Slice && list.Kind() != reflect.Array {
+		return fmt.Errorf("%w: %T has no protobuf message", ErrUnsupported, v)
+	}
+	var buf []byte
+	for i := range list.Len() {
+		m, ok := protoMarshaler(list.Index(i))
+		if !ok {
+			return fmt.Errorf("%w: %s has no protobuf message", ErrUnsupported, list.Index(i).Type())
+		}
+		data, err := m.MarshalProto()
+		if err != nil {
+			return err
+		}
+		buf = append(binary.AppendUvarint(buf, uint64(len(data))), data...)
+	}
+	_, err := w.Write(buf)
+	return err
+}
+
+// Decode reads the protobuf message of the value v points to, allocating the pointers on the way.
+// Returns ErrUnsupported if the value is not decoded from a protobuf message.
+func (c Protobuf) Decode(r io.Reader, v any) error {
+	value := reflect.ValueOf(v)
+	for value.Kind() == reflect.Pointer && !value.IsNil() {
+		if u, ok := value.Interface().(ProtoUnmarshaler); ok {
+			data, err := io.ReadAll(r)
+			if err != nil {
+				return err
+			}
+			return u.UnmarshalProto(data)
+		}
+		value = value.Elem()
+		if value.Kind() == reflect.Pointer && value.IsNil() && value.CanSet() {
+			value.Set(reflect.New(value.Type().Elem()))
+		}
+	}
+	return fmt.Errorf("%w: %T is not decoded from a protobuf message", ErrUnsupported, v)
+}
+
+// protoMarshaler returns the value, or its address, as a ProtoMarshaler if it is one.
+func protoMarshaler(v reflect.Value) (ProtoMarshaler, bool) {
+	if !v.IsValid() {
+		return nil, false
+	}
+	if v.CanAddr() {
+		if m, ok := v.Addr().Interface().(ProtoMarshaler); ok {
+			return m, true
+		}
+	}
+	if v.Kind() == reflect.Pointer && v.IsNil() {
+		return nil, false
+	}
+	m, ok := v.Interface().(ProtoMarshaler)
+	return m, ok
+}
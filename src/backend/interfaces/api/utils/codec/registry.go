@@ -0,0 +1,174 @@
+package codec
+
+import (
+	"mime"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Registry holds the codecs of the API by media type, in order of preference of the server.
+type Registry struct {
+	entries []entry // Media types of the codecs, in order of registration.
+}
+
+// entry is a media type handled by a codec, either its own or an alias.
+type entry struct {
+	mediaType string
+	codec     Codec
+}
+
+// mediaRange is a media range of the Accept header with its quality value.
+type mediaRange struct {
+	mediaType string
+	q         float64
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// Register adds the codec under its media type and the given aliases, e.g., "application/x-msgpack", and returns
+// the registry. Codecs registered first are preferred when the client accepts several equally.
+func (r *Registry) Register(c Codec, aliases ...string) *Registry {
+	r.entries = append(r.entries, entry{mediaType: c.MediaType(), codec: c})
+	for _, alias := range aliases {
+		r.entries = append(r.entries, entry{mediaType: alias, codec: c})
+	}
+	return r
+}
+
+// With returns a copy of the registry with the codec registered after the others under its media type and the
+// given aliases, e.g., to negotiate a representation written by a single handler along with the codecs of the API.
+func (r *Registry) With(c Codec, aliases ...string) *Registry {
+	return (&Registry{entries: slices.Clone(r.entries)}).Register(c, aliases...)
+}
+
+// MediaTypes returns the media types of the codecs, without their aliases, in order of registration.
+func (r *Registry) MediaTypes() []string {
+	var mediaTypes []string
+	for _, e := range r.entries {
+		if e.mediaType == e.codec.MediaType() {
+			mediaTypes = append(mediaTypes, e.mediaType)
+		}
+	}
+	return mediaTypes
+}
+
+// DecoderMediaTypes returns the media types of the codecs decoding request bodies, in order of registration.
+func (r *Registry) DecoderMediaTypes() []string {
+	var mediaTypes []string
+	for _, e := range r.entries {
+		if _, ok := e.codec.(Decoder); ok && e.mediaType == e.codec.MediaType() {
+			mediaTypes = append(mediaTypes, e.mediaType)
+		}
+	}
+	return mediaTypes
+}
+
+// Negotiate returns the codecs acceptable to a client sending the given Accept header, the ones with the highest
+// quality value first, then in order of registration. Media ranges with a quality value of 0 exclude the codecs
+// they match most specifically, and aliases only count when accepted by name, so wildcards do not bring back an
+// excluded codec. Every codec is acceptable to clients sending no Accept header.
+func (r *Registry) Negotiate(accept string) []Codec {
+	ranges := parseAccept(accept)
+	quality := make(map[Codec]float64)
+	var codecs []Codec
+	for _, e := range r.entries {
+		q, specificity := 1.0, 3
+		if len(ranges) > 0 {
+			q, specificity = qualityOf(ranges, e.mediaType)
+		}
+		previous, seen := quality[e.codec]
+		if !seen {
+			codecs = append(codecs, e.codec)
+		}
+		if !seen || (specificity == 3 && q > previous) {
+			quality[e.codec] = q
+		}
+	}
+
+	acceptable := codecs[:0]
+	for _, c := range codecs {
+		if quality[c] > 0 {
+			acceptable = append(acceptable, c)
+		}
+	}
+	sort.SliceStable(acceptable, func(i, j int) bool { return quality[acceptable[i]] > quality[acceptable[j]] })
+	return acceptable
+}
+
+// Decoder returns the decoder of request bodies with the given Content-Type, if any.
+func (r *Registry) Decoder(contentType string) (Decoder, bool) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, false
+	}
+	for _, e := range r.entries {
+		if d, ok := e.codec.(Decoder); ok && e.mediaType == mediaType {
+			return d, true
+		}
+	}
+	return nil, false
+}
+
+// Accepts reports whether a client sending the given Accept header accepts the media type: a client sending none
+// accepts any.
+func Accepts(accept, mediaType string) bool {
+	ranges := parseAccept(accept)
+	if len(ranges) == 0 {
+		return true
+	}
+	q, _ := qualityOf(ranges, mediaType)
+	return q > 0
+}
+
+// parseAccept parses the media ranges of the Accept header, skipping malformed ones and invalid quality values.
+func parseAccept(accept string) []mediaRange {
+	var ranges []mediaRange
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if value, ok := params["q"]; ok {
+			if q, err = strconv.ParseFloat(value, 64); err != nil || q < 0 || q > 1 {
+				continue
+			}
+		}
+		ranges = append(ranges, mediaRange{mediaType: mediaType, q: q})
+	}
+	return ranges
+}
+
+// qualityOf returns the quality value of the media range matching the media type most specifically, 0 if none
+// does: the media type itself, then its type with any subtype, then any media type. The specificity of the match
+// is returned as by matches.
+func qualityOf(ranges []mediaRange, mediaType string) (float64, int) {
+	q, specificity := 0.0, 0
+	for _, mr := range ranges {
+		s := matches(mr.mediaType, mediaType)
+		if s > specificity {
+			q, specificity = mr.q, s
+		}
+	}
+	return q, specificity
+}
+
+// matches returns how specifically the media range matches the media type: 3 for the media type itself, 2 for its
+// type with any subtype, 1 for any media type and 0 if it does not match.
+func matches(mediaRange, mediaType string) int {
+	switch {
+	case mediaRange == mediaType:
+		return 3
+	case mediaRange == "*/*":
+		return 1
+	case strings.HasSuffix(mediaRange, "/*") && strings.HasPrefix(mediaType, strings.TrimSuffix(mediaRange, "*")):
+		return 2
+	default:
+		return 0
+	}
+}
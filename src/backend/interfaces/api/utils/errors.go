@@ -1,9 +1,12 @@
 package utils
 
 import (
+	"application/auth"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
+	"strings"
 )
 
 // Errors is a structured error handler with logging capabilities.
@@ -18,14 +21,50 @@ func NewErrors(l *slog.Logger, h *Handler) *Errors {
 }
 
 // LogError logs the request method, URL, and error message for better context.
+// The request context is passed to the logger, so the entry carries the request ID.
 func (e *Errors) LogError(r *http.Request, err error) {
-	e.Logger.Error("Error occurred", "error", err.Error(), "method", r.Method, "uri", r.URL.RequestURI())
+	e.Logger.ErrorContext(r.Context(), "Error occurred", "error", err.Error(), "method", r.Method,
+		"uri", LoggedURI(r))
 }
 
-// ErrorResponse sends a JSON error message with the specified status and logs it if necessary.
+// LoggedURI returns the URI of the request to log, with the token of a feed URL redacted, as it grants access to
+// the feed for a long time.
+func LoggedURI(r *http.Request) string {
+	if !r.URL.Query().Has(auth.FeedTokenParam) {
+		return r.URL.RequestURI()
+	}
+	u := *r.URL
+	q := u.Query()
+	q.Set(auth.FeedTokenParam, "REDACTED")
+	u.RawQuery = q.Encode()
+	return u.RequestURI()
+}
+
+// ErrorResponse logs the message and sends it with the specified status as RFC 9457 problem details, or as a
+// legacy {"error": message} body to clients asking for it (see WantsLegacyErrors). A message holding validation
+// errors by field is sent as the individual errors of the problem, locating the fields in the request body.
 func (e *Errors) ErrorResponse(w http.ResponseWriter, r *http.Request, status int, message any) {
 	e.LogError(r, fmt.Errorf("%v", message))
-	e.logAndSend(w, r, status, map[string]any{"error": message})
+	if WantsLegacyErrors(r) {
+		e.logAndSend(w, r, status, map[string]any{"error": message})
+		return
+	}
+
+	if errors, ok := message.(map[string]string); ok {
+		p := NewProblem(r, status, "the request contains invalid fields")
+		p.Errors = fieldErrors(errors, bodyField)
+		e.ProblemResponse(w, r, p)
+		return
+	}
+	e.ProblemResponse(w, r, NewProblem(r, status, fmt.Sprint(message)))
+}
+
+// ProblemResponse sends the problem details with their status.
+func (e *Errors) ProblemResponse(w http.ResponseWriter, r *http.Request, p *Problem) {
+	w.Header().Set("Content-Type", ProblemContentType)
+	if err := e.Handler.WriteJson(w, p.Status, p, nil); err != nil {
+		e.LogError(r, err)
+	}
 }
 
 // ServerErrorResponse logs a server error and sends a 500 Internal Server Error response.
@@ -50,6 +89,20 @@ func (e *Errors) FailedValidationResponse(w http.ResponseWriter, r *http.Request
 	e.ErrorResponse(w, r, http.StatusUnprocessableEntity, errors)
 }
 
+// FailedQueryValidationResponse sends a 422 Unprocessable Entity response with validation errors by query
+// parameter.
+func (e *Errors) FailedQueryValidationResponse(w http.ResponseWriter, r *http.Request, errors map[string]string) {
+	if WantsLegacyErrors(r) {
+		e.FailedValidationResponse(w, r, errors)
+		return
+	}
+
+	e.LogError(r, fmt.Errorf("%v", errors))
+	p := NewProblem(r, http.StatusUnprocessableEntity, "the request contains invalid query parameters")
+	p.Errors = fieldErrors(errors, queryParameter)
+	e.ProblemResponse(w, r, p)
+}
+
 // InvalidCredentialsResponse sends a 401 Unauthorized response for failed authentication attempts.
 func (e *Errors) InvalidCredentialsResponse(w http.ResponseWriter, r *http.Request) {
 	e.ErrorResponse(w, r, http.StatusUnauthorized, "invalid authentication credentials")
@@ -76,6 +129,43 @@ func (e *Errors) NotPermittedResponse(w http.ResponseWriter, r *http.Request) {
 	e.ErrorResponse(w, r, http.StatusForbidden, "your user account doesn't have permission to access this resource")
 }
 
+// EditConflictResponse sends a 409 Conflict response when a record was modified concurrently.
+func (e *Errors) EditConflictResponse(w http.ResponseWriter, r *http.Request) {
+	e.ErrorResponse(w, r, http.StatusConflict, "unable to update the record due to an edit conflict, please try again")
+}
+
+// NotAcceptableResponse sends a 406 Not Acceptable response listing the media types the response is available in.
+func (e *Errors) NotAcceptableResponse(w http.ResponseWriter, r *http.Request, available ...string) {
+	e.ErrorResponse(w, r, http.StatusNotAcceptable,
+		fmt.Sprintf("the response is only available as %s", strings.Join(available, ", ")))
+}
+
+// WriteErrorResponse sends the error of writing a response with Handler.Write: 406 Not Acceptable if no media
+// type accepted by the client can represent it, 500 Internal Server Error otherwise.
+func (e *Errors) WriteErrorResponse(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, ErrNotAcceptable) {
+		e.NotAcceptableResponse(w, r, e.Handler.Codecs.MediaTypes()...)
+		return
+	}
+	e.ServerErrorResponse(w, r, err)
+}
+
+// ReadErrorResponse sends the error of reading a request body with Handler.Read: 415 Unsupported Media Type if it
+// cannot be decoded from its content type, 400 Bad Request otherwise.
+func (e *Errors) ReadErrorResponse(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, ErrUnsupportedMediaType) {
+		e.UnsupportedMediaTypeResponse(w, r, e.Handler.Codecs.DecoderMediaTypes()...)
+		return
+	}
+	e.ErrorResponse(w, r, http.StatusBadRequest, err.Error())
+}
+
+// UnsupportedMediaTypeResponse sends a 415 Unsupported Media Type response listing the accepted content types.
+func (e *Errors) UnsupportedMediaTypeResponse(w http.ResponseWriter, r *http.Request, accepted ...string) {
+	e.ErrorResponse(w, r, http.StatusUnsupportedMediaType,
+		fmt.Sprintf("the content type of the body must be one of %s", strings.Join(accepted, ", ")))
+}
+
 // logAndSend sends the JSON response and handles any errors that occur during writing.
 func (e *Errors) logAndSend(w http.ResponseWriter, r *http.Request, status int, payload map[string]any) {
 	if err := e.Handler.WriteJson(w, status, payload, nil); err != nil {
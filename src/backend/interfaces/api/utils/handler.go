@@ -1,9 +1,11 @@
 package utils
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"interfaces/api/utils/codec"
 	"io"
 	"net/http"
 	"net/url"
@@ -13,16 +15,35 @@ import (
 	"github.com/julienschmidt/httprouter"
 )
 
-type Handler struct{}
+// Handler reads the request bodies and writes the response bodies of the handlers.
+type Handler struct {
+	Codecs *codec.Registry // Codecs of the bodies by media type.
+}
+
+// NewHandler initializes and returns a new Handler instance, with the codecs of JSON, MessagePack, protobuf and
+// CSV, in order of preference. Clients accepting the legacy error format get JSON.
+func NewHandler() *Handler {
+	return &Handler{Codecs: codec.NewRegistry().
+		Register(codec.JSON{}, LegacyErrorType).
+		Register(codec.MessagePack{}, "application/x-msgpack", "application/vnd.msgpack").
+		Register(codec.Protobuf{}, "application/protobuf", "application/vnd.google.protobuf").
+		Register(codec.CSV{})}
+}
 
-// NewHandler initializes and returns a new Handler instance.
-func NewHandler() *Handler { return &Handler{} }
+// PrettyParam is the query parameter asking for indented JSON responses.
+const PrettyParam = "pretty"
 
 // requestBodyLimit specifies the maximum size of the request body (1MB).
 const requestBodyLimit = int64(1_048_576)
 
-// ErrInvalidIdParameter is an error for invalid ID parameters in requests.
-var ErrInvalidIdParameter = errors.New("invalid id parameter")
+var (
+	// ErrInvalidIdParameter is an error for invalid ID parameters in requests.
+	ErrInvalidIdParameter = errors.New("invalid id parameter")
+	// ErrNotAcceptable is returned by Write when no media type accepted by the client can represent the response.
+	ErrNotAcceptable = errors.New("not acceptable")
+	// ErrUnsupportedMediaType is returned by Read when the request body cannot be decoded from its content type.
+	ErrUnsupportedMediaType = errors.New("unsupported media type")
+)
 
 // ExtractId extracts the "id" parameter from the HTTP request context and parses it into an int64.
 // It returns an error if the parameter is missing or invalid.
@@ -42,8 +63,8 @@ func (h *Handler) ExtractId(r *http.Request) (int64, error) {
 	return id, nil
 }
 
-// WriteJson writes a JSON response to the client with the specified status code and headers.
-// If data is nil, it sends an empty response.
+// WriteJson writes a compact JSON response to the client with the specified status code and headers, whatever the
+// client accepts, e.g., problem details. If data is nil, it sends an empty response.
 func (h *Handler) WriteJson(w http.ResponseWriter, status int, data any, headers http.Header) error {
 	h.setDefaultHeaders(w, headers)
 	w.WriteHeader(status)
@@ -53,13 +74,13 @@ func (h *Handler) WriteJson(w http.ResponseWriter, status int, data any, headers
 	}
 
 	// Marshal the data into JSON format and write it to the response.
-	payload, err := json.MarshalIndent(data, "", "\t")
-	if err != nil {
+	var payload bytes.Buffer
+	if err := (codec.JSON{}).Encode(&payload, data); err != nil {
 		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
 		return fmt.Errorf("unable to marshal JSON response: %w", err)
 	}
 
-	if _, err = w.Write(append(payload, '\n')); err != nil {
+	if _, err := w.Write(payload.Bytes()); err != nil {
 		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
 		return fmt.Errorf("unable to write JSON response: %w", err)
 	}
@@ -67,25 +88,116 @@ func (h *Handler) WriteJson(w http.ResponseWriter, status int, data any, headers
 	return nil
 }
 
+// Write writes a response to the client with the specified status code and headers, in the media type the client
+// prefers (Accept) among those of the codecs able to represent the data. JSON is compact unless the PrettyParam
+// query parameter is given. If data is nil, it sends an empty response. Returns ErrNotAcceptable, without writing
+// anything, if no codec is acceptable.
+func (h *Handler) Write(w http.ResponseWriter, r *http.Request, status int, data any, headers http.Header) error {
+	if data == nil {
+		return h.WriteJson(w, status, nil, headers)
+	}
+
+	c, payload, err := h.encode(r, data)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", c.ContentType())
+	w.Header().Add("Vary", "Accept")
+	h.setDefaultHeaders(w, headers)
+	w.WriteHeader(status)
+	if _, err = w.Write(payload); err != nil {
+		return fmt.Errorf("unable to write %s response: %w", c.MediaType(), err)
+	}
+	return nil
+}
+
+// Prefers reports whether the client prefers the media type of the codec to the media types of the other codecs of
+// the handler, e.g., to write a representation of its own such as JSON-LD. The quality values of the Accept header
+// are honored, so a media type excluded with q=0 is never preferred, and the other codecs win ties, so clients
+// sending no Accept header or a wildcard get the usual representation.
+func (h *Handler) Prefers(r *http.Request, c codec.Codec) bool {
+	codecs := h.Codecs.With(c).Negotiate(r.Header.Get("Accept"))
+	return len(codecs) > 0 && codecs[0] == c
+}
+
+// encode encodes the data with the first codec acceptable to the client able to represent it.
+func (h *Handler) encode(r *http.Request, data any) (codec.Codec, []byte, error) {
+	var payload bytes.Buffer
+	for _, c := range h.Codecs.Negotiate(r.Header.Get("Accept")) {
+		if j, ok := c.(codec.JSON); ok && r.URL.Query().Has(PrettyParam) {
+			j.Indent = "\t"
+			c = j
+		}
+
+		payload.Reset()
+		err := c.Encode(&payload, data)
+		if errors.Is(err, codec.ErrUnsupported) {
+			continue
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to encode %s response: %w", c.MediaType(), err)
+		}
+		return c, payload.Bytes(), nil
+	}
+	return nil, nil, ErrNotAcceptable
+}
+
 // ReadJson reads and parses JSON data from the request body into the provided data structure.
 // It enforces a body size limit and disallows unknown fields.
 func (h *Handler) ReadJson(w http.ResponseWriter, r *http.Request, data any) error {
 	r.Body = http.MaxBytesReader(w, r.Body, requestBodyLimit)
+	return h.decodeJson(r.Body, data)
+}
 
-	// Create a JSON decoder that disallows unknown fields to enforce strict parsing.
-	decoder := json.NewDecoder(r.Body)
-	decoder.DisallowUnknownFields()
+// Read reads and decodes the request body into the provided data structure with the codec of its content type,
+// JSON if none is given, as ReadJson does. It returns ErrUnsupportedMediaType for content types without a codec
+// and for data that cannot be decoded from its content type.
+func (h *Handler) Read(w http.ResponseWriter, r *http.Request, data any) error {
+	contentType := r.Header.Get("Content-Type")
+	if contentType == "" {
+		return h.ReadJson(w, r, data)
+	}
+	decoder, ok := h.Codecs.Decoder(contentType)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnsupportedMediaType, contentType)
+	}
 
-	// Decode the request body into the provided data structure.
-	if err := decoder.Decode(data); err != nil {
+	r.Body = http.MaxBytesReader(w, r.Body, requestBodyLimit)
+	err := decoder.Decode(r.Body, data)
+	if errors.Is(err, codec.ErrUnsupported) {
+		return fmt.Errorf("%w: %s", ErrUnsupportedMediaType, contentType)
+	}
+	if err != nil {
 		return h.handleJsonDecodeError(err)
 	}
+	return nil
+}
 
-	// Check for any additional, unexpected data in the request body.
-	if err := decoder.Decode(&struct{}{}); !errors.Is(err, io.EOF) {
-		return errors.New("body must contain a single JSON object")
+// ReadBody reads the request body as is, e.g., a patch document, enforcing the body size limit.
+func (h *Handler) ReadBody(w http.ResponseWriter, r *http.Request) ([]byte, error) {
+	r.Body = http.MaxBytesReader(w, r.Body, requestBodyLimit)
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, h.handleJsonDecodeError(err)
+	}
+	if len(body) == 0 {
+		return nil, errors.New("body must not be empty")
 	}
+	return body, nil
+}
 
+// UnmarshalJson parses the JSON data into the provided data structure as ReadJson parses request bodies.
+func (h *Handler) UnmarshalJson(data []byte, v any) error {
+	return h.decodeJson(bytes.NewReader(data), v)
+}
+
+// decodeJson parses a single JSON value from the reader into the provided data structure, disallowing unknown
+// fields.
+func (h *Handler) decodeJson(reader io.Reader, data any) error {
+	if err := (codec.JSON{}).Decode(reader, data); err != nil {
+		return h.handleJsonDecodeError(err)
+	}
 	return nil
 }
 
@@ -97,6 +209,15 @@ func (h *Handler) GetQueryString(qs url.Values, key, value string) string {
 	return qs.Get(key)
 }
 
+// GetQueryList retrieves the comma-separated values of a list from the query string, or nil if it is missing or
+// empty.
+func (h *Handler) GetQueryList(qs url.Values, key string) []string {
+	if value := qs.Get(key); value != "" {
+		return strings.Split(value, ",")
+	}
+	return nil
+}
+
 // GetQueryInt retrieves an integer value from the query string or returns the provided default value.
 func (h *Handler) GetQueryInt(qs url.Values, key string, value int) int {
 	if !qs.Has(key) {
@@ -0,0 +1,45 @@
+package patch
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// MergePatchContentType is the content type of JSON Merge Patch documents (RFC 7396).
+const MergePatchContentType = "application/merge-patch+json"
+
+// Merge applies the JSON Merge Patch (RFC 7396) to the JSON document and returns the patched document. Members
+// of the patch set to null are removed from the document, objects are merged recursively and other values
+// replace the ones of the document.
+// Returns ErrMalformed if the patch is not valid JSON.
+func Merge(doc, patch []byte) ([]byte, error) {
+	target, err := decode(doc)
+	if err != nil {
+		return nil, fmt.Errorf("decode document: %w", err)
+	}
+	p, err := decode(patch)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformed, err)
+	}
+	return json.Marshal(merge(target, p))
+}
+
+// merge merges the patch into the target value and returns the merged value.
+func merge(target, patch any) any {
+	p, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+	t, ok := target.(map[string]any)
+	if !ok {
+		t = make(map[string]any, len(p))
+	}
+	for name, value := range p {
+		if value == nil {
+			delete(t, name)
+			continue
+		}
+		t[name] = merge(t[name], value)
+	}
+	return t
+}
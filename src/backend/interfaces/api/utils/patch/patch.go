@@ -0,0 +1,382 @@
+package patch
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// JsonPatchContentType is the content type of JSON Patch documents (RFC 6902).
+const JsonPatchContentType = "application/json-patch+json"
+
+var (
+	// ErrMalformed is returned when a patch is not a valid patch document.
+	ErrMalformed = errors.New("malformed patch document")
+
+	// ErrInapplicable is returned when an operation of a JSON Patch cannot be applied to the document, e.g.,
+	// because its path does not exist.
+	ErrInapplicable = errors.New("the patch cannot be applied to the document")
+
+	// ErrTestFailed is returned when the value of a test operation of a JSON Patch differs from the document.
+	ErrTestFailed = errors.New("the patch test failed")
+)
+
+// Operation is an operation of a JSON Patch document. It describes the schema of the documents, which Apply
+// decodes itself to tell a null value from a missing one.
+type Operation struct {
+	Op    string `json:"op"`              // Operation: "add", "remove", "replace", "move", "copy" or "test".
+	Path  string `json:"path"`            // JSON Pointer (RFC 6901) of the target location, e.g., "/title".
+	From  string `json:"from,omitempty"`  // JSON Pointer of the source location of move and copy operations.
+	Value any    `json:"value,omitempty"` // Value of add, replace and test operations.
+}
+
+// operation is a parsed operation of a JSON Patch document.
+type operation struct {
+	op      string   // Operation, e.g., "add".
+	pointer string   // JSON Pointer of the target location, for the messages.
+	path    []string // Reference tokens of the target location.
+	from    []string // Reference tokens of the source location of move and copy operations.
+	value   any      // Value of add, replace and test operations.
+}
+
+// Apply applies the operations of the JSON Patch (RFC 6902) to the JSON document in order and returns the patched
+// document. Nothing is returned if an operation fails.
+// Returns ErrMalformed if the patch is not a valid JSON Patch document, ErrInapplicable if an operation cannot be
+// applied, or ErrTestFailed if a test operation does not match.
+func Apply(doc, patch []byte) ([]byte, error) {
+	target, err := decode(doc)
+	if err != nil {
+		return nil, fmt.Errorf("decode document: %w", err)
+	}
+	var list []map[string]json.RawMessage
+	if err = json.Unmarshal(patch, &list); err != nil {
+		return nil, fmt.Errorf("%w: a JSON Patch must be an array of operations", ErrMalformed)
+	}
+
+	for i, raw := range list {
+		o, err := parseOperation(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%w: operation %d: %v", ErrMalformed, i, err)
+		}
+		if target, err = o.apply(target); err != nil {
+			return nil, fmt.Errorf("operation %d: %w", i, err)
+		}
+	}
+	return json.Marshal(target)
+}
+
+// parseOperation parses an operation of a JSON Patch document, checking it has the members its kind requires.
+func parseOperation(raw map[string]json.RawMessage) (*operation, error) {
+	o := &operation{}
+	if err := member(raw, "op", &o.op); err != nil {
+		return nil, err
+	}
+	if err := member(raw, "path", &o.pointer); err != nil {
+		return nil, err
+	}
+	path, err := parsePointer(o.pointer)
+	if err != nil {
+		return nil, err
+	}
+	o.path = path
+
+	switch o.op {
+	case "add", "replace", "test":
+		if _, ok := raw["value"]; !ok {
+			return nil, errors.New(`"value" is missing`)
+		}
+		o.value, err = decode(raw["value"])
+	case "move", "copy":
+		var from string
+		if err = member(raw, "from", &from); err == nil {
+			o.from, err = parsePointer(from)
+		}
+	case "remove":
+	default:
+		return nil, fmt.Errorf("unknown operation %q", o.op)
+	}
+	return o, err
+}
+
+// member decodes the string member of an operation with the given name.
+func member(raw map[string]json.RawMessage, name string, value *string) error {
+	data, ok := raw[name]
+	if !ok {
+		return fmt.Errorf("%q is missing", name)
+	}
+	if err := json.Unmarshal(data, value); err != nil {
+		return fmt.Errorf("%q must be a string", name)
+	}
+	return nil
+}
+
+// parsePointer parses the JSON Pointer (RFC 6901) into its reference tokens, none for the whole document.
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("pointer %q must start with /", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	unescape := strings.NewReplacer("~1", "/", "~0", "~")
+	for i, token := range tokens {
+		tokens[i] = unescape.Replace(token)
+	}
+	return tokens, nil
+}
+
+// apply applies the operation to the document and returns the updated document.
+func (o *operation) apply(doc any) (any, error) {
+	switch o.op {
+	case "add":
+		return add(doc, o.path, o.value)
+	case "remove":
+		return remove(doc, o.path)
+	case "replace":
+		return replace(doc, o.path, o.value)
+	case "move":
+		return o.move(doc)
+	case "copy":
+		value, err := get(doc, o.from)
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, o.path, deepCopy(value))
+	}
+	return o.test(doc)
+}
+
+// move removes the value at the source location and adds it at the target location.
+func (o *operation) move(doc any) (any, error) {
+	if len(o.from) < len(o.path) && slices.Equal(o.from, o.path[:len(o.from)]) {
+		return nil, fmt.Errorf("%w: %q cannot be moved into one of its children", ErrInapplicable, o.pointer)
+	}
+	value, err := get(doc, o.from)
+	if err != nil {
+		return nil, err
+	}
+	if doc, err = remove(doc, o.from); err != nil {
+		return nil, err
+	}
+	return add(doc, o.path, value)
+}
+
+// test checks that the value at the target location equals the value of the operation.
+func (o *operation) test(doc any) (any, error) {
+	value, err := get(doc, o.path)
+	if err != nil {
+		return nil, err
+	}
+	if !equal(value, o.value) {
+		return nil, fmt.Errorf("%w: the value at %q differs", ErrTestFailed, o.pointer)
+	}
+	return doc, nil
+}
+
+// add adds the value at the path, replacing the member of an object or inserting it into an array.
+func add(doc any, path []string, value any) (any, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	return update(doc, path, func(parent any, token string) (any, error) {
+		switch p := parent.(type) {
+		case map[string]any:
+			p[token] = value
+			return p, nil
+		case []any:
+			if token == "-" {
+				return append(p, value), nil
+			}
+			i, err := index(token, len(p))
+			if err != nil {
+				return nil, err
+			}
+			return slices.Insert(p, i, value), nil
+		}
+		return nil, notContainer(token)
+	})
+}
+
+// remove removes the value at the path, which must exist.
+func remove(doc any, path []string) (any, error) {
+	if len(path) == 0 {
+		return nil, fmt.Errorf("%w: the whole document cannot be removed", ErrInapplicable)
+	}
+	return update(doc, path, func(parent any, token string) (any, error) {
+		if _, err := child(parent, token); err != nil {
+			return nil, err
+		}
+		if p, ok := parent.(map[string]any); ok {
+			delete(p, token)
+			return p, nil
+		}
+		i, _ := strconv.Atoi(token)
+		return slices.Delete(parent.([]any), i, i+1), nil
+	})
+}
+
+// replace replaces the value at the path, which must exist.
+func replace(doc any, path []string, value any) (any, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	return update(doc, path, func(parent any, token string) (any, error) {
+		if _, err := child(parent, token); err != nil {
+			return nil, err
+		}
+		return setChild(parent, token, value), nil
+	})
+}
+
+// update updates the parent of the value at the path, which must not be empty, with the function given the parent
+// and the last token of the path. Returns the updated document.
+func update(doc any, path []string, fn func(parent any, token string) (any, error)) (any, error) {
+	if len(path) == 1 {
+		return fn(doc, path[0])
+	}
+	c, err := child(doc, path[0])
+	if err != nil {
+		return nil, err
+	}
+	updated, err := update(c, path[1:], fn)
+	if err != nil {
+		return nil, err
+	}
+	return setChild(doc, path[0], updated), nil
+}
+
+// get returns the value at the path, which must exist.
+func get(doc any, path []string) (any, error) {
+	for _, token := range path {
+		var err error
+		if doc, err = child(doc, token); err != nil {
+			return nil, err
+		}
+	}
+	return doc, nil
+}
+
+// child returns the member of an object or the item of an array referenced by the token.
+func child(doc any, token string) (any, error) {
+	switch d := doc.(type) {
+	case map[string]any:
+		value, ok := d[token]
+		if !ok {
+			return nil, fmt.Errorf("%w: member %q does not exist", ErrInapplicable, token)
+		}
+		return value, nil
+	case []any:
+		i, err := index(token, len(d)-1)
+		if err != nil {
+			return nil, err
+		}
+		return d[i], nil
+	}
+	return nil, notContainer(token)
+}
+
+// setChild sets the existing member of an object or item of an array referenced by the token and returns the
+// container.
+func setChild(doc any, token string, value any) any {
+	switch d := doc.(type) {
+	case map[string]any:
+		d[token] = value
+	case []any:
+		i, _ := strconv.Atoi(token)
+		d[i] = value
+	}
+	return doc
+}
+
+// index parses the token referencing an item of an array as an index of at most limit.
+func index(token string, limit int) (int, error) {
+	if token == "" || strings.Trim(token, "0123456789") != "" || (len(token) > 1 && token[0] == '0') {
+		return 0, fmt.Errorf("%w: %q is not an array index", ErrInapplicable, token)
+	}
+	i, err := strconv.Atoi(token)
+	if err != nil || i > limit {
+		return 0, fmt.Errorf("%w: index %s is out of bounds", ErrInapplicable, token)
+	}
+	return i, nil
+}
+
+// notContainer returns the error of a token referencing a child of a value that is neither an object nor an array.
+func notContainer(token string) error {
+	return fmt.Errorf("%w: %q does not reference a member of an object or an array", ErrInapplicable, token)
+}
+
+// equal reports whether the JSON values are equal, comparing numbers by value and objects regardless of the order
+// of their members.
+func equal(a, b any) bool {
+	switch x := a.(type) {
+	case json.Number:
+		y, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		fx, errX := x.Float64()
+		fy, errY := y.Float64()
+		return errX == nil && errY == nil && fx == fy
+	case map[string]any:
+		y, ok := b.(map[string]any)
+		return ok && membersEqual(x, y)
+	case []any:
+		y, ok := b.([]any)
+		return ok && slices.EqualFunc(x, y, equal)
+	}
+	return a == b
+}
+
+// membersEqual reports whether the objects have the same members with equal values.
+func membersEqual(a, b map[string]any) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for name, value := range a {
+		other, ok := b[name]
+		if !ok || !equal(value, other) {
+			return false
+		}
+	}
+	return true
+}
+
+// deepCopy returns a copy of the JSON value sharing no object or array with it.
+func deepCopy(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		c := make(map[string]any, len(v))
+		for name, member := range v {
+			c[name] = deepCopy(member)
+		}
+		return c
+	case []any:
+		c := make([]any, len(v))
+		for i, item := range v {
+			c[i] = deepCopy(item)
+		}
+		return c
+	}
+	return value
+}
+
+// decode decodes a single JSON value, keeping its numbers as json.Number so they are encoded back unchanged.
+func decode(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("body must contain a single JSON value")
+	}
+	return value, nil
+}
@@ -0,0 +1,123 @@
+package utils
+
+import (
+	"application/requestid"
+	"mime"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Media types and headers selecting the format of the error responses.
+const (
+	ProblemContentType = "application/problem+json"             // RFC 9457 problem details, the default format.
+	LegacyErrorType    = "application/vnd.pulse-finder.v1+json" // Legacy {"error": ...} bodies, kept for migration.
+	HeaderApiVersion   = "Api-Version"                          // API version of the client; "1" selects legacy bodies.
+	LegacyApiVersion   = "1"                                    // API version answered with legacy error bodies.
+	ProblemTypeBase    = "/problems/"                           // Base of the problem type URIs.
+)
+
+// problemTypes maps the status codes to the type of their problems, relative to ProblemTypeBase. Other statuses
+// are answered with the "about:blank" type, whose title is the status text.
+var problemTypes = map[int]string{
+	http.StatusBadRequest:            "bad-request",
+	http.StatusUnauthorized:          "unauthorized",
+	http.StatusForbidden:             "forbidden",
+	http.StatusNotFound:              "not-found",
+	http.StatusMethodNotAllowed:      "method-not-allowed",
+	http.StatusConflict:              "conflict",
+	http.StatusUnprocessableEntity:   "validation-error",
+	http.StatusTooManyRequests:       "rate-limited",
+	http.StatusInternalServerError:   "internal-error",
+	http.StatusServiceUnavailable:    "unavailable",
+	http.StatusRequestEntityTooLarge: "payload-too-large",
+}
+
+// Problem is an RFC 9457 problem details object, the body of the error responses.
+type Problem struct {
+	Type     string         `json:"type"`               // URI identifying the kind of problem.
+	Title    string         `json:"title"`              // Short summary of the kind of problem.
+	Status   int            `json:"status"`             // HTTP status code of the response.
+	Detail   string         `json:"detail,omitempty"`   // Explanation specific to this occurrence.
+	Instance string         `json:"instance,omitempty"` // Request ID of this occurrence, as found in the logs.
+	Errors   []ProblemError `json:"errors,omitempty"`   // Individual errors of invalid requests.
+}
+
+// ProblemError locates an individual error of an invalid request.
+type ProblemError struct {
+	Detail    string `json:"detail"`              // Explanation of the error.
+	Pointer   string `json:"pointer,omitempty"`   // JSON pointer to the invalid member of the body, e.g., "#/title".
+	Parameter string `json:"parameter,omitempty"` // Name of the invalid query parameter.
+}
+
+// NewProblem creates the Problem of a response with the given status to the request.
+func NewProblem(r *http.Request, status int, detail string) *Problem {
+	p := &Problem{
+		Type:     "about:blank",
+		Title:    http.StatusText(status),
+		Status:   status,
+		Detail:   detail,
+		Instance: requestid.FromContext(r.Context()),
+	}
+	if name, ok := problemTypes[status]; ok {
+		p.Type = ProblemTypeBase + name
+	}
+	return p
+}
+
+// fieldErrors converts the validation errors by field into individual errors, sorted by field, locating each
+// field with the given function.
+func fieldErrors(errors map[string]string, locate func(ProblemError, string) ProblemError) []ProblemError {
+	fields := make([]string, 0, len(errors))
+	for field := range errors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	list := make([]ProblemError, 0, len(fields))
+	for _, field := range fields {
+		list = append(list, locate(ProblemError{Detail: errors[field]}, field))
+	}
+	return list
+}
+
+// bodyField locates the error at the member of the request body, escaping the field name as per RFC 6901.
+func bodyField(e ProblemError, field string) ProblemError {
+	e.Pointer = "#/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(field)
+	return e
+}
+
+// NestedFieldErrors converts the validation errors by field of a nested object of the request body into
+// individual errors, locating the fields under the JSON pointer to the object, e.g., "#/items/0".
+func NestedFieldErrors(errors map[string]string, pointer string) []ProblemError {
+	return fieldErrors(errors, func(e ProblemError, field string) ProblemError {
+		e = bodyField(e, field)
+		e.Pointer = pointer + strings.TrimPrefix(e.Pointer, "#")
+		return e
+	})
+}
+
+// queryParameter locates the error at the query parameter.
+func queryParameter(e ProblemError, name string) ProblemError {
+	e.Parameter = name
+	return e
+}
+
+// WantsLegacyErrors reports whether the client asked for the legacy {"error": ...} error bodies, either by
+// accepting LegacyErrorType rather than ProblemContentType or by sending version 1 in the Api-Version header.
+// Problem details are sent to every other client.
+func WantsLegacyErrors(r *http.Request) bool {
+	for _, accepted := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(accepted))
+		if err != nil {
+			continue
+		}
+		switch mediaType {
+		case ProblemContentType:
+			return false
+		case LegacyErrorType:
+			return true
+		}
+	}
+	return r.Header.Get(HeaderApiVersion) == LegacyApiVersion
+}
@@ -0,0 +1,31 @@
+package batch
+
+import "interfaces/api/vacancy/dto"
+
+// Modes of a batch.
+const (
+	ModeAtomic     = "atomic"      // Every item is applied, or none if any fails.
+	ModeBestEffort = "best_effort" // The valid items are applied even if others fail.
+)
+
+// Request represents the data transfer object for creating or updating job vacancies in a batch.
+type Request struct {
+	Mode  *string       `json:"mode,omitempty"` // Mode of the batch: "atomic", the default, or "best_effort".
+	Items []dto.Request `json:"items"`          // Items of the batch; updated items must have an ID.
+}
+
+// DeleteRequest represents the data transfer object for deleting job vacancies in a batch.
+type DeleteRequest struct {
+	Mode *string `json:"mode,omitempty"` // Mode of the batch: "atomic", the default, or "best_effort".
+	IDs  []int64 `json:"ids"`            // IDs of the job vacancies to delete.
+}
+
+// Atomic reports whether the batch of the given mode is applied atomically, which is the default.
+func Atomic(mode *string) bool {
+	return mode == nil || *mode == ModeAtomic
+}
+
+// ValidMode reports whether the mode, if given, is a known mode.
+func ValidMode(mode *string) bool {
+	return mode == nil || *mode == ModeAtomic || *mode == ModeBestEffort
+}
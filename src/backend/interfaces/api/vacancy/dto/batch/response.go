@@ -0,0 +1,65 @@
+package batch
+
+import (
+	"fmt"
+	"interfaces/api/utils"
+	"net/http"
+)
+
+// Response represents the data transfer object for the result of a batch, with the result of every item.
+type Response struct {
+	Mode      string `json:"mode"`      // Mode the batch was applied in.
+	Succeeded int    `json:"succeeded"` // Number of items applied.
+	Failed    int    `json:"failed"`    // Number of items not applied.
+	Items     []Item `json:"items"`     // Result of every item, in the order of the request.
+}
+
+// Item represents the result of an item of a batch.
+type Item struct {
+	Index  int                  `json:"index"`            // Index of the item in the request.
+	Status int                  `json:"status"`           // HTTP status of the item, as if requested alone.
+	ID     *int64               `json:"id,omitempty"`     // ID of the job vacancy, unless a creation failed.
+	Errors []utils.ProblemError `json:"errors,omitempty"` // Errors of a failed item, located in the request.
+}
+
+// NewResponse creates the response of a batch of n items applied in the given mode.
+func NewResponse(mode *string, n int) *Response {
+	r := &Response{Mode: ModeAtomic, Items: make([]Item, n)}
+	if mode != nil {
+		r.Mode = *mode
+	}
+	for i := range r.Items {
+		r.Items[i].Index = i
+	}
+	return r
+}
+
+// Set records the result of the item at the given index.
+func (r *Response) Set(index, status int, id *int64, errors ...utils.ProblemError) {
+	r.Items[index].Status = status
+	r.Items[index].ID = id
+	r.Items[index].Errors = errors
+}
+
+// Status counts the items applied and not applied and returns the status of the response: 200 OK if every item
+// was applied, 207 Multi-Status otherwise.
+func (r *Response) Status() int {
+	r.Succeeded, r.Failed = 0, 0
+	for _, item := range r.Items {
+		if item.Status < http.StatusMultipleChoices {
+			r.Succeeded++
+		} else {
+			r.Failed++
+		}
+	}
+	if r.Failed > 0 {
+		return http.StatusMultiStatus
+	}
+	return http.StatusOK
+}
+
+// ItemPointer returns the JSON pointer to the member of the request body holding the item at the given index,
+// e.g., "#/items/0".
+func ItemPointer(member string, index int) string {
+	return fmt.Sprintf("#/%s/%d", member, index)
+}
@@ -0,0 +1,18 @@
+package export
+
+// Orders the exported vacancies may be sorted in.
+const (
+	OrderAsc  = "asc"
+	OrderDesc = "desc"
+)
+
+// Request represents the data transfer object for exporting the job vacancies matching the filters, parsed from
+// the query of the request.
+type Request struct {
+	Title     string   // Title filters the vacancies by a part of their title.
+	Company   string   // Company filters the vacancies by a part of the name of their company.
+	SortField string   // SortField is the field the vacancies are sorted by.
+	SortOrder string   // SortOrder is the order the vacancies are sorted in, "asc" or "desc".
+	Format    string   // Format of the export, "csv" or "ndjson".
+	Columns   []string // Columns exported, in order.
+}
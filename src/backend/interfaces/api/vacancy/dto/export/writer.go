@@ -0,0 +1,144 @@
+package export
+
+import (
+	"application/vacancy"
+	"bufio"
+	"domain/vacancy/entity"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// Formats of an export.
+const (
+	FormatCSV    = "csv"    // Comma-separated values with a header row, RFC 4180.
+	FormatNDJSON = "ndjson" // Newline-delimited JSON, one object per vacancy.
+)
+
+// Media types of the formats of an export.
+const (
+	MediaTypeCSV    = "text/csv"
+	MediaTypeNDJSON = "application/x-ndjson"
+)
+
+// Formats returns the formats of an export.
+func Formats() []string {
+	return []string{FormatCSV, FormatNDJSON}
+}
+
+// ContentType returns the content type of the format, along with its charset for CSV.
+func ContentType(format string) string {
+	if format == FormatNDJSON {
+		return MediaTypeNDJSON
+	}
+	return MediaTypeCSV + "; charset=utf-8"
+}
+
+// values extracts the value of each column of a vacancy, nil if it has none, e.g., the owner of a vacancy without
+// one.
+var values = map[string]func(v *entity.Vacancy) any{
+	vacancy.ColumnId:          func(v *entity.Vacancy) any { return v.GetId() },
+	vacancy.ColumnTitle:       func(v *entity.Vacancy) any { return v.GetTitle() },
+	vacancy.ColumnCompany:     func(v *entity.Vacancy) any { return v.GetCompany() },
+	vacancy.ColumnDescription: func(v *entity.Vacancy) any { return v.GetDescription() },
+	vacancy.ColumnPostedAt: func(v *entity.Vacancy) any {
+		if postedAt := v.GetPostedAt(); !postedAt.IsZero() {
+			return postedAt.Format(time.DateOnly)
+		}
+		return nil
+	},
+	vacancy.ColumnLocation: func(v *entity.Vacancy) any { return v.GetLocation() },
+	vacancy.ColumnOrganizationId: func(v *entity.Vacancy) any {
+		if organizationId := v.GetOrganizationId(); organizationId != 0 {
+			return organizationId
+		}
+		return nil
+	},
+	vacancy.ColumnVersion: func(v *entity.Vacancy) any { return v.GetVersion() },
+}
+
+// Writer writes the exported vacancies in a format.
+type Writer interface {
+	// Write writes the selected columns of the vacancy.
+	Write(v *entity.Vacancy) error
+
+	// Flush writes the buffered data to the underlying writer.
+	Flush() error
+}
+
+// NewWriter creates a Writer writing the given columns of the vacancies to w in the format, FormatCSV or
+// FormatNDJSON. The CSV header row is written at once.
+func NewWriter(format string, w io.Writer, columns []string) (Writer, error) {
+	if format == FormatNDJSON {
+		return &ndjsonWriter{w: bufio.NewWriter(w), columns: columns}, nil
+	}
+	cw := &csvWriter{w: csv.NewWriter(w), columns: columns, record: make([]string, len(columns))}
+	if err := cw.w.Write(columns); err != nil {
+		return nil, fmt.Errorf("write csv header: %w", err)
+	}
+	return cw, nil
+}
+
+// csvWriter writes the vacancies as CSV records, leaving the columns without a value empty.
+type csvWriter struct {
+	w       *csv.Writer
+	columns []string
+	record  []string // Record reused for every vacancy.
+}
+
+// Write writes the selected columns of the vacancy as a record.
+func (c *csvWriter) Write(v *entity.Vacancy) error {
+	for i, column := range c.columns {
+		switch value := values[column](v).(type) {
+		case nil:
+			c.record[i] = ""
+		case string:
+			c.record[i] = value
+		default:
+			c.record[i] = fmt.Sprint(value)
+		}
+	}
+	return c.w.Write(c.record)
+}
+
+// Flush writes the buffered records to the underlying writer.
+func (c *csvWriter) Flush() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// ndjsonWriter writes the vacancies as JSON objects holding the selected columns in their order, one per line,
+// with null for the columns without a value.
+type ndjsonWriter struct {
+	w       *bufio.Writer
+	columns []string
+	line    []byte // Line reused for every vacancy.
+}
+
+// Write writes the selected columns of the vacancy as a line.
+func (n *ndjsonWriter) Write(v *entity.Vacancy) error {
+	n.line = append(n.line[:0], '{')
+	for i, column := range n.columns {
+		if i > 0 {
+			n.line = append(n.line, ',')
+		}
+		n.line = strconv.AppendQuote(n.line, column)
+		n.line = append(n.line, ':')
+		value, err := json.Marshal(values[column](v))
+		if err != nil {
+			return fmt.Errorf("encode %s: %w", column, err)
+		}
+		n.line = append(n.line, value...)
+	}
+	n.line = append(n.line, '}', '\n')
+	_, err := n.w.Write(n.line)
+	return err
+}
+
+// Flush writes the buffered lines to the underlying writer.
+func (n *ndjsonWriter) Flush() error {
+	return n.w.Flush()
+}
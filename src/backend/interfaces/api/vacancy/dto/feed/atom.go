@@ -0,0 +1,68 @@
+package feed
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// atomFeed is an Atom feed document.
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Id      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Link    atomLink    `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+// atomEntry is an entry of an Atom feed.
+type atomEntry struct {
+	Id        string     `xml:"id"`
+	Title     string     `xml:"title"`
+	Updated   string     `xml:"updated"`
+	Published string     `xml:"published"`
+	Author    atomPerson `xml:"author"`
+	Link      atomLink   `xml:"link"`
+	Summary   string     `xml:"summary"`
+}
+
+// atomLink is a link of an Atom feed or entry.
+type atomLink struct {
+	Rel  string `xml:"rel,attr,omitempty"`
+	Href string `xml:"href,attr"`
+}
+
+// atomPerson is the author of an Atom entry.
+type atomPerson struct {
+	Name string `xml:"name"`
+}
+
+// atom returns the feed as an Atom document. The vacancies have no time of their last update, so their entries
+// are updated when posted; their IDs change with their version instead. An empty feed is updated at the Unix
+// epoch, so the document only depends on its vacancies.
+func (f *Feed) atom() *atomFeed {
+	updated := f.LastModified()
+	if updated.IsZero() {
+		updated = time.Unix(0, 0).UTC()
+	}
+	document := &atomFeed{
+		Id:      f.Self,
+		Title:   f.title(),
+		Updated: updated.Format(time.RFC3339),
+		Link:    atomLink{Rel: "self", Href: f.Self},
+		Entries: make([]atomEntry, 0, len(f.Items)),
+	}
+	for _, v := range f.Items {
+		postedAt := v.GetPostedAt().UTC().Format(time.RFC3339)
+		document.Entries = append(document.Entries, atomEntry{
+			Id:        guid(v),
+			Title:     itemTitle(v),
+			Updated:   postedAt,
+			Published: postedAt,
+			Author:    atomPerson{Name: v.GetCompany()},
+			Link:      atomLink{Href: f.link(v)},
+			Summary:   v.GetDescription(),
+		})
+	}
+	return document
+}
@@ -0,0 +1,98 @@
+package feed
+
+import (
+	"crypto/sha256"
+	"domain/vacancy/entity"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// feedTitle is the title of the feeds, followed by their filters, if any.
+const feedTitle = "Pulse Finder vacancies"
+
+// Feed is a feed of job vacancies, written in the format of its request.
+type Feed struct {
+	Request *Request          // Filters and format of the feed.
+	Self    string            // URL of the feed, without its token.
+	Base    string            // Base URL of the API the vacancies link to, e.g., https://api.example.com.
+	Items   []*entity.Vacancy // Vacancies of the feed, newest first.
+}
+
+// ETag returns the entity tag of the feed, derived from its URL, its format and the ID and version of each of its
+// vacancies, so it changes whenever a vacancy of the feed is added, updated or removed.
+func (f *Feed) ETag() string {
+	h := sha256.New()
+	_, _ = fmt.Fprintf(h, "%s\n%s\n", f.Request.Format, f.Self)
+	for _, v := range f.Items {
+		_, _ = fmt.Fprintf(h, "%d:%d\n", v.GetId(), v.GetVersion())
+	}
+	return `"` + hex.EncodeToString(h.Sum(nil)[:16]) + `"`
+}
+
+// LastModified returns the time the newest vacancy of the feed was posted at, or the zero time if the feed is
+// empty.
+func (f *Feed) LastModified() time.Time {
+	var last time.Time
+	for _, v := range f.Items {
+		if postedAt := v.GetPostedAt(); postedAt.After(last) {
+			last = postedAt
+		}
+	}
+	return last.UTC().Truncate(time.Second)
+}
+
+// Write writes the feed as XML in its format.
+func (f *Feed) Write(w io.Writer) error {
+	var document any = f.rss()
+	if f.Request.Format == FormatAtom {
+		document = f.atom()
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(document); err != nil {
+		return fmt.Errorf("encode %s feed: %w", f.Request.Format, err)
+	}
+	return encoder.Close()
+}
+
+// title returns the title of the feed, naming its filters.
+func (f *Feed) title() string {
+	var filters []string
+	for _, filter := range []struct{ name, value string }{
+		{"title", f.Request.Title}, {"company", f.Request.Company}, {"location", f.Request.Location},
+	} {
+		if filter.value != "" {
+			filters = append(filters, fmt.Sprintf("%s %q", filter.name, filter.value))
+		}
+	}
+	if len(filters) == 0 {
+		return feedTitle
+	}
+	return feedTitle + " matching " + strings.Join(filters, ", ")
+}
+
+// guid returns the identifier of the vacancy at its version, which stays the same until the vacancy is updated.
+func guid(v *entity.Vacancy) string {
+	return fmt.Sprintf("urn:pulse-finder:vacancy:%d:%d", v.GetId(), v.GetVersion())
+}
+
+// itemTitle returns the title of the item of the vacancy, with its company and location.
+func itemTitle(v *entity.Vacancy) string {
+	title := v.GetTitle() + " at " + v.GetCompany()
+	if v.GetLocation() != "" {
+		title += " (" + v.GetLocation() + ")"
+	}
+	return title
+}
+
+// link returns the URL of the vacancy in the API.
+func (f *Feed) link(v *entity.Vacancy) string {
+	return fmt.Sprintf("%s/v1/vacancies/%d", f.Base, v.GetId())
+}
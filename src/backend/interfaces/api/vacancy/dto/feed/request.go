@@ -0,0 +1,30 @@
+package feed
+
+// Formats of a feed.
+const (
+	FormatRSS  = "rss"  // RSS 2.0.
+	FormatAtom = "atom" // Atom, RFC 4287.
+)
+
+// Media types of the formats of a feed.
+const (
+	MediaTypeRSS  = "application/rss+xml"
+	MediaTypeAtom = "application/atom+xml"
+)
+
+// Request represents the data transfer object for a feed of the job vacancies matching the filters, parsed from
+// the query of the request.
+type Request struct {
+	Title    string // Title filters the vacancies by a part of their title.
+	Company  string // Company filters the vacancies by a part of the name of their company.
+	Location string // Location filters the vacancies by a part of their location.
+	Format   string // Format of the feed, "rss" or "atom".
+}
+
+// ContentType returns the content type of the format, along with its charset.
+func ContentType(format string) string {
+	if format == FormatAtom {
+		return MediaTypeAtom + "; charset=utf-8"
+	}
+	return MediaTypeRSS + "; charset=utf-8"
+}
@@ -0,0 +1,56 @@
+package feed
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// rss is an RSS 2.0 document.
+type rss struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+// rssChannel is the channel of an RSS document.
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+// rssItem is an item of an RSS channel.
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link"`
+	Description string  `xml:"description"`
+	GUID        rssGUID `xml:"guid"`
+	PubDate     string  `xml:"pubDate"`
+}
+
+// rssGUID is the unique identifier of an RSS item.
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// rss returns the feed as an RSS 2.0 document.
+func (f *Feed) rss() *rss {
+	channel := rssChannel{Title: f.title(), Link: f.Self, Description: f.title()}
+	if lastModified := f.LastModified(); !lastModified.IsZero() {
+		channel.LastBuildDate = lastModified.Format(time.RFC1123Z)
+	}
+	channel.Items = make([]rssItem, 0, len(f.Items))
+	for _, v := range f.Items {
+		channel.Items = append(channel.Items, rssItem{
+			Title:       itemTitle(v),
+			Link:        f.link(v),
+			Description: v.GetDescription(),
+			GUID:        rssGUID{Value: guid(v)},
+			PubDate:     v.GetPostedAt().UTC().Format(time.RFC1123Z),
+		})
+	}
+	return &rss{Version: "2.0", Channel: channel}
+}
@@ -0,0 +1,65 @@
+package imports
+
+import (
+	"application/vacancy"
+	"encoding/csv"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+// MediaTypeCSV is the media type of the report downloaded as CSV.
+const MediaTypeCSV = "text/csv"
+
+// Report represents the data transfer object for the result of an import, with the errors of every failed row.
+type Report struct {
+	DryRun   bool       `json:"dry_run"`           // Whether the rows were only validated.
+	Rows     int        `json:"rows"`              // Number of rows read.
+	Valid    int        `json:"valid"`             // Number of rows passing the validation.
+	Imported int        `json:"imported"`          // Number of vacancies created, none in a dry run.
+	Failed   int        `json:"failed"`            // Number of rows not imported, or invalid in a dry run.
+	Errors   []RowError `json:"errors"`            // Errors of the failed rows, in the order of the rows.
+	Aborted  string     `json:"aborted,omitempty"` // Why the import stopped before the end of the file, if it did.
+}
+
+// RowError represents an error of a row of an import.
+type RowError struct {
+	Line   int    `json:"line"`             // Line of the row in the file.
+	Field  string `json:"field,omitempty"`  // Field of the vacancy in error, if any.
+	Column string `json:"column,omitempty"` // Column of the file holding the field.
+	Detail string `json:"detail"`           // Explanation of the error.
+}
+
+// FromImport creates the report of the import.
+func FromImport(r *vacancy.ImportReport) *Report {
+	report := &Report{DryRun: r.DryRun, Rows: r.Rows, Valid: r.Valid, Imported: r.Imported, Failed: r.Failed,
+		Errors: make([]RowError, len(r.Errors)), Aborted: r.Aborted}
+	for i, e := range r.Errors {
+		report.Errors[i] = RowError{Line: e.Line, Field: e.Field, Column: e.Column, Detail: e.Detail}
+	}
+	return report
+}
+
+// Status returns the status of the response: 200 OK if no row failed, 207 Multi-Status otherwise, including when
+// the import was aborted, since the rows of the failed batch are reported failed.
+func (r *Report) Status() int {
+	if r.Failed > 0 {
+		return http.StatusMultiStatus
+	}
+	return http.StatusOK
+}
+
+// WriteCSV writes the errors of the report as CSV, with a header row and a record per error.
+func (r *Report) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"line", "field", "column", "error"}); err != nil {
+		return err
+	}
+	for _, e := range r.Errors {
+		if err := cw.Write([]string{strconv.Itoa(e.Line), e.Field, e.Column, e.Detail}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,21 @@
+package imports
+
+import "application/vacancy"
+
+// Request represents the data transfer object for importing job vacancies, parsed from the query of the request
+// or from the flags of the command line.
+type Request struct {
+	Format  string // Format of the file, "csv" or "ndjson".
+	Mapping string // Mapping is the column of the file holding each field, as field=column,... if not the field.
+	DryRun  bool   // DryRun validates the rows and reports their errors without importing them.
+}
+
+// Form describes the multipart form of an uploaded import.
+type Form struct {
+	File string `json:"file"` // CSV or NDJSON file holding a vacancy per row.
+}
+
+// Options returns the options of the import, creating the valid rows in batches of batchSize.
+func (r *Request) Options(batchSize int) vacancy.ImportOptions {
+	return vacancy.ImportOptions{Format: r.Format, Mapping: r.Mapping, DryRun: r.DryRun, BatchSize: batchSize}
+}
@@ -0,0 +1,14 @@
+package jobposting
+
+import "interfaces/api/utils/codec"
+
+// Codec encodes the JSON-LD representation of the vacancies, negotiated along with the codecs of the API.
+type Codec struct {
+	codec.JSON
+}
+
+// MediaType returns the media type of JSON-LD.
+func (c Codec) MediaType() string { return MediaType }
+
+// ContentType returns the Content-Type header of JSON-LD bodies.
+func (c Codec) ContentType() string { return MediaType }
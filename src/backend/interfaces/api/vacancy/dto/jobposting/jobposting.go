@@ -0,0 +1,102 @@
+package jobposting
+
+import (
+	"domain/vacancy/entity"
+	"strconv"
+	"time"
+)
+
+// MediaType is the media type of the JSON-LD representation of the vacancies.
+const MediaType = "application/ld+json"
+
+// schemaContext is the JSON-LD context of the schema.org vocabulary.
+const schemaContext = "https://schema.org"
+
+// identifierName names the system the identifiers of the postings belong to.
+const identifierName = "Pulse Finder"
+
+// JobPosting represents the data transfer object for a job vacancy as a schema.org JobPosting, as consumed by job
+// aggregators. See https://schema.org/JobPosting.
+type JobPosting struct {
+	Context            string        `json:"@context,omitempty"` // JSON-LD context, only set on the top-level object.
+	Type               string        `json:"@type"`              // Always "JobPosting".
+	Identifier         PropertyValue `json:"identifier"`         // Identifier of the vacancy.
+	Title              string        `json:"title"`              // Title of the job vacancy.
+	Description        string        `json:"description"`        // Description of the job vacancy.
+	DatePosted         string        `json:"datePosted"`         // Date the job was posted, YYYY-MM-DD.
+	HiringOrganization Organization  `json:"hiringOrganization"` // Company offering the job.
+	JobLocation        Place         `json:"jobLocation"`        // Location of the job.
+}
+
+// PropertyValue is a schema.org PropertyValue, identifying the posting.
+type PropertyValue struct {
+	Type  string `json:"@type"` // Always "PropertyValue".
+	Name  string `json:"name"`  // System the identifier belongs to.
+	Value string `json:"value"` // ID of the vacancy.
+}
+
+// Organization is a schema.org Organization, the company offering the job.
+type Organization struct {
+	Type string `json:"@type"` // Always "Organization".
+	Name string `json:"name"`  // Name of the company.
+}
+
+// Place is a schema.org Place, the location of the job.
+type Place struct {
+	Type    string        `json:"@type"`   // Always "Place".
+	Address PostalAddress `json:"address"` // Address of the job.
+}
+
+// PostalAddress is a schema.org PostalAddress. Vacancies only hold a free-form location, stored as its locality.
+type PostalAddress struct {
+	Type            string `json:"@type"`           // Always "PostalAddress".
+	AddressLocality string `json:"addressLocality"` // Location of the vacancy.
+}
+
+// ItemList represents the data transfer object for a list of job vacancies as a schema.org ItemList of
+// JobPostings.
+type ItemList struct {
+	Context         string     `json:"@context"`        // JSON-LD context.
+	Type            string     `json:"@type"`           // Always "ItemList".
+	ItemListElement []ListItem `json:"itemListElement"` // Postings of the list, in order.
+}
+
+// ListItem is a schema.org ListItem holding a JobPosting.
+type ListItem struct {
+	Type     string     `json:"@type"`    // Always "ListItem".
+	Position int        `json:"position"` // Position of the posting in the list, starting at 1.
+	Item     JobPosting `json:"item"`     // Posting of the item.
+}
+
+// FromEntity maps the Vacancy entity fields to a JobPosting, without its JSON-LD context.
+func FromEntity(e *entity.Vacancy) *JobPosting {
+	p := &JobPosting{
+		Type:               "JobPosting",
+		Identifier:         PropertyValue{Type: "PropertyValue", Name: identifierName},
+		Title:              e.GetTitle(),
+		Description:        e.GetDescription(),
+		HiringOrganization: Organization{Type: "Organization", Name: e.GetCompany()},
+		JobLocation: Place{Type: "Place",
+			Address: PostalAddress{Type: "PostalAddress", AddressLocality: e.GetLocation()}},
+	}
+	p.Identifier.Value = strconv.FormatInt(e.GetId(), 10)
+	if postedAt := e.GetPostedAt(); !postedAt.IsZero() {
+		p.DatePosted = postedAt.Format(time.DateOnly)
+	}
+	return p
+}
+
+// WithContext sets the JSON-LD context of the posting, sent as a top-level object, and returns it.
+func (p *JobPosting) WithContext() *JobPosting {
+	p.Context = schemaContext
+	return p
+}
+
+// NewItemList returns the list of the postings, in order.
+func NewItemList(postings []*JobPosting) *ItemList {
+	list := &ItemList{Context: schemaContext, Type: "ItemList", ItemListElement: make([]ListItem, 0, len(postings))}
+	for i, p := range postings {
+		list.ItemListElement = append(list.ItemListElement, ListItem{Type: "ListItem", Position: i + 1, Item: *p})
+	}
+	return list
+}
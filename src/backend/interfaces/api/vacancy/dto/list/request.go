@@ -24,9 +24,10 @@ func getRequestPool() func() *sync.Pool {
 
 // Request represents the data transfer object for listing job vacancies with filtering options.
 type Request struct {
-	Title   *string `json:"title,omitempty"`   // Title specifies a filter by job vacancy title.
-	Company *string `json:"company,omitempty"` // Company specifies a filter by company offering the job.
-	Filters Filters `json:"filters"`           // Filters define pagination and sorting options for listing.
+	Title   *string  `json:"title,omitempty"`   // Title specifies a filter by job vacancy title.
+	Company *string  `json:"company,omitempty"` // Company specifies a filter by company offering the job.
+	Filters Filters  `json:"filters"`           // Filters define pagination and sorting options for listing.
+	Fields  []string `json:"-"`                 // Fields limits the listed vacancies to the given fields, if any.
 }
 
 // Filters defines pagination and sorting options.
@@ -42,6 +43,7 @@ func (r *Request) Reset() *Request {
 	r.Title = nil
 	r.Company = nil
 	r.Filters.Reset()
+	r.Fields = nil
 	return r
 }
 
@@ -0,0 +1,57 @@
+package dto
+
+import (
+	"fmt"
+	vacancyv1 "infrastructure/proto/vacancy/gen"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// MarshalProto encodes the Response as the ExportedVacancy message of the gRPC API, which holds every field of a
+// vacancy.
+func (r *Response) MarshalProto() ([]byte, error) {
+	return proto.Marshal(&vacancyv1.ExportedVacancy{
+		Id:             valueOf(r.ID),
+		Title:          valueOf(r.Title),
+		Company:        valueOf(r.Company),
+		Description:    valueOf(r.Description),
+		PostedAt:       valueOf(r.PostedAt),
+		Location:       valueOf(r.Location),
+		OrganizationId: valueOf(r.OrganizationId),
+		Version:        valueOf(r.Version),
+	})
+}
+
+// UnmarshalProto decodes the Request from the CreateVacancyRequest message of the gRPC API, as sent by the bot.
+// Fields left empty are not set, as protobuf cannot tell them from missing ones.
+func (r *Request) UnmarshalProto(data []byte) error {
+	var m vacancyv1.CreateVacancyRequest
+	if err := proto.Unmarshal(data, &m); err != nil {
+		return fmt.Errorf("body contains badly-formed protobuf: %w", err)
+	}
+	r.Title = optional(m.GetTitle())
+	r.Company = optional(m.GetCompany())
+	r.Description = optional(m.GetDescription())
+	r.PostedAt = optional(m.GetPostedAt())
+	r.Location = optional(m.GetLocation())
+	r.OrganizationId = optional(m.GetOrganizationId())
+	return nil
+}
+
+// valueOf returns the value the pointer points to, the zero value if nil.
+func valueOf[T any](p *T) T {
+	var v T
+	if p != nil {
+		v = *p
+	}
+	return v
+}
+
+// optional returns a pointer to the value, nil for the zero value.
+func optional[T comparable](v T) *T {
+	var zero T
+	if v == zero {
+		return nil
+	}
+	return &v
+}
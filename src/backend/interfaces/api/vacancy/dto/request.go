@@ -34,6 +34,9 @@ type Request struct {
 	Description *string `json:"description,omitempty"` // Description of the job vacancy.
 	PostedAt    *string `json:"posted_at,omitempty"`   // PostedAt is the timestamp when the job was posted.
 	Location    *string `json:"location,omitempty"`    // Location of the job.
+
+	OrganizationId *int64 `json:"organization_id,omitempty"` // OrganizationId of the organization owning the vacancy.
+	Version        *int32 `json:"version,omitempty"`         // Version the client expects to update.
 }
 
 // Reset resets the fields of the Request to their zero values and returns the updated Request.
@@ -44,6 +47,8 @@ func (r *Request) Reset() *Request {
 	r.Description = nil
 	r.PostedAt = nil
 	r.Location = nil
+	r.OrganizationId = nil
+	r.Version = nil
 	return r
 }
 
@@ -78,4 +83,10 @@ func (r *Request) ToEntity(e *entity.Vacancy) {
 	if r.Location != nil {
 		e.SetLocation(*r.Location)
 	}
+	if r.OrganizationId != nil {
+		e.SetOrganizationId(*r.OrganizationId)
+	}
+	if r.Version != nil {
+		e.SetVersion(*r.Version)
+	}
 }
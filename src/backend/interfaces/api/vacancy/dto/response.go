@@ -2,6 +2,7 @@ package dto
 
 import (
 	"domain/vacancy/entity"
+	"slices"
 	"sync"
 	"time"
 )
@@ -34,6 +35,9 @@ type Response struct {
 	Description *string `json:"description,omitempty"` // Description of the job vacancy.
 	PostedAt    *string `json:"posted_at,omitempty"`   // PostedAt is the timestamp when the job was posted.
 	Location    *string `json:"location,omitempty"`    // Location of the job.
+
+	OrganizationId *int64 `json:"organization_id,omitempty"` // OrganizationId of the organization owning the vacancy.
+	Version        *int32 `json:"version,omitempty"`         // Version of the vacancy, incremented by every update.
 }
 
 // Reset resets the fields of the Response to their zero values and returns the updated Response.
@@ -44,6 +48,8 @@ func (r *Response) Reset() *Response {
 	r.Description = nil
 	r.PostedAt = nil
 	r.Location = nil
+	r.OrganizationId = nil
+	r.Version = nil
 	return r
 }
 
@@ -72,14 +78,45 @@ func (r *Response) FromEntity(e *entity.Vacancy) *Response {
 		r.PostedAt = &v
 	}
 	r.Location = &location
+	if organizationId := e.GetOrganizationId(); organizationId != 0 {
+		r.OrganizationId = &organizationId
+	}
+	if version := e.GetVersion(); version != 0 {
+		r.Version = &version
+	}
+	return r
+}
+
+// Project keeps the given fields of the Response, named as in JSON, and clears the others, so they are left out.
+// Every field is kept if none is given.
+func (r *Response) Project(fields []string) *Response {
+	if len(fields) == 0 {
+		return r
+	}
+	clearers := map[string]func(){
+		"id":              func() { r.ID = nil },
+		"title":           func() { r.Title = nil },
+		"company":         func() { r.Company = nil },
+		"description":     func() { r.Description = nil },
+		"posted_at":       func() { r.PostedAt = nil },
+		"location":        func() { r.Location = nil },
+		"organization_id": func() { r.OrganizationId = nil },
+		"version":         func() { r.Version = nil },
+	}
+	for field, unset := range clearers {
+		if !slices.Contains(fields, field) {
+			unset()
+		}
+	}
 	return r
 }
 
-// ToList converts a slice of Vacancy entities to a slice of Response objects.
-func (r *Response) ToList(list []*entity.Vacancy) *[]Response {
+// ToList converts a slice of Vacancy entities to a slice of Response objects, keeping the given fields only, if
+// any, as Project.
+func (r *Response) ToList(list []*entity.Vacancy, fields ...string) *[]Response {
 	items := make([]Response, len(list))
 	for i, item := range list {
-		items[i] = *r.Reset().FromEntity(item)
+		items[i] = *r.Reset().FromEntity(item).Project(fields)
 	}
 	return &items
 }
@@ -0,0 +1,306 @@
+package handlers
+
+import (
+	"application/organization"
+	"application/vacancy"
+	"context"
+	"domain/organization/repository"
+	"domain/vacancy/entity"
+	vacancyRepository "domain/vacancy/repository"
+	"errors"
+	"interfaces/api/utils"
+	"interfaces/api/vacancy/dto/batch"
+	"interfaces/api/vacancy/validators"
+	"net/http"
+)
+
+// BatchVacancyHandler handles HTTP requests creating, updating and deleting job vacancies in batches.
+type BatchVacancyHandler struct {
+	*utils.Handler   // HTTP handler utility.
+	*utils.Errors    // Error handler for standardized error responses.
+	*vacancy.Service // Vacancy service for business logic.
+	maxItems         int
+}
+
+// NewBatchVacancyHandler creates and returns a new instance of BatchVacancyHandler accepting batches of up to
+// maxItems items.
+func NewBatchVacancyHandler(
+	handler *utils.Handler,
+	errors *utils.Errors,
+	service *vacancy.Service,
+	maxItems int,
+) *BatchVacancyHandler {
+	return &BatchVacancyHandler{
+		Handler:  handler,
+		Errors:   errors,
+		Service:  service,
+		maxItems: maxItems,
+	}
+}
+
+// batchOperation applies the pending items of a batch in the service, returning the error of each.
+type batchOperation func(ctx context.Context, atomic bool) ([]error, error)
+
+// batchItems describes the items of a batch passing the checks of the handler, to be applied by the service.
+type batchItems struct {
+	member  string            // Member of the request body holding the items, e.g., "items".
+	pending []int             // Indexes of the pending items in the request.
+	success int               // Status of an applied item.
+	id      func(k int) int64 // ID of an applied item, given its index among the pending items.
+}
+
+// Create processes the HTTP request creating job vacancies in a batch, answering the result of every item.
+func (h *BatchVacancyHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var request batch.Request
+	if !h.readBatch(w, r, &request) || !h.checkBatch(w, r, request.Mode, "items", len(request.Items)) {
+		return
+	}
+	response := batch.NewResponse(request.Mode, len(request.Items))
+
+	v := validators.NewRequestValidator()
+	defer v.Release()
+
+	var pending []int
+	var list []*entity.Vacancy
+	defer func() {
+		for _, e := range list {
+			e.Release()
+		}
+	}()
+	for i := range request.Items {
+		if !v.Validate(&request.Items[i]) {
+			h.failValidation(v, response, i)
+			continue
+		}
+		e := entity.GetVacancy()
+		request.Items[i].ToEntity(e)
+		pending = append(pending, i)
+		list = append(list, e)
+	}
+
+	items := batchItems{member: "items", pending: pending, success: http.StatusCreated,
+		id: func(k int) int64 { return list[k].GetId() }}
+	h.apply(w, r, response, batch.Atomic(request.Mode), items,
+		func(ctx context.Context, atomic bool) ([]error, error) {
+			return h.Service.CreateVacancies(ctx, list, atomic)
+		})
+}
+
+// Update processes the HTTP request updating the given fields of job vacancies in a batch, answering the result
+// of every item.
+func (h *BatchVacancyHandler) Update(w http.ResponseWriter, r *http.Request) {
+	var request batch.Request
+	if !h.readBatch(w, r, &request) || !h.checkBatch(w, r, request.Mode, "items", len(request.Items)) {
+		return
+	}
+	response := batch.NewResponse(request.Mode, len(request.Items))
+
+	v := validators.NewRequestValidator()
+	defer v.Release()
+
+	var valid []int
+	var ids []int64
+	for i := range request.Items {
+		if !v.ValidateBatchUpdate(&request.Items[i]) {
+			h.failValidation(v, response, i)
+			continue
+		}
+		valid = append(valid, i)
+		ids = append(ids, *request.Items[i].ID)
+	}
+
+	// Apply the items to the stored vacancies, as a PATCH of each would
+	stored, err := h.Service.GetVacancies(r.Context(), ids)
+	if err != nil {
+		h.ServerErrorResponse(w, r, err)
+		return
+	}
+	byId := make(map[int64]*entity.Vacancy, len(stored))
+	for _, e := range stored {
+		byId[e.GetId()] = e
+	}
+	var pending []int
+	var list []*entity.Vacancy
+	for _, i := range valid {
+		e, ok := byId[*request.Items[i].ID]
+		if !ok {
+			h.fail(r, response, "items", i, request.Items[i].ID, vacancyRepository.ErrVacancyNotFound)
+			continue
+		}
+		request.Items[i].ToEntity(e)
+		pending = append(pending, i)
+		list = append(list, e)
+	}
+
+	items := batchItems{member: "items", pending: pending, success: http.StatusOK,
+		id: func(k int) int64 { return list[k].GetId() }}
+	h.apply(w, r, response, batch.Atomic(request.Mode), items,
+		func(ctx context.Context, atomic bool) ([]error, error) {
+			return h.Service.UpdateVacancies(ctx, list, atomic)
+		})
+}
+
+// Delete processes the HTTP request deleting job vacancies in a batch, answering the result of every item.
+func (h *BatchVacancyHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	var request batch.DeleteRequest
+	if !h.readBatch(w, r, &request) || !h.checkBatch(w, r, request.Mode, "ids", len(request.IDs)) {
+		return
+	}
+	response := batch.NewResponse(request.Mode, len(request.IDs))
+
+	var pending []int
+	var ids []int64
+	for i, id := range request.IDs {
+		if id < 1 {
+			response.Set(i, http.StatusUnprocessableEntity, nil, utils.ProblemError{
+				Detail: "id must be greater than zero", Pointer: batch.ItemPointer("ids", i),
+			})
+			continue
+		}
+		pending = append(pending, i)
+		ids = append(ids, id)
+	}
+
+	items := batchItems{member: "ids", pending: pending, success: http.StatusNoContent,
+		id: func(k int) int64 { return ids[k] }}
+	h.apply(w, r, response, batch.Atomic(request.Mode), items,
+		func(ctx context.Context, atomic bool) ([]error, error) {
+			return h.Service.DeleteVacancies(ctx, ids, atomic)
+		})
+}
+
+// readBatch reads the batch request into the given DTO. Sends the error response and returns false if the body
+// cannot be read.
+func (h *BatchVacancyHandler) readBatch(w http.ResponseWriter, r *http.Request, request any) bool {
+	if err := h.Read(w, r, request); err != nil {
+		h.ReadErrorResponse(w, r, err)
+		return false
+	}
+	return true
+}
+
+// checkBatch validates the mode and the number of items of the batch, held by the given member of the request
+// body. Sends the error response and returns false if the batch is invalid.
+func (h *BatchVacancyHandler) checkBatch(
+	w http.ResponseWriter,
+	r *http.Request,
+	mode *string,
+	member string,
+	n int,
+) bool {
+	v := validators.NewRequestValidator()
+	defer v.Release()
+
+	if !v.ValidateBatch(mode, member, n, h.maxItems) {
+		h.FailedValidationResponse(w, r, v.Errors)
+		return false
+	}
+	return true
+}
+
+// apply applies the pending items with the operation and sends the result of every item. In atomic mode,
+// nothing is applied if any item already failed the checks of the handler.
+func (h *BatchVacancyHandler) apply(
+	w http.ResponseWriter,
+	r *http.Request,
+	response *batch.Response,
+	atomic bool,
+	items batchItems,
+	operation batchOperation,
+) {
+	errs, err := h.run(r, response, atomic, items, operation)
+	if errs == nil {
+		h.ServerErrorResponse(w, r, err)
+		return
+	}
+	if err != nil {
+		h.LogError(r, err)
+	}
+
+	for k, i := range items.pending {
+		id := items.id(k)
+		switch {
+		case errs[k] == nil:
+			response.Set(i, items.success, &id)
+		case items.success == http.StatusCreated:
+			h.fail(r, response, items.member, i, nil, errs[k])
+		default:
+			h.fail(r, response, items.member, i, &id, errs[k])
+		}
+	}
+
+	if err = h.Write(w, r, response.Status(), response, nil); err != nil {
+		h.WriteErrorResponse(w, r, err)
+	}
+}
+
+// run applies the pending items with the operation, unless the batch is atomic and an item already failed, in
+// which case the pending items are aborted. Returns the error of each pending item, or nil and the error if the
+// batch failed as a whole.
+func (h *BatchVacancyHandler) run(
+	r *http.Request,
+	response *batch.Response,
+	atomic bool,
+	items batchItems,
+	operation batchOperation,
+) ([]error, error) {
+	errs := make([]error, len(items.pending))
+	switch {
+	case len(items.pending) == 0:
+		return errs, nil
+	case atomic && len(items.pending) < len(response.Items):
+		for k := range errs {
+			errs[k] = vacancyRepository.ErrBatchAborted
+		}
+		return errs, nil
+	}
+	return operation(r.Context(), atomic)
+}
+
+// failValidation records the validation errors of the item at the given index, reported by the validator, and
+// clears them.
+func (h *BatchVacancyHandler) failValidation(v *validators.RequestValidator, response *batch.Response, index int) {
+	errs := utils.NestedFieldErrors(v.Errors, batch.ItemPointer("items", index))
+	v.ClearErrors()
+	response.Set(index, http.StatusUnprocessableEntity, nil, errs...)
+}
+
+// fail records the error of the item at the given index of the member of the request body with the status it
+// would get if requested alone.
+func (h *BatchVacancyHandler) fail(
+	r *http.Request,
+	response *batch.Response,
+	member string,
+	index int,
+	id *int64,
+	err error,
+) {
+	pointer := batch.ItemPointer(member, index)
+	switch {
+	case errors.Is(err, organization.ErrNotPermitted):
+		response.Set(index, http.StatusForbidden, id, utils.ProblemError{
+			Detail: "your user account doesn't have permission to access this resource", Pointer: pointer,
+		})
+	case errors.Is(err, vacancyRepository.ErrVacancyNotFound):
+		response.Set(index, http.StatusNotFound, id, utils.ProblemError{
+			Detail: "the vacancy does not exist", Pointer: pointer,
+		})
+	case errors.Is(err, vacancyRepository.ErrEditConflict):
+		response.Set(index, http.StatusConflict, id, utils.ProblemError{
+			Detail: "the vacancy was modified concurrently", Pointer: pointer,
+		})
+	case errors.Is(err, repository.ErrOrganizationNotFound):
+		response.Set(index, http.StatusUnprocessableEntity, id, utils.ProblemError{
+			Detail: "organization does not exist", Pointer: pointer + "/organization_id",
+		})
+	case errors.Is(err, vacancyRepository.ErrBatchAborted):
+		response.Set(index, http.StatusFailedDependency, id, utils.ProblemError{
+			Detail: "not applied because another item of the atomic batch failed", Pointer: pointer,
+		})
+	default:
+		h.LogError(r, err)
+		response.Set(index, http.StatusInternalServerError, id, utils.ProblemError{
+			Detail: http.StatusText(http.StatusInternalServerError), Pointer: pointer,
+		})
+	}
+}
@@ -1,8 +1,11 @@
 package handlers
 
 import (
+	"application/organization"
 	"application/vacancy"
+	"domain/organization/repository"
 	"domain/vacancy/entity"
+	"errors"
 	"fmt"
 	"interfaces/api/utils"
 	"interfaces/api/vacancy/dto"
@@ -12,10 +15,9 @@ import (
 
 // CreateVacancyHandler handles the HTTP requests for creating a new job vacancy.
 type CreateVacancyHandler struct {
-	*utils.Handler               // HTTP handler utility.
-	*utils.Errors                // Error handler for standardized error responses.
-	*vacancy.Service             // Vacancy service for business logic.
-	*validators.RequestValidator // Vacancy request validator.
+	*utils.Handler   // HTTP handler utility.
+	*utils.Errors    // Error handler for standardized error responses.
+	*vacancy.Service // Vacancy service for business logic.
 }
 
 // NewCreateVacancyHandler creates and returns a new instance of CreateVacancyHandler.
@@ -23,13 +25,11 @@ func NewCreateVacancyHandler(
 	handler *utils.Handler,
 	errors *utils.Errors,
 	service *vacancy.Service,
-	validator *validators.RequestValidator,
 ) *CreateVacancyHandler {
 	return &CreateVacancyHandler{
-		Handler:          handler,
-		Errors:           errors,
-		Service:          service,
-		RequestValidator: validator,
+		Handler: handler,
+		Errors:  errors,
+		Service: service,
 	}
 }
 
@@ -49,7 +49,7 @@ func (h *CreateVacancyHandler) Execute(w http.ResponseWriter, r *http.Request) {
 
 	// Save vacancy
 	if err = h.Service.CreateVacancy(r.Context(), e); err != nil {
-		h.ServerErrorResponse(w, r, err)
+		h.handleCreateError(w, r, err)
 		return
 	}
 
@@ -57,31 +57,44 @@ func (h *CreateVacancyHandler) Execute(w http.ResponseWriter, r *http.Request) {
 	h.sendSuccessResponse(w, r, e)
 }
 
-// parseAndValidateRequest reads, parses, and validates the incoming JSON request body.
+// parseAndValidateRequest reads, parses, and validates the incoming request body, in JSON, MessagePack or protobuf.
 // Returns the validated request or an error if validation fails.
 func (h *CreateVacancyHandler) parseAndValidateRequest(w http.ResponseWriter, r *http.Request) (*dto.Request, error) {
 	request := dto.GetRequest()
-	if err := h.ReadJson(w, r, &request); err != nil {
-		h.ErrorResponse(w, r, http.StatusBadRequest, err.Error())
+	if err := h.Read(w, r, &request); err != nil {
+		h.ReadErrorResponse(w, r, err)
 		return nil, err
 	}
 
-	if !h.RequestValidator.Validate(request) {
-		h.FailedValidationResponse(w, r, h.RequestValidator.Errors)
-		h.RequestValidator.ClearErrors()
+	v := validators.NewRequestValidator()
+	defer v.Release()
+	if !v.Validate(request) {
+		h.FailedValidationResponse(w, r, v.Errors)
 		return nil, fmt.Errorf("validation failed")
 	}
 
 	return request, nil
 }
 
+// handleCreateError maps errors returned while creating a vacancy to HTTP responses.
+func (h *CreateVacancyHandler) handleCreateError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, organization.ErrNotPermitted):
+		h.NotPermittedResponse(w, r)
+	case errors.Is(err, repository.ErrOrganizationNotFound):
+		h.FailedValidationResponse(w, r, map[string]string{"organization_id": "organization does not exist"})
+	default:
+		h.ServerErrorResponse(w, r, err)
+	}
+}
+
 // sendSuccessResponse sends a success response with the created Vacancy's data.
 func (h *CreateVacancyHandler) sendSuccessResponse(w http.ResponseWriter, r *http.Request, e *entity.Vacancy) {
 	response := dto.GetResponse().FromEntity(e)
 	defer response.Release()
 
 	w.Header().Add("Location", fmt.Sprintf("/v1/vacancies/%d", e.GetId()))
-	if err := h.WriteJson(w, http.StatusCreated, response, nil); err != nil {
-		h.ServerErrorResponse(w, r, err)
+	if err := h.Write(w, r, http.StatusCreated, response, nil); err != nil {
+		h.WriteErrorResponse(w, r, err)
 	}
 }
@@ -1,7 +1,9 @@
 package handlers
 
 import (
+	"application/organization"
 	"application/vacancy"
+	"errors"
 	"interfaces/api/utils"
 	"net/http"
 )
@@ -35,6 +37,10 @@ func (h *DeleteVacancyHandler) Execute(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err = h.Service.DeleteVacancy(r.Context(), id); err != nil {
+		if errors.Is(err, organization.ErrNotPermitted) {
+			h.NotPermittedResponse(w, r)
+			return
+		}
 		h.NotFoundResponse(w, r)
 		return
 	}
@@ -0,0 +1,173 @@
+package handlers
+
+import (
+	"application/vacancy"
+	"bufio"
+	"compress/gzip"
+	"domain/vacancy/entity"
+	"errors"
+	"fmt"
+	"interfaces/api/utils"
+	"interfaces/api/vacancy/dto/export"
+	"interfaces/api/vacancy/validators"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// exportBufferSize is the size of the buffer the export is written through, sent to the client when full.
+	exportBufferSize = 32 << 10
+
+	// exportWriteTimeout bounds the time to send each buffer of an export to the client. It replaces the write
+	// timeout of the server, which would otherwise interrupt long exports.
+	exportWriteTimeout = 30 * time.Second
+)
+
+// ExportVacancyHandler handles the HTTP requests for exporting the job vacancies matching the list filters.
+type ExportVacancyHandler struct {
+	*utils.Handler   // HTTP handler utility.
+	*utils.Errors    // Error handler for standardized error responses.
+	*vacancy.Service // Vacancy service for business logic.
+}
+
+// NewExportVacancyHandler creates and returns a new instance of ExportVacancyHandler.
+func NewExportVacancyHandler(
+	handler *utils.Handler,
+	errors *utils.Errors,
+	service *vacancy.Service,
+) *ExportVacancyHandler {
+	return &ExportVacancyHandler{
+		Handler: handler,
+		Errors:  errors,
+		Service: service,
+	}
+}
+
+// Execute processes the HTTP request to export job vacancies. The vacancies are streamed from the database to the
+// client as they are read, gzipped if the client accepts it, so exports of any size use the same memory.
+func (h *ExportVacancyHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	// Parse and validate the request
+	rq, err := h.parseAndValidateRequest(w, r)
+	if err != nil {
+		return
+	}
+
+	// Stream the vacancies, answering with an error while nothing was sent yet
+	out := &exportWriter{w: w, rc: http.NewResponseController(w)}
+	h.setHeaders(w, r, rq.Format)
+	if err = h.stream(out, r, rq); err == nil {
+		return
+	}
+	if !out.sent {
+		for _, header := range []string{"Content-Disposition", "Content-Encoding", "Vary"} {
+			w.Header().Del(header)
+		}
+		h.ServerErrorResponse(w, r, err)
+		return
+	}
+
+	// The status was sent, so abort the response for the client to tell it is incomplete
+	h.LogError(r, err)
+	panic(http.ErrAbortHandler)
+}
+
+// parseAndValidateRequest reads, parses, and validates the incoming query parameters from the request URL.
+// Returns a validated request DTO or an error if the validation fails.
+func (h *ExportVacancyHandler) parseAndValidateRequest(
+	w http.ResponseWriter,
+	r *http.Request,
+) (*export.Request, error) {
+	q := r.URL.Query()
+	rq := &export.Request{
+		Title:     h.GetQueryString(q, "title", ""),
+		Company:   h.GetQueryString(q, "company", ""),
+		SortField: h.GetQueryString(q, "sort_field", vacancy.ColumnId),
+		SortOrder: strings.ToLower(h.GetQueryString(q, "sort_order", export.OrderAsc)),
+		Format:    h.GetQueryString(q, "format", export.FormatCSV),
+		Columns:   vacancy.ExportColumns(),
+	}
+	if columns := h.GetQueryString(q, "columns", ""); columns != "" {
+		rq.Columns = strings.Split(columns, ",")
+	}
+
+	// Validate
+	v := validators.NewRequestValidator()
+	defer v.Release()
+	if !v.ValidateExport(rq) {
+		h.FailedQueryValidationResponse(w, r, v.Errors)
+		return nil, fmt.Errorf("validation failed")
+	}
+	return rq, nil
+}
+
+// setHeaders sets the headers of the export, offering it as a file download.
+func (h *ExportVacancyHandler) setHeaders(w http.ResponseWriter, r *http.Request, format string) {
+	w.Header().Set("Content-Type", export.ContentType(format))
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"vacancies.%s\"", format))
+	w.Header().Set("Vary", "Accept-Encoding")
+	if acceptsGzip(r) {
+		w.Header().Set("Content-Encoding", "gzip")
+	}
+}
+
+// stream writes the vacancies matching the request to the writer, in the requested format.
+func (h *ExportVacancyHandler) stream(out io.Writer, r *http.Request, rq *export.Request) error {
+	buffered := bufio.NewWriterSize(out, exportBufferSize)
+	var dst io.Writer = buffered
+	var gz *gzip.Writer
+	if acceptsGzip(r) {
+		gz = gzip.NewWriter(buffered)
+		dst = gz
+	}
+
+	writer, err := export.NewWriter(rq.Format, dst, rq.Columns)
+	if err != nil {
+		return err
+	}
+	err = h.Service.ExportVacancies(r.Context(), rq.Title, rq.Company, rq.SortField, strings.ToUpper(rq.SortOrder),
+		func(v *entity.Vacancy) error { return writer.Write(v) })
+	if err != nil {
+		return err
+	}
+
+	if err = writer.Flush(); err != nil {
+		return err
+	}
+	if gz != nil {
+		if err = gz.Close(); err != nil {
+			return err
+		}
+	}
+	return buffered.Flush()
+}
+
+// acceptsGzip reports whether the client accepts gzip encoded responses.
+func acceptsGzip(r *http.Request) bool {
+	for _, coding := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(coding), ";")
+		if strings.EqualFold(strings.TrimSpace(name), "gzip") && strings.ReplaceAll(params, " ", "") != "q=0" {
+			return true
+		}
+	}
+	return false
+}
+
+// exportWriter writes an export to the response, extending the write deadline before every write and recording
+// whether the response was sent.
+type exportWriter struct {
+	w    http.ResponseWriter
+	rc   *http.ResponseController
+	sent bool // Whether the status and headers were sent.
+}
+
+// Write extends the write deadline and writes the bytes to the response.
+func (e *exportWriter) Write(b []byte) (int, error) {
+	err := e.rc.SetWriteDeadline(time.Now().Add(exportWriteTimeout))
+	if err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return 0, err
+	}
+	e.sent = true
+	return e.w.Write(b)
+}
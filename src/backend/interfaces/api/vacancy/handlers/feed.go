@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"application/auth"
+	"application/vacancy"
+	"bytes"
+	"interfaces/api/utils"
+	"interfaces/api/vacancy/dto/feed"
+	"net/http"
+)
+
+// FeedVacancyHandler handles the HTTP requests for the RSS and Atom feeds of the job vacancies matching the
+// filters.
+type FeedVacancyHandler struct {
+	*utils.Handler   // HTTP handler utility.
+	*utils.Errors    // Error handler for standardized error responses.
+	*vacancy.Service // Vacancy service for business logic.
+	limit            int
+}
+
+// NewFeedVacancyHandler creates and returns a new instance of FeedVacancyHandler serving feeds of up to limit
+// vacancies.
+func NewFeedVacancyHandler(
+	handler *utils.Handler,
+	errors *utils.Errors,
+	service *vacancy.Service,
+	limit int,
+) *FeedVacancyHandler {
+	return &FeedVacancyHandler{
+		Handler: handler,
+		Errors:  errors,
+		Service: service,
+		limit:   limit,
+	}
+}
+
+// Rss processes the HTTP request for the RSS 2.0 feed of job vacancies.
+func (h *FeedVacancyHandler) Rss(w http.ResponseWriter, r *http.Request) {
+	h.serve(w, r, feed.FormatRSS)
+}
+
+// Atom processes the HTTP request for the Atom feed of job vacancies.
+func (h *FeedVacancyHandler) Atom(w http.ResponseWriter, r *http.Request) {
+	h.serve(w, r, feed.FormatAtom)
+}
+
+// serve sends the feed of the most recently added vacancies matching the filters of the request in the format,
+// with its ETag and Last-Modified, answering 304 Not Modified to the conditional requests of feed readers that
+// already have it.
+func (h *FeedVacancyHandler) serve(w http.ResponseWriter, r *http.Request, format string) {
+	q := r.URL.Query()
+	rq := &feed.Request{
+		Title:    h.GetQueryString(q, "title", ""),
+		Company:  h.GetQueryString(q, "company", ""),
+		Location: h.GetQueryString(q, "location", ""),
+		Format:   format,
+	}
+
+	// Fetch the vacancies of the feed
+	items, err := h.Service.FeedVacancies(r.Context(), rq.Title, rq.Company, rq.Location, h.limit)
+	if err != nil {
+		h.ServerErrorResponse(w, r, err)
+		return
+	}
+
+	base := baseURL(r)
+	f := &feed.Feed{Request: rq, Self: base + feedURI(r), Base: base, Items: items}
+	var body bytes.Buffer
+	if err = f.Write(&body); err != nil {
+		h.ServerErrorResponse(w, r, err)
+		return
+	}
+
+	// Send the feed, or 304 Not Modified if it matches the validators of the request
+	w.Header().Set("Content-Type", feed.ContentType(format))
+	w.Header().Set("ETag", f.ETag())
+	w.Header().Set("Cache-Control", "private, no-cache")
+	http.ServeContent(w, r, "", f.LastModified(), bytes.NewReader(body.Bytes()))
+}
+
+// baseURL returns the scheme and host the request was sent to, as forwarded by a TLS-terminating proxy if any.
+func baseURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host
+}
+
+// feedURI returns the URI of the feed requested, without the token of its URL, which must not be published.
+func feedURI(r *http.Request) string {
+	q := r.URL.Query()
+	q.Del(auth.FeedTokenParam)
+	if len(q) == 0 {
+		return r.URL.Path
+	}
+	return r.URL.Path + "?" + q.Encode()
+}
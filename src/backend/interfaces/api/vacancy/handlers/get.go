@@ -5,7 +5,11 @@ import (
 	"domain/vacancy/entity"
 	"interfaces/api/utils"
 	"interfaces/api/vacancy/dto"
+	"interfaces/api/vacancy/dto/jobposting"
+	"interfaces/api/vacancy/validators"
 	"net/http"
+	"sort"
+	"strings"
 )
 
 // GetVacancyHandler handles HTTP requests for retrieving a job vacancy by its unique identifier.
@@ -28,7 +32,8 @@ func NewGetVacancyHandler(
 	}
 }
 
-// Execute processes the HTTP request to retrieve a job vacancy by its ID.
+// Execute processes the HTTP request to retrieve a job vacancy by its ID, limited to the requested fields, if any,
+// or as a complete schema.org JobPosting in JSON-LD to clients accepting application/ld+json.
 func (h *GetVacancyHandler) Execute(w http.ResponseWriter, r *http.Request) {
 	id, err := h.ExtractId(r)
 	if err != nil {
@@ -36,23 +41,89 @@ func (h *GetVacancyHandler) Execute(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Retrieve vacancy
-	v, err := h.Service.GetVacancy(r.Context(), id)
+	// Validate the fields, ignored by JSON-LD postings
+	jsonLd := h.Prefers(r, jobposting.Codec{})
+	fields := h.GetQueryList(r.URL.Query(), "fields")
+	if !h.validateFields(w, r, fields) {
+		return
+	}
+	if jsonLd {
+		fields = nil
+	}
+
+	// Retrieve vacancy, reading the requested fields only
+	v, err := h.Service.GetVacancy(r.Context(), id, fields...)
 	if err != nil {
 		h.NotFoundResponse(w, r)
 		return
 	}
 
 	// Send success response
-	h.sendSuccessResponse(w, r, v)
+	if jsonLd {
+		h.sendJobPosting(w, r, v)
+		return
+	}
+	h.sendSuccessResponse(w, r, v, fields)
 }
 
-// sendSuccessResponse sends a success response containing the retrieved Vacancy's data.
-func (h *GetVacancyHandler) sendSuccessResponse(w http.ResponseWriter, r *http.Request, e *entity.Vacancy) {
-	response := dto.GetResponse().FromEntity(e)
-	defer response.Release()
+// validateFields validates the requested fields, sending a failed validation response if they are invalid.
+func (h *GetVacancyHandler) validateFields(w http.ResponseWriter, r *http.Request, fields []string) bool {
+	v := validators.NewRequestValidator()
+	defer v.Release()
+
+	if !v.ValidateFields(fields) {
+		h.FailedQueryValidationResponse(w, r, v.Errors)
+		return false
+	}
+	return true
+}
+
+// sendJobPosting sends the vacancy as a schema.org JobPosting, or 422 Unprocessable Entity if it lacks properties
+// job aggregators require.
+func (h *GetVacancyHandler) sendJobPosting(w http.ResponseWriter, r *http.Request, e *entity.Vacancy) {
+	v := validators.NewRequestValidator()
+	defer v.Release()
+
+	posting := jobposting.FromEntity(e).WithContext()
+	if !v.ValidateJobPosting(posting) {
+		h.ErrorResponse(w, r, http.StatusUnprocessableEntity,
+			"vacancy cannot be published as a JobPosting: "+joinErrors(v.Errors))
+		return
+	}
 
-	if err := h.WriteJson(w, http.StatusOK, response, nil); err != nil {
+	headers := http.Header{"Content-Type": {jobposting.MediaType}, "Vary": {"Accept"}}
+	if err := h.WriteJson(w, http.StatusOK, posting, headers); err != nil {
 		h.ServerErrorResponse(w, r, err)
 	}
 }
+
+// joinErrors joins the validation errors, sorted by field, into a single message.
+func joinErrors(errors map[string]string) string {
+	fields := make([]string, 0, len(errors))
+	for field := range errors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	messages := make([]string, 0, len(fields))
+	for _, field := range fields {
+		messages = append(messages, errors[field])
+	}
+	return strings.Join(messages, "; ")
+}
+
+// sendSuccessResponse sends a success response containing the retrieved Vacancy's data, limited to the given
+// fields, if any.
+func (h *GetVacancyHandler) sendSuccessResponse(
+	w http.ResponseWriter,
+	r *http.Request,
+	e *entity.Vacancy,
+	fields []string,
+) {
+	response := dto.GetResponse().FromEntity(e).Project(fields)
+	defer response.Release()
+
+	if err := h.Write(w, r, http.StatusOK, response, nil); err != nil {
+		h.WriteErrorResponse(w, r, err)
+	}
+}
@@ -0,0 +1,188 @@
+package handlers
+
+import (
+	"application/vacancy"
+	"errors"
+	"fmt"
+	"interfaces/api/utils"
+	"interfaces/api/utils/codec"
+	"interfaces/api/vacancy/dto/imports"
+	"interfaces/api/vacancy/validators"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// importTimeout bounds the time to upload and import a file. It replaces the read and write timeouts of the
+// server, which would otherwise interrupt large imports.
+const importTimeout = 10 * time.Minute
+
+// importFormField is the field of the multipart form holding the imported file.
+const importFormField = "file"
+
+// ImportVacancyHandler handles the HTTP requests importing job vacancies from CSV or NDJSON files.
+type ImportVacancyHandler struct {
+	*utils.Handler   // HTTP handler utility.
+	*utils.Errors    // Error handler for standardized error responses.
+	*vacancy.Service // Vacancy service for business logic.
+	batchSize        int
+	maxBytes         int64
+}
+
+// NewImportVacancyHandler creates and returns a new instance of ImportVacancyHandler creating the vacancies in
+// batches of batchSize and accepting uploads of up to maxBytes bytes.
+func NewImportVacancyHandler(
+	handler *utils.Handler,
+	errors *utils.Errors,
+	service *vacancy.Service,
+	batchSize int,
+	maxBytes int64,
+) *ImportVacancyHandler {
+	return &ImportVacancyHandler{
+		Handler:   handler,
+		Errors:    errors,
+		Service:   service,
+		batchSize: batchSize,
+		maxBytes:  maxBytes,
+	}
+}
+
+// Execute processes the HTTP request importing the file of a multipart form and answers the report of the import,
+// as JSON or, to clients accepting text/csv, as a CSV download of the errors of the rows.
+func (h *ImportVacancyHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	// Parse and validate the request
+	rq, err := h.parseAndValidateRequest(w, r)
+	if err != nil {
+		return
+	}
+
+	rc := http.NewResponseController(w)
+	for _, extend := range []func(time.Time) error{rc.SetReadDeadline, rc.SetWriteDeadline} {
+		if err = extend(time.Now().Add(importTimeout)); err != nil && !errors.Is(err, http.ErrNotSupported) {
+			h.ServerErrorResponse(w, r, err)
+			return
+		}
+	}
+
+	// Find the file in the form, read as it is uploaded
+	r.Body = http.MaxBytesReader(w, r.Body, h.maxBytes)
+	file, err := h.formFile(r)
+	if err != nil {
+		h.uploadErrorResponse(w, r, err)
+		return
+	}
+
+	// Import the rows, reporting the rows read before a batch failed as a whole, if any
+	imported, err := h.Service.ImportVacancies(r.Context(), file, rq.Options(h.batchSize))
+	if imported == nil || errors.Is(err, vacancy.ErrImportUnreadable) {
+		h.uploadErrorResponse(w, r, err)
+		return
+	}
+	if err != nil {
+		h.LogError(r, err)
+	}
+	h.writeReport(w, r, imports.FromImport(imported))
+}
+
+// parseAndValidateRequest reads, parses, and validates the incoming query parameters from the request URL.
+// Returns a validated request DTO or an error if the validation fails.
+func (h *ImportVacancyHandler) parseAndValidateRequest(
+	w http.ResponseWriter,
+	r *http.Request,
+) (*imports.Request, error) {
+	q := r.URL.Query()
+	dryRun, err := strconv.ParseBool(h.GetQueryString(q, "dry_run", "false"))
+	v := validators.NewRequestValidator()
+	defer v.Release()
+	v.Check(err == nil, "dry_run", "dry_run must be a boolean")
+	rq := &imports.Request{
+		Format:  h.GetQueryString(q, "format", vacancy.ImportFormatCSV),
+		Mapping: h.GetQueryString(q, "mapping", ""),
+		DryRun:  dryRun,
+	}
+
+	// Validate
+	if !v.ValidateImport(rq) {
+		h.FailedQueryValidationResponse(w, r, v.Errors)
+		return nil, fmt.Errorf("validation failed")
+	}
+	return rq, nil
+}
+
+// formFile returns the part of the multipart form of the request holding the file, skipping the parts before it.
+func (h *ImportVacancyHandler) formFile(r *http.Request) (*multipart.Part, error) {
+	form, err := r.MultipartReader()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		part, err := form.NextPart()
+		if errors.Is(err, io.EOF) {
+			return nil, errMissingFile
+		}
+		if err != nil {
+			return nil, fileError{err: err}
+		}
+		if part.FormName() == importFormField {
+			return part, nil
+		}
+	}
+}
+
+// errMissingFile is returned when the multipart form has no file.
+var errMissingFile = errors.New(importFormField + " must be provided")
+
+// fileError is an error reading the uploaded form or file, e.g., a malformed form or an interrupted upload.
+type fileError struct {
+	err error
+}
+
+// Error returns the message of the underlying error.
+func (e fileError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e fileError) Unwrap() error {
+	return e.err
+}
+
+// uploadErrorResponse answers an upload whose file cannot be read or imported with the matching error.
+func (h *ImportVacancyHandler) uploadErrorResponse(w http.ResponseWriter, r *http.Request, err error) {
+	var maxBytesError *http.MaxBytesError
+	switch {
+	case errors.As(err, &maxBytesError):
+		h.ErrorResponse(w, r, http.StatusRequestEntityTooLarge,
+			fmt.Sprintf("body must not be larger than %d bytes", maxBytesError.Limit))
+	case errors.Is(err, errMissingFile):
+		h.FailedValidationResponse(w, r, map[string]string{importFormField: err.Error()})
+	case errors.Is(err, http.ErrNotMultipart), errors.Is(err, http.ErrMissingBoundary):
+		h.ErrorResponse(w, r, http.StatusBadRequest, "body must be a multipart/form-data form")
+	case errors.As(err, &fileError{}) && r.Context().Err() == nil:
+		h.ErrorResponse(w, r, http.StatusBadRequest, "file cannot be read: "+err.Error())
+	case errors.Is(err, vacancy.ErrImportUnreadable) && r.Context().Err() == nil:
+		h.ErrorResponse(w, r, http.StatusBadRequest, err.Error())
+	default:
+		h.ServerErrorResponse(w, r, err)
+	}
+}
+
+// writeReport sends the report in the media type negotiated with the client or, to clients preferring text/csv, the
+// errors of the rows as a CSV download.
+func (h *ImportVacancyHandler) writeReport(w http.ResponseWriter, r *http.Request, report *imports.Report) {
+	if !h.Prefers(r, codec.CSV{}) {
+		if err := h.Write(w, r, report.Status(), report, nil); err != nil {
+			h.WriteErrorResponse(w, r, err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", imports.MediaTypeCSV+"; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="import-report.csv"`)
+	w.WriteHeader(report.Status())
+	if err := report.WriteCSV(w); err != nil {
+		h.LogError(r, err)
+	}
+}
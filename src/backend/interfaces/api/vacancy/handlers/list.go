@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"interfaces/api/utils"
 	"interfaces/api/vacancy/dto"
+	"interfaces/api/vacancy/dto/jobposting"
 	"interfaces/api/vacancy/dto/list"
 	"interfaces/api/vacancy/validators"
 	"net/http"
@@ -13,10 +14,9 @@ import (
 
 // ListVacancyHandler handles the HTTP requests for listing job vacancies.
 type ListVacancyHandler struct {
-	*utils.Handler               // HTTP handler utility.
-	*utils.Errors                // Error handler for standardized error responses.
-	*vacancy.Service             // Vacancy service for business logic.
-	*validators.RequestValidator // Vacancy request validator.
+	*utils.Handler   // HTTP handler utility.
+	*utils.Errors    // Error handler for standardized error responses.
+	*vacancy.Service // Vacancy service for business logic.
 }
 
 // NewListVacancyHandler creates and returns a new instance of ListVacancyHandler.
@@ -24,17 +24,16 @@ func NewListVacancyHandler(
 	handler *utils.Handler,
 	errors *utils.Errors,
 	service *vacancy.Service,
-	validator *validators.RequestValidator,
 ) *ListVacancyHandler {
 	return &ListVacancyHandler{
-		Handler:          handler,
-		Errors:           errors,
-		Service:          service,
-		RequestValidator: validator,
+		Handler: handler,
+		Errors:  errors,
+		Service: service,
 	}
 }
 
-// Execute processes the HTTP request to list job vacancies.
+// Execute processes the HTTP request to list job vacancies, limited to the requested fields, if any, or as a
+// schema.org ItemList of complete JobPostings in JSON-LD to clients accepting application/ld+json.
 func (h *ListVacancyHandler) Execute(w http.ResponseWriter, r *http.Request) {
 	// Parse and validate the request
 	rq, err := h.parseAndValidateRequest(w, r)
@@ -43,16 +42,25 @@ func (h *ListVacancyHandler) Execute(w http.ResponseWriter, r *http.Request) {
 	}
 	defer rq.Release()
 
-	// Fetch filtered vacancies
+	jsonLd := h.Prefers(r, jobposting.Codec{})
+	if jsonLd {
+		rq.Fields = nil
+	}
+
+	// Fetch filtered vacancies, reading the requested fields only
 	items, err := h.Service.ListFilteredVacancies(r.Context(), *rq.Title, *rq.Company, *rq.Filters.Page,
-		*rq.Filters.PageSize, *rq.Filters.SortField, *rq.Filters.SortOrder)
+		*rq.Filters.PageSize, *rq.Filters.SortField, *rq.Filters.SortOrder, rq.Fields...)
 	if err != nil {
 		h.NotFoundResponse(w, r)
 		return
 	}
 
 	// Send success response
-	h.sendSuccessResponse(w, r, items)
+	if jsonLd {
+		h.sendJobPostings(w, r, items)
+		return
+	}
+	h.sendSuccessResponse(w, r, items, rq.Fields)
 }
 
 // parseAndValidateRequest reads, parses, and validates the incoming query parameters from the request URL.
@@ -68,11 +76,14 @@ func (h *ListVacancyHandler) parseAndValidateRequest(w http.ResponseWriter, r *h
 	sortOrder := h.GetQueryString(q, "sort_order", "desc")
 	page := h.GetQueryInt(q, "page", 1)
 	pageSize := h.GetQueryInt(q, "page_size", 10)
+	fields := h.GetQueryList(q, "fields")
 
-	// Validate
-	if !h.RequestValidator.ValidateFilters(page, pageSize, sortField) {
-		h.FailedValidationResponse(w, r, h.RequestValidator.Errors)
-		h.RequestValidator.ClearErrors()
+	// Validate, reporting the errors of the filters and of the fields together
+	v := validators.NewRequestValidator()
+	defer v.Release()
+	v.ValidateFilters(page, pageSize, sortField)
+	if !v.ValidateFields(fields) {
+		h.FailedQueryValidationResponse(w, r, v.Errors)
 		return nil, fmt.Errorf("validation failed")
 	}
 
@@ -83,17 +94,45 @@ func (h *ListVacancyHandler) parseAndValidateRequest(w http.ResponseWriter, r *h
 	request.Filters.PageSize = &pageSize
 	request.Filters.SortField = &sortField
 	request.Filters.SortOrder = &sortOrder
+	request.Fields = fields
 
 	return request, nil
 }
 
-// sendSuccessResponse sends a success response with the list of vacancies.
-func (h *ListVacancyHandler) sendSuccessResponse(w http.ResponseWriter, r *http.Request, data []*entity.Vacancy) {
+// sendJobPostings sends the vacancies as a schema.org ItemList of JobPostings, leaving out the vacancies lacking
+// properties job aggregators require.
+func (h *ListVacancyHandler) sendJobPostings(w http.ResponseWriter, r *http.Request, data []*entity.Vacancy) {
+	v := validators.NewRequestValidator()
+	defer v.Release()
+
+	postings := make([]*jobposting.JobPosting, 0, len(data))
+	for _, e := range data {
+		posting := jobposting.FromEntity(e)
+		if !v.ValidateJobPosting(posting) {
+			v.ClearErrors()
+			continue
+		}
+		postings = append(postings, posting)
+	}
+
+	headers := http.Header{"Content-Type": {jobposting.MediaType}, "Vary": {"Accept"}}
+	if err := h.WriteJson(w, http.StatusOK, jobposting.NewItemList(postings), headers); err != nil {
+		h.ServerErrorResponse(w, r, err)
+	}
+}
+
+// sendSuccessResponse sends a success response with the list of vacancies, limited to the given fields, if any.
+func (h *ListVacancyHandler) sendSuccessResponse(
+	w http.ResponseWriter,
+	r *http.Request,
+	data []*entity.Vacancy,
+	fields []string,
+) {
 	response := dto.GetResponse()
 	defer response.Release()
 
-	items := response.ToList(data)
-	if err := h.WriteJson(w, http.StatusOK, items, nil); err != nil {
-		h.ServerErrorResponse(w, r, err)
+	items := response.ToList(data, fields...)
+	if err := h.Write(w, r, http.StatusOK, items, nil); err != nil {
+		h.WriteErrorResponse(w, r, err)
 	}
 }
@@ -0,0 +1,175 @@
+package handlers
+
+import (
+	"application/vacancy"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"interfaces/api/utils"
+	"interfaces/api/vacancy/dto"
+	"net/http"
+	"time"
+)
+
+const (
+	// streamRetry is the delay, in milliseconds, clients wait before reconnecting to an interrupted stream.
+	streamRetry = 3000
+
+	// streamWriteTimeout bounds the time to send each event of a stream to the client. It replaces the write
+	// timeout of the server, which would otherwise close the stream.
+	streamWriteTimeout = 30 * time.Second
+)
+
+// StreamVacancyHandler handles the HTTP requests for the Server-Sent Events stream of the changes of job vacancies.
+type StreamVacancyHandler struct {
+	*utils.Handler                       // HTTP handler utility.
+	*utils.Errors                        // Error handler for standardized error responses.
+	stream         *vacancy.ChangeStream // Stream of the changes of vacancies.
+	heartbeat      time.Duration         // Interval of the heartbeats of idle streams.
+}
+
+// NewStreamVacancyHandler creates and returns a new instance of StreamVacancyHandler sending the changes of the
+// stream, with a heartbeat at the given interval.
+func NewStreamVacancyHandler(
+	handler *utils.Handler,
+	errors *utils.Errors,
+	stream *vacancy.ChangeStream,
+	heartbeat time.Duration,
+) *StreamVacancyHandler {
+	return &StreamVacancyHandler{
+		Handler:   handler,
+		Errors:    errors,
+		stream:    stream,
+		heartbeat: heartbeat,
+	}
+}
+
+// Execute processes the HTTP request for the stream of vacancy changes. The created, updated and deleted events of
+// the vacancies matching the title and company filters are sent until the client disconnects, after the changes it
+// missed since the change of the Last-Event-ID header, or a reset event if they are no longer known.
+func (h *StreamVacancyHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	title, company := h.GetQueryString(q, "title", ""), h.GetQueryString(q, "company", "")
+
+	f, err := h.stream.Follow(r.Header.Get("Last-Event-ID"))
+	if err != nil {
+		h.ErrorResponse(w, r, http.StatusServiceUnavailable, "the event stream is shutting down")
+		return
+	}
+	defer f.Stop()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no") // Disable the buffering of reverse proxies, e.g., nginx
+	w.WriteHeader(http.StatusOK)
+
+	out := &eventWriter{w: w, rc: http.NewResponseController(w), title: title, company: company}
+	if err = h.send(r, out, f); err != nil && r.Context().Err() == nil {
+		h.LogError(r, err)
+	}
+}
+
+// send writes the replayed changes, then the changes of the follower as they come, with heartbeats in between,
+// until the client disconnects or the follower stops.
+func (h *StreamVacancyHandler) send(r *http.Request, out *eventWriter, f *vacancy.Follower) error {
+	if err := h.replay(out, f); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(h.heartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return nil
+		case c, ok := <-f.Changes():
+			if !ok {
+				return nil
+			}
+			if err := out.change(c); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			out.buf.WriteString(": heartbeat\n")
+		}
+		if err := out.flush(); err != nil {
+			return err
+		}
+	}
+}
+
+// replay writes the reconnection delay and the reset event or the replayed changes of the follower.
+func (h *StreamVacancyHandler) replay(out *eventWriter, f *vacancy.Follower) error {
+	fmt.Fprintf(&out.buf, "retry: %d\n\n", streamRetry)
+	out.skipped = f.LastId // Resume from the latest change even if none of the replay is sent
+	if f.Reset {
+		out.event(f.LastId, "reset", []byte("{}"))
+	}
+	for _, c := range f.Replay {
+		if err := out.change(c); err != nil {
+			return err
+		}
+	}
+	return out.flush()
+}
+
+// eventWriter buffers Server-Sent Events and sends them to the response, extending the write deadline before every
+// write.
+type eventWriter struct {
+	w              http.ResponseWriter
+	rc             *http.ResponseController
+	buf            bytes.Buffer
+	title, company string // Filters of the vacancies of the changes to send.
+	skipped        string // ID of the latest change not sent, sent as the last event ID with the next write.
+}
+
+// change buffers the change as an event named by its type, with the vacancy as data, or only its ID if deleted.
+// Changes of vacancies not matching the filters are skipped.
+func (e *eventWriter) change(c *vacancy.Change) error {
+	if !c.Matches(e.title, e.company) {
+		e.skipped = c.Id
+		return nil
+	}
+
+	response := dto.GetResponse()
+	defer response.Release()
+	if c.Vacancy != nil {
+		response.FromEntity(c.Vacancy)
+	} else {
+		response.ID = &c.VacancyId
+	}
+	data, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+	e.event(c.Id, c.Type, data)
+	return nil
+}
+
+// event buffers an event with the ID, name and data.
+func (e *eventWriter) event(id, name string, data []byte) {
+	fmt.Fprintf(&e.buf, "id: %s\nevent: %s\ndata: %s\n\n", id, name, data)
+	e.skipped = ""
+}
+
+// flush sends the buffered events, followed by the ID of the latest skipped change, so clients resume after it.
+func (e *eventWriter) flush() error {
+	if e.skipped != "" {
+		fmt.Fprintf(&e.buf, "id: %s\n\n", e.skipped)
+		e.skipped = ""
+	}
+	if e.buf.Len() == 0 {
+		return nil
+	}
+
+	err := e.rc.SetWriteDeadline(time.Now().Add(streamWriteTimeout))
+	if err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	if _, err = e.w.Write(e.buf.Bytes()); err != nil {
+		return err
+	}
+	e.buf.Reset()
+	return e.rc.Flush()
+}
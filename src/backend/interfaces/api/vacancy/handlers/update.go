@@ -1,21 +1,28 @@
 package handlers
 
 import (
+	"application/organization"
 	"application/vacancy"
 	"domain/vacancy/entity"
+	"domain/vacancy/repository"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"interfaces/api/utils"
+	"interfaces/api/utils/patch"
 	"interfaces/api/vacancy/dto"
 	"interfaces/api/vacancy/validators"
+	"mime"
 	"net/http"
+	"strconv"
+	"strings"
 )
 
-// UpdateVacancyHandler handles HTTP requests for updating an existing vacancy by its ID.
+// UpdateVacancyHandler handles HTTP requests for updating or replacing an existing vacancy by its ID.
 type UpdateVacancyHandler struct {
-	*utils.Handler               // HTTP handler utility.
-	*utils.Errors                // Error handler for standardized error responses.
-	*vacancy.Service             // Vacancy service for business logic.
-	*validators.RequestValidator // Vacancy request validator.
+	*utils.Handler   // HTTP handler utility.
+	*utils.Errors    // Error handler for standardized error responses.
+	*vacancy.Service // Vacancy service for business logic.
 }
 
 // NewUpdateVacancyHandler creates and returns a new instance of UpdateVacancyHandler.
@@ -23,18 +30,70 @@ func NewUpdateVacancyHandler(
 	handler *utils.Handler,
 	errors *utils.Errors,
 	service *vacancy.Service,
-	validator *validators.RequestValidator,
 ) *UpdateVacancyHandler {
 	return &UpdateVacancyHandler{
-		Handler:          handler,
-		Errors:           errors,
-		Service:          service,
-		RequestValidator: validator,
+		Handler: handler,
+		Errors:  errors,
+		Service: service,
 	}
 }
 
-// Execute processes the HTTP request to update an existing vacancy.
+// patchContentTypes are the content types of the bodies of the PATCH requests, announced in the Accept-Patch
+// header.
+var patchContentTypes = []string{"application/json", patch.MergePatchContentType, patch.JsonPatchContentType}
+
+// Execute processes the HTTP request to update an existing vacancy. By its content type, the request body holds
+// the fields to update (application/json), a JSON Merge Patch (RFC 7396) or a JSON Patch (RFC 6902) of the JSON
+// representation of the vacancy.
+// Patches must carry the version they apply to, see requireVersion. Updates of the given fields only succeed if
+// the version they carry, if any, matches the stored version; without one, the last writer wins.
 func (h *UpdateVacancyHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch mediaType {
+	case "", "application/json":
+		h.update(w, r)
+	case patch.MergePatchContentType:
+		h.patch(w, r, patch.Merge)
+	case patch.JsonPatchContentType:
+		h.patch(w, r, patch.Apply)
+	default:
+		w.Header().Set("Accept-Patch", strings.Join(patchContentTypes, ", "))
+		h.UnsupportedMediaTypeResponse(w, r, patchContentTypes...)
+	}
+}
+
+// Replace processes the HTTP request to replace an existing vacancy with the one of the request body, which must
+// hold every field.
+// The request must carry the version it replaces, see requireVersion.
+func (h *UpdateVacancyHandler) Replace(w http.ResponseWriter, r *http.Request) {
+	id, err := h.ExtractId(r)
+	if err != nil {
+		h.NotFoundResponse(w, r)
+		return
+	}
+
+	request := dto.GetRequest()
+	defer request.Release()
+	if err = h.ReadJson(w, r, &request); err != nil {
+		h.ErrorResponse(w, r, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+	if !h.requireVersion(w, r, request) {
+		return
+	}
+
+	// Retrieve vacancy by ID
+	e, err := h.Service.GetVacancy(r.Context(), id)
+	if err != nil {
+		h.NotFoundResponse(w, r)
+		return
+	}
+	defer e.Release()
+	h.replace(w, r, e, request)
+}
+
+// update updates the fields of an existing vacancy given in the request body.
+func (h *UpdateVacancyHandler) update(w http.ResponseWriter, r *http.Request) {
 	// Parse and validate request
 	request, err := h.parseAndValidateRequest(w, r)
 	if err != nil {
@@ -48,13 +107,89 @@ func (h *UpdateVacancyHandler) Execute(w http.ResponseWriter, r *http.Request) {
 		h.NotFoundResponse(w, r)
 		return
 	}
+	defer e.Release()
 	request.ToEntity(e)
 
-	// Save vacancy
-	if err = h.Service.UpdateVacancy(r.Context(), e); err != nil {
+	h.save(w, r, e)
+}
+
+// patch updates an existing vacancy with the patch of the request body, applied to the JSON representation of the
+// vacancy by the given function. The patched representation replaces the vacancy.
+func (h *UpdateVacancyHandler) patch(
+	w http.ResponseWriter,
+	r *http.Request,
+	apply func(doc, p []byte) ([]byte, error),
+) {
+	id, err := h.ExtractId(r)
+	if err != nil {
+		h.NotFoundResponse(w, r)
+		return
+	}
+	body, err := h.ReadBody(w, r)
+	if err != nil {
+		h.ErrorResponse(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Retrieve vacancy by ID
+	e, err := h.Service.GetVacancy(r.Context(), id)
+	if err != nil {
+		h.NotFoundResponse(w, r)
+		return
+	}
+	defer e.Release()
+
+	// Apply the patch to the representation of the vacancy, without its version, which the client must give
+	response := dto.GetResponse().FromEntity(e)
+	response.Version = nil
+	doc, err := json.Marshal(response)
+	response.Release()
+	if err != nil {
 		h.ServerErrorResponse(w, r, err)
 		return
 	}
+	patched, err := apply(doc, body)
+	if err != nil {
+		h.handlePatchError(w, r, err)
+		return
+	}
+
+	request := dto.GetRequest()
+	defer request.Release()
+	if err = h.UnmarshalJson(patched, request); err != nil {
+		h.ErrorResponse(w, r, http.StatusUnprocessableEntity, "the patched vacancy is invalid: "+err.Error())
+		return
+	}
+	if !h.requireVersion(w, r, request) {
+		return
+	}
+	h.replace(w, r, e, request)
+}
+
+// replace validates the request holding every field of the vacancy and replaces the stored vacancy with it.
+func (h *UpdateVacancyHandler) replace(
+	w http.ResponseWriter,
+	r *http.Request,
+	e *entity.Vacancy,
+	request *dto.Request,
+) {
+	v := validators.NewRequestValidator()
+	defer v.Release()
+	if !v.ValidateForReplace(request, e.GetId(), e.GetOrganizationId()) {
+		h.FailedValidationResponse(w, r, v.Errors)
+		return
+	}
+	request.ToEntity(e)
+
+	h.save(w, r, e)
+}
+
+// save saves the updated vacancy and sends it.
+func (h *UpdateVacancyHandler) save(w http.ResponseWriter, r *http.Request, e *entity.Vacancy) {
+	if err := h.Service.UpdateVacancy(r.Context(), e); err != nil {
+		h.handleUpdateError(w, r, err)
+		return
+	}
 
 	// Send success response
 	h.sendSuccessResponse(w, r, e)
@@ -71,29 +206,94 @@ func (h *UpdateVacancyHandler) parseAndValidateRequest(w http.ResponseWriter, r
 
 	request := dto.GetRequest()
 	if err = h.ReadJson(w, r, &request); err != nil {
-		h.FailedValidationResponse(w, r, map[string]string{"error": err.Error()})
-		h.RequestValidator.ClearErrors()
+		h.ErrorResponse(w, r, http.StatusUnprocessableEntity, err.Error())
+		return nil, err
+	}
+	if err = matchVersion(r, request); err != nil {
+		h.ErrorResponse(w, r, http.StatusBadRequest, err.Error())
+		request.Release()
 		return nil, err
 	}
 
 	// Assign the extracted ID to the request
 	request.ID = &id
-	if !h.RequestValidator.ValidateForUpdate(request) {
-		h.FailedValidationResponse(w, r, h.RequestValidator.Errors)
-		h.RequestValidator.ClearErrors()
+	v := validators.NewRequestValidator()
+	defer v.Release()
+	if !v.ValidateForUpdate(request) {
+		h.FailedValidationResponse(w, r, v.Errors)
 		return nil, fmt.Errorf("validation failed")
 	}
 
 	return request, nil
 }
 
+// requireVersion sets the version of the request to the one the client expects to replace, given by the If-Match
+// header, e.g., If-Match: "3", or by the version member of the request, so the replacement fails with a conflict
+// if the vacancy was changed since the client read it. Sends 428 Precondition Required if neither is given, or 400
+// Bad Request if the header is malformed, and returns false.
+func (h *UpdateVacancyHandler) requireVersion(w http.ResponseWriter, r *http.Request, request *dto.Request) bool {
+	if err := matchVersion(r, request); err != nil {
+		h.ErrorResponse(w, r, http.StatusBadRequest, err.Error())
+		return false
+	}
+	if request.Version == nil {
+		h.ErrorResponse(w, r, http.StatusPreconditionRequired,
+			"the version of the vacancy must be given in the If-Match header or the version member")
+		return false
+	}
+	return true
+}
+
+// matchVersion sets the version of the request to the entity tag of the If-Match header, if any, which takes
+// precedence over the version member.
+func matchVersion(r *http.Request, request *dto.Request) error {
+	tag := r.Header.Get("If-Match")
+	if tag == "" {
+		return nil
+	}
+	version, err := strconv.ParseInt(strings.Trim(tag, `"`), 10, 32)
+	if err != nil {
+		return errors.New(`the If-Match header must hold the version of the vacancy, e.g., "3"`)
+	}
+	v := int32(version)
+	request.Version = &v
+	return nil
+}
+
+// handlePatchError maps the errors of applying a patch to HTTP responses: a malformed patch is a bad request, a
+// failed test operation a conflict with the current state of the vacancy.
+func (h *UpdateVacancyHandler) handlePatchError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, patch.ErrMalformed):
+		h.ErrorResponse(w, r, http.StatusBadRequest, err.Error())
+	case errors.Is(err, patch.ErrTestFailed):
+		h.ErrorResponse(w, r, http.StatusConflict, err.Error())
+	case errors.Is(err, patch.ErrInapplicable):
+		h.ErrorResponse(w, r, http.StatusUnprocessableEntity, err.Error())
+	default:
+		h.ServerErrorResponse(w, r, err)
+	}
+}
+
+// handleUpdateError maps the errors returned by the vacancy service to HTTP responses.
+func (h *UpdateVacancyHandler) handleUpdateError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, organization.ErrNotPermitted):
+		h.NotPermittedResponse(w, r)
+	case errors.Is(err, repository.ErrEditConflict):
+		h.EditConflictResponse(w, r)
+	default:
+		h.ServerErrorResponse(w, r, err)
+	}
+}
+
 // sendSuccessResponse sends a success response with the updated vacancy data.
 func (h *UpdateVacancyHandler) sendSuccessResponse(w http.ResponseWriter, r *http.Request, e *entity.Vacancy) {
 	response := dto.GetResponse().FromEntity(e)
 	defer response.Release()
 
 	w.Header().Add("Location", fmt.Sprintf("/v1/vacancies/%d", e.GetId()))
-	if err := h.WriteJson(w, http.StatusOK, response, nil); err != nil {
-		h.ServerErrorResponse(w, r, err)
+	if err := h.Write(w, r, http.StatusOK, response, nil); err != nil {
+		h.WriteErrorResponse(w, r, err)
 	}
 }
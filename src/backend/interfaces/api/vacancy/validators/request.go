@@ -1,10 +1,16 @@
 package validators
 
 import (
+	"application/vacancy"
+	"domain/vacancy/entity"
+	"fmt"
 	"interfaces/api/utils/validators"
 	"interfaces/api/vacancy/dto"
+	"interfaces/api/vacancy/dto/batch"
+	"interfaces/api/vacancy/dto/export"
+	"interfaces/api/vacancy/dto/imports"
+	"interfaces/api/vacancy/dto/jobposting"
 	"strings"
-	"time"
 )
 
 // RequestValidator is responsible for validating Vacancy request DTOs.
@@ -33,17 +39,100 @@ func (v *RequestValidator) ValidateForUpdate(r *dto.Request) bool {
 	return v.performValidation(r, false)
 }
 
+// ValidateForReplace performs validation on the provided vacancy request DTO replacing the whole vacancy with the
+// given ID and owning organization, 0 if none. Every field must be provided, as on creation, and the ID and the
+// owning organization cannot be changed.
+func (v *RequestValidator) ValidateForReplace(r *dto.Request, id, organizationId int64) bool {
+	if r.ID != nil {
+		v.Check(*r.ID == id, "id", "id cannot be changed")
+	}
+	var replacing int64
+	if r.OrganizationId != nil {
+		replacing = *r.OrganizationId
+	}
+	v.Check(replacing == organizationId, "organization_id", "organization_id cannot be changed")
+
+	v.validateField(r.Title, "title", true)
+	v.validateField(r.Company, "company", true)
+	v.validateField(r.Description, "description", true)
+	v.validateField(r.Location, "location", true)
+	v.validatePostedAt(r.PostedAt, true)
+	v.validateVersion(r.Version)
+
+	return v.Valid()
+}
+
+// ValidateBatchUpdate performs validation on an item of a batch update, which must also identify the vacancy.
+func (v *RequestValidator) ValidateBatchUpdate(r *dto.Request) bool {
+	v.Check(r.ID != nil && *r.ID > 0, "id", "id must be greater than zero")
+	return v.performValidation(r, false)
+}
+
+// ValidateBatch validates the mode of a batch and its number of items, held by the given member of the request.
+func (v *RequestValidator) ValidateBatch(mode *string, member string, n, maxItems int) bool {
+	v.Check(batch.ValidMode(mode), "mode", fmt.Sprintf("mode must be %q or %q", batch.ModeAtomic,
+		batch.ModeBestEffort))
+	v.Check(n > 0, member, member+" must not be empty")
+	v.Check(n <= maxItems, member, fmt.Sprintf("%s must hold at most %d items", member, maxItems))
+	return v.Valid()
+}
+
 // ValidateFilters validates the pagination and sort filter fields.
 func (v *RequestValidator) ValidateFilters(page, size int, sort string) bool {
 	v.Check(page > 0, "page", "page must be greater than zero")
 	v.Check(page <= 1_000, "page", "page must be less than or equal to 1_000")
 
-	v.Check(size > 0, "size", "size must be greater than zero")
-	v.Check(size <= 750, "size", "size must be less than or equal to 750")
+	v.Check(size > 0, "page_size", "page_size must be greater than zero")
+	v.Check(size <= 750, "page_size", "page_size must be less than or equal to 750")
 
 	if sort != "" {
-		v.Check(v.PermittedValue(sort, v.list...), "sort", "sort contains an invalid value")
+		v.Check(v.PermittedValue(sort, v.list...), "sort_field", "sort_field contains an invalid value")
+	}
+	return v.Valid()
+}
+
+// ValidateFields validates the fields a get or a list is limited to, which must be distinct fields of a vacancy.
+func (v *RequestValidator) ValidateFields(fields []string) bool {
+	v.Check(vacancy.ValidExportColumns(fields), "fields",
+		fmt.Sprintf("fields must list distinct fields among %s", strings.Join(vacancy.ExportColumns(), ", ")))
+	return v.Valid()
+}
+
+// ValidateExport validates the format, columns and sorting of an export.
+func (v *RequestValidator) ValidateExport(r *export.Request) bool {
+	v.Check(v.PermittedValue(r.Format, export.Formats()...), "format",
+		fmt.Sprintf("format must be one of %s", strings.Join(export.Formats(), ", ")))
+	v.Check(len(r.Columns) > 0 && vacancy.ValidExportColumns(r.Columns), "columns",
+		fmt.Sprintf("columns must list distinct columns among %s", strings.Join(vacancy.ExportColumns(), ", ")))
+	v.Check(v.PermittedValue(r.SortField, vacancy.ExportSortFields()...), "sort_field",
+		"sort_field contains an invalid value")
+	v.Check(v.PermittedValue(r.SortOrder, export.OrderAsc, export.OrderDesc), "sort_order",
+		fmt.Sprintf("sort_order must be %q or %q", export.OrderAsc, export.OrderDesc))
+	return v.Valid()
+}
+
+// ValidateImport validates the format and the column mapping of an import.
+func (v *RequestValidator) ValidateImport(r *imports.Request) bool {
+	v.Check(v.PermittedValue(r.Format, vacancy.ImportFormats()...), "format",
+		fmt.Sprintf("format must be one of %s", strings.Join(vacancy.ImportFormats(), ", ")))
+	if _, err := vacancy.ParseImportMapping(r.Mapping); err != nil {
+		v.AddError("mapping", "mapping is invalid: "+err.Error())
+	}
+	return v.Valid()
+}
+
+// ValidateJobPosting validates that the posting holds the properties job aggregators require of a schema.org
+// JobPosting, which the vacancies created without a location lack.
+func (v *RequestValidator) ValidateJobPosting(p *jobposting.JobPosting) bool {
+	required := func(value, property, field string) {
+		v.Check(strings.TrimSpace(value) != "", property,
+			fmt.Sprintf("%s must be provided: the vacancy has no %s", property, field))
 	}
+	required(p.Title, "title", "title")
+	required(p.Description, "description", "description")
+	required(p.DatePosted, "datePosted", "posted_at")
+	required(p.HiringOrganization.Name, "hiringOrganization", "company")
+	required(p.JobLocation.Address.AddressLocality, "jobLocation", "location")
 	return v.Valid()
 }
 
@@ -54,6 +143,8 @@ func (v *RequestValidator) performValidation(r *dto.Request, checkRequired bool)
 	v.validateField(r.Description, "description", checkRequired)
 	v.validateField(r.Location, "location", checkRequired)
 	v.validatePostedAt(r.PostedAt, checkRequired)
+	v.validateOrganizationId(r.OrganizationId, checkRequired)
+	v.validateVersion(r.Version)
 
 	return v.Valid()
 }
@@ -61,23 +152,49 @@ func (v *RequestValidator) performValidation(r *dto.Request, checkRequired bool)
 // validateField checks if a field is provided and non-empty based on the given flag.
 func (v *RequestValidator) validateField(field *string, fieldName string, checkRequired bool) {
 	if checkRequired || field != nil {
-		if field == nil || strings.TrimSpace(*field) == "" {
-			v.AddError(fieldName, fieldName+" must be provided and cannot be empty or whitespace")
-		}
+		v.checkErr(entity.ValidateText(fieldName, deref(field)), fieldName)
+	}
+}
+
+// validateOrganizationId checks that the owning organization is a positive ID and is only set on creation,
+// since the ownership of an existing vacancy cannot be transferred.
+func (v *RequestValidator) validateOrganizationId(organizationId *int64, isCreate bool) {
+	if organizationId == nil {
+		return
+	}
+	if !isCreate {
+		v.AddError("organization_id", "organization_id cannot be changed")
+		return
+	}
+	v.checkErr(entity.ValidateOrganizationId(*organizationId), "organization_id")
+}
+
+// validateVersion checks that the version the client expects to update, if given, is positive.
+func (v *RequestValidator) validateVersion(version *int32) {
+	if version != nil {
+		v.Check(*version > 0, "version", "version must be a positive integer")
 	}
 }
 
 // validatePostedAt checks if the PostedAt field is valid.
 func (v *RequestValidator) validatePostedAt(postedAt *string, checkRequired bool) {
 	if checkRequired || postedAt != nil {
-		if postedAt == nil || strings.TrimSpace(*postedAt) == "" {
-			v.AddError("posted_at", "posted_at must be provided and cannot be empty or whitespace")
-			return
-		}
-
-		_, err := time.Parse(time.DateOnly, strings.TrimSpace(*postedAt))
-		if err != nil {
-			v.AddError("posted_at", "posted_at must be in the format YYYY-MM-DD. Example: 2006-01-02")
-		}
+		_, err := entity.ParsePostedAt(deref(postedAt))
+		v.checkErr(err, "posted_at")
+	}
+}
+
+// checkErr adds the error of the vacancy rule broken by the field, if any.
+func (v *RequestValidator) checkErr(err error, key string) {
+	if err != nil {
+		v.AddError(key, err.Error())
+	}
+}
+
+// deref returns the value of an optional string, empty if it is missing.
+func deref(s *string) string {
+	if s == nil {
+		return ""
 	}
+	return *s
 }
@@ -1,21 +1,48 @@
 package interfaces
 
 import (
+	"application/audit"
 	appAuth "application/auth"
 	"application/config"
 	"application/dependency"
+	"application/feature"
+	"application/idempotency"
+	"application/metrics"
+	"application/ratelimit"
+	"application/signing"
 	"interfaces/api/utils"
+	"interfaces/middleware"
 	"interfaces/middleware/auth"
+	"interfaces/openapi"
 )
 
 // Container provides a lazily initialized set of dependencies for the interfaces layer.
 type Container struct {
 	JwtAuthService    dependency.LazyDependency[*appAuth.Service]
 	JwtAuthMiddleware dependency.LazyDependency[*auth.JwtAuthMiddleware]
+	HmacMiddleware    dependency.LazyDependency[*auth.HmacAuthMiddleware]
+	AuditMiddleware   dependency.LazyDependency[*middleware.AuditMiddleware]
+	AccessLog         dependency.LazyDependency[*middleware.AccessLogMiddleware]
+	Recovery          dependency.LazyDependency[*middleware.RecoveryMiddleware]
+	Metrics           dependency.LazyDependency[*middleware.MetricsMiddleware]
+	RateLimit         dependency.LazyDependency[*middleware.RateLimitMiddleware]
+	Idempotency       dependency.LazyDependency[*middleware.IdempotencyMiddleware]
+	Features          dependency.LazyDependency[*middleware.FeatureMiddleware]
+	OpenApi           dependency.LazyDependency[*openapi.Spec]
+	Validation        dependency.LazyDependency[*middleware.OpenApiMiddleware]
 }
 
 // NewContainer initializes and returns a new Container with lazy dependencies for the interfaces layer.
-func NewContainer(cfg *config.Configuration, e *utils.Errors) *Container {
+func NewContainer(
+	cfg *config.Configuration,
+	v *signing.Verifier,
+	a *audit.Service,
+	m *metrics.Registry,
+	l *ratelimit.Limiter,
+	i *idempotency.Service,
+	f *feature.Flags,
+	e *utils.Errors,
+) *Container {
 	c := &Container{
 		JwtAuthService: dependency.LazyDependency[*appAuth.Service]{
 			InitFunc: func() *appAuth.Service { return appAuth.NewService(cfg) },
@@ -23,7 +50,64 @@ func NewContainer(cfg *config.Configuration, e *utils.Errors) *Container {
 	}
 	c.JwtAuthMiddleware = dependency.LazyDependency[*auth.JwtAuthMiddleware]{
 		InitFunc: func() *auth.JwtAuthMiddleware {
-			return auth.NewJwtAuthMiddleware(c.JwtAuthService.Get(), e)
+			return auth.NewJwtAuthMiddleware(c.JwtAuthService.Get(), a, e)
+		},
+	}
+	c.HmacMiddleware = dependency.LazyDependency[*auth.HmacAuthMiddleware]{
+		InitFunc: func() *auth.HmacAuthMiddleware {
+			return auth.NewHmacAuthMiddleware(v, e)
+		},
+	}
+	c.AuditMiddleware = dependency.LazyDependency[*middleware.AuditMiddleware]{
+		InitFunc: func() *middleware.AuditMiddleware {
+			return middleware.NewAuditMiddleware(a)
+		},
+	}
+	c.AccessLog = dependency.LazyDependency[*middleware.AccessLogMiddleware]{
+		InitFunc: func() *middleware.AccessLogMiddleware {
+			return middleware.NewAccessLogMiddleware(e.Logger)
+		},
+	}
+	c.Recovery = dependency.LazyDependency[*middleware.RecoveryMiddleware]{
+		InitFunc: func() *middleware.RecoveryMiddleware {
+			return middleware.NewRecoveryMiddleware(e)
+		},
+	}
+	c.Metrics = dependency.LazyDependency[*middleware.MetricsMiddleware]{
+		InitFunc: func() *middleware.MetricsMiddleware {
+			return middleware.NewMetricsMiddleware(m)
+		},
+	}
+	c.RateLimit = dependency.LazyDependency[*middleware.RateLimitMiddleware]{
+		InitFunc: func() *middleware.RateLimitMiddleware {
+			return middleware.NewRateLimitMiddleware(l, e)
+		},
+	}
+	c.Idempotency = dependency.LazyDependency[*middleware.IdempotencyMiddleware]{
+		InitFunc: func() *middleware.IdempotencyMiddleware {
+			return middleware.NewIdempotencyMiddleware(i, e)
+		},
+	}
+	c.Features = dependency.LazyDependency[*middleware.FeatureMiddleware]{
+		InitFunc: func() *middleware.FeatureMiddleware {
+			return middleware.NewFeatureMiddleware(f, e)
+		},
+	}
+	c.OpenApi = dependency.LazyDependency[*openapi.Spec]{
+		InitFunc: func() *openapi.Spec {
+			return openapi.NewSpec(openapi.Info{
+				Title:   "Job Vacancy API",
+				Version: "1.0.0",
+				Description: "REST API of Pulse Finder for browsing job vacancies and managing them, their " +
+					"organizations, roles, signing keys and audit log. Errors are RFC 9457 problem details. JSON " +
+					"responses are also available as MessagePack, protobuf and CSV, negotiated with the Accept " +
+					"header, and indented with the pretty query parameter.",
+			})
+		},
+	}
+	c.Validation = dependency.LazyDependency[*middleware.OpenApiMiddleware]{
+		InitFunc: func() *middleware.OpenApiMiddleware {
+			return middleware.NewOpenApiMiddleware(c.OpenApi.Get(), e)
 		},
 	}
 
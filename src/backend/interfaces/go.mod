@@ -2,4 +2,7 @@ module interfaces
 
 go 1.23.0
 
-require github.com/julienschmidt/httprouter v1.3.0
+require (
+	github.com/julienschmidt/httprouter v1.3.0
+	google.golang.org/protobuf v1.36.1
+)
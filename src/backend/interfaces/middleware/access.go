@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"application/auth"
+	"interfaces/api/utils"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+)
+
+// AccessLogMiddleware logs a structured entry for every handled request.
+type AccessLogMiddleware struct {
+	logger *slog.Logger // Logger writing the entries.
+}
+
+// NewAccessLogMiddleware creates a new instance of AccessLogMiddleware.
+func NewAccessLogMiddleware(l *slog.Logger) *AccessLogMiddleware {
+	return &AccessLogMiddleware{logger: l}
+}
+
+// Handle wraps the handler, logging the method, URI, status, response size, latency, client IP and authenticated
+// principal of every request. It must run after the RequestId middleware, so the entries carry the request ID.
+func (m *AccessLogMiddleware) Handle(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		ctx, principal := auth.TrackPrincipal(r.Context())
+		rec := newResponseRecorder(w)
+
+		next.ServeHTTP(rec, r.WithContext(ctx))
+
+		m.logger.LogAttrs(ctx, slog.LevelInfo, "Request handled",
+			slog.String("method", r.Method),
+			slog.String("uri", utils.LoggedURI(r)),
+			slog.Int("status", rec.status),
+			slog.Int("bytes", rec.bytes),
+			slog.Duration("latency", time.Since(start)),
+			slog.String("remote_ip", clientIp(r)),
+			slog.String("principal", principal()),
+		)
+	})
+}
+
+// clientIp returns the IP address of the client connected to the server. It is shared by the access log, the audit
+// log and the rate limits, so the entries and the buckets of a client agree on its address.
+func clientIp(r *http.Request) string {
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
+	}
+	return r.RemoteAddr
+}
@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"application/audit"
+	"application/ratelimit"
+	"application/signing"
+	auditEntity "domain/audit/entity"
+	"net/http"
+	"time"
+)
+
+// failurePolicy limits the authentication failures recorded for each client IP address, so a client flooding the
+// API with invalid credentials does not flood the audit log as well. Every failure is still in the access log.
+var failurePolicy = ratelimit.Policy{Name: "audit-auth-failure", Rate: 10.0 / 60, Burst: 10}
+
+// AuditMiddleware stores the source of every request in its context for the audit log and records requests
+// rejected as unauthenticated.
+type AuditMiddleware struct {
+	audit    *audit.Service     // Audit service recording authentication failures.
+	failures *ratelimit.Limiter // Limiter sampling the recorded authentication failures by client IP address.
+}
+
+// NewAuditMiddleware creates a new instance of AuditMiddleware.
+func NewAuditMiddleware(a *audit.Service) *AuditMiddleware {
+	return &AuditMiddleware{
+		audit:    a,
+		failures: ratelimit.NewLimiter(ratelimit.NewMemoryStore(time.Minute), &ratelimit.Policies{Default: failurePolicy}),
+	}
+}
+
+// Handle wraps the handler, which must include the authentication middlewares. It must run after the
+// RequestId middleware, so the records carry the request ID. Authentication failures beyond the failure policy of
+// their client IP address are not recorded.
+func (m *AuditMiddleware) Handle(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := audit.ContextWithSource(r.Context(), audit.Source{IP: clientIp(r)})
+		rec := newResponseRecorder(w)
+		next.ServeHTTP(rec, r.WithContext(ctx))
+
+		if rec.status != http.StatusUnauthorized {
+			return
+		}
+		if d, _ := m.failures.Allow(ctx, "", clientIp(r)); d.Allowed {
+			m.audit.Report(ctx, audit.ActionAuthFailure, r.Method+" "+r.URL.Path, auditEntity.OutcomeFailure,
+				credentialDetails(r))
+		}
+	})
+}
+
+// credentialDetails describes the credentials presented with a rejected request, without revealing them.
+func credentialDetails(r *http.Request) map[string]any {
+	switch {
+	case r.Header.Get(signing.HeaderSignature) != "":
+		return map[string]any{"scheme": "hmac", "key_id": r.Header.Get(signing.HeaderKeyId)}
+	case r.Header.Get("Authorization") != "":
+		return map[string]any{"scheme": "bearer"}
+	default:
+		return map[string]any{"scheme": "none"}
+	}
+}
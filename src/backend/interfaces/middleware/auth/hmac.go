@@ -0,0 +1,87 @@
+package auth
+
+import (
+	"application/auth"
+	"application/signing"
+	"bytes"
+	"errors"
+	"interfaces/api/utils"
+	"io"
+	"net/http"
+)
+
+// signedBodyLimit specifies the maximum size of a signed request body read to compute its digest (1MB).
+const signedBodyLimit = int64(1_048_576)
+
+// HmacAuthMiddleware handles HMAC request signing, an alternative to bearer tokens for API clients.
+type HmacAuthMiddleware struct {
+	*signing.Verifier // Signed request verifier
+	*utils.Errors     // Error handling utility
+}
+
+// NewHmacAuthMiddleware creates a new instance of HmacAuthMiddleware.
+func NewHmacAuthMiddleware(
+	verifier *signing.Verifier,
+	errors *utils.Errors,
+) *HmacAuthMiddleware {
+	return &HmacAuthMiddleware{
+		Verifier: verifier,
+		Errors:   errors,
+	}
+}
+
+// Handle verifies the signature of requests carrying the signing headers and stores the claims of the signing
+// client in the context. Requests without a signature are passed through unchanged, leaving authentication to
+// the JWT middleware, which accepts requests already authenticated here.
+func (m *HmacAuthMiddleware) Handle(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", signing.HeaderSignature)
+		if r.Header.Get(signing.HeaderSignature) == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, signedBodyLimit))
+		if err != nil {
+			m.Errors.ErrorResponse(w, r, http.StatusRequestEntityTooLarge, "request body too large to be signed")
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		claims, err := m.Verifier.Verify(r.Context(), signedRequest(r), body)
+		if err != nil {
+			m.handleError(w, r, err)
+			return
+		}
+
+		// Signature is valid
+		next.ServeHTTP(w, r.WithContext(auth.ContextWithClaims(r.Context(), claims)))
+	})
+}
+
+// handleError writes an Unauthorized response for signature failures and a server error otherwise.
+func (m *HmacAuthMiddleware) handleError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, signing.ErrMissingSignature),
+		errors.Is(err, signing.ErrExpiredSignature),
+		errors.Is(err, signing.ErrDigestMismatch),
+		errors.Is(err, signing.ErrInvalidSignature),
+		errors.Is(err, signing.ErrReplayedNonce):
+		m.Errors.ErrorResponse(w, r, http.StatusUnauthorized, err.Error())
+	default:
+		m.Errors.ServerErrorResponse(w, r, err)
+	}
+}
+
+// signedRequest extracts the signed parts of an HTTP request.
+func signedRequest(r *http.Request) *signing.SignedRequest {
+	return &signing.SignedRequest{
+		KeyId:     r.Header.Get(signing.HeaderKeyId),
+		Method:    r.Method,
+		Path:      r.URL.RequestURI(),
+		Timestamp: r.Header.Get(signing.HeaderTimestamp),
+		Nonce:     r.Header.Get(signing.HeaderNonce),
+		Digest:    r.Header.Get(signing.HeaderDigest),
+		Signature: r.Header.Get(signing.HeaderSignature),
+	}
+}
@@ -1,7 +1,10 @@
 package auth
 
 import (
+	"application/audit"
 	"application/auth"
+	auditEntity "domain/audit/entity"
+	"domain/auth/entity"
 	"interfaces/api/utils"
 	"net/http"
 	"strings"
@@ -9,46 +12,123 @@ import (
 
 // JwtAuthMiddleware handles JWT-based authorization.
 type JwtAuthMiddleware struct {
-	*auth.Service // Jwt auth service
-	*utils.Errors // Error handling utility
+	*auth.Service                // Jwt auth service
+	*utils.Errors                // Error handling utility
+	audit         *audit.Service // Audit service recording denied requests
 }
 
 // NewJwtAuthMiddleware creates a new instance of JwtAuthMiddleware.
 func NewJwtAuthMiddleware(
 	service *auth.Service,
+	audit *audit.Service,
 	errors *utils.Errors,
 ) *JwtAuthMiddleware {
 	return &JwtAuthMiddleware{
 		Service: service,
 		Errors:  errors,
+		audit:   audit,
 	}
 }
 
 // Handle checks for a valid JWT token and verifies the issuer.
 func (m *JwtAuthMiddleware) Handle(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Add("Vary", "Authorization")
+		claims, ok := m.verify(w, r)
+		if !ok {
+			return
+		}
 
-		h := r.Header.Get("Authorization")
-		if h == "" || !strings.HasPrefix(h, "Bearer ") {
+		expectedIssuer := "api.pulse-finder"
+		if claims.GetIssuer() != expectedIssuer {
 			m.Errors.Unauthorized(w, r)
 			return
 		}
 
-		token := strings.TrimPrefix(h, "Bearer ")
-		claims, err := m.Service.Verify(token)
-		if err != nil {
-			m.Errors.Unauthorized(w, r)
+		// Token is valid
+		next.ServeHTTP(w, r.WithContext(auth.ContextWithClaims(r.Context(), claims)))
+	})
+}
+
+// Authenticate checks for a valid JWT token regardless of its issuer.
+// Requests already authenticated by a preceding middleware (e.g., HmacAuthMiddleware) are passed through.
+// Access is then governed by the scopes of the token, see RequireScope.
+func (m *JwtAuthMiddleware) Authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := auth.ClaimsFromContext(r.Context()); ok {
+			next.ServeHTTP(w, r)
 			return
 		}
 
-		expectedIssuer := "api.pulse-finder"
-		if claims.GetIssuer() != expectedIssuer {
+		claims, ok := m.verify(w, r)
+		if !ok {
+			return
+		}
+
+		// Token is valid
+		next.ServeHTTP(w, r.WithContext(auth.ContextWithClaims(r.Context(), claims)))
+	})
+}
+
+// AuthenticateFeed checks the token carried by the URL of a feed, issued by GenerateFeed, for feed readers that
+// cannot send an Authorization header. Requests without one are passed through, e.g., to Authenticate.
+func (m *JwtAuthMiddleware) AuthenticateFeed(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.URL.Query().Get(auth.FeedTokenParam)
+		if token == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		claims, err := m.Service.VerifyFeed(token)
+		if err != nil {
 			m.Errors.Unauthorized(w, r)
 			return
 		}
 
 		// Token is valid
-		next.ServeHTTP(w, r)
+		next.ServeHTTP(w, r.WithContext(auth.ContextWithClaims(r.Context(), claims)))
 	})
 }
+
+// RequireScope returns a middleware that allows the request only if the authenticated token carries all
+// the given scopes. It must run after Handle or Authenticate. Denied requests are recorded in the audit log.
+func (m *JwtAuthMiddleware) RequireScope(scopes ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims, ok := auth.ClaimsFromContext(r.Context())
+			if !ok {
+				m.Errors.AuthenticationRequiredResponse(w, r)
+				return
+			}
+			for _, scope := range scopes {
+				if !claims.HasScope(scope) {
+					m.audit.Report(r.Context(), audit.ActionAuthDenied, r.Method+" "+r.URL.Path,
+						auditEntity.OutcomeDenied, map[string]any{"scope": scope})
+					m.Errors.NotPermittedResponse(w, r)
+					return
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// verify extracts the bearer token from the request and verifies it.
+// It writes an Unauthorized response and returns false if the token is missing or invalid.
+func (m *JwtAuthMiddleware) verify(w http.ResponseWriter, r *http.Request) (*entity.TokenClaims, bool) {
+	w.Header().Add("Vary", "Authorization")
+
+	h := r.Header.Get("Authorization")
+	if h == "" || !strings.HasPrefix(h, "Bearer ") {
+		m.Errors.Unauthorized(w, r)
+		return nil, false
+	}
+
+	token := strings.TrimPrefix(h, "Bearer ")
+	claims, err := m.Service.Verify(token)
+	if err != nil {
+		m.Errors.Unauthorized(w, r)
+		return nil, false
+	}
+	return claims, true
+}
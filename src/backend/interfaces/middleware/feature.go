@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"application/feature"
+	"interfaces/api/utils"
+	"net/http"
+)
+
+// FeatureMiddleware serves the routes of optional features only while their flag is switched on.
+type FeatureMiddleware struct {
+	flags  *feature.Flags // Feature flags of the process.
+	errors *utils.Errors  // Error handling utility.
+}
+
+// NewFeatureMiddleware creates a new instance of FeatureMiddleware.
+func NewFeatureMiddleware(flags *feature.Flags, errors *utils.Errors) *FeatureMiddleware {
+	return &FeatureMiddleware{flags: flags, errors: errors}
+}
+
+// Require returns a middleware answering 404 Not Found, as if the route did not exist, while the feature is
+// switched off. The flag is checked on every request, so switching it takes effect at once.
+func (m *FeatureMiddleware) Require(name string) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !m.flags.Enabled(name) {
+				m.errors.NotFoundResponse(w, r)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
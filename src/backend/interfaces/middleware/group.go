@@ -1,19 +1,15 @@
 package middleware
 
-import (
-	"net/http"
-
-	"github.com/julienschmidt/httprouter"
-)
+import "net/http"
 
 // RouteGroup allows grouping routes and applying middleware to all of them.
 type RouteGroup struct {
-	router      *httprouter.Router
+	router      *Router
 	middlewares []Middleware
 }
 
 // NewRouteGroup creates a new RouteGroup with the given middlewares.
-func NewRouteGroup(router *httprouter.Router, middlewares ...Middleware) *RouteGroup {
+func NewRouteGroup(router *Router, middlewares ...Middleware) *RouteGroup {
 	return &RouteGroup{router: router, middlewares: middlewares}
 }
 
@@ -25,3 +21,8 @@ func (rg *RouteGroup) HandlerFunc(method, path string, handlerFunc http.HandlerF
 	}
 	rg.router.HandlerFunc(method, path, h)
 }
+
+// Route records the template of a route dispatched by the handler of a broader route of the group.
+func (rg *RouteGroup) Route(method, path string) {
+	rg.router.Route(method, path)
+}
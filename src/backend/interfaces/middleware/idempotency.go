@@ -0,0 +1,152 @@
+package middleware
+
+import (
+	"application/auth"
+	"application/idempotency"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"interfaces/api/utils"
+	"io"
+	"net/http"
+)
+
+// replayedHeaders lists the headers of a response replayed to the retries of its request along with its body.
+var replayedHeaders = []string{"Content-Type", "Location"}
+
+// IdempotencyMiddleware makes requests sent with an Idempotency-Key header idempotent, replaying the response of a
+// request to its retries.
+type IdempotencyMiddleware struct {
+	service *idempotency.Service // Service storing the requests and their responses.
+	errors  *utils.Errors        // Error handling utility.
+}
+
+// NewIdempotencyMiddleware creates a new instance of IdempotencyMiddleware.
+func NewIdempotencyMiddleware(service *idempotency.Service, errors *utils.Errors) *IdempotencyMiddleware {
+	return &IdempotencyMiddleware{service: service, errors: errors}
+}
+
+// Handle returns a middleware processing a request sent with an Idempotency-Key header once per key, route of the
+// given router and client: its retries get the original response, with an Idempotent-Replayed header. Reusing a key
+// with another path or body is answered with 422 Unprocessable Entity, a retry sent while the request is in progress
+// with 409 Conflict. Responses with a server error are not kept, so the request may be retried. It must run after
+// the authentication middlewares, as keys are scoped to the subject of the client.
+func (m *IdempotencyMiddleware) Handle(router *Router) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(idempotency.HeaderKey)
+			if key == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if !idempotency.ValidKey(key) {
+				m.errors.ErrorResponse(w, r, http.StatusBadRequest, fmt.Sprintf("the %s header must have 1 to %d "+
+					"printable ASCII characters", idempotency.HeaderKey, idempotency.MaxKeyLength))
+				return
+			}
+
+			body, err := io.ReadAll(io.LimitReader(r.Body, maxValidatedBody+1))
+			r.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(body), r.Body), Closer: r.Body}
+			if err != nil {
+				m.errors.ErrorResponse(w, r, http.StatusBadRequest, "the request body could not be read")
+				return
+			}
+
+			var subject string
+			if claims, ok := auth.ClaimsFromContext(r.Context()); ok {
+				subject = claims.GetSubject()
+			}
+			key = idempotency.Key(r.Method+" "+router.Pattern(r), subject, key)
+			lock, stored, err := m.service.Begin(r.Context(), key, idempotency.Fingerprint([]byte(r.URL.RequestURI()), body))
+			switch {
+			case errors.Is(err, idempotency.ErrKeyReused):
+				m.errors.ErrorResponse(w, r, http.StatusUnprocessableEntity, err.Error())
+			case errors.Is(err, idempotency.ErrInProgress):
+				m.errors.ErrorResponse(w, r, http.StatusConflict, err.Error())
+			case err != nil:
+				m.errors.ServerErrorResponse(w, r, err)
+			case stored != nil:
+				m.replay(w, r, stored)
+			default:
+				m.process(w, r, next, lock)
+			}
+		})
+	}
+}
+
+// process processes the request holding the lock, storing its response unless it is a server error, in which case
+// the request is released for retries, as it is if the handler panics. The response is stored even if the client is
+// gone, as it is then most likely to retry.
+func (m *IdempotencyMiddleware) process(
+	w http.ResponseWriter, r *http.Request, next http.Handler, lock idempotency.Lock,
+) {
+	ctx := context.WithoutCancel(r.Context())
+	rec := &idempotencyRecorder{ResponseWriter: w, status: http.StatusOK}
+	completed := false
+	defer func() {
+		if !completed {
+			if err := m.service.Release(ctx, lock); err != nil {
+				m.errors.LogError(r, err)
+			}
+		}
+	}()
+
+	next.ServeHTTP(rec, r)
+	if rec.status >= http.StatusInternalServerError {
+		return
+	}
+
+	response := idempotency.Response{Status: rec.status, Headers: make(map[string]string), Body: rec.body.Bytes()}
+	for _, name := range replayedHeaders {
+		if value := w.Header().Get(name); value != "" {
+			response.Headers[name] = value
+		}
+	}
+	if err := m.service.Complete(ctx, lock, response); err != nil {
+		m.errors.LogError(r, err)
+		return
+	}
+	completed = true
+}
+
+// replay writes the stored response of the original request.
+func (m *IdempotencyMiddleware) replay(w http.ResponseWriter, r *http.Request, stored *idempotency.Response) {
+	for name, value := range stored.Headers {
+		w.Header().Set(name, value)
+	}
+	w.Header().Set(idempotency.HeaderReplayed, "true")
+	w.WriteHeader(stored.Status)
+	if _, err := w.Write(stored.Body); err != nil {
+		m.errors.LogError(r, err)
+	}
+}
+
+// idempotencyRecorder captures the status code and the body of the response written by the wrapped handler.
+type idempotencyRecorder struct {
+	http.ResponseWriter
+	status      int
+	body        bytes.Buffer
+	wroteHeader bool
+}
+
+// WriteHeader records the status code and forwards it to the underlying writer.
+func (r *idempotencyRecorder) WriteHeader(status int) {
+	if !r.wroteHeader {
+		r.status = status
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// Write records the bytes written and forwards them to the underlying writer.
+func (r *idempotencyRecorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+// Unwrap returns the underlying writer, allowing http.ResponseController to reach it.
+func (r *idempotencyRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
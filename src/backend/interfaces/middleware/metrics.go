@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"application/metrics"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// MetricsMiddleware counts the handled requests and observes their latency per route.
+type MetricsMiddleware struct {
+	requests *metrics.CounterVec   // Requests by method, route and status code.
+	latency  *metrics.HistogramVec // Latency by method and route.
+}
+
+// NewMetricsMiddleware creates a new instance of MetricsMiddleware registering its metrics in the registry.
+func NewMetricsMiddleware(r *metrics.Registry) *MetricsMiddleware {
+	return &MetricsMiddleware{
+		requests: r.NewCounterVec("http_requests_total",
+			"Number of HTTP requests handled by method, route and status code.", "method", "route", "status"),
+		latency: r.NewHistogramVec("http_request_duration_seconds",
+			"Latency of HTTP requests by method and route.", metrics.DefaultBuckets, "method", "route"),
+	}
+}
+
+// Handle returns a middleware instrumenting the requests, labelled with the routes of the given router
+// (e.g., "/v1/vacancies/:id") rather than their paths. It should run before the recovery middleware, so failed
+// requests are counted as well.
+func (m *MetricsMiddleware) Handle(router *Router) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			rec := newResponseRecorder(w)
+			next.ServeHTTP(rec, r)
+
+			route := router.Pattern(r)
+			m.requests.With(r.Method, route, strconv.Itoa(rec.status)).Inc()
+			m.latency.With(r.Method, route).Observe(time.Since(start).Seconds())
+		})
+	}
+}
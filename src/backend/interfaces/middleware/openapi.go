@@ -0,0 +1,122 @@
+package middleware
+
+import (
+	"bytes"
+	"interfaces/api/utils"
+	"interfaces/api/utils/codec"
+	"interfaces/openapi"
+	"io"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// jsonContentType is the media type of the JSON bodies, encoded in the media type accepted by the client.
+const jsonContentType = "application/json"
+
+// maxValidatedBody is the size of the largest request body validated, matching the limit of the handlers.
+const maxValidatedBody = 1_048_576
+
+// OpenApiMiddleware validates requests against the OpenAPI description of their route.
+type OpenApiMiddleware struct {
+	spec   *openapi.Spec // Description of the routes.
+	errors *utils.Errors // Error handling utility.
+}
+
+// NewOpenApiMiddleware creates a new instance of OpenApiMiddleware.
+func NewOpenApiMiddleware(spec *openapi.Spec, errors *utils.Errors) *OpenApiMiddleware {
+	return &OpenApiMiddleware{spec: spec, errors: errors}
+}
+
+// Handle returns a middleware validating the query parameters and the JSON body of the requests against the
+// operation of their route of the given router, answering invalid ones with 422 Unprocessable Entity, and answering
+// requests accepting none of the media types of its successful responses with 406 Not Acceptable before they are
+// handled. It should run after the authentication middlewares, so unauthenticated clients learn nothing about the
+// API. Bodies that are not valid JSON or exceed the size limit are left to the handlers, which report them.
+func (m *OpenApiMiddleware) Handle(router *Router) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			doc := m.spec.Document()
+			op, ok := doc.Operation(r.Method, router.Pattern(r))
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if errs := doc.ValidateQuery(op, r.URL.Query()); len(errs) > 0 {
+				m.errors.FailedQueryValidationResponse(w, r, errs)
+				return
+			}
+			if available, ok := m.acceptable(r, op); !ok {
+				m.errors.NotAcceptableResponse(w, r, available...)
+				return
+			}
+			if op.RequestBody != nil && !m.validBody(w, r, doc, op) {
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// acceptable returns the media types of the successful responses of the operation, JSON standing for the media
+// types of every codec, and whether the client accepts any of them. Operations without response bodies are
+// acceptable to every client.
+func (m *OpenApiMiddleware) acceptable(r *http.Request, op *openapi.OperationObject) ([]string, bool) {
+	accept, codecs := r.Header.Get("Accept"), m.errors.Handler.Codecs
+	var available []string
+	accepted := false
+	for status, response := range op.Responses {
+		if !strings.HasPrefix(status, "2") {
+			continue
+		}
+		for mediaType := range response.Content {
+			if mediaType == jsonContentType {
+				available = append(available, codecs.MediaTypes()...)
+				accepted = accepted || len(codecs.Negotiate(accept)) > 0
+				continue
+			}
+			available = append(available, mediaType)
+			accepted = accepted || codec.Accepts(accept, mediaType)
+		}
+	}
+	sort.Strings(available)
+	return slices.Compact(available), accepted || len(available) == 0
+}
+
+// validBody validates the JSON body of the request, replacing the body read with a copy for the handler. It
+// reports whether the request may proceed, responding to it otherwise.
+func (m *OpenApiMiddleware) validBody(
+	w http.ResponseWriter,
+	r *http.Request,
+	doc *openapi.Document,
+	op *openapi.OperationObject,
+) bool {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxValidatedBody+1))
+	r.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(body), r.Body), Closer: r.Body}
+	if err != nil || len(body) > maxValidatedBody {
+		return true
+	}
+	value, err := openapi.DecodeJson(body)
+	if err != nil {
+		return true
+	}
+
+	errs := doc.ValidateBody(op, r.Header.Get("Content-Type"), value)
+	if message, ok := errs[""]; ok {
+		m.errors.ErrorResponse(w, r, http.StatusBadRequest, message)
+		return false
+	}
+	if len(errs) > 0 {
+		m.errors.FailedValidationResponse(w, r, errs)
+		return false
+	}
+	return true
+}
+
+// readCloser reads a request body from a reader, closing the original body.
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
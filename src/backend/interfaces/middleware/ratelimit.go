@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"application/auth"
+	"application/ratelimit"
+	"interfaces/api/utils"
+	"net/http"
+)
+
+// RateLimitMiddleware enforces the rate limit policies of the routes on each client.
+type RateLimitMiddleware struct {
+	limiter *ratelimit.Limiter // Limiter enforcing the policies.
+	errors  *utils.Errors      // Error handling utility.
+}
+
+// NewRateLimitMiddleware creates a new instance of RateLimitMiddleware.
+func NewRateLimitMiddleware(limiter *ratelimit.Limiter, errors *utils.Errors) *RateLimitMiddleware {
+	return &RateLimitMiddleware{limiter: limiter, errors: errors}
+}
+
+// Handle returns a middleware limiting the requests of each client to the policy of the route of the given router
+// (e.g., "GET /v1/vacancies"). It should run after the authentication middlewares, so clients are identified by the
+// subject of their token or signing key rather than by their IP address. Limited responses carry the RateLimit
+// headers; rejected requests get a 429 Too Many Requests response with a Retry-After header.
+func (m *RateLimitMiddleware) Handle(router *Router) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var subject string
+			if claims, ok := auth.ClaimsFromContext(r.Context()); ok {
+				subject = claims.GetSubject()
+			}
+
+			route := r.Method + " " + router.Pattern(r)
+			d, limited := m.limiter.Allow(r.Context(), route, ratelimit.ClientKey(subject, clientIp(r)))
+			if limited {
+				for name, value := range d.Headers() {
+					w.Header().Set(name, value)
+				}
+			}
+			if !d.Allowed {
+				m.errors.ErrorResponse(w, r, http.StatusTooManyRequests, "rate limit exceeded, retry later")
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
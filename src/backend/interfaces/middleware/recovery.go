@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"fmt"
+	"interfaces/api/utils"
+	"net/http"
+	"runtime/debug"
+)
+
+// RecoveryMiddleware recovers from panics in the wrapped handler, so a failing request does not take down
+// its connection.
+type RecoveryMiddleware struct {
+	errors *utils.Errors // Error handler logging the panic and sending the response.
+}
+
+// NewRecoveryMiddleware creates a new instance of RecoveryMiddleware.
+func NewRecoveryMiddleware(e *utils.Errors) *RecoveryMiddleware {
+	return &RecoveryMiddleware{errors: e}
+}
+
+// Handle wraps the handler, recovering from its panics, see Recover.
+func (m *RecoveryMiddleware) Handle(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			if p := recover(); p != nil {
+				m.Recover(w, r, p)
+			}
+		}()
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Recover logs the stack of a recovered panic and responds with a 500 Internal Server Error in the error format of
+// the API. It also serves as the PanicHandler of the router. The connection is closed afterwards, as the handler may
+// have left the request body partially read. http.ErrAbortHandler is passed on, since it deliberately aborts the
+// response.
+func (m *RecoveryMiddleware) Recover(w http.ResponseWriter, r *http.Request, p any) {
+	if p == http.ErrAbortHandler {
+		panic(p)
+	}
+
+	m.errors.Logger.ErrorContext(r.Context(), "Panic recovered", "panic", fmt.Sprint(p),
+		"method", r.Method, "uri", utils.LoggedURI(r), "stack", string(debug.Stack()))
+	w.Header().Set("Connection", "close")
+	m.errors.ErrorResponse(w, r, http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError))
+}
@@ -0,0 +1,17 @@
+package middleware
+
+import (
+	"application/requestid"
+	"net/http"
+)
+
+// RequestId assigns every request an ID, stores it in the request context and returns it in the X-Request-Id
+// response header. A valid ID sent by the client in the X-Request-Id header is kept, so a request can be traced
+// across services; otherwise a new one is generated.
+func RequestId(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := requestid.Resolve(r.Header.Get(requestid.Header))
+		w.Header().Set(requestid.Header, id)
+		next.ServeHTTP(w, r.WithContext(requestid.ContextWithId(r.Context(), id)))
+	})
+}
@@ -0,0 +1,89 @@
+package middleware
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// unmatchedRoute labels requests that match no route, keeping the number of series bounded.
+const unmatchedRoute = "unmatched"
+
+// Router is an httprouter.Router recording the templates of its routes (e.g., "/v1/vacancies/:id"), which label the
+// requests in metrics, spans, rate limits and idempotency keys rather than their paths.
+type Router struct {
+	*httprouter.Router
+	templates map[string][]template // Templates by method, the ones with fewer parameters first.
+}
+
+// template is the template of a route split into segments, parameters starting with a colon.
+type template struct {
+	path     string
+	segments []string
+	params   int
+}
+
+// NewRouter creates a new instance of Router.
+func NewRouter() *Router {
+	return &Router{Router: httprouter.New(), templates: make(map[string][]template)}
+}
+
+// HandlerFunc registers the handler for the route and records its template. Templates with a parameter within a
+// segment, such as "/v1/vacancies:method" dispatching the custom methods, are not recorded, so only the routes
+// recorded with Route label the requests they serve.
+func (rt *Router) HandlerFunc(method, path string, handler http.HandlerFunc) {
+	if !slices.ContainsFunc(strings.Split(path, "/"), func(s string) bool { return strings.IndexByte(s, ':') > 0 }) {
+		rt.Route(method, path)
+	}
+	rt.Router.HandlerFunc(method, path, handler)
+}
+
+// Route records the template of a route served by the handler of a broader one, e.g., POST /v1/vacancies:batchCreate
+// dispatched by the handler of POST /v1/vacancies:method. Segments starting with a colon are parameters, any other
+// is literal. Routes must be recorded before the router serves requests.
+func (rt *Router) Route(method, path string) {
+	t := template{path: path, segments: strings.Split(path, "/")}
+	for _, segment := range t.segments {
+		if strings.HasPrefix(segment, ":") {
+			t.params++
+		}
+	}
+
+	templates := rt.templates[method]
+	if slices.ContainsFunc(templates, func(o template) bool { return o.path == path }) {
+		return
+	}
+	i, _ := slices.BinarySearchFunc(templates, t.params+1, func(o template, n int) int { return o.params - n })
+	rt.templates[method] = slices.Insert(templates, i, t)
+}
+
+// Pattern returns the template of the route matching the request, preferring the templates with fewer parameters
+// (e.g., "/v1/vacancies/export" to "/v1/vacancies/:id"), or unmatchedRoute if it matches no recorded route.
+func (rt *Router) Pattern(r *http.Request) string {
+	if handle, _, _ := rt.Lookup(r.Method, r.URL.Path); handle == nil {
+		return unmatchedRoute
+	}
+
+	segments := strings.Split(r.URL.Path, "/")
+	for _, t := range rt.templates[r.Method] {
+		if t.matches(segments) {
+			return t.path
+		}
+	}
+	return unmatchedRoute
+}
+
+// matches reports whether the segments of a path match the template.
+func (t template) matches(segments []string) bool {
+	if len(segments) != len(t.segments) {
+		return false
+	}
+	for i, segment := range t.segments {
+		if !strings.HasPrefix(segment, ":") && segment != segments[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"application/tracing"
+	"net/http"
+)
+
+// Tracing returns a middleware starting a server span for every request, named after the route of the given
+// router (e.g., "GET /v1/vacancies/:id"). The span continues the trace of the traceparent header sent by the
+// client, if any. It should run right after the request ID middleware, so the span covers the whole request.
+func Tracing(router *Router) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			if remote, ok := tracing.ParseTraceparent(r.Header.Get(tracing.Header)); ok {
+				ctx = tracing.ContextWithRemote(ctx, remote)
+			}
+
+			route := router.Pattern(r)
+			ctx, span := tracing.Start(ctx, r.Method+" "+route, tracing.KindServer)
+			defer span.End()
+			span.SetAttribute("http.method", r.Method)
+			span.SetAttribute("http.route", route)
+
+			rec := newResponseRecorder(w)
+			next.ServeHTTP(rec, r.WithContext(ctx))
+
+			span.SetAttribute("http.status_code", rec.status)
+			if rec.status >= http.StatusInternalServerError {
+				span.SetError(http.StatusText(rec.status))
+			}
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package openapi
+
+import (
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Schema is a JSON Schema of the OpenAPI 3.1 document, limited to the keywords the REST API needs.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`                 // Reference to a component schema.
+	Type                 any                `json:"type,omitempty"`                 // Type name, or names if nullable.
+	Format               string             `json:"format,omitempty"`               // Format of strings and numbers.
+	Description          string             `json:"description,omitempty"`          // Description of the value.
+	Enum                 []string           `json:"enum,omitempty"`                 // Values allowed for strings.
+	Minimum              *float64           `json:"minimum,omitempty"`              // Inclusive minimum of numbers.
+	Maximum              *float64           `json:"maximum,omitempty"`              // Inclusive maximum of numbers.
+	Properties           map[string]*Schema `json:"properties,omitempty"`           // Properties of objects.
+	Required             []string           `json:"required,omitempty"`             // Properties objects must have.
+	AdditionalProperties any                `json:"additionalProperties,omitempty"` // Schema of others, or false.
+	Items                *Schema            `json:"items,omitempty"`                // Schema of the items of arrays.
+	AllOf                []*Schema          `json:"allOf,omitempty"`                // Schemas the value must all match.
+	AnyOf                []*Schema          `json:"anyOf,omitempty"`                // Schemas the value must match one of.
+}
+
+// Types of the JSON Schema.
+const (
+	TypeString  = "string"
+	TypeInteger = "integer"
+	TypeNumber  = "number"
+	TypeBoolean = "boolean"
+	TypeArray   = "array"
+	TypeObject  = "object"
+	TypeNull    = "null"
+)
+
+// componentsPrefix is the prefix of the references to component schemas.
+const componentsPrefix = "#/components/schemas/"
+
+// timeType is the type of time.Time, described as a date-time string.
+var timeType = reflect.TypeOf(time.Time{})
+
+// String returns a string schema with the given description.
+func String(description string) *Schema {
+	return &Schema{Type: TypeString, Description: description}
+}
+
+// Integer returns an integer schema with the given description and inclusive bounds, if not nil.
+func Integer(description string, minimum, maximum *float64) *Schema {
+	return &Schema{Type: TypeInteger, Format: "int64", Description: description, Minimum: minimum, Maximum: maximum}
+}
+
+// Enum returns a string schema allowing the given values only.
+func Enum(description string, values ...string) *Schema {
+	return &Schema{Type: TypeString, Description: description, Enum: values}
+}
+
+// Bound returns a pointer to the bound of a numeric schema.
+func Bound(v float64) *float64 {
+	return &v
+}
+
+// types returns the type names of the schema, which is a single name or a list of names if nullable.
+func (s *Schema) types() []string {
+	switch t := s.Type.(type) {
+	case string:
+		return []string{t}
+	case []string:
+		return t
+	}
+	return nil
+}
+
+// nullable returns a copy of the schema also allowing null.
+func (s *Schema) nullable() *Schema {
+	if s.Ref != "" {
+		return &Schema{AnyOf: []*Schema{s, {Type: TypeNull}}}
+	}
+	c := *s
+	if t, ok := s.Type.(string); ok {
+		c.Type = []string{t, TypeNull}
+	}
+	return &c
+}
+
+// generator derives schemas from Go types, encoded with encoding/json. Named structs become component schemas.
+type generator struct {
+	components map[string]*Schema
+}
+
+// newGenerator creates a generator adding component schemas to the given map.
+func newGenerator(components map[string]*Schema) *generator {
+	return &generator{components: components}
+}
+
+// schemaOf returns the schema of the values of the type. Pointers are nullable, since null decodes to a nil pointer
+// and nil pointers not omitted encode to null.
+func (g *generator) schemaOf(t reflect.Type) *Schema {
+	switch {
+	case t.Kind() == reflect.Pointer:
+		return g.schemaOf(t.Elem()).nullable()
+	case t == timeType:
+		return &Schema{Type: TypeString, Format: "date-time"}
+	}
+	return g.kindSchema(t)
+}
+
+// kindSchema returns the schema of the values of the type by its kind.
+func (g *generator) kindSchema(t reflect.Type) *Schema {
+	switch t.Kind() {
+	case reflect.String:
+		return &Schema{Type: TypeString}
+	case reflect.Bool:
+		return &Schema{Type: TypeBoolean}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return &Schema{Type: TypeInteger, Format: "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: TypeInteger, Format: "int64"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: TypeNumber}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: TypeArray, Items: g.schemaOf(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: TypeObject, AdditionalProperties: g.schemaOf(t.Elem())}
+	case reflect.Struct:
+		return g.structSchema(t)
+	default:
+		return &Schema{} // Any value, e.g., of an interface type
+	}
+}
+
+// structSchema returns a reference to the component schema of a named struct, generating it on first use, or the
+// inline schema of an anonymous struct.
+func (g *generator) structSchema(t reflect.Type) *Schema {
+	if t.Name() == "" {
+		return g.objectSchema(t)
+	}
+
+	name := componentName(t)
+	if _, ok := g.components[name]; !ok {
+		g.components[name] = &Schema{} // Placeholder breaking the recursion of recursive types
+		g.components[name] = g.objectSchema(t)
+	}
+	return &Schema{Ref: componentsPrefix + name}
+}
+
+// objectSchema returns the schema of the JSON object encoding the struct. Fields without omitempty are required and
+// embedded structs without a name are flattened, as encoding/json does.
+func (g *generator) objectSchema(t reflect.Type) *Schema {
+	s := &Schema{Type: TypeObject, Properties: map[string]*Schema{}, AdditionalProperties: false}
+	for _, f := range fields(t) {
+		s.Properties[f.name] = g.schemaOf(f.typ)
+		if !f.omitEmpty {
+			s.Required = append(s.Required, f.name)
+		}
+	}
+	return s
+}
+
+// field is a field of a struct encoded as a property of a JSON object.
+type field struct {
+	name      string       // Name of the property.
+	typ       reflect.Type // Type of the field.
+	omitEmpty bool         // Whether the property is omitted when empty.
+}
+
+// fields returns the fields of the struct encoded by encoding/json, in declaration order.
+func fields(t reflect.Type) []field {
+	var list []field
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" || (!f.IsExported() && !f.Anonymous) {
+			continue
+		}
+
+		name, options, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct {
+			list = append(list, fields(f.Type)...)
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		list = append(list, field{name: name, typ: f.Type, omitEmpty: strings.Contains(options, "omitempty")})
+	}
+	return list
+}
+
+// componentName names the component schema of a struct after the package of its DTOs, e.g., "VacancyRequest" for
+// interfaces/api/vacancy/dto.Request or "RoleAssignmentRequest" for interfaces/api/role/dto.AssignmentRequest. The
+// prefix is omitted when the type name already starts with it, and for the types shared by the API, e.g., "Problem".
+func componentName(t reflect.Type) string {
+	var prefix string
+	for _, segment := range strings.Split(strings.TrimPrefix(t.PkgPath(), "interfaces/api/"), "/") {
+		if segment != "dto" && segment != "utils" {
+			prefix += capitalize(segment)
+		}
+	}
+	if strings.HasPrefix(t.Name(), prefix) {
+		return t.Name()
+	}
+	return prefix + t.Name()
+}
+
+// capitalize returns the string with its first letter in upper case.
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	r := []rune(s)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
@@ -0,0 +1,387 @@
+package openapi
+
+import (
+	"application/auth"
+	"application/signing"
+	"encoding/json"
+	"interfaces/api/utils"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Security schemes of the REST API.
+const (
+	BearerAuth = "bearerAuth" // JWT bearer tokens issued at /v1/jwt.
+	HmacAuth   = "hmacAuth"   // Requests signed with the HMAC signing key of an API client.
+	FeedAuth   = "feedToken"  // Tokens carried by the URLs of the feeds, issued at /v1/feeds/token.
+)
+
+// jsonContentType is the content type of the request and response bodies.
+const jsonContentType = "application/json"
+
+// Operation describes a route of the REST API. Its request and response bodies are described by the zero values of
+// their DTOs, from which the schemas are derived.
+type Operation struct {
+	Method      string         // HTTP method, e.g., http.MethodGet.
+	Path        string         // Pattern of the route, e.g., "/v1/vacancies/:id".
+	Id          string         // Unique identifier of the operation, e.g., "getVacancy".
+	Summary     string         // Short summary of what the operation does.
+	Tag         string         // Tag grouping the operations of a resource, e.g., "Vacancies".
+	Auth        []string       // Security schemes accepted by the route, none for public routes.
+	Scopes      []string       // Scopes the credentials must grant.
+	Query       []Parameter    // Query parameters of the route.
+	Headers     []Parameter    // Header parameters of the route, e.g., Idempotency-Key.
+	Body        any            // Zero value of the request body DTO, nil if the route takes no body.
+	BodyType    string         // Content type of the request body, application/json by default.
+	Bodies      map[string]any // Other request bodies by content type, e.g., the JSON Patch of a PATCH route.
+	Required    []string       // Properties the request body must have.
+	Status      int            // Status of the successful response, 200 OK by default.
+	Response    any            // Zero value of the successful response body, nil if empty.
+	ContentType string         // Content type of the successful response, application/json by default.
+	Produces    []string       // Other content types of the successful response, with the same body, e.g., NDJSON.
+	Variants    map[string]any // Other bodies of the successful response by content type, e.g., the JSON-LD one.
+	Responses   map[int]any    // Other responses with the body of the successful one, by status, e.g., 503 of /readyz.
+	Errors      []int          // Error statuses of the route besides those implied by the other fields.
+}
+
+// Parameter is a query or header parameter of an operation.
+type Parameter struct {
+	Name     string  // Name of the parameter.
+	Required bool    // Whether the parameter must be given.
+	Schema   *Schema // Schema of the parameter value.
+}
+
+// QueryOf returns the query parameters of the DTO holding the query of a route, e.g., list.Request, named after the
+// JSON properties of its fields. Nested structs are flattened.
+func QueryOf(dto any) []Parameter {
+	var params []Parameter
+	var collect func(t reflect.Type)
+	collect = func(t reflect.Type) {
+		for _, f := range fields(t) {
+			typ := f.typ
+			if typ.Kind() == reflect.Pointer {
+				typ = typ.Elem()
+			}
+			if typ.Kind() == reflect.Struct {
+				collect(typ)
+				continue
+			}
+			params = append(params, Parameter{Name: f.name, Schema: newGenerator(nil).schemaOf(typ)})
+		}
+	}
+	collect(reflect.TypeOf(dto))
+	return params
+}
+
+// Document is an OpenAPI 3.1 document.
+type Document struct {
+	OpenApi    string               `json:"openapi"`    // Version of the specification.
+	Info       Info                 `json:"info"`       // Metadata of the API.
+	Paths      map[string]*PathItem `json:"paths"`      // Operations by path template, e.g., "/v1/vacancies/{id}".
+	Components Components           `json:"components"` // Schemas and security schemes referenced by the paths.
+}
+
+// Info holds the metadata of the API.
+type Info struct {
+	Title       string `json:"title"`                 // Title of the API.
+	Version     string `json:"version"`               // Version of the document.
+	Description string `json:"description,omitempty"` // Description of the API.
+}
+
+// PathItem holds the operations of a path by lower case method.
+type PathItem map[string]*OperationObject
+
+// OperationObject is the description of an operation in the document.
+type OperationObject struct {
+	OperationId string                `json:"operationId"`           // Unique identifier of the operation.
+	Summary     string                `json:"summary,omitempty"`     // Short summary of the operation.
+	Tags        []string              `json:"tags,omitempty"`        // Tags grouping the operation.
+	Security    []map[string][]string `json:"security,omitempty"`    // Alternative security requirements.
+	Parameters  []*ParameterObject    `json:"parameters,omitempty"`  // Path and query parameters.
+	RequestBody *RequestBody          `json:"requestBody,omitempty"` // Request body, if any.
+	Responses   map[string]*Response  `json:"responses"`             // Responses by status code.
+}
+
+// ParameterObject is the description of a path or query parameter.
+type ParameterObject struct {
+	Name     string  `json:"name"`     // Name of the parameter.
+	In       string  `json:"in"`       // Location of the parameter: "path" or "query".
+	Required bool    `json:"required"` // Whether the parameter must be given.
+	Schema   *Schema `json:"schema"`   // Schema of the parameter value.
+}
+
+// RequestBody is the description of a request body.
+type RequestBody struct {
+	Required bool                 `json:"required"` // Whether the body must be given.
+	Content  map[string]MediaType `json:"content"`  // Schemas by content type.
+}
+
+// MediaType holds the schema of a body of a content type.
+type MediaType struct {
+	Schema *Schema `json:"schema,omitempty"` // Schema of the body.
+}
+
+// Response is the description of a response.
+type Response struct {
+	Description string               `json:"description"`       // Description of the response.
+	Content     map[string]MediaType `json:"content,omitempty"` // Schemas by content type, if not empty.
+}
+
+// Components holds the schemas and security schemes referenced by the operations.
+type Components struct {
+	Schemas         map[string]*Schema        `json:"schemas"`         // Schemas by name.
+	SecuritySchemes map[string]SecurityScheme `json:"securitySchemes"` // Security schemes by name.
+}
+
+// SecurityScheme is the description of a security scheme.
+type SecurityScheme struct {
+	Type         string `json:"type"`                   // Type of the scheme: "http" or "apiKey".
+	Scheme       string `json:"scheme,omitempty"`       // HTTP authentication scheme.
+	BearerFormat string `json:"bearerFormat,omitempty"` // Format of the bearer token.
+	In           string `json:"in,omitempty"`           // Location of the API key.
+	Name         string `json:"name,omitempty"`         // Name of the header or query parameter carrying the API key.
+	Description  string `json:"description,omitempty"`  // Description of the scheme.
+}
+
+// Spec collects the operations of the routes as they are registered and describes them in an OpenAPI document,
+// which it serves as JSON.
+type Spec struct {
+	mu         sync.Mutex
+	info       Info
+	operations map[string]Operation // Operations by method and path pattern.
+	document   *Document            // Document of the operations, built on first use.
+	payload    []byte               // JSON encoding of the document.
+}
+
+// NewSpec creates a Spec of the API with the given metadata.
+func NewSpec(info Info) *Spec {
+	return &Spec{info: info, operations: make(map[string]Operation)}
+}
+
+// Add adds the operation of a route, replacing the one with the same method and path, if any.
+func (s *Spec) Add(op Operation) {
+	if op.Status == 0 {
+		op.Status = http.StatusOK
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.operations[op.Method+" "+op.Path] = op
+	s.document, s.payload = nil, nil
+}
+
+// Operations returns the operations, sorted by path and method.
+func (s *Spec) Operations() []Operation {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sorted()
+}
+
+// Document returns the OpenAPI document of the operations.
+func (s *Spec) Document() *Document {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.document == nil {
+		s.document = build(s.info, s.sorted())
+	}
+	return s.document
+}
+
+// sorted returns the operations sorted by path and method. The caller must hold the lock.
+func (s *Spec) sorted() []Operation {
+	list := make([]Operation, 0, len(s.operations))
+	for _, op := range s.operations {
+		list = append(list, op)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Path != list[j].Path {
+			return list[i].Path < list[j].Path
+		}
+		return list[i].Method < list[j].Method
+	})
+	return list
+}
+
+// ServeHTTP serves the OpenAPI document as JSON.
+func (s *Spec) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	doc := s.Document()
+
+	s.mu.Lock()
+	if s.payload == nil {
+		s.payload, _ = json.MarshalIndent(doc, "", "\t") // The document only holds encodable values
+	}
+	payload := s.payload
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	_, _ = w.Write(append(payload, '\n'))
+}
+
+// PathTemplate converts a route pattern to a path template, e.g., "/v1/vacancies/:id" to "/v1/vacancies/{id}".
+func PathTemplate(pattern string) string {
+	segments := strings.Split(pattern, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") || strings.HasPrefix(segment, "*") {
+			segments[i] = "{" + segment[1:] + "}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// build describes the operations in a document.
+func build(info Info, operations []Operation) *Document {
+	doc := &Document{
+		OpenApi: "3.1.0",
+		Info:    info,
+		Paths:   make(map[string]*PathItem),
+		Components: Components{
+			Schemas:         make(map[string]*Schema),
+			SecuritySchemes: securitySchemes(),
+		},
+	}
+	g := newGenerator(doc.Components.Schemas)
+	problem := g.schemaOf(reflect.TypeOf(utils.Problem{}))
+
+	for _, op := range operations {
+		path := PathTemplate(op.Path)
+		item, ok := doc.Paths[path]
+		if !ok {
+			item = &PathItem{}
+			doc.Paths[path] = item
+		}
+		(*item)[strings.ToLower(op.Method)] = describe(g, op, problem)
+	}
+	return doc
+}
+
+// describe describes the operation, deriving the schemas of its bodies with the generator. Its error responses
+// are problem details.
+func describe(g *generator, op Operation, problem *Schema) *OperationObject {
+	o := &OperationObject{OperationId: op.Id, Summary: op.Summary, Responses: make(map[string]*Response)}
+	if op.Tag != "" {
+		o.Tags = []string{op.Tag}
+	}
+	for _, scheme := range op.Auth {
+		o.Security = append(o.Security, map[string][]string{scheme: append([]string{}, op.Scopes...)})
+	}
+
+	for _, segment := range strings.Split(op.Path, "/") {
+		if strings.HasPrefix(segment, ":") {
+			o.Parameters = append(o.Parameters, &ParameterObject{
+				Name: segment[1:], In: "path", Required: true, Schema: Integer("", Bound(1), nil)})
+		}
+	}
+	for _, p := range op.Query {
+		o.Parameters = append(o.Parameters, &ParameterObject{Name: p.Name, In: "query", Required: p.Required,
+			Schema: p.Schema})
+	}
+	for _, p := range op.Headers {
+		o.Parameters = append(o.Parameters, &ParameterObject{Name: p.Name, In: "header", Required: p.Required,
+			Schema: p.Schema})
+	}
+
+	if op.Body != nil {
+		o.RequestBody = requestBody(g, op)
+	}
+
+	for _, status := range errorStatuses(op) {
+		o.Responses[strconv.Itoa(status)] = &Response{
+			Description: http.StatusText(status),
+			Content:     map[string]MediaType{utils.ProblemContentType: {Schema: problem}},
+		}
+	}
+	o.Responses[strconv.Itoa(op.Status)] = successResponse(g, op)
+	for status, body := range op.Responses {
+		o.Responses[strconv.Itoa(status)] = response(g, op, status, body)
+	}
+	return o
+}
+
+// requestBody describes the request body of the operation in its content type and in its other content types, if
+// any.
+func requestBody(g *generator, op Operation) *RequestBody {
+	schema := g.schemaOf(reflect.TypeOf(op.Body))
+	if len(op.Required) > 0 {
+		schema = &Schema{AllOf: []*Schema{schema, {Required: op.Required}}}
+	}
+	contentType := op.BodyType
+	if contentType == "" {
+		contentType = jsonContentType
+	}
+	body := &RequestBody{Required: true, Content: map[string]MediaType{contentType: {Schema: schema}}}
+	for contentType, other := range op.Bodies {
+		body.Content[contentType] = MediaType{Schema: g.schemaOf(reflect.TypeOf(other))}
+	}
+	return body
+}
+
+// response describes a response of the operation with the given status and body, in the content type of its
+// successful response.
+func response(g *generator, op Operation, status int, body any) *Response {
+	r := &Response{Description: http.StatusText(status)}
+	if body == nil {
+		return r
+	}
+	contentType := op.ContentType
+	if contentType == "" {
+		contentType = jsonContentType
+	}
+	schema := g.schemaOf(reflect.TypeOf(body))
+	r.Content = map[string]MediaType{contentType: {Schema: schema}}
+	for _, other := range op.Produces {
+		r.Content[other] = MediaType{Schema: schema}
+	}
+	return r
+}
+
+// successResponse describes the successful response of the operation, in its content types and with its variants,
+// if any.
+func successResponse(g *generator, op Operation) *Response {
+	r := response(g, op, op.Status, op.Response)
+	for contentType, body := range op.Variants {
+		r.Content[contentType] = MediaType{Schema: g.schemaOf(reflect.TypeOf(body))}
+	}
+	return r
+}
+
+// errorStatuses returns the error statuses of the operation: those it lists and those implied by its credentials,
+// parameters and body.
+func errorStatuses(op Operation) []int {
+	statuses := map[int]bool{http.StatusInternalServerError: true}
+	for _, status := range op.Errors {
+		statuses[status] = true
+	}
+	statuses[http.StatusBadRequest] = op.Body != nil
+	statuses[http.StatusUnprocessableEntity] = op.Body != nil || len(op.Query) > 0
+	statuses[http.StatusUnauthorized] = len(op.Auth) > 0
+	statuses[http.StatusNotAcceptable] = op.Response != nil
+	statuses[http.StatusForbidden] = statuses[http.StatusForbidden] || len(op.Scopes) > 0
+	statuses[http.StatusNotFound] = statuses[http.StatusNotFound] || strings.Contains(op.Path, "/:")
+
+	var list []int
+	for status, ok := range statuses {
+		if ok {
+			list = append(list, status)
+		}
+	}
+	sort.Ints(list)
+	return list
+}
+
+// securitySchemes returns the security schemes of the API.
+func securitySchemes() map[string]SecurityScheme {
+	return map[string]SecurityScheme{
+		BearerAuth: {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+		HmacAuth: {Type: "apiKey", In: "header", Name: signing.HeaderKeyId,
+			Description: "Requests signed with the HMAC signing key of an API client: the " + signing.HeaderSignature +
+				" header holds the signature of the method, path, " + signing.HeaderTimestamp + ", " +
+				signing.HeaderNonce + " and " + signing.HeaderDigest + " headers."},
+		FeedAuth: {Type: "apiKey", In: "query", Name: auth.FeedTokenParam,
+			Description: "Read-only JWT issued at /v1/feeds/token, only accepted by the feeds, for feed readers " +
+				"that cannot send an Authorization header."},
+	}
+}
@@ -0,0 +1,267 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Operation returns the description of the operation of the route with the given method and pattern, if any.
+func (d *Document) Operation(method, pattern string) (*OperationObject, bool) {
+	item, ok := d.Paths[PathTemplate(pattern)]
+	if !ok {
+		return nil, false
+	}
+	o, ok := (*item)[strings.ToLower(method)]
+	return o, ok
+}
+
+// DecodeJson decodes a JSON value keeping its numbers as json.Number, so integers can be told from other numbers.
+func DecodeJson(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf("body must contain a single JSON value")
+	}
+	return value, nil
+}
+
+// ValidateQuery validates the query parameters of a request against the operation and returns the errors by
+// parameter. Parameters the operation does not describe are ignored.
+func (d *Document) ValidateQuery(o *OperationObject, query url.Values) map[string]string {
+	errs := make(map[string]string)
+	for _, p := range o.Parameters {
+		if p.In != "query" {
+			continue
+		}
+		if !query.Has(p.Name) {
+			if p.Required {
+				errs[p.Name] = p.Name + " must be provided"
+			}
+			continue
+		}
+		d.validate(p.Schema, parameterValue(p.Schema, query.Get(p.Name)), p.Name, p.Name, errs)
+	}
+	return errs
+}
+
+// ValidateBody validates a request body, decoded with DecodeJson, against the schema of its content type in the
+// operation and returns the errors by property of the body. Bodies of content types the operation does not
+// describe are validated as JSON. An error of the body as a whole is returned under the empty key.
+func (d *Document) ValidateBody(o *OperationObject, contentType string, body any) map[string]string {
+	errs := make(map[string]string)
+	if o.RequestBody == nil {
+		return errs
+	}
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	media, ok := o.RequestBody.Content[mediaType]
+	if !ok {
+		media, ok = o.RequestBody.Content[jsonContentType]
+	}
+	if ok {
+		d.validate(media.Schema, body, "", "body", errs)
+	}
+	return errs
+}
+
+// ValidateResponse validates the status, content type and body of a response against the operation.
+func (d *Document) ValidateResponse(o *OperationObject, status int, contentType string, body []byte) error {
+	response, ok := o.Responses[strconv.Itoa(status)]
+	if !ok {
+		return fmt.Errorf("status %d is not documented", status)
+	}
+	if len(response.Content) == 0 {
+		if len(body) > 0 {
+			return fmt.Errorf("status %d is documented without a body", status)
+		}
+		return nil
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	media, ok := response.Content[mediaType]
+	if !ok {
+		return fmt.Errorf("content type %q of status %d is not documented", contentType, status)
+	}
+	if mediaType != jsonContentType && !strings.HasSuffix(mediaType, "+json") {
+		return nil // Only JSON bodies are described by schemas
+	}
+	value, err := DecodeJson(body)
+	if err != nil {
+		return fmt.Errorf("decode body: %w", err)
+	}
+
+	errs := make(map[string]string)
+	d.validate(media.Schema, value, "", "body", errs)
+	if len(errs) == 0 {
+		return nil
+	}
+	messages := make([]string, 0, len(errs))
+	for _, message := range errs {
+		messages = append(messages, message)
+	}
+	sort.Strings(messages)
+	return fmt.Errorf("body of status %d does not match its schema: %s", status, strings.Join(messages, "; "))
+}
+
+// parameterValue converts the value of a query parameter to the JSON value its schema describes, if it can.
+func parameterValue(s *Schema, value string) any {
+	for _, t := range s.types() {
+		switch t {
+		case TypeInteger, TypeNumber:
+			if _, err := strconv.ParseFloat(value, 64); err == nil {
+				return json.Number(value)
+			}
+		case TypeBoolean:
+			if b, err := strconv.ParseBool(value); err == nil {
+				return b
+			}
+		}
+	}
+	return value
+}
+
+// validate validates the value against the schema, adding the errors to errs under the given key. The label names
+// the value in the messages, e.g., "scopes[0]".
+func (d *Document) validate(s *Schema, value any, key, label string, errs map[string]string) {
+	if s.Ref != "" {
+		d.validate(d.Components.Schemas[strings.TrimPrefix(s.Ref, componentsPrefix)], value, key, label, errs)
+		return
+	}
+	for _, sub := range s.AllOf {
+		d.validate(sub, value, key, label, errs)
+	}
+	if len(s.AnyOf) > 0 && !d.matchesAny(s.AnyOf, value, key, label, errs) {
+		return
+	}
+
+	if types := s.types(); len(types) > 0 && !slices.ContainsFunc(types, func(t string) bool {
+		return hasType(value, t)
+	}) {
+		errs[key] = fmt.Sprintf("%s must be %s", label, article(types[0]))
+		return
+	}
+	d.validateValue(s, value, key, label, errs)
+}
+
+// validateValue validates the value of a type allowed by the schema against its other keywords.
+func (d *Document) validateValue(s *Schema, value any, key, label string, errs map[string]string) {
+	switch v := value.(type) {
+	case string:
+		if len(s.Enum) > 0 && !slices.Contains(s.Enum, v) {
+			errs[key] = fmt.Sprintf("%s must be one of %s", label, strings.Join(s.Enum, ", "))
+		}
+	case json.Number:
+		if !s.inRange(v) {
+			errs[key] = fmt.Sprintf("%s is out of range", label)
+		}
+	case []any:
+		for i, item := range v {
+			if s.Items != nil {
+				d.validate(s.Items, item, key, fmt.Sprintf("%s[%d]", label, i), errs)
+			}
+		}
+	case map[string]any:
+		d.validateObject(s, v, key, label, errs)
+	}
+}
+
+// inRange reports whether the number is within the bounds of the schema.
+func (s *Schema) inRange(n json.Number) bool {
+	f, _ := n.Float64()
+	return (s.Minimum == nil || f >= *s.Minimum) && (s.Maximum == nil || f <= *s.Maximum)
+}
+
+// matchesAny reports whether the value matches one of the schemas. Otherwise, the errors of the first schema not
+// only allowing null are added to errs.
+func (d *Document) matchesAny(schemas []*Schema, value any, key, label string, errs map[string]string) bool {
+	var first map[string]string
+	for _, s := range schemas {
+		branch := make(map[string]string)
+		d.validate(s, value, key, label, branch)
+		if len(branch) == 0 {
+			return true
+		}
+		if first == nil && !slices.Equal(s.types(), []string{TypeNull}) {
+			first = branch
+		}
+	}
+	for k, message := range first {
+		errs[k] = message
+	}
+	return false
+}
+
+// validateObject validates the properties of an object. The errors of the properties of the body are added under
+// the name of the property, those of nested objects under the key of the property of the body holding them.
+func (d *Document) validateObject(s *Schema, object map[string]any, key, label string, errs map[string]string) {
+	child := func(name string) (string, string) {
+		if key == "" {
+			return name, name
+		}
+		return key, label + "." + name
+	}
+
+	for _, name := range s.Required {
+		if _, ok := object[name]; !ok {
+			k, l := child(name)
+			errs[k] = l + " must be provided"
+		}
+	}
+	for name, value := range object {
+		k, l := child(name)
+		if property, ok := s.Properties[name]; ok {
+			d.validate(property, value, k, l, errs)
+			continue
+		}
+		switch additional := s.AdditionalProperties.(type) {
+		case bool:
+			if !additional {
+				errs[k] = l + " is not a known field"
+			}
+		case *Schema:
+			d.validate(additional, value, k, l, errs)
+		}
+	}
+}
+
+// hasType reports whether the JSON value, decoded with DecodeJson, is of the type.
+func hasType(value any, t string) bool {
+	switch v := value.(type) {
+	case nil:
+		return t == TypeNull
+	case string:
+		return t == TypeString
+	case bool:
+		return t == TypeBoolean
+	case json.Number:
+		if t == TypeInteger {
+			_, err := v.Int64()
+			return err == nil
+		}
+		return t == TypeNumber
+	case []any:
+		return t == TypeArray
+	case map[string]any:
+		return t == TypeObject
+	}
+	return false
+}
+
+// article returns the type name with its indefinite article, e.g., "an integer".
+func article(t string) string {
+	if strings.IndexAny(t[:1], "aeiou") == 0 {
+		return "an " + t
+	}
+	return "a " + t
+}
@@ -0,0 +1,32 @@
+package tests
+
+import (
+	"application/auth"
+	"domain/auth/entity"
+	"net/http"
+	"strings"
+)
+
+// Headers read by WithClaims to override the principal of a single test request.
+const (
+	SubjectHeader = "X-Test-Subject"
+	ScopeHeader   = "X-Test-Scope"
+)
+
+// WithClaims stands in for the JWT middleware in handler tests by storing token claims in the request context.
+// The claims default to the given subject and scopes; a request may override them with the SubjectHeader and
+// the space separated ScopeHeader.
+func WithClaims(next http.Handler, subject string, scopes ...string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims := entity.GetTokenClaims().SetIssuer(subject).SetSubject(subject).SetScope(scopes)
+		defer claims.Release()
+
+		if s := r.Header.Get(SubjectHeader); s != "" {
+			claims.SetIssuer(s).SetSubject(s)
+		}
+		if s, ok := r.Header[http.CanonicalHeaderKey(ScopeHeader)]; ok {
+			claims.SetScope(strings.Fields(strings.Join(s, " ")))
+		}
+		next.ServeHTTP(w, r.WithContext(auth.ContextWithClaims(r.Context(), claims)))
+	})
+}
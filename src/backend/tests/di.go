@@ -1,20 +1,43 @@
 package tests
 
 import (
+	"application/audit"
 	"application/config"
 	"application/dependency"
 	appEvent "application/event"
+	"application/idempotency"
+	"application/organization"
+	"application/requestid"
+	"application/role"
+	"application/signing"
 	"application/vacancy"
+	organizationRepository "domain/organization/repository"
+	roleRepository "domain/role/repository"
+	signingRepository "domain/signing/repository"
 	"domain/vacancy/repository"
+	infraAudit "infrastructure/audit"
 	"infrastructure/database"
 	"infrastructure/event"
+	infraIdempotency "infrastructure/idempotency"
+	infraOrganization "infrastructure/organization"
+	infraRole "infrastructure/role"
+	infraSigning "infrastructure/signing"
 	infraVacancy "infrastructure/vacancy"
+	auditHandlers "interfaces/api/audit/handlers"
+	auditValidators "interfaces/api/audit/validators"
+	organizationHandlers "interfaces/api/organization/handlers"
+	organizationValidators "interfaces/api/organization/validators"
+	roleHandlers "interfaces/api/role/handlers"
+	roleValidators "interfaces/api/role/validators"
+	signingHandlers "interfaces/api/signing/handlers"
+	signingValidators "interfaces/api/signing/validators"
 	"interfaces/api/utils"
 	"interfaces/api/vacancy/handlers"
-	apiValidators "interfaces/api/vacancy/validators"
+	"interfaces/middleware"
 	"log"
 	"log/slog"
 	"os"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 )
@@ -22,19 +45,58 @@ import (
 // TestContainer manages the dependencies for integration tests.
 // It provides lazy initialization for core and domain specific dependencies.
 type TestContainer struct {
-	Config            dependency.LazyDependency[*config.Configuration]
-	DB                dependency.LazyDependency[*pgxpool.Pool]
-	Handler           dependency.LazyDependency[*utils.Handler]
-	Errors            dependency.LazyDependency[*utils.Errors]
-	VacancyRepository dependency.LazyDependency[repository.VacancyRepository]
-	EventDispatcher   dependency.LazyDependency[appEvent.Dispatcher]
-	VacancyValidator  dependency.LazyDependency[*apiValidators.RequestValidator]
-	VacancyService    dependency.LazyDependency[*vacancy.Service]
-	CreateHandler     dependency.LazyDependency[*handlers.CreateVacancyHandler]
-	DeleteHandler     dependency.LazyDependency[*handlers.DeleteVacancyHandler]
-	GetHandler        dependency.LazyDependency[*handlers.GetVacancyHandler]
-	ListHandler       dependency.LazyDependency[*handlers.ListVacancyHandler]
-	UpdateHandler     dependency.LazyDependency[*handlers.UpdateVacancyHandler]
+	Config                dependency.LazyDependency[*config.Configuration]
+	DB                    dependency.LazyDependency[*pgxpool.Pool]
+	Handler               dependency.LazyDependency[*utils.Handler]
+	Errors                dependency.LazyDependency[*utils.Errors]
+	VacancyRepository     dependency.LazyDependency[repository.VacancyRepository]
+	NatsDispatcher        dependency.LazyDependency[*event.NatsEventDispatcher]
+	EventDispatcher       dependency.LazyDependency[appEvent.Dispatcher]
+	VacancyService        dependency.LazyDependency[*vacancy.Service]
+	CreateHandler         dependency.LazyDependency[*handlers.CreateVacancyHandler]
+	DeleteHandler         dependency.LazyDependency[*handlers.DeleteVacancyHandler]
+	GetHandler            dependency.LazyDependency[*handlers.GetVacancyHandler]
+	ListHandler           dependency.LazyDependency[*handlers.ListVacancyHandler]
+	ExportHandler         dependency.LazyDependency[*handlers.ExportVacancyHandler]
+	UpdateHandler         dependency.LazyDependency[*handlers.UpdateVacancyHandler]
+	BatchHandler          dependency.LazyDependency[*handlers.BatchVacancyHandler]
+	ImportHandler         dependency.LazyDependency[*handlers.ImportVacancyHandler]
+	FeedHandler           dependency.LazyDependency[*handlers.FeedVacancyHandler]
+	ChangeStream          dependency.LazyDependency[*vacancy.ChangeStream]
+	StreamHandler         dependency.LazyDependency[*handlers.StreamVacancyHandler]
+	Idempotency           dependency.LazyDependency[*idempotency.Service]
+	IdempotencyMiddleware dependency.LazyDependency[*middleware.IdempotencyMiddleware]
+
+	AuditService      dependency.LazyDependency[*audit.Service]
+	AuditValidator    dependency.LazyDependency[*auditValidators.RequestValidator]
+	ListRecordHandler dependency.LazyDependency[*auditHandlers.ListRecordHandler]
+	AuditMiddleware   dependency.LazyDependency[*middleware.AuditMiddleware]
+
+	RoleRepository          dependency.LazyDependency[roleRepository.RoleRepository]
+	RoleService             dependency.LazyDependency[*role.Service]
+	RoleValidator           dependency.LazyDependency[*roleValidators.RequestValidator]
+	ListRoleHandler         dependency.LazyDependency[*roleHandlers.ListRoleHandler]
+	CreateRoleHandler       dependency.LazyDependency[*roleHandlers.CreateRoleHandler]
+	UpdateRoleHandler       dependency.LazyDependency[*roleHandlers.UpdateRoleHandler]
+	ListAssignmentHandler   dependency.LazyDependency[*roleHandlers.ListAssignmentHandler]
+	CreateAssignmentHandler dependency.LazyDependency[*roleHandlers.CreateAssignmentHandler]
+	DeleteAssignmentHandler dependency.LazyDependency[*roleHandlers.DeleteAssignmentHandler]
+
+	OrganizationRepository    dependency.LazyDependency[organizationRepository.OrganizationRepository]
+	OrganizationService       dependency.LazyDependency[*organization.Service]
+	OrganizationValidator     dependency.LazyDependency[*organizationValidators.RequestValidator]
+	ListOrganizationHandler   dependency.LazyDependency[*organizationHandlers.ListOrganizationHandler]
+	CreateOrganizationHandler dependency.LazyDependency[*organizationHandlers.CreateOrganizationHandler]
+	ListMemberHandler         dependency.LazyDependency[*organizationHandlers.ListMemberHandler]
+	InviteMemberHandler       dependency.LazyDependency[*organizationHandlers.InviteMemberHandler]
+	AcceptInvitationHandler   dependency.LazyDependency[*organizationHandlers.AcceptInvitationHandler]
+
+	SigningKeyRepository dependency.LazyDependency[signingRepository.KeyRepository]
+	SigningKeyService    dependency.LazyDependency[*signing.Service]
+	SigningKeyValidator  dependency.LazyDependency[*signingValidators.RequestValidator]
+	ListKeyHandler       dependency.LazyDependency[*signingHandlers.ListKeyHandler]
+	IssueKeyHandler      dependency.LazyDependency[*signingHandlers.IssueKeyHandler]
+	RevokeKeyHandler     dependency.LazyDependency[*signingHandlers.RevokeKeyHandler]
 }
 
 // NewTestContainer creates a new instance of TestContainer.
@@ -43,6 +105,10 @@ func NewTestContainer() *TestContainer {
 
 	initCoreDependencies(c)
 	initVacancyDomainDependencies(c)
+	initAuditDomainDependencies(c)
+	initRoleDomainDependencies(c)
+	initOrganizationDomainDependencies(c)
+	initSigningDomainDependencies(c)
 
 	return c
 }
@@ -54,8 +120,8 @@ func initVacancyDomainDependencies(c *TestContainer) {
 			return infraVacancy.NewPgxVacancyRepository(c.DB.Get())
 		},
 	}
-	c.EventDispatcher = dependency.LazyDependency[appEvent.Dispatcher]{
-		InitFunc: func() appEvent.Dispatcher {
+	c.NatsDispatcher = dependency.LazyDependency[*event.NatsEventDispatcher]{
+		InitFunc: func() *event.NatsEventDispatcher {
 			d, err := event.NewNatsEventDispatcher(c.Config.Get().Nats.URL)
 			if err != nil {
 				log.Fatalf("Failed to initialize NATS event dispatcher: %v", err)
@@ -63,18 +129,21 @@ func initVacancyDomainDependencies(c *TestContainer) {
 			return d
 		},
 	}
-	c.VacancyValidator = dependency.LazyDependency[*apiValidators.RequestValidator]{
-		InitFunc: apiValidators.NewRequestValidator,
+	c.EventDispatcher = dependency.LazyDependency[appEvent.Dispatcher]{
+		InitFunc: func() appEvent.Dispatcher {
+			return c.NatsDispatcher.Get()
+		},
 	}
 	c.VacancyService = dependency.LazyDependency[*vacancy.Service]{
 		InitFunc: func() *vacancy.Service {
-			return vacancy.NewService(c.VacancyRepository.Get(), c.EventDispatcher.Get())
+			return vacancy.NewService(c.VacancyRepository.Get(), c.EventDispatcher.Get(), c.OrganizationService.Get(),
+				c.AuditService.Get())
 		},
 	}
 	c.CreateHandler = dependency.LazyDependency[*handlers.CreateVacancyHandler]{
 		InitFunc: func() *handlers.CreateVacancyHandler {
 			return handlers.NewCreateVacancyHandler(
-				c.Handler.Get(), c.Errors.Get(), c.VacancyService.Get(), c.VacancyValidator.Get())
+				c.Handler.Get(), c.Errors.Get(), c.VacancyService.Get())
 		},
 	}
 	c.DeleteHandler = dependency.LazyDependency[*handlers.DeleteVacancyHandler]{
@@ -90,20 +159,224 @@ func initVacancyDomainDependencies(c *TestContainer) {
 	c.ListHandler = dependency.LazyDependency[*handlers.ListVacancyHandler]{
 		InitFunc: func() *handlers.ListVacancyHandler {
 			return handlers.NewListVacancyHandler(
-				c.Handler.Get(), c.Errors.Get(), c.VacancyService.Get(), c.VacancyValidator.Get())
+				c.Handler.Get(), c.Errors.Get(), c.VacancyService.Get())
+		},
+	}
+	c.ExportHandler = dependency.LazyDependency[*handlers.ExportVacancyHandler]{
+		InitFunc: func() *handlers.ExportVacancyHandler {
+			return handlers.NewExportVacancyHandler(
+				c.Handler.Get(), c.Errors.Get(), c.VacancyService.Get())
 		},
 	}
 	c.UpdateHandler = dependency.LazyDependency[*handlers.UpdateVacancyHandler]{
 		InitFunc: func() *handlers.UpdateVacancyHandler {
 			return handlers.NewUpdateVacancyHandler(
-				c.Handler.Get(), c.Errors.Get(), c.VacancyService.Get(), c.VacancyValidator.Get())
+				c.Handler.Get(), c.Errors.Get(), c.VacancyService.Get())
+		},
+	}
+	c.BatchHandler = dependency.LazyDependency[*handlers.BatchVacancyHandler]{
+		InitFunc: func() *handlers.BatchVacancyHandler {
+			return handlers.NewBatchVacancyHandler(c.Handler.Get(), c.Errors.Get(), c.VacancyService.Get(),
+				c.Config.Get().Batch.MaxItems)
+		},
+	}
+	c.ImportHandler = dependency.LazyDependency[*handlers.ImportVacancyHandler]{
+		InitFunc: func() *handlers.ImportVacancyHandler {
+			return handlers.NewImportVacancyHandler(c.Handler.Get(), c.Errors.Get(), c.VacancyService.Get(),
+				c.Config.Get().Batch.MaxItems, int64(c.Config.Get().Import.MaxBytes))
+		},
+	}
+	c.FeedHandler = dependency.LazyDependency[*handlers.FeedVacancyHandler]{
+		InitFunc: func() *handlers.FeedVacancyHandler {
+			return handlers.NewFeedVacancyHandler(c.Handler.Get(), c.Errors.Get(), c.VacancyService.Get(),
+				c.Config.Get().Feed.ItemLimit)
+		},
+	}
+	c.ChangeStream = dependency.LazyDependency[*vacancy.ChangeStream]{
+		InitFunc: func() *vacancy.ChangeStream {
+			stream, err := vacancy.NewChangeStream(c.VacancyService.Get(), c.NatsDispatcher.Get(),
+				c.Config.Get().Stream.ReplaySize)
+			if err != nil {
+				log.Fatalf("Failed to initialize vacancy change stream: %v", err)
+			}
+			return stream
+		},
+	}
+	c.StreamHandler = dependency.LazyDependency[*handlers.StreamVacancyHandler]{
+		InitFunc: func() *handlers.StreamVacancyHandler {
+			return handlers.NewStreamVacancyHandler(c.Handler.Get(), c.Errors.Get(), c.ChangeStream.Get(),
+				c.Config.Get().Stream.Heartbeat)
+		},
+	}
+	c.Idempotency = dependency.LazyDependency[*idempotency.Service]{
+		InitFunc: func() *idempotency.Service {
+			return idempotency.NewService(infraIdempotency.NewPgxStore(c.DB.Get(), time.Minute),
+				c.Config.Get().Idempotency.TTL)
+		},
+	}
+	c.IdempotencyMiddleware = dependency.LazyDependency[*middleware.IdempotencyMiddleware]{
+		InitFunc: func() *middleware.IdempotencyMiddleware {
+			return middleware.NewIdempotencyMiddleware(c.Idempotency.Get(), c.Errors.Get())
+		},
+	}
+}
+
+// initAuditDomainDependencies initializes dependencies related to the audit domain.
+func initAuditDomainDependencies(c *TestContainer) {
+	c.AuditService = dependency.LazyDependency[*audit.Service]{
+		InitFunc: func() *audit.Service {
+			return audit.NewService(infraAudit.NewPgxAuditRepository(c.DB.Get()))
+		},
+	}
+	c.AuditValidator = dependency.LazyDependency[*auditValidators.RequestValidator]{
+		InitFunc: auditValidators.NewRequestValidator,
+	}
+	c.ListRecordHandler = dependency.LazyDependency[*auditHandlers.ListRecordHandler]{
+		InitFunc: func() *auditHandlers.ListRecordHandler {
+			return auditHandlers.NewListRecordHandler(
+				c.Handler.Get(), c.Errors.Get(), c.AuditService.Get(), c.AuditValidator.Get())
+		},
+	}
+	c.AuditMiddleware = dependency.LazyDependency[*middleware.AuditMiddleware]{
+		InitFunc: func() *middleware.AuditMiddleware {
+			return middleware.NewAuditMiddleware(c.AuditService.Get())
+		},
+	}
+}
+
+// initRoleDomainDependencies initializes dependencies related to the role domain.
+func initRoleDomainDependencies(c *TestContainer) {
+	c.RoleRepository = dependency.LazyDependency[roleRepository.RoleRepository]{
+		InitFunc: func() roleRepository.RoleRepository {
+			return infraRole.NewPgxRoleRepository(c.DB.Get())
+		},
+	}
+	c.RoleService = dependency.LazyDependency[*role.Service]{
+		InitFunc: func() *role.Service {
+			return role.NewService(c.RoleRepository.Get(), c.AuditService.Get())
+		},
+	}
+	c.RoleValidator = dependency.LazyDependency[*roleValidators.RequestValidator]{
+		InitFunc: roleValidators.NewRequestValidator,
+	}
+	c.ListRoleHandler = dependency.LazyDependency[*roleHandlers.ListRoleHandler]{
+		InitFunc: func() *roleHandlers.ListRoleHandler {
+			return roleHandlers.NewListRoleHandler(c.Handler.Get(), c.Errors.Get(), c.RoleService.Get())
+		},
+	}
+	c.CreateRoleHandler = dependency.LazyDependency[*roleHandlers.CreateRoleHandler]{
+		InitFunc: func() *roleHandlers.CreateRoleHandler {
+			return roleHandlers.NewCreateRoleHandler(
+				c.Handler.Get(), c.Errors.Get(), c.RoleService.Get(), c.RoleValidator.Get())
+		},
+	}
+	c.UpdateRoleHandler = dependency.LazyDependency[*roleHandlers.UpdateRoleHandler]{
+		InitFunc: func() *roleHandlers.UpdateRoleHandler {
+			return roleHandlers.NewUpdateRoleHandler(
+				c.Handler.Get(), c.Errors.Get(), c.RoleService.Get(), c.RoleValidator.Get())
+		},
+	}
+	c.ListAssignmentHandler = dependency.LazyDependency[*roleHandlers.ListAssignmentHandler]{
+		InitFunc: func() *roleHandlers.ListAssignmentHandler {
+			return roleHandlers.NewListAssignmentHandler(
+				c.Handler.Get(), c.Errors.Get(), c.RoleService.Get(), c.RoleValidator.Get())
+		},
+	}
+	c.CreateAssignmentHandler = dependency.LazyDependency[*roleHandlers.CreateAssignmentHandler]{
+		InitFunc: func() *roleHandlers.CreateAssignmentHandler {
+			return roleHandlers.NewCreateAssignmentHandler(
+				c.Handler.Get(), c.Errors.Get(), c.RoleService.Get(), c.RoleValidator.Get())
+		},
+	}
+	c.DeleteAssignmentHandler = dependency.LazyDependency[*roleHandlers.DeleteAssignmentHandler]{
+		InitFunc: func() *roleHandlers.DeleteAssignmentHandler {
+			return roleHandlers.NewDeleteAssignmentHandler(c.Handler.Get(), c.Errors.Get(), c.RoleService.Get())
+		},
+	}
+}
+
+// initOrganizationDomainDependencies initializes dependencies related to the organization domain.
+func initOrganizationDomainDependencies(c *TestContainer) {
+	c.OrganizationRepository = dependency.LazyDependency[organizationRepository.OrganizationRepository]{
+		InitFunc: func() organizationRepository.OrganizationRepository {
+			return infraOrganization.NewPgxOrganizationRepository(c.DB.Get())
+		},
+	}
+	c.OrganizationService = dependency.LazyDependency[*organization.Service]{
+		InitFunc: func() *organization.Service {
+			return organization.NewService(c.OrganizationRepository.Get(), c.AuditService.Get())
+		},
+	}
+	c.OrganizationValidator = dependency.LazyDependency[*organizationValidators.RequestValidator]{
+		InitFunc: organizationValidators.NewRequestValidator,
+	}
+	c.ListOrganizationHandler = dependency.LazyDependency[*organizationHandlers.ListOrganizationHandler]{
+		InitFunc: func() *organizationHandlers.ListOrganizationHandler {
+			return organizationHandlers.NewListOrganizationHandler(
+				c.Handler.Get(), c.Errors.Get(), c.OrganizationService.Get())
+		},
+	}
+	c.CreateOrganizationHandler = dependency.LazyDependency[*organizationHandlers.CreateOrganizationHandler]{
+		InitFunc: func() *organizationHandlers.CreateOrganizationHandler {
+			return organizationHandlers.NewCreateOrganizationHandler(
+				c.Handler.Get(), c.Errors.Get(), c.OrganizationService.Get(), c.OrganizationValidator.Get())
+		},
+	}
+	c.ListMemberHandler = dependency.LazyDependency[*organizationHandlers.ListMemberHandler]{
+		InitFunc: func() *organizationHandlers.ListMemberHandler {
+			return organizationHandlers.NewListMemberHandler(c.Handler.Get(), c.Errors.Get(), c.OrganizationService.Get())
+		},
+	}
+	c.InviteMemberHandler = dependency.LazyDependency[*organizationHandlers.InviteMemberHandler]{
+		InitFunc: func() *organizationHandlers.InviteMemberHandler {
+			return organizationHandlers.NewInviteMemberHandler(
+				c.Handler.Get(), c.Errors.Get(), c.OrganizationService.Get(), c.OrganizationValidator.Get())
+		},
+	}
+	c.AcceptInvitationHandler = dependency.LazyDependency[*organizationHandlers.AcceptInvitationHandler]{
+		InitFunc: func() *organizationHandlers.AcceptInvitationHandler {
+			return organizationHandlers.NewAcceptInvitationHandler(
+				c.Handler.Get(), c.Errors.Get(), c.OrganizationService.Get())
+		},
+	}
+}
+
+// initSigningDomainDependencies initializes dependencies related to the signing domain.
+func initSigningDomainDependencies(c *TestContainer) {
+	c.SigningKeyRepository = dependency.LazyDependency[signingRepository.KeyRepository]{
+		InitFunc: func() signingRepository.KeyRepository {
+			return infraSigning.NewPgxKeyRepository(c.DB.Get())
+		},
+	}
+	c.SigningKeyService = dependency.LazyDependency[*signing.Service]{
+		InitFunc: func() *signing.Service {
+			return signing.NewService(c.SigningKeyRepository.Get(), c.AuditService.Get())
+		},
+	}
+	c.SigningKeyValidator = dependency.LazyDependency[*signingValidators.RequestValidator]{
+		InitFunc: signingValidators.NewRequestValidator,
+	}
+	c.ListKeyHandler = dependency.LazyDependency[*signingHandlers.ListKeyHandler]{
+		InitFunc: func() *signingHandlers.ListKeyHandler {
+			return signingHandlers.NewListKeyHandler(c.Handler.Get(), c.Errors.Get(), c.SigningKeyService.Get())
+		},
+	}
+	c.IssueKeyHandler = dependency.LazyDependency[*signingHandlers.IssueKeyHandler]{
+		InitFunc: func() *signingHandlers.IssueKeyHandler {
+			return signingHandlers.NewIssueKeyHandler(
+				c.Handler.Get(), c.Errors.Get(), c.SigningKeyService.Get(), c.SigningKeyValidator.Get())
+		},
+	}
+	c.RevokeKeyHandler = dependency.LazyDependency[*signingHandlers.RevokeKeyHandler]{
+		InitFunc: func() *signingHandlers.RevokeKeyHandler {
+			return signingHandlers.NewRevokeKeyHandler(c.Handler.Get(), c.Errors.Get(), c.SigningKeyService.Get())
 		},
 	}
 }
 
 // initCoreDependencies initializes core application dependencies.
 func initCoreDependencies(c *TestContainer) {
-	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	logger := slog.New(requestid.NewLogHandler(slog.NewTextHandler(os.Stdout, nil)))
 
 	c.Config = dependency.LazyDependency[*config.Configuration]{
 		InitFunc: config.LoadConfig,
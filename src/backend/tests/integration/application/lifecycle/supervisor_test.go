@@ -0,0 +1,148 @@
+package lifecycle
+
+import (
+	"application/lifecycle"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recorder records the start and stop events of the components in order.
+type recorder struct {
+	mu     sync.Mutex
+	events []string
+}
+
+// hook returns a hook of the named component recording its events.
+func (r *recorder) hook(name string, dependsOn ...string) lifecycle.Hook {
+	return lifecycle.Hook{
+		Name:      name,
+		DependsOn: dependsOn,
+		Start:     func(context.Context) error { r.record("start " + name); return nil },
+		Stop:      func(context.Context) error { r.record("stop " + name); return nil },
+	}
+}
+
+// record appends an event.
+func (r *recorder) record(event string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+}
+
+// newSupervisor returns a supervisor discarding its logs.
+func newSupervisor(timeout time.Duration) *lifecycle.Supervisor {
+	return lifecycle.NewSupervisor(slog.New(slog.NewTextHandler(io.Discard, nil)), timeout)
+}
+
+// TestSupervisor tests the coordinated startup and shutdown of components.
+//
+// This test covers the following scenarios:
+// 1. Components should start after their dependencies and stop in reverse order when the context is canceled.
+// 2. A failing background component should stop the application and be reported.
+// 3. A component missing the shutdown deadline should be forced, and the remaining ones still stopped.
+// 4. A component failing to start should stop the components already started.
+// 5. Unknown dependencies and cycles should fail the startup.
+func TestSupervisor(t *testing.T) {
+	t.Run("Dependency Order", func(t *testing.T) {
+		r := &recorder{}
+		s := newSupervisor(time.Second)
+		s.Register(r.hook("http", "database", "nats"))
+		s.Register(r.hook("nats", "tracer"))
+		s.Register(r.hook("database", "tracer"))
+		s.Register(r.hook("tracer"))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		require.NoError(t, s.Run(ctx))
+		assert.Equal(t, []string{
+			"start tracer", "start database", "start nats", "start http",
+			"stop http", "stop nats", "stop database", "stop tracer",
+		}, r.events)
+	})
+
+	t.Run("Background Failure", func(t *testing.T) {
+		r := &recorder{}
+		s := newSupervisor(time.Second)
+		s.Register(r.hook("database"))
+		s.Register(lifecycle.Hook{
+			Name:      "http",
+			DependsOn: []string{"database"},
+			Start: func(context.Context) error {
+				s.Go("http", func() error { return errors.New("address already in use") })
+				return nil
+			},
+		})
+
+		err := s.Run(context.Background())
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "http: address already in use")
+		assert.Equal(t, []string{"start database", "stop database"}, r.events)
+	})
+
+	t.Run("Forced Stop", func(t *testing.T) {
+		r := &recorder{}
+		forced := make(chan struct{})
+		s := newSupervisor(50 * time.Millisecond)
+		s.Register(r.hook("database"))
+		s.Register(lifecycle.Hook{
+			Name:      "grpc",
+			DependsOn: []string{"database"},
+			Stop: func(ctx context.Context) error {
+				<-forced // A request that never completes
+				return nil
+			},
+			Force: func() { close(forced) },
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		start := time.Now()
+		err := s.Run(ctx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Less(t, time.Since(start), time.Second, "the shutdown should not wait past the deadline")
+
+		select {
+		case <-forced:
+		default:
+			t.Fatal("the component missing the deadline should be forced")
+		}
+		assert.Equal(t, []string{"start database"}, r.events,
+			"components after the deadline should be forced rather than stopped gracefully")
+	})
+
+	t.Run("Failed Start", func(t *testing.T) {
+		r := &recorder{}
+		s := newSupervisor(time.Second)
+		s.Register(r.hook("database"))
+		s.Register(lifecycle.Hook{
+			Name:      "http",
+			DependsOn: []string{"database"},
+			Start:     func(context.Context) error { return errors.New("port unavailable") },
+		})
+
+		err := s.Run(context.Background())
+		assert.ErrorContains(t, err, "failed to start http: port unavailable")
+		assert.Equal(t, []string{"start database", "stop database"}, r.events)
+	})
+
+	t.Run("Invalid Dependencies", func(t *testing.T) {
+		r := &recorder{}
+		s := newSupervisor(time.Second)
+		s.Register(r.hook("http", "database"))
+		assert.ErrorContains(t, s.Start(context.Background()), "depends on unknown component database")
+
+		s = newSupervisor(time.Second)
+		s.Register(r.hook("a", "b"))
+		s.Register(r.hook("b", "a"))
+		assert.ErrorContains(t, s.Start(context.Background()), "dependency cycle")
+		assert.Empty(t, r.events)
+	})
+}
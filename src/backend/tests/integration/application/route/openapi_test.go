@@ -0,0 +1,92 @@
+package route
+
+import (
+	"application"
+	"application/config"
+	"application/route"
+	"domain/auth/entity"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pathParameter matches the parameters of a route pattern, e.g., "/:id", but not the custom methods of a
+// collection, e.g., ":batchCreate" of "/v1/vacancies:batchCreate".
+var pathParameter = regexp.MustCompile(`/:\w+`)
+
+// TestOpenApi_Drift tests that the handlers registered by route.Register and the OpenAPI document describing
+// them agree, so a handler and its description cannot drift apart unnoticed.
+//
+// This test covers the following scenarios:
+// 1. Every documented operation should be routed to a handler.
+// 2. The status, content type and body of the response of every operation should be documented. Path parameters
+// are set to 0, which matches no resource, and bodies are empty objects, so the requests have no side effects.
+// The bodies of event streams are not read, as they do not end.
+// 3. The served document should describe the same paths as the generated one.
+func TestOpenApi_Drift(t *testing.T) {
+	cfg := config.LoadConfig()
+	cfg.Metrics.Port = 0
+	di := application.NewContainer(config.NewLoader(nil), cfg)
+	server := httptest.NewServer(route.Register(di))
+	t.Cleanup(server.Close)
+
+	claims := entity.GetTokenClaims().SetIssuer("api.pulse-finder").SetSubject("test-openapi").
+		SetScope(entity.Scopes()).SetExpiresAt(time.Now().Add(time.Hour).Unix())
+	defer claims.Release()
+	token, err := di.JwtAuthContainer.Get().JwtAuthService.Get().Generate(claims)
+	require.NoError(t, err)
+
+	spec := di.InterfacesContainer.Get().OpenApi.Get()
+	doc := spec.Document()
+	for _, op := range spec.Operations() {
+		t.Run(op.Id, func(t *testing.T) {
+			var body io.Reader
+			if op.Body != nil {
+				body = strings.NewReader("{}")
+			}
+			req, err := http.NewRequest(op.Method, server.URL+pathParameter.ReplaceAllString(op.Path, "/0"), body)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer func() { _ = resp.Body.Close() }()
+
+			// Event streams do not end, so only their status and content type are checked
+			var payload []byte
+			if resp.Header.Get("Content-Type") != "text/event-stream" {
+				payload, err = io.ReadAll(resp.Body)
+				require.NoError(t, err)
+			}
+
+			assert.NotEqual(t, http.StatusMethodNotAllowed, resp.StatusCode, "operation is not routed")
+			o, ok := doc.Operation(op.Method, op.Path)
+			require.True(t, ok)
+			assert.NoError(t, doc.ValidateResponse(o, resp.StatusCode, resp.Header.Get("Content-Type"), payload))
+		})
+	}
+
+	t.Run("Served", func(t *testing.T) {
+		resp, err := http.Get(server.URL + route.OpenApiPath)
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var served struct {
+			Paths map[string]any `json:"paths"`
+		}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&served))
+		for path := range doc.Paths {
+			assert.Contains(t, served.Paths, path)
+		}
+		assert.Len(t, served.Paths, len(doc.Paths))
+	})
+}
@@ -0,0 +1,138 @@
+package vacancy
+
+import (
+	"application/audit"
+	"application/auth"
+	"application/organization"
+	"application/vacancy"
+	"context"
+	"domain"
+	auditEntity "domain/audit/entity"
+	auditRepository "domain/audit/repository"
+	authEntity "domain/auth/entity"
+	organizationRepository "domain/organization/repository"
+	"domain/vacancy/entity"
+	"domain/vacancy/repository"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// errConnectionLost is the error of the batches failing as a whole.
+var errConnectionLost = errors.New("connection lost")
+
+// batchRepository saves the batches of vacancies until failAfter batches were saved, then fails the following
+// ones as a whole.
+type batchRepository struct {
+	repository.VacancyRepository
+	failAfter int
+	saved     int
+	nextId    int64
+}
+
+// SaveBatch saves the vacancies, assigning their IDs, or fails the batch as a whole.
+func (r *batchRepository) SaveBatch(_ context.Context, list []*entity.Vacancy, _ bool) ([]error, error) {
+	if r.saved == r.failAfter {
+		return nil, errConnectionLost
+	}
+	r.saved++
+	for _, v := range list {
+		r.nextId++
+		v.SetId(r.nextId)
+	}
+	return make([]error, len(list)), nil
+}
+
+// auditLog accepts every audit record.
+type auditLog struct {
+	auditRepository.AuditRepository
+}
+
+// Save accepts the record.
+func (auditLog) Save(context.Context, *auditEntity.Record) error {
+	return nil
+}
+
+// dispatcher accepts every event.
+type dispatcher struct{}
+
+// Dispatch accepts the event.
+func (dispatcher) Dispatch(context.Context, domain.Event) error {
+	return nil
+}
+
+// DispatchBatch accepts the events.
+func (dispatcher) DispatchBatch(context.Context, []domain.Event) error {
+	return nil
+}
+
+// newService returns a vacancy service saving the vacancies in the repository.
+func newService(r repository.VacancyRepository) *vacancy.Service {
+	a := audit.NewService(auditLog{})
+	var organizations organizationRepository.OrganizationRepository
+	return vacancy.NewService(r, dispatcher{}, organization.NewService(organizations, a), a)
+}
+
+// adminContext returns a context whose caller holds cross-organization rights.
+func adminContext(t *testing.T) context.Context {
+	claims := authEntity.GetTokenClaims().SetSubject("importer").SetScope([]string{authEntity.ScopeAdmin})
+	t.Cleanup(claims.Release)
+	return auth.ContextWithClaims(context.Background(), claims)
+}
+
+// TestService_ImportVacancies tests importing the vacancies of a file in batches.
+//
+// This test covers the following scenarios:
+// 1. The valid rows should be created in batches and the invalid ones reported with the errors of their fields.
+// 2. A batch failing as a whole should stop the import, reporting its rows as failed along with the rows of the
+// previous batches, which remain imported.
+// 3. A file that cannot be read should return the report of the rows read with ErrImportUnreadable.
+func TestService_ImportVacancies(t *testing.T) {
+	rows := []string{"title,company,description,posted_at,location"}
+	for range 5 {
+		rows = append(rows, "Backend Engineer,Tech Corp,Builds APIs,2025-01-02,Remote")
+	}
+	file := strings.Join(append(rows, ",Tech Corp,No title,2025-01-02,Remote"), "\n")
+	options := vacancy.ImportOptions{Format: vacancy.ImportFormatCSV, BatchSize: 2}
+
+	t.Run("Success", func(t *testing.T) {
+		service := newService(&batchRepository{failAfter: -1})
+		report, err := service.ImportVacancies(adminContext(t), strings.NewReader(file), options)
+		require.NoError(t, err)
+		assert.Equal(t, 6, report.Rows)
+		assert.Equal(t, 5, report.Valid)
+		assert.Equal(t, 5, report.Imported)
+		assert.Equal(t, 1, report.Failed)
+		assert.Equal(t, []vacancy.ImportRowError{{Line: 7, Field: "title", Column: "title",
+			Detail: "title must be provided and cannot be empty or whitespace"}}, report.Errors)
+		assert.Empty(t, report.Aborted)
+	})
+
+	t.Run("Batch Failure", func(t *testing.T) {
+		service := newService(&batchRepository{failAfter: 1})
+		report, err := service.ImportVacancies(adminContext(t), strings.NewReader(file), options)
+		require.ErrorIs(t, err, errConnectionLost)
+		require.NotNil(t, report, "the report of the rows read should be returned")
+		assert.Equal(t, 4, report.Rows)
+		assert.Equal(t, 2, report.Imported)
+		assert.Equal(t, 2, report.Failed)
+		require.Len(t, report.Errors, 2)
+		assert.Equal(t, []int{4, 5}, []int{report.Errors[0].Line, report.Errors[1].Line},
+			"the rows of the failed batch should be reported failed")
+		assert.Equal(t, report.Errors[0].Detail, report.Aborted, "the rows and the report should tell the same reason")
+	})
+
+	t.Run("Unreadable File", func(t *testing.T) {
+		service := newService(&batchRepository{failAfter: -1})
+		ndjson := `{"title":"Data Analyst"}` + "\n" + strings.Repeat("x", 2<<20)
+		report, err := service.ImportVacancies(adminContext(t), strings.NewReader(ndjson),
+			vacancy.ImportOptions{Format: vacancy.ImportFormatNDJSON, BatchSize: 2})
+		require.ErrorIs(t, err, vacancy.ErrImportUnreadable)
+		require.NotNil(t, report)
+		assert.Equal(t, 1, report.Rows)
+		assert.Equal(t, 1, report.Failed)
+	})
+}
@@ -0,0 +1,168 @@
+package certs
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"infrastructure/certs"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeCertificate writes a self-signed certificate with the given serial number and its key to the files.
+func writeCertificate(t *testing.T, certFile, keyFile string, serial int64) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+	require.NoError(t, os.WriteFile(certFile, certPem, 0o600))
+	require.NoError(t, os.WriteFile(keyFile, keyPem, 0o600))
+}
+
+// startEchoServer starts a TLS server echoing the data it receives and returns its address.
+func startEchoServer(t *testing.T, config *tls.Config) string {
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", config)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				_, _ = io.Copy(conn, conn)
+			}()
+		}
+	}()
+	return listener.Addr().String()
+}
+
+// dial connects to the server with the given maximum TLS version and returns the connection.
+func dial(t *testing.T, address string, maxVersion uint16) (*tls.Conn, error) {
+	conn, err := tls.Dial("tcp", address, &tls.Config{
+		InsecureSkipVerify: true, // The test certificates are self-signed
+		MaxVersion:         maxVersion,
+	})
+	if err == nil {
+		t.Cleanup(func() { _ = conn.Close() })
+	}
+	return conn, err
+}
+
+// serial returns the serial number of the certificate served on the connection.
+func serial(conn *tls.Conn) int64 {
+	return conn.ConnectionState().PeerCertificates[0].SerialNumber.Int64()
+}
+
+// echo sends a message on the connection and asserts it is echoed back.
+func echo(t *testing.T, conn *tls.Conn) {
+	_, err := conn.Write([]byte("ping"))
+	require.NoError(t, err)
+	reply := make([]byte, 4)
+	_, err = io.ReadFull(conn, reply)
+	require.NoError(t, err)
+	assert.Equal(t, "ping", string(reply))
+}
+
+// TestManager_Reload tests the reload of the certificate served by the Manager.
+//
+// This test covers the following scenarios:
+// 1. A certificate replaced on disk should be served to new connections once the change is detected.
+// 2. Connections established with the previous certificate should be kept.
+// 3. An invalid certificate should be rejected, keeping the current one.
+func TestManager_Reload(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	writeCertificate(t, certFile, keyFile, 1)
+
+	manager, err := certs.NewManager(certFile, keyFile, certs.WithReloadInterval(20*time.Millisecond))
+	require.NoError(t, err)
+	require.NoError(t, manager.Start(context.Background()))
+	t.Cleanup(func() { _ = manager.Stop(context.Background()) })
+	address := startEchoServer(t, manager.ServerConfig())
+
+	established, err := dial(t, address, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), serial(established))
+
+	t.Run("Changed Files", func(t *testing.T) {
+		// Ensure the modification time differs on file systems with a coarse resolution
+		time.Sleep(10 * time.Millisecond)
+		writeCertificate(t, certFile, keyFile, 2)
+
+		assert.Eventually(t, func() bool {
+			conn, err := dial(t, address, 0)
+			return err == nil && serial(conn) == 2
+		}, 2*time.Second, 20*time.Millisecond)
+		echo(t, established)
+	})
+
+	t.Run("Invalid Files", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(certFile, []byte("not a certificate"), 0o600))
+		require.Error(t, manager.Reload())
+
+		conn, err := dial(t, address, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), serial(conn))
+	})
+}
+
+// TestManager_Options tests the TLS settings of the Manager.
+//
+// This test covers the following scenarios:
+// 1. Clients not supporting the minimum TLS version should be rejected.
+// 2. Unsupported minimum versions and client auth modes should be reported.
+func TestManager_Options(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	writeCertificate(t, certFile, keyFile, 1)
+
+	t.Run("Minimum Version", func(t *testing.T) {
+		manager, err := certs.NewManager(certFile, keyFile, certs.WithMinVersion(certs.Version13))
+		require.NoError(t, err)
+		address := startEchoServer(t, manager.ServerConfig())
+
+		_, err = dial(t, address, tls.VersionTLS12)
+		require.Error(t, err)
+		conn, err := dial(t, address, 0)
+		require.NoError(t, err)
+		assert.Equal(t, uint16(tls.VersionTLS13), conn.ConnectionState().Version)
+	})
+
+	t.Run("Invalid Options", func(t *testing.T) {
+		_, err := certs.NewManager(certFile, keyFile, certs.WithMinVersion("1.0"))
+		require.Error(t, err)
+		_, err = certs.NewManager(certFile, keyFile, certs.WithClientCA(certFile, "optional"))
+		require.Error(t, err)
+	})
+}
@@ -1,16 +1,35 @@
 package handler
 
 import (
+	"application/audit"
 	"application/auth"
 	"application/config"
 	"application/dependency"
+	"application/role"
+	"application/signing"
+	"domain/role/repository"
+	signingRepository "domain/signing/repository"
+	infraAudit "infrastructure/audit"
+	"infrastructure/database"
 	authHandler "infrastructure/grpc/auth/handler"
+	infraRole "infrastructure/role"
+	infraSigning "infrastructure/signing"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // TestContainer holds dependencies for the integration tests.
 type TestContainer struct {
 	Config            dependency.LazyDependency[*config.Configuration]
+	DB                dependency.LazyDependency[*pgxpool.Pool]
 	JwtAuthService    dependency.LazyDependency[*auth.Service]
+	AuditService      dependency.LazyDependency[*audit.Service]
+	RoleRepository    dependency.LazyDependency[repository.RoleRepository]
+	RoleService       dependency.LazyDependency[*role.Service]
+	KeyRepository     dependency.LazyDependency[signingRepository.KeyRepository]
+	Verifier          dependency.LazyDependency[*signing.Verifier]
 	AuthServiceServer dependency.LazyDependency[*authHandler.Service]
 }
 
@@ -21,12 +40,47 @@ func NewTestContainer() *TestContainer {
 	c.Config = dependency.LazyDependency[*config.Configuration]{
 		InitFunc: config.LoadConfig,
 	}
+	c.DB = dependency.LazyDependency[*pgxpool.Pool]{
+		InitFunc: func() *pgxpool.Pool {
+			instance, err := database.NewPostgresDB(c.Config.Get().DB.DSN)
+			if err != nil {
+				log.Fatalf("fail to create postgres db: %v", err)
+			}
+			return instance
+		},
+	}
 	c.JwtAuthService = dependency.LazyDependency[*auth.Service]{
 		InitFunc: func() *auth.Service { return auth.NewService(c.Config.Get()) },
 	}
+	c.AuditService = dependency.LazyDependency[*audit.Service]{
+		InitFunc: func() *audit.Service {
+			return audit.NewService(infraAudit.NewPgxAuditRepository(c.DB.Get()))
+		},
+	}
+	c.RoleRepository = dependency.LazyDependency[repository.RoleRepository]{
+		InitFunc: func() repository.RoleRepository {
+			return infraRole.NewPgxRoleRepository(c.DB.Get())
+		},
+	}
+	c.RoleService = dependency.LazyDependency[*role.Service]{
+		InitFunc: func() *role.Service {
+			return role.NewService(c.RoleRepository.Get(), c.AuditService.Get())
+		},
+	}
+	c.KeyRepository = dependency.LazyDependency[signingRepository.KeyRepository]{
+		InitFunc: func() signingRepository.KeyRepository {
+			return infraSigning.NewPgxKeyRepository(c.DB.Get())
+		},
+	}
+	c.Verifier = dependency.LazyDependency[*signing.Verifier]{
+		InitFunc: func() *signing.Verifier {
+			return signing.NewVerifier(c.KeyRepository.Get(), c.RoleService.Get(), signing.NewMemoryNonceCache(time.Minute),
+				c.Config.Get().Signature.Window)
+		},
+	}
 	c.AuthServiceServer = dependency.LazyDependency[*authHandler.Service]{
 		InitFunc: func() *authHandler.Service {
-			return authHandler.NewService(c.JwtAuthService.Get())
+			return authHandler.NewService(c.JwtAuthService.Get(), c.RoleService.Get(), c.AuditService.Get())
 		},
 	}
 
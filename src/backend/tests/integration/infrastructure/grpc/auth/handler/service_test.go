@@ -18,28 +18,36 @@ import (
 // 1. A valid request with proper inputs returns a valid token.
 // 2. A request with a missing issuer (Issuer) returns an InvalidArgument error.
 // 3. A request with an empty scope returns an InvalidArgument error.
+// 4. A request without scopes returns a token carrying the effective scopes of the issuer.
+// 5. A request for a scope outside the issuer roles returns a PermissionDenied error.
+// 6. A request from an issuer without roles returns a PermissionDenied error.
+// 7. A request without a signature proving the issuer returns an Unauthenticated error.
+// 8. A request for an issuer other than the signing client returns a PermissionDenied error.
 //
 // It uses the SetupTestContainer to initialize dependencies and ensures proper cleanup of resources after the test.
 func TestAuthServiceServer_GenerateToken(t *testing.T) {
-	client := SetupTestContainer(t)
+	clients := SetupTestContainer(t)
 
 	// Define test cases
 	tests := []struct {
 		name        string
+		client      authv1.AuthServiceClient
 		request     *authv1.GenerateTokenRequest
 		expectedErr bool
 		errCode     codes.Code
 	}{
 		{
-			name: "Valid Request",
+			name:   "Valid Request",
+			client: clients.Issuer,
 			request: &authv1.GenerateTokenRequest{
-				Issuer: "test-issuer",
+				Issuer: testIssuer,
 				Scopes: []string{"read", "write"},
 			},
 			expectedErr: false,
 		},
 		{
-			name: "Missing Issuer",
+			name:   "Missing Issuer",
+			client: clients.Issuer,
 			request: &authv1.GenerateTokenRequest{
 				Scopes: []string{"read", "write"},
 			},
@@ -47,13 +55,62 @@ func TestAuthServiceServer_GenerateToken(t *testing.T) {
 			errCode:     codes.InvalidArgument,
 		},
 		{
-			name: "Empty Scope",
+			name:   "Empty Scope",
+			client: clients.Issuer,
 			request: &authv1.GenerateTokenRequest{
 				Issuer: "",
 			},
 			expectedErr: true,
 			errCode:     codes.InvalidArgument,
 		},
+		{
+			name:   "Effective Scopes",
+			client: clients.Issuer,
+			request: &authv1.GenerateTokenRequest{
+				Issuer: testIssuer,
+			},
+			expectedErr: false,
+		},
+		{
+			name:   "Scope Not Granted",
+			client: clients.Issuer,
+			request: &authv1.GenerateTokenRequest{
+				Issuer: testIssuer,
+				Scopes: []string{"read", "admin"},
+			},
+			expectedErr: true,
+			errCode:     codes.PermissionDenied,
+		},
+		{
+			name:   "Issuer Without Roles",
+			client: clients.Unassigned,
+			request: &authv1.GenerateTokenRequest{
+				Issuer: unassignedIssuer,
+				Scopes: []string{"read"},
+			},
+			expectedErr: true,
+			errCode:     codes.PermissionDenied,
+		},
+		{
+			name:   "Unsigned Request",
+			client: clients.Unsigned,
+			request: &authv1.GenerateTokenRequest{
+				Issuer: testIssuer,
+				Scopes: []string{"read"},
+			},
+			expectedErr: true,
+			errCode:     codes.Unauthenticated,
+		},
+		{
+			name:   "Issuer Not Proven",
+			client: clients.Unassigned,
+			request: &authv1.GenerateTokenRequest{
+				Issuer: testIssuer,
+				Scopes: []string{"read"},
+			},
+			expectedErr: true,
+			errCode:     codes.PermissionDenied,
+		},
 	}
 
 	// Execute the test cases
@@ -63,7 +120,7 @@ func TestAuthServiceServer_GenerateToken(t *testing.T) {
 			defer cancel()
 
 			// Make the gRPC call
-			resp, err := client.GenerateToken(ctx, tc.request)
+			resp, err := tc.client.GenerateToken(ctx, tc.request)
 
 			if tc.expectedErr {
 				// Validate the expected error
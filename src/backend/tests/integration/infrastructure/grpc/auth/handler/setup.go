@@ -1,25 +1,53 @@
 package handler
 
 import (
+	"context"
+	"domain/signing/entity"
+	"infrastructure/grpc/authn"
 	authv1 "infrastructure/proto/auth/gen"
 	"net"
 	"testing"
 
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
+// API clients used by the tests, with their signing keys. The test issuer is assigned the editor role for the
+// duration of the tests, the unassigned issuer has no roles.
+const (
+	testIssuer       = "test-issuer"
+	testKeyId        = "test-issuer-key"
+	unassignedIssuer = "test-unassigned-issuer"
+	unassignedKeyId  = "test-unassigned-key"
+	testSecret       = "test-secret"
+)
+
+// TestClients are the clients of the test server: unsigned, and signing every request with the key of the test
+// issuer or of the unassigned issuer.
+type TestClients struct {
+	Unsigned   authv1.AuthServiceClient
+	Issuer     authv1.AuthServiceClient
+	Unassigned authv1.AuthServiceClient
+}
+
 // SetupTestContainer initializes the TestContainer.
-func SetupTestContainer(t *testing.T) authv1.AuthServiceClient {
+func SetupTestContainer(t *testing.T) *TestClients {
 	container := NewTestContainer()
 
+	// Grant the editor role to the test issuer, issue the signing keys and revoke them after the tests
+	seed(container, t)
+	t.Cleanup(func() {
+		teardown(container.DB.Get(), t)
+	})
+
 	// Create a listener for the in-process gRPC server
 	listener, err := net.Listen("tcp", ":0") // Use a random available port
 	require.NoError(t, err, "Failed to create listener")
 
-	// Initialize the gRPC server and register the AuthServiceServer
-	server := grpc.NewServer()
+	// Initialize the gRPC server with the HMAC interceptor proving the issuer and register the AuthServiceServer
+	server := grpc.NewServer(grpc.UnaryInterceptor(authn.HmacInterceptor(container.Verifier.Get())))
 	authService := container.AuthServiceServer.Get()
 	authv1.RegisterAuthServiceServer(server, authService)
 
@@ -34,11 +62,19 @@ func SetupTestContainer(t *testing.T) authv1.AuthServiceClient {
 		server.GracefulStop()
 	})
 
-	// Set up a gRPC client to interact with the server
 	target := listener.Addr().String()
-	opts := []grpc.DialOption{
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	return &TestClients{
+		Unsigned: authv1.NewAuthServiceClient(dial(t, target)),
+		Issuer: authv1.NewAuthServiceClient(dial(t, target,
+			grpc.WithUnaryInterceptor(authn.HmacClientInterceptor(testKeyId, testSecret)))),
+		Unassigned: authv1.NewAuthServiceClient(dial(t, target,
+			grpc.WithUnaryInterceptor(authn.HmacClientInterceptor(unassignedKeyId, testSecret)))),
 	}
+}
+
+// dial sets up an insecure gRPC client connection to the test server and closes it after the test.
+func dial(t *testing.T, target string, opts ...grpc.DialOption) *grpc.ClientConn {
+	opts = append([]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, opts...)
 	conn, err := grpc.NewClient(target, opts...)
 	require.NoError(t, err, "Failed to connect to gRPC server")
 
@@ -47,6 +83,31 @@ func SetupTestContainer(t *testing.T) authv1.AuthServiceClient {
 		err = conn.Close()
 		require.NoError(t, err, "Failed to close gRPC connection")
 	})
+	return conn
+}
+
+// seed assigns the editor role to the test issuer and issues the signing keys of the test clients.
+func seed(container *TestContainer, t *testing.T) {
+	ctx := context.Background()
+	_, err := container.DB.Get().Exec(ctx, `
+		INSERT INTO role_assignments (subject_type, subject_id, role_id)
+		SELECT 'client', $1, id FROM roles WHERE name = 'editor'
+		ON CONFLICT DO NOTHING`, testIssuer)
+	require.NoError(t, err, "Failed to assign the editor role")
+
+	for keyId, clientId := range map[string]string{testKeyId: testIssuer, unassignedKeyId: unassignedIssuer} {
+		key := entity.GetKey().SetKeyId(keyId).SetClientId(clientId).SetSecret(testSecret)
+		err = container.KeyRepository.Get().Save(ctx, key)
+		key.Release()
+		require.NoError(t, err, "Failed to issue signing key")
+	}
+}
 
-	return authv1.NewAuthServiceClient(conn)
+// teardown removes the role assignments of the test issuer and the signing keys of the test clients.
+func teardown(db *pgxpool.Pool, t *testing.T) {
+	ctx := context.Background()
+	_, err := db.Exec(ctx, "DELETE FROM role_assignments WHERE subject_type = 'client' AND subject_id = $1", testIssuer)
+	require.NoError(t, err, "Failed to revoke role assignments")
+	_, err = db.Exec(ctx, "DELETE FROM signing_keys WHERE key_id IN ($1, $2)", testKeyId, unassignedKeyId)
+	require.NoError(t, err, "Failed to delete signing keys")
 }
@@ -0,0 +1,107 @@
+package health
+
+import (
+	"application/config"
+	"application/healthcheck"
+	"context"
+	"errors"
+	"infrastructure/grpc/health"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthv1 "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+const testService = "vacancy.v1.VacancyService"
+
+// setupHealthClient starts a server whose health depends on the given flag, behind an interceptor rejecting every
+// request that does not bypass it, and returns a client of its health service.
+func setupHealthClient(t *testing.T, down *atomic.Bool) healthv1.HealthClient {
+	cfg := &config.Configuration{Health: config.HealthConfig{Timeout: time.Second}}
+	service := healthcheck.NewService(cfg, healthcheck.Checker{
+		Name: "database",
+		Check: func(context.Context) error {
+			if down.Load() {
+				return errors.New("connection refused")
+			}
+			return nil
+		},
+	})
+
+	deny := func(context.Context, interface{}, *grpc.UnaryServerInfo, grpc.UnaryHandler) (interface{}, error) {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	server := grpc.NewServer(grpc.UnaryInterceptor(health.Bypass(deny)))
+	healthv1.RegisterHealthServer(server, health.NewServer(service, 10*time.Millisecond, testService))
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return healthv1.NewHealthClient(conn)
+}
+
+// TestHealthServer tests the grpc.health.v1 protocol.
+//
+// This test covers the following scenarios:
+// 1. The server and its services should be reported serving without credentials while the checks pass.
+// 2. The server should be reported not serving when a check fails.
+// 3. An unknown service should return a NotFound error.
+// 4. Watching a service should stream its status whenever it changes.
+func TestHealthServer(t *testing.T) {
+	var down atomic.Bool
+	client := setupHealthClient(t, &down)
+	ctx := context.Background()
+
+	t.Run("Serving", func(t *testing.T) {
+		for _, name := range []string{"", testService} {
+			resp, err := client.Check(ctx, &healthv1.HealthCheckRequest{Service: name})
+			require.NoError(t, err)
+			assert.Equal(t, healthv1.HealthCheckResponse_SERVING, resp.GetStatus())
+		}
+	})
+
+	t.Run("Not Serving", func(t *testing.T) {
+		down.Store(true)
+		defer down.Store(false)
+
+		resp, err := client.Check(ctx, &healthv1.HealthCheckRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, healthv1.HealthCheckResponse_NOT_SERVING, resp.GetStatus())
+	})
+
+	t.Run("Unknown Service", func(t *testing.T) {
+		_, err := client.Check(ctx, &healthv1.HealthCheckRequest{Service: "unknown.v1.Service"})
+		require.Error(t, err)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("Watch", func(t *testing.T) {
+		watchCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		stream, err := client.Watch(watchCtx, &healthv1.HealthCheckRequest{Service: testService})
+		require.NoError(t, err)
+
+		resp, err := stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, healthv1.HealthCheckResponse_SERVING, resp.GetStatus())
+
+		down.Store(true)
+		defer down.Store(false)
+		resp, err = stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, healthv1.HealthCheckResponse_NOT_SERVING, resp.GetStatus())
+	})
+}
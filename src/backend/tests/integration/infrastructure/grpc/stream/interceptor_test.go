@@ -0,0 +1,99 @@
+package stream
+
+import (
+	"context"
+	"infrastructure/grpc/stream"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// testStream is a server stream whose client sent a single request message.
+type testStream struct {
+	grpc.ServerStream
+}
+
+// Context returns the context of the stream.
+func (s *testStream) Context() context.Context {
+	return context.Background()
+}
+
+// RecvMsg receives the request message.
+func (s *testStream) RecvMsg(interface{}) error {
+	return nil
+}
+
+// testInfo describes the server streaming method of the tests.
+var testInfo = &grpc.StreamServerInfo{FullMethod: "/test.v1.TestService/Watch", IsServerStream: true}
+
+// TestFromUnary_HandlerPanic tests that a panic of the stream handler ends the interceptor with an Internal error
+// before going on.
+func TestFromUnary_HandlerPanic(t *testing.T) {
+	ended := make(chan error, 1)
+	interceptor := stream.FromUnary(func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		ended <- err
+		return resp, err
+	})
+
+	assert.PanicsWithValue(t, "handler failed", func() {
+		_ = interceptor(nil, &testStream{}, testInfo, func(_ interface{}, ss grpc.ServerStream) error {
+			require.NoError(t, ss.RecvMsg(new(struct{})))
+			panic("handler failed")
+		})
+	})
+
+	select {
+	case err := <-ended:
+		assert.Equal(t, codes.Internal, status.Code(err))
+	case <-time.After(time.Second):
+		require.FailNow(t, "the interceptor did not end")
+	}
+}
+
+// TestFromUnary_InterceptorPanic tests that a panic of the interceptor fails the stream with an Internal error,
+// before or after the stream ran.
+func TestFromUnary_InterceptorPanic(t *testing.T) {
+	t.Run("Before", func(t *testing.T) {
+		interceptor := stream.FromUnary(func(
+			context.Context,
+			interface{},
+			*grpc.UnaryServerInfo,
+			grpc.UnaryHandler,
+		) (interface{}, error) {
+			panic("interceptor failed")
+		})
+
+		err := interceptor(nil, &testStream{}, testInfo, func(_ interface{}, ss grpc.ServerStream) error {
+			return ss.RecvMsg(new(struct{}))
+		})
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("After", func(t *testing.T) {
+		interceptor := stream.FromUnary(func(
+			ctx context.Context,
+			req interface{},
+			_ *grpc.UnaryServerInfo,
+			handler grpc.UnaryHandler,
+		) (interface{}, error) {
+			_, _ = handler(ctx, req)
+			panic("interceptor failed")
+		})
+
+		err := interceptor(nil, &testStream{}, testInfo, func(_ interface{}, ss grpc.ServerStream) error {
+			return ss.RecvMsg(new(struct{}))
+		})
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+}
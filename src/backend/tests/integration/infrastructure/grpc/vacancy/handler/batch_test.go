@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"context"
+	"domain/auth/entity"
+	vacancyv1 "infrastructure/proto/vacancy/gen"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestVacancyService_BatchVacancies tests the batch methods of the VacancyServiceServer.
+//
+// This test covers the following scenarios:
+// 1. An atomic batch of valid items creates every vacancy.
+// 2. An atomic batch with an invalid item creates none, the others being aborted.
+// 3. A best-effort batch updates the existing vacancies and reports the missing ones as not found.
+// 4. A best-effort batch deletes the existing vacancies and reports the missing ones as not found.
+// 5. An empty batch is rejected with an InvalidArgument error.
+func TestVacancyService_BatchVacancies(t *testing.T) {
+	client, jwtService := SetupTestContainer(t)
+
+	claims := entity.GetTokenClaims().
+		SetIssuer("test-issuer").
+		SetScope([]string{entity.ScopeWrite, entity.ScopeDelete, entity.ScopePurge}).
+		SetExpiresAt(time.Now().Add(time.Hour).Unix())
+	token, err := jwtService.Generate(claims)
+	require.NoError(t, err, "could not generate token")
+	ctx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+
+	vacancy := func(title string) *vacancyv1.CreateVacancyRequest {
+		return &vacancyv1.CreateVacancyRequest{
+			Title:       title,
+			Company:     "Tech Co.",
+			Description: "Works on the batch API.",
+			PostedAt:    "2025-01-01",
+			Location:    "Remote",
+		}
+	}
+
+	// Every item is created
+	created, err := client.BatchCreateVacancies(ctx, &vacancyv1.BatchCreateVacanciesRequest{
+		Items: []*vacancyv1.CreateVacancyRequest{vacancy("Engineer"), vacancy("Tester")},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, vacancyv1.BatchMode_BATCH_MODE_ATOMIC, created.GetMode())
+	assert.Equal(t, int32(2), created.GetSucceeded())
+	first, second := created.GetItems()[0].GetId(), created.GetItems()[1].GetId()
+	assert.NotZero(t, first)
+	assert.NotZero(t, second)
+
+	// An invalid item aborts the others
+	aborted, err := client.BatchCreateVacancies(ctx, &vacancyv1.BatchCreateVacanciesRequest{
+		Items: []*vacancyv1.CreateVacancyRequest{vacancy("Designer"), vacancy("")},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), aborted.GetFailed())
+	assert.Equal(t, int32(codes.Aborted), aborted.GetItems()[0].GetCode())
+	assert.Equal(t, int32(codes.InvalidArgument), aborted.GetItems()[1].GetCode())
+	assert.NotEmpty(t, aborted.GetItems()[1].GetErrors())
+
+	// Updating a missing vacancy fails on its own
+	updated, err := client.BatchUpdateVacancies(ctx, &vacancyv1.BatchUpdateVacanciesRequest{
+		Mode: vacancyv1.BatchMode_BATCH_MODE_BEST_EFFORT,
+		Items: []*vacancyv1.UpdateVacancyRequest{
+			{Id: first, Title: proto.String("Senior Engineer")},
+			{Id: 9999, Title: proto.String("Ghost")},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(codes.OK), updated.GetItems()[0].GetCode())
+	assert.Equal(t, int32(codes.NotFound), updated.GetItems()[1].GetCode())
+
+	// Deleting a missing vacancy fails on its own
+	deleted, err := client.BatchDeleteVacancies(ctx, &vacancyv1.BatchDeleteVacanciesRequest{
+		Mode: vacancyv1.BatchMode_BATCH_MODE_BEST_EFFORT,
+		Ids:  []int64{first, second, 9999},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), deleted.GetSucceeded())
+	assert.Equal(t, int32(codes.NotFound), deleted.GetItems()[2].GetCode())
+
+	// An empty batch is rejected
+	_, err = client.BatchDeleteVacancies(ctx, &vacancyv1.BatchDeleteVacanciesRequest{})
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -1,18 +1,31 @@
 package handler
 
 import (
+	"application/audit"
 	"application/auth"
+	"application/certauth"
 	"application/config"
 	"application/dependency"
 	appEvent "application/event"
+	"application/idempotency"
+	"application/organization"
+	"application/role"
+	"application/signing"
 	"application/vacancy"
+	signingRepository "domain/signing/repository"
 	"domain/vacancy/repository"
+	infraAudit "infrastructure/audit"
 	"infrastructure/database"
 	"infrastructure/event"
 	vacancyHandler "infrastructure/grpc/vacancy/handler"
 	"infrastructure/grpc/vacancy/validators"
+	infraIdempotency "infrastructure/idempotency"
+	infraOrganization "infrastructure/organization"
+	infraRole "infrastructure/role"
+	infraSigning "infrastructure/signing"
 	infraVacancy "infrastructure/vacancy"
 	"log"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 )
@@ -23,10 +36,16 @@ type TestContainer struct {
 	JwtService           dependency.LazyDependency[*auth.Service]
 	EventDispatcher      dependency.LazyDependency[appEvent.Dispatcher]
 	DB                   dependency.LazyDependency[*pgxpool.Pool]
+	AuditService         dependency.LazyDependency[*audit.Service]
 	VacancyRepository    dependency.LazyDependency[repository.VacancyRepository]
+	OrganizationService  dependency.LazyDependency[*organization.Service]
 	VacancyService       dependency.LazyDependency[*vacancy.Service]
 	Validator            dependency.LazyDependency[validators.Validator]
 	VacancyServiceServer dependency.LazyDependency[*vacancyHandler.VacancyService]
+	KeyRepository        dependency.LazyDependency[signingRepository.KeyRepository]
+	Verifier             dependency.LazyDependency[*signing.Verifier]
+	CertificateMapper    dependency.LazyDependency[*certauth.Mapper]
+	Idempotency          dependency.LazyDependency[*idempotency.Service]
 }
 
 // NewTestContainer initializes a new test container.
@@ -57,19 +76,32 @@ func NewTestContainer() *TestContainer {
 			return instance
 		},
 	}
+	c.AuditService = dependency.LazyDependency[*audit.Service]{
+		InitFunc: func() *audit.Service {
+			return audit.NewService(infraAudit.NewPgxAuditRepository(c.DB.Get()))
+		},
+	}
 	c.VacancyRepository = dependency.LazyDependency[repository.VacancyRepository]{
 		InitFunc: func() repository.VacancyRepository {
 			return infraVacancy.NewPgxVacancyRepository(c.DB.Get())
 		},
 	}
+	c.OrganizationService = dependency.LazyDependency[*organization.Service]{
+		InitFunc: func() *organization.Service {
+			return organization.NewService(
+				infraOrganization.NewPgxOrganizationRepository(c.DB.Get()),
+				c.AuditService.Get())
+		},
+	}
 	c.VacancyService = dependency.LazyDependency[*vacancy.Service]{
 		InitFunc: func() *vacancy.Service {
-			return vacancy.NewService(c.VacancyRepository.Get(), c.EventDispatcher.Get())
+			return vacancy.NewService(c.VacancyRepository.Get(), c.EventDispatcher.Get(), c.OrganizationService.Get(),
+				c.AuditService.Get())
 		},
 	}
 	c.Validator = dependency.LazyDependency[validators.Validator]{
 		InitFunc: func() validators.Validator {
-			return validators.NewVacancyValidator()
+			return validators.NewVacancyValidator(c.Config.Get().Batch.MaxItems)
 		},
 	}
 	c.VacancyServiceServer = dependency.LazyDependency[*vacancyHandler.VacancyService]{
@@ -77,6 +109,32 @@ func NewTestContainer() *TestContainer {
 			return vacancyHandler.NewVacancyService(c.VacancyService.Get(), c.Validator.Get())
 		},
 	}
+	c.KeyRepository = dependency.LazyDependency[signingRepository.KeyRepository]{
+		InitFunc: func() signingRepository.KeyRepository {
+			return infraSigning.NewPgxKeyRepository(c.DB.Get())
+		},
+	}
+	c.Verifier = dependency.LazyDependency[*signing.Verifier]{
+		InitFunc: func() *signing.Verifier {
+			roles := role.NewService(infraRole.NewPgxRoleRepository(c.DB.Get()),
+				c.AuditService.Get())
+			return signing.NewVerifier(c.KeyRepository.Get(), roles, signing.NewMemoryNonceCache(time.Minute),
+				c.Config.Get().Signature.Window)
+		},
+	}
+	c.CertificateMapper = dependency.LazyDependency[*certauth.Mapper]{
+		InitFunc: func() *certauth.Mapper {
+			roles := role.NewService(infraRole.NewPgxRoleRepository(c.DB.Get()),
+				c.AuditService.Get())
+			return certauth.NewMapper(map[string]string{testCertIdentity: testClientId}, roles)
+		},
+	}
+	c.Idempotency = dependency.LazyDependency[*idempotency.Service]{
+		InitFunc: func() *idempotency.Service {
+			return idempotency.NewService(infraIdempotency.NewPgxStore(c.DB.Get(), time.Minute),
+				c.Config.Get().Idempotency.TTL)
+		},
+	}
 
 	return c
 }
@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"context"
+	"domain/auth/entity"
+	"errors"
+	vacancyv1 "infrastructure/proto/vacancy/gen"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// TestVacancyService_ExportVacancies tests the ExportVacancies method of the VacancyServiceServer.
+//
+// This test covers the following scenarios:
+// 1. The vacancies matching the filters should be streamed with the selected fields set, in the requested order,
+// compressed if the client asks for it.
+// 2. A request without a token, or with a token lacking the "read" scope, should be rejected before streaming.
+// 3. A request with an unknown column should return an InvalidArgument error.
+func TestVacancyService_ExportVacancies(t *testing.T) {
+	client, jwtService := SetupTestContainer(t)
+
+	withScope := func(scope string) context.Context {
+		claims := entity.GetTokenClaims().
+			SetIssuer("test-issuer").
+			SetScope([]string{scope}).
+			SetExpiresAt(time.Now().Add(time.Hour).Unix())
+		token, err := jwtService.Generate(claims)
+		require.NoError(t, err, "could not generate token")
+		return metadata.NewOutgoingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	}
+	for _, title := range []string{"Backend Engineer", "Frontend Engineer", "Data Analyst"} {
+		_, err := client.CreateVacancy(withScope(entity.ScopeWrite), &vacancyv1.CreateVacancyRequest{
+			Title:       title,
+			Company:     "Tech Co.",
+			Description: "Exported over gRPC.",
+			PostedAt:    "2025-01-01",
+			Location:    "Remote",
+		})
+		require.NoError(t, err)
+	}
+	export := func(ctx context.Context, req *vacancyv1.ExportVacanciesRequest) ([]*vacancyv1.ExportedVacancy, error) {
+		stream, err := client.ExportVacancies(ctx, req, grpc.UseCompressor(gzip.Name))
+		require.NoError(t, err)
+		var list []*vacancyv1.ExportedVacancy
+		for {
+			v, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return list, nil
+			}
+			if err != nil {
+				return list, err
+			}
+			list = append(list, v)
+		}
+	}
+
+	t.Run("Streamed", func(t *testing.T) {
+		list, err := export(withScope(entity.ScopeRead), &vacancyv1.ExportVacanciesRequest{
+			Title:     "engineer",
+			SortField: "title",
+			SortOrder: "desc",
+			Columns:   []string{"title", "posted_at"},
+		})
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		assert.Equal(t, "Frontend Engineer", list[0].Title)
+		assert.Equal(t, "Backend Engineer", list[1].Title)
+		assert.Equal(t, "2025-01-01", list[0].PostedAt)
+		assert.Zero(t, list[0].Id, "unselected columns should not be set")
+		assert.Empty(t, list[0].Company, "unselected columns should not be set")
+	})
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		_, err := export(context.Background(), &vacancyv1.ExportVacanciesRequest{})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("Missing Scope", func(t *testing.T) {
+		_, err := export(withScope(entity.ScopeWrite), &vacancyv1.ExportVacanciesRequest{})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Unknown Column", func(t *testing.T) {
+		_, err := export(withScope(entity.ScopeRead), &vacancyv1.ExportVacanciesRequest{Columns: []string{"salary"}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"context"
+	"domain/auth/entity"
+	vacancyv1 "infrastructure/proto/vacancy/gen"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestVacancyService_Idempotency tests the creation of vacancies with an idempotency-key in the metadata.
+//
+// This test covers the following scenarios:
+// 1. A retry should get the response of the original request, marked as replayed, without creating a vacancy.
+// 2. Reusing a key with another request should fail with InvalidArgument.
+// 3. A rejected request should be replayed with its error.
+func TestVacancyService_Idempotency(t *testing.T) {
+	container, target := startServer(t)
+	client := vacancyv1.NewVacancyServiceClient(dial(t, target))
+
+	claims := entity.GetTokenClaims().
+		SetIssuer("test-issuer").
+		SetSubject("test-client").
+		SetScope([]string{entity.ScopeWrite}).
+		SetExpiresAt(time.Now().Add(time.Hour).Unix())
+	token, err := container.JwtService.Get().Generate(claims)
+	require.NoError(t, err, "could not generate token")
+	withKey := func(key string) context.Context {
+		return metadata.NewOutgoingContext(context.Background(),
+			metadata.Pairs("authorization", "Bearer "+token, "idempotency-key", key))
+	}
+	request := &vacancyv1.CreateVacancyRequest{
+		Title:       "Engineer",
+		Company:     "Tech Co.",
+		Description: "Works on the idempotent API.",
+		PostedAt:    "2025-01-01",
+		Location:    "Remote",
+	}
+	count := func() int {
+		var n int
+		require.NoError(t, container.DB.Get().QueryRow(context.Background(),
+			"SELECT COUNT(*) FROM job_vacancies").Scan(&n))
+		return n
+	}
+
+	t.Run("Replayed", func(t *testing.T) {
+		var header metadata.MD
+		original, err := client.CreateVacancy(withKey("test-key-1"), request, grpc.Header(&header))
+		require.NoError(t, err)
+		assert.Empty(t, header.Get("idempotent-replayed"))
+
+		retry, err := client.CreateVacancy(withKey("test-key-1"), request, grpc.Header(&header))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"true"}, header.Get("idempotent-replayed"))
+		assert.True(t, proto.Equal(original, retry))
+		assert.Equal(t, 1, count())
+	})
+
+	t.Run("Key Reused", func(t *testing.T) {
+		other := proto.Clone(request).(*vacancyv1.CreateVacancyRequest)
+		other.Location = "Berlin"
+		_, err := client.CreateVacancy(withKey("test-key-1"), other)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, 1, count())
+	})
+
+	t.Run("Error Replayed", func(t *testing.T) {
+		invalid := proto.Clone(request).(*vacancyv1.CreateVacancyRequest)
+		invalid.Title = ""
+		_, err := client.CreateVacancy(withKey("test-key-2"), invalid)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		var header metadata.MD
+		_, retryErr := client.CreateVacancy(withKey("test-key-2"), invalid, grpc.Header(&header))
+		assert.Equal(t, status.Convert(err).Proto(), status.Convert(retryErr).Proto())
+		assert.Equal(t, []string{"true"}, header.Get("idempotent-replayed"))
+	})
+}
@@ -0,0 +1,163 @@
+package handler
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"infrastructure/grpc/mtls"
+	vacancyv1 "infrastructure/proto/vacancy/gen"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+)
+
+// testPKI holds a certificate authority issuing the server and client certificates of a test.
+type testPKI struct {
+	dir    string
+	ca     *x509.Certificate
+	caKey  *ecdsa.PrivateKey
+	caPool *x509.CertPool
+	serial int64
+}
+
+// newTestPKI creates a certificate authority and writes its certificate to a temporary directory.
+func newTestPKI(t *testing.T) *testPKI {
+	p := &testPKI{dir: t.TempDir(), caPool: x509.NewCertPool()}
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	p.ca, err = x509.ParseCertificate(der)
+	require.NoError(t, err)
+	p.caKey, p.serial = key, 1
+	p.caPool.AddCert(p.ca)
+
+	p.write(t, "ca.pem", "CERTIFICATE", der)
+	return p
+}
+
+// issue creates a certificate signed by the CA and returns it with the paths of its PEM files.
+func (p *testPKI) issue(t *testing.T, name string, usage x509.ExtKeyUsage) (tls.Certificate, string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	p.serial++
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(p.serial),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		IPAddresses:  []net.IP{net.IPv6loopback, net.IPv4(127, 0, 0, 1)},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, p.ca, &key.PublicKey, p.caKey)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile := p.write(t, name+".pem", "CERTIFICATE", der)
+	keyFile := p.write(t, name+"-key.pem", "EC PRIVATE KEY", keyDer)
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	require.NoError(t, err)
+	return cert, certFile, keyFile
+}
+
+// write stores a PEM block in the PKI directory and returns its path.
+func (p *testPKI) write(t *testing.T, name, blockType string, der []byte) string {
+	path := filepath.Join(p.dir, name)
+	err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600)
+	require.NoError(t, err)
+	return path
+}
+
+// setupMtlsClient starts a test server with mutual TLS in the given mode and returns a client connecting to it,
+// presenting a certificate issued to the given identity, or none if the identity is empty.
+func setupMtlsClient(t *testing.T, mode, identity string) vacancyv1.VacancyServiceClient {
+	pki := newTestPKI(t)
+	_, certFile, keyFile := pki.issue(t, "localhost", x509.ExtKeyUsageServerAuth)
+
+	creds, err := mtls.ServerCredentials(certFile, keyFile, filepath.Join(pki.dir, "ca.pem"), mode)
+	require.NoError(t, err)
+	_, target := startServer(t, grpc.Creds(creds))
+
+	config := &tls.Config{RootCAs: pki.caPool, ServerName: "localhost", MinVersion: tls.VersionTLS12}
+	if identity != "" {
+		cert, _, _ := pki.issue(t, identity, x509.ExtKeyUsageClientAuth)
+		config.Certificates = []tls.Certificate{cert}
+	}
+	conn := dial(t, target, grpc.WithTransportCredentials(credentials.NewTLS(config)))
+	return vacancyv1.NewVacancyServiceClient(conn)
+}
+
+// TestVacancyService_MutualTLS tests requests authenticated by client certificates.
+//
+// This test covers the following scenarios:
+// 1. A client certificate mapped to the scraper bot should successfully create a vacancy without a token.
+// 2. A client certificate without a mapped identity and without a token should return an Unauthenticated error.
+// 3. A client without a certificate should be rejected when certificates are required.
+// 4. A client without a certificate and without a token should return an Unauthenticated error when
+// certificates are only verified if given.
+func TestVacancyService_MutualTLS(t *testing.T) {
+	request := &vacancyv1.CreateVacancyRequest{
+		Title:       "Software Engineer",
+		Company:     "Tech Co.",
+		Description: "Exciting opportunity in tech.",
+		PostedAt:    "2025-01-01",
+		Location:    "New York",
+	}
+
+	t.Run("Mapped Certificate", func(t *testing.T) {
+		client := setupMtlsClient(t, mtls.ModeRequire, testCertIdentity)
+		resp, err := client.CreateVacancy(context.Background(), request)
+		require.NoError(t, err)
+		assert.Equal(t, request.Title, resp.Title)
+	})
+
+	t.Run("Unmapped Certificate", func(t *testing.T) {
+		client := setupMtlsClient(t, mtls.ModeRequire, "test-unknown.pulse-finder")
+		_, err := client.CreateVacancy(context.Background(), request)
+		require.Error(t, err)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("Missing Certificate When Required", func(t *testing.T) {
+		client := setupMtlsClient(t, mtls.ModeRequire, "")
+		_, err := client.CreateVacancy(context.Background(), request)
+		require.Error(t, err)
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+
+	t.Run("Missing Certificate When Verified If Given", func(t *testing.T) {
+		client := setupMtlsClient(t, mtls.ModeVerifyIfGiven, "")
+		_, err := client.CreateVacancy(context.Background(), request)
+		require.Error(t, err)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
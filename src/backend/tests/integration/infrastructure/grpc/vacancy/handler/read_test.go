@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"context"
+	"domain/auth/entity"
+	vacancyv1 "infrastructure/proto/vacancy/gen"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// TestVacancyService_ReadVacancies tests the GetVacancy and ListVacancies methods of the VacancyServiceServer.
+//
+// This test covers the following scenarios:
+// 1. A vacancy retrieved with a read mask should have the fields of the mask set only.
+// 2. The listed vacancies should match the filters, sorted and paginated, with the fields of the mask set only.
+// 3. A request without a read mask should get every field.
+// 4. A read mask with an unknown field should return an InvalidArgument error, and an unknown ID NotFound.
+func TestVacancyService_ReadVacancies(t *testing.T) {
+	client, jwtService := SetupTestContainer(t)
+
+	claims := entity.GetTokenClaims().
+		SetIssuer("test-issuer").
+		SetScope([]string{entity.ScopeRead, entity.ScopeWrite}).
+		SetExpiresAt(time.Now().Add(time.Hour).Unix())
+	token, err := jwtService.Generate(claims)
+	require.NoError(t, err, "could not generate token")
+	ctx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+
+	var ids []int64
+	for _, title := range []string{"Masked Backend Engineer", "Masked Frontend Engineer"} {
+		created, err := client.CreateVacancy(ctx, &vacancyv1.CreateVacancyRequest{
+			Title:       title,
+			Company:     "Mask Co.",
+			Description: "Read over gRPC.",
+			PostedAt:    "2025-01-01",
+			Location:    "Remote",
+		})
+		require.NoError(t, err)
+		ids = append(ids, created.Id)
+	}
+
+	t.Run("Get", func(t *testing.T) {
+		v, err := client.GetVacancy(ctx, &vacancyv1.GetVacancyRequest{
+			Id:       ids[0],
+			ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"id", "title"}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, ids[0], v.Id)
+		assert.Equal(t, "Masked Backend Engineer", v.Title)
+		assert.Empty(t, v.Description, "fields out of the read mask should not be set")
+		assert.Empty(t, v.PostedAt, "fields out of the read mask should not be set")
+	})
+
+	t.Run("List", func(t *testing.T) {
+		resp, err := client.ListVacancies(ctx, &vacancyv1.ListVacanciesRequest{
+			Title:     "masked",
+			SortField: "title",
+			SortOrder: "asc",
+			PageSize:  1,
+			Page:      2,
+			ReadMask:  &fieldmaskpb.FieldMask{Paths: []string{"title", "location"}},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Vacancies, 1)
+		assert.Equal(t, "Masked Frontend Engineer", resp.Vacancies[0].Title)
+		assert.Equal(t, "Remote", resp.Vacancies[0].Location)
+		assert.Zero(t, resp.Vacancies[0].Id, "fields out of the read mask should not be set")
+		assert.Empty(t, resp.Vacancies[0].Company, "fields out of the read mask should not be set")
+	})
+
+	t.Run("Every Field", func(t *testing.T) {
+		v, err := client.GetVacancy(ctx, &vacancyv1.GetVacancyRequest{Id: ids[1]})
+		require.NoError(t, err)
+		assert.Equal(t, "Mask Co.", v.Company)
+		assert.Equal(t, "2025-01-01", v.PostedAt)
+		assert.Positive(t, v.Version)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := client.GetVacancy(ctx, &vacancyv1.GetVacancyRequest{
+			Id:       ids[0],
+			ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"salary"}},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = client.ListVacancies(ctx, &vacancyv1.ListVacanciesRequest{PageSize: 1_000})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = client.GetVacancy(ctx, &vacancyv1.GetVacancyRequest{Id: 1 << 40})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
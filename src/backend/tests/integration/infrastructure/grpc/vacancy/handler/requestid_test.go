@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"application/requestid"
+	"context"
+	grpcRequestId "infrastructure/grpc/requestid"
+	vacancyv1 "infrastructure/proto/vacancy/gen"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// TestVacancyService_RequestId tests the propagation of request IDs to the VacancyServiceServer.
+//
+// This test covers the following scenarios:
+// 1. The request ID of the client context should be returned in the response header and recorded with
+// the authentication failure in the audit log.
+// 2. A request without an ID should be assigned a generated one.
+func TestVacancyService_RequestId(t *testing.T) {
+	container, target := startServer(t)
+	client := vacancyv1.NewVacancyServiceClient(
+		dial(t, target, grpc.WithUnaryInterceptor(grpcRequestId.UnaryClientInterceptor())))
+	request := &vacancyv1.DeleteVacancyRequest{Id: 1}
+
+	t.Run("Propagated Request ID", func(t *testing.T) {
+		id := "test-grpc-request-1"
+		var header metadata.MD
+		_, err := client.DeleteVacancy(requestid.ContextWithId(context.Background(), id), request, grpc.Header(&header))
+		require.Error(t, err)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		assert.Equal(t, []string{id}, header.Get("x-request-id"))
+
+		var action, outcome string
+		err = container.DB.Get().QueryRow(context.Background(),
+			"SELECT action, outcome FROM audit_log WHERE request_id = $1", id).Scan(&action, &outcome)
+		require.NoError(t, err, "the authentication failure should be recorded with the request ID")
+		assert.Equal(t, "auth.failure", action)
+		assert.Equal(t, "failure", outcome)
+	})
+
+	t.Run("Generated Request ID", func(t *testing.T) {
+		var header metadata.MD
+		_, err := client.DeleteVacancy(context.Background(), request, grpc.Header(&header))
+		require.Error(t, err)
+		require.Len(t, header.Get("x-request-id"), 1)
+		assert.Len(t, header.Get("x-request-id")[0], 32)
+	})
+}
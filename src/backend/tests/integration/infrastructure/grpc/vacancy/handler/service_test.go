@@ -24,6 +24,7 @@ import (
 // 4. A request with an expired token should return an Unauthenticated error.
 // 5. A request missing the required "Title" field should return an InvalidArgument error.
 // 6. A request with an invalid date format for the "PostedAt" field should return an InvalidArgument error.
+// 7. A request with a token lacking the "write" scope should return a PermissionDenied error.
 //
 // The test uses the SetupTestContainer function to initialize the test dependencies and ensures proper cleanup of resources.
 func TestVacancyService_CreateVacancy(t *testing.T) {
@@ -32,7 +33,7 @@ func TestVacancyService_CreateVacancy(t *testing.T) {
 	// Generate a valid token
 	validClaims := entity.GetTokenClaims().
 		SetIssuer("test-issuer").
-		SetScope([]string{"test"}).
+		SetScope([]string{entity.ScopeWrite, entity.ScopeDelete, entity.ScopePurge}).
 		SetExpiresAt(time.Now().Add(time.Hour).Unix())
 	validToken, err := jwtService.Generate(validClaims)
 	require.NoError(t, err, "could not generate token")
@@ -40,11 +41,19 @@ func TestVacancyService_CreateVacancy(t *testing.T) {
 	// Generate an expired token
 	expiredClaims := entity.GetTokenClaims().
 		SetIssuer("test-issuer").
-		SetScope([]string{"test"}).
+		SetScope([]string{entity.ScopeWrite, entity.ScopeDelete, entity.ScopePurge}).
 		SetExpiresAt(time.Now().Add(-time.Hour).Unix())
 	expiredToken, err := jwtService.Generate(expiredClaims)
 	require.NoError(t, err, "could not generate expired token")
 
+	// Generate a token without the write scope
+	readOnlyClaims := entity.GetTokenClaims().
+		SetIssuer("test-issuer").
+		SetScope([]string{entity.ScopeRead}).
+		SetExpiresAt(time.Now().Add(time.Hour).Unix())
+	readOnlyToken, err := jwtService.Generate(readOnlyClaims)
+	require.NoError(t, err, "could not generate read-only token")
+
 	// Define test cases
 	tests := []struct {
 		name        string
@@ -117,6 +126,19 @@ func TestVacancyService_CreateVacancy(t *testing.T) {
 			expectedErr: true,
 			errCode:     codes.InvalidArgument,
 		},
+		{
+			name:  "Missing Write Scope",
+			token: readOnlyToken,
+			request: &vacancyv1.CreateVacancyRequest{
+				Title:       "Software Engineer",
+				Company:     "Tech Co.",
+				Description: "Exciting opportunity in tech.",
+				PostedAt:    "2025-01-01",
+				Location:    "New York",
+			},
+			expectedErr: true,
+			errCode:     codes.PermissionDenied,
+		},
 	}
 
 	// Execute test cases
@@ -166,7 +188,7 @@ func TestVacancyService_DeleteVacancy(t *testing.T) {
 	// Generate a valid token
 	claims := entity.GetTokenClaims().
 		SetIssuer("test-issuer").
-		SetScope([]string{"test"}).
+		SetScope([]string{entity.ScopeWrite, entity.ScopeDelete, entity.ScopePurge}).
 		SetExpiresAt(time.Now().Add(time.Hour).Unix())
 	validToken, err := jwtService.Generate(claims)
 	require.NoError(t, err, "could not generate token")
@@ -268,7 +290,7 @@ func TestVacancyService_PurgeVacancies(t *testing.T) {
 	// Generate a valid token
 	validClaims := entity.GetTokenClaims().
 		SetIssuer("test-issuer").
-		SetScope([]string{"test"}).
+		SetScope([]string{entity.ScopeWrite, entity.ScopeDelete, entity.ScopePurge}).
 		SetExpiresAt(time.Now().Add(time.Hour).Unix())
 	validToken, err := jwtService.Generate(validClaims)
 	require.NoError(t, err, "could not generate token")
@@ -276,7 +298,7 @@ func TestVacancyService_PurgeVacancies(t *testing.T) {
 	// Generate an expired token
 	expiredClaims := entity.GetTokenClaims().
 		SetIssuer("test-issuer").
-		SetScope([]string{"test"}).
+		SetScope([]string{entity.ScopeWrite, entity.ScopeDelete, entity.ScopePurge}).
 		SetExpiresAt(time.Now().Add(-time.Hour).Unix())
 	expiredToken, err := jwtService.Generate(expiredClaims)
 	require.NoError(t, err, "could not generate token")
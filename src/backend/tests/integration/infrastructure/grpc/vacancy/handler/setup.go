@@ -3,6 +3,13 @@ package handler
 import (
 	"application/auth"
 	"context"
+	"domain/signing/entity"
+	auditInterceptor "infrastructure/grpc/audit"
+	"infrastructure/grpc/authn"
+	grpcIdempotency "infrastructure/grpc/idempotency"
+	"infrastructure/grpc/requestid"
+	"infrastructure/grpc/stream"
+	"infrastructure/grpc/tracing"
 	"infrastructure/grpc/vacancy/interceptors"
 	vacancyv1 "infrastructure/proto/vacancy/gen"
 	"net"
@@ -14,17 +21,64 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 )
 
+// Signing key and client certificate identity of the seeded scraper bot client for the tests.
+// The bot holds the ingestor role.
+const (
+	testKeyId        = "test-bot-key"
+	testSecret       = "test-secret"
+	testCertIdentity = "test-bot.pulse-finder"
+	testClientId     = "pulse-finder-bot"
+)
+
 // SetupTestContainer initializes the TestContainer.
 func SetupTestContainer(t *testing.T) (vacancyv1.VacancyServiceClient, *auth.Service) {
+	container, target := startServer(t)
+	return vacancyv1.NewVacancyServiceClient(dial(t, target)), container.JwtService.Get()
+}
+
+// SetupSignedTestContainer initializes the TestContainer and issues the test signing key to the scraper bot.
+// It returns a plain client, for requests carrying hand-crafted signatures, and a client signing every request
+// with the test key.
+func SetupSignedTestContainer(t *testing.T) (vacancyv1.VacancyServiceClient, vacancyv1.VacancyServiceClient) {
+	container, target := startServer(t)
+
+	key := entity.GetKey().SetKeyId(testKeyId).SetClientId(testClientId).SetSecret(testSecret)
+	defer key.Release()
+	require.NoError(t, container.KeyRepository.Get().Save(context.Background(), key), "Failed to issue signing key")
+
+	signed := dial(t, target, grpc.WithUnaryInterceptor(authn.HmacClientInterceptor(testKeyId, testSecret)))
+	return vacancyv1.NewVacancyServiceClient(dial(t, target)), vacancyv1.NewVacancyServiceClient(signed)
+}
+
+// startServer starts an in-process gRPC server with the authentication and idempotency interceptors and returns
+// its address.
+// Additional server options, such as transport credentials, may be provided.
+func startServer(t *testing.T, opts ...grpc.ServerOption) (*TestContainer, string) {
 	container := NewTestContainer()
 
 	// Create a listener for the in-process gRPC server
 	listener, err := net.Listen("tcp", ":0") // Use a random available port
 	require.NoError(t, err, "Failed to create listener")
 
-	// Initialize the gRPC server with the JWT interceptor
-	jwtService := container.JwtService.Get()
-	server := grpc.NewServer(grpc.UnaryInterceptor(interceptors.JwtVacancyInterceptor(jwtService)))
+	// Initialize the gRPC server with the request ID, tracing, audit, mTLS, HMAC, JWT and scope interceptors, and the
+	// idempotency interceptor for unary methods
+	unary := []grpc.UnaryServerInterceptor{
+		requestid.UnaryServerInterceptor(),
+		tracing.UnaryServerInterceptor(),
+		auditInterceptor.UnaryServerInterceptor(container.AuditService.Get()),
+		authn.MtlsInterceptor(container.CertificateMapper.Get()),
+		authn.HmacInterceptor(container.Verifier.Get()),
+		interceptors.JwtVacancyInterceptor(container.JwtService.Get()),
+		interceptors.ScopeVacancyInterceptor(interceptors.VacancyMethodScopes(), container.AuditService.Get()),
+	}
+	streams := make([]grpc.StreamServerInterceptor, len(unary))
+	for i, interceptor := range unary {
+		streams[i] = stream.FromUnary(interceptor)
+	}
+	unary = append(unary,
+		grpcIdempotency.UnaryServerInterceptor(container.Idempotency.Get(), interceptors.VacancyIdempotentMethods()))
+	server := grpc.NewServer(append(opts, grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(streams...))...)
 
 	// Register the VacancyService
 	vacancyService := container.VacancyServiceServer.Get()
@@ -41,11 +95,18 @@ func SetupTestContainer(t *testing.T) (vacancyv1.VacancyServiceClient, *auth.Ser
 		server.GracefulStop()
 	})
 
-	// Set up a gRPC client to interact with the server
-	target := listener.Addr().String()
-	opts := []grpc.DialOption{
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	}
+	// Cleans up the database by truncating tables.
+	t.Cleanup(func() {
+		teardown(container.DB.Get(), t)
+	})
+
+	return container, listener.Addr().String()
+}
+
+// dial sets up a gRPC client connection to the test server and closes it after the test.
+// Connections are insecure unless transport credentials are provided.
+func dial(t *testing.T, target string, opts ...grpc.DialOption) *grpc.ClientConn {
+	opts = append([]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, opts...)
 	conn, err := grpc.NewClient(target, opts...)
 	require.NoError(t, err, "Failed to connect to gRPC server")
 
@@ -54,18 +115,22 @@ func SetupTestContainer(t *testing.T) (vacancyv1.VacancyServiceClient, *auth.Ser
 		err = conn.Close()
 		require.NoError(t, err, "Failed to close gRPC connection")
 	})
-
-	// Cleans up the database by truncating tables.
-	t.Cleanup(func() {
-		teardown(container.DB.Get(), t)
-	})
-
-	return vacancyv1.NewVacancyServiceClient(conn), jwtService
+	return conn
 }
 
-// teardown cleans up the database by truncating tables.
+// teardown cleans up the database by truncating tables and removing the test signing keys and the audit records
+// and idempotency keys of test requests.
 func teardown(db *pgxpool.Pool, t *testing.T) {
 	ctx := context.Background()
 	_, err := db.Exec(ctx, "TRUNCATE TABLE job_vacancies RESTART IDENTITY CASCADE;")
 	require.NoError(t, err, "Failed to truncate job_vacancies")
+
+	_, err = db.Exec(ctx, "DELETE FROM signing_keys WHERE key_id LIKE 'test-%'")
+	require.NoError(t, err, "Failed to delete signing keys")
+
+	_, err = db.Exec(ctx, "DELETE FROM audit_log WHERE request_id LIKE 'test-%'")
+	require.NoError(t, err, "Failed to delete audit records")
+
+	_, err = db.Exec(ctx, "DELETE FROM idempotency_keys WHERE key LIKE '%|subject:test-%'")
+	require.NoError(t, err, "Failed to delete idempotency keys")
 }
@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"application/signing"
+	"context"
+	vacancyv1 "infrastructure/proto/vacancy/gen"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const createVacancyMethod = "/vacancy.v1.VacancyService/CreateVacancy"
+
+// signedContext returns an outgoing context carrying a signature of the given request built from the provided parts.
+func signedContext(keyId, secret, nonce string, signedAt time.Time, body []byte) context.Context {
+	r := &signing.SignedRequest{
+		KeyId:     keyId,
+		Method:    http.MethodPost,
+		Path:      createVacancyMethod,
+		Timestamp: strconv.FormatInt(signedAt.Unix(), 10),
+		Nonce:     nonce,
+		Digest:    signing.Digest(body),
+	}
+	return metadata.AppendToOutgoingContext(context.Background(),
+		strings.ToLower(signing.HeaderKeyId), r.KeyId,
+		strings.ToLower(signing.HeaderTimestamp), r.Timestamp,
+		strings.ToLower(signing.HeaderNonce), r.Nonce,
+		strings.ToLower(signing.HeaderDigest), r.Digest,
+		strings.ToLower(signing.HeaderSignature), signing.Sign(secret, r),
+	)
+}
+
+// TestVacancyService_SignedRequests tests HMAC-signed requests to the VacancyServiceServer.
+//
+// This test covers the following scenarios:
+// 1. A request signed by the client interceptor should successfully create a vacancy.
+// 2. A request signed with an unknown key should return an Unauthenticated error.
+// 3. A request signed with a wrong secret should return an Unauthenticated error.
+// 4. A request signed outside the time window should return an Unauthenticated error.
+// 5. A request whose body does not match its digest should return an Unauthenticated error.
+// 6. A replayed request should return an Unauthenticated error.
+//
+// The test uses the SetupSignedTestContainer function to initialize the test dependencies.
+func TestVacancyService_SignedRequests(t *testing.T) {
+	client, signedClient := SetupSignedTestContainer(t)
+
+	request := &vacancyv1.CreateVacancyRequest{
+		Title:       "Software Engineer",
+		Company:     "Tech Co.",
+		Description: "Exciting opportunity in tech.",
+		PostedAt:    "2025-01-01",
+		Location:    "New York",
+	}
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(request)
+	require.NoError(t, err, "could not marshal request")
+
+	t.Run("Signed By Client Interceptor", func(t *testing.T) {
+		resp, err := signedClient.CreateVacancy(context.Background(), request)
+		require.NoError(t, err)
+		assert.Equal(t, request.Title, resp.Title)
+	})
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+	}{
+		{
+			name: "Unknown Key",
+			ctx:  signedContext("test-unknown-key", testSecret, "nonce-1", time.Now(), body),
+		},
+		{
+			name: "Wrong Secret",
+			ctx:  signedContext(testKeyId, "wrong-secret", "nonce-2", time.Now(), body),
+		},
+		{
+			name: "Expired Timestamp",
+			ctx:  signedContext(testKeyId, testSecret, "nonce-3", time.Now().Add(-time.Hour), body),
+		},
+		{
+			name: "Tampered Body",
+			ctx:  signedContext(testKeyId, testSecret, "nonce-4", time.Now(), []byte("tampered")),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.CreateVacancy(tt.ctx, request)
+			require.Error(t, err)
+			assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		})
+	}
+
+	t.Run("Replayed Nonce", func(t *testing.T) {
+		ctx := signedContext(testKeyId, testSecret, "nonce-5", time.Now(), body)
+
+		_, err := client.CreateVacancy(ctx, request)
+		require.NoError(t, err)
+
+		_, err = client.CreateVacancy(ctx, request)
+		require.Error(t, err)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
@@ -226,6 +226,52 @@ func TestPgxVacancyRepository_GetFilteredList_NoFilters(t *testing.T) {
 	assert.Len(t, list, 2, "Expected exactly 2 vacancies in the list")
 }
 
+// TestPgxVacancyRepository_GetFilteredList_Fields tests fetching vacancies reading the given fields only.
+func TestPgxVacancyRepository_GetFilteredList_Fields(t *testing.T) {
+	c := SetupTestDatabase(t)
+	r := c.Container.VacancyRepository.Get()
+	ctx := context.Background()
+
+	// Insert a test vacancy
+	v := newVacancy("Software Engineer", "Tech Innovations", "Develop cutting-edge software solutions", "New York")
+	require.NoError(t, r.Save(ctx, v), "Failed to save vacancy")
+
+	// Fetch the title and location of the vacancies only
+	list, err := r.GetFilteredList(ctx, "", "", 1, 10, "", "", "title", "location")
+	require.NoError(t, err)
+	require.Len(t, list, 1, "Expected exactly 1 vacancy in the list")
+	assert.Equal(t, "Software Engineer", list[0].GetTitle(), "Expected the title to be read")
+	assert.Equal(t, "New York", list[0].GetLocation(), "Expected the location to be read")
+	assert.Zero(t, list[0].GetId(), "Expected the id not to be read")
+	assert.Empty(t, list[0].GetDescription(), "Expected the description not to be read")
+
+	// Fetch the description of the vacancy only
+	item, err := r.Get(ctx, v.GetId(), "description")
+	require.NoError(t, err)
+	assert.Equal(t, v.GetDescription(), item.GetDescription(), "Expected the description to be read")
+	assert.Empty(t, item.GetTitle(), "Expected the title not to be read")
+}
+
+// TestPgxVacancyRepository_Count tests counting the vacancies stored in the database.
+func TestPgxVacancyRepository_Count(t *testing.T) {
+	c := SetupTestDatabase(t)
+	r := c.Container.VacancyRepository.Get()
+	ctx := context.Background()
+
+	count, err := r.Count(ctx)
+	require.NoError(t, err, "Failed to count vacancies")
+	assert.Zero(t, count, "Expected no vacancies in an empty database")
+
+	err = r.Save(ctx, newVacancy("Software Engineer", "Tech Innovations", "Develop software", "New York"))
+	require.NoError(t, err, "Failed to save first vacancy")
+	err = r.Save(ctx, newVacancy("Data Scientist", "AI Corp", "Build AI models", "San Francisco"))
+	require.NoError(t, err, "Failed to save second vacancy")
+
+	count, err = r.Count(ctx)
+	require.NoError(t, err, "Failed to count vacancies")
+	assert.Equal(t, int64(2), count, "Expected exactly 2 vacancies")
+}
+
 // TestPgxVacancyRepository_Purge tests the repository's ability to purge all vacancies from the database.
 func TestPgxVacancyRepository_Purge(t *testing.T) {
 	c := SetupTestDatabase(t)
@@ -0,0 +1,194 @@
+package handlers
+
+import (
+	"application/auth"
+	"domain/audit/entity"
+	authEntity "domain/auth/entity"
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/url"
+	"testing"
+	"tests"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testActor     = testPrefix + "auditor"
+	testRequestId = testPrefix + "request-1"
+)
+
+// configureRecordRoutes registers the audit log route and a route recording an action of the test actor,
+// failing with 401 Unauthorized unless the request carries a bearer token.
+func configureRecordRoutes(router *httprouter.Router, container *tests.TestContainer) {
+	router.HandlerFunc(http.MethodGet, "/v1/admin/audit-log", container.ListRecordHandler.Get().Execute)
+
+	service := container.AuditService.Get()
+	router.HandlerFunc(http.MethodPost, "/v1/actions/:action", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		claims := authEntity.GetTokenClaims().SetIssuer(testActor).SetSubject(testActor)
+		defer claims.Release()
+
+		ctx := auth.ContextWithClaims(r.Context(), claims)
+		action := httprouter.ParamsFromContext(r.Context()).ByName("action")
+		outcome := r.URL.Query().Get("outcome")
+		if err := service.RecordOutcome(ctx, action, "vacancy:1", outcome, nil); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// recordAction performs an audited action on behalf of the test actor and returns the response status code.
+func recordAction(t *testing.T, testServer *TestServer, action, outcome string, authorized bool) int {
+	req, err := http.NewRequest(http.MethodPost,
+		testServer.Server.URL+"/v1/actions/"+action+"?outcome="+outcome, nil)
+	require.NoError(t, err)
+	req.Header.Set("X-Request-Id", testRequestId)
+	if authorized {
+		req.Header.Set("Authorization", "Bearer test")
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	return resp.StatusCode
+}
+
+// listRecords queries the audit log with the given parameters.
+// Returns the status code and the decoded response body.
+func listRecords(t *testing.T, testServer *TestServer, params url.Values) (int, map[string]any) {
+	resp, err := http.Get(testServer.Server.URL + "/v1/admin/audit-log?" + params.Encode())
+	require.NoError(t, err)
+	defer func() {
+		if err = resp.Body.Close(); err != nil {
+			log.Println("failed to close response body")
+		}
+	}()
+
+	var body map[string]any
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	return resp.StatusCode, body
+}
+
+// TestListRecordHandler_Filter tests querying the audit log by actor, action prefix and outcome.
+func TestListRecordHandler_Filter(t *testing.T) {
+	testServer := SetupTestServer(t, configureRecordRoutes)
+
+	require.Equal(t, http.StatusNoContent, recordAction(t, testServer, "vacancy.create", entity.OutcomeSuccess, true))
+	require.Equal(t, http.StatusNoContent, recordAction(t, testServer, "vacancy.update", entity.OutcomeSuccess, true))
+	require.Equal(t, http.StatusNoContent, recordAction(t, testServer, "vacancy.delete", entity.OutcomeDenied, true))
+	require.Equal(t, http.StatusNoContent, recordAction(t, testServer, "role.create", entity.OutcomeSuccess, true))
+
+	status, body := listRecords(t, testServer, url.Values{"actor": {testActor}, "action": {"vacancy.*"}})
+	require.Equal(t, http.StatusOK, status)
+	records := body["records"].([]any)
+	require.Len(t, records, 3)
+
+	latest := records[0].(map[string]any)
+	assert.Equal(t, "vacancy.delete", latest["action"], "records should be ordered newest first")
+	assert.Equal(t, entity.OutcomeDenied, latest["outcome"])
+	assert.Equal(t, testRequestId, latest["request_id"])
+	assert.NotEmpty(t, latest["source_ip"])
+
+	status, body = listRecords(t, testServer, url.Values{"actor": {testActor}, "outcome": {entity.OutcomeDenied}})
+	require.Equal(t, http.StatusOK, status)
+	assert.Len(t, body["records"], 1)
+}
+
+// TestListRecordHandler_Pagination tests paging through the audit log with the returned cursor.
+func TestListRecordHandler_Pagination(t *testing.T) {
+	testServer := SetupTestServer(t, configureRecordRoutes)
+
+	for range 3 {
+		require.Equal(t, http.StatusNoContent, recordAction(t, testServer, "role.update", entity.OutcomeSuccess, true))
+	}
+
+	params := url.Values{"actor": {testActor}, "limit": {"2"}}
+	status, body := listRecords(t, testServer, params)
+	require.Equal(t, http.StatusOK, status)
+	assert.Len(t, body["records"], 2)
+	require.NotNil(t, body["next_cursor"])
+
+	params.Set("cursor", body["next_cursor"].(string))
+	status, body = listRecords(t, testServer, params)
+	require.Equal(t, http.StatusOK, status)
+	assert.Len(t, body["records"], 1)
+	assert.Nil(t, body["next_cursor"], "the last page should not return a cursor")
+}
+
+// TestListRecordHandler_AuthFailure tests that a request rejected with 401 Unauthorized is recorded as a failure.
+func TestListRecordHandler_AuthFailure(t *testing.T) {
+	testServer := SetupTestServer(t, configureRecordRoutes)
+
+	require.Equal(t, http.StatusUnauthorized, recordAction(t, testServer, "vacancy.create", "", false))
+
+	status, body := listRecords(t, testServer, url.Values{"action": {"auth.failure"}, "limit": {"100"}})
+	require.Equal(t, http.StatusOK, status)
+
+	var found map[string]any
+	for _, item := range body["records"].([]any) {
+		if record := item.(map[string]any); record["request_id"] == testRequestId {
+			found = record
+			break
+		}
+	}
+	require.NotNil(t, found, "the rejected request should be recorded")
+	assert.Equal(t, "anonymous", found["actor"])
+	assert.Equal(t, entity.OutcomeFailure, found["outcome"])
+	assert.Equal(t, "POST /v1/actions/vacancy.create", found["target"])
+}
+
+// TestListRecordHandler_AuthFailureSampling tests that the authentication failures of a client flooding the API are
+// only recorded up to the burst of the failure policy.
+func TestListRecordHandler_AuthFailureSampling(t *testing.T) {
+	testServer := SetupTestServer(t, configureRecordRoutes)
+
+	for range 15 {
+		require.Equal(t, http.StatusUnauthorized, recordAction(t, testServer, "vacancy.create", "", false))
+	}
+
+	status, body := listRecords(t, testServer, url.Values{"action": {"auth.failure"}, "limit": {"100"}})
+	require.Equal(t, http.StatusOK, status)
+
+	recorded := 0
+	for _, item := range body["records"].([]any) {
+		if item.(map[string]any)["request_id"] == testRequestId {
+			recorded++
+		}
+	}
+	assert.Equal(t, 10, recorded, "the failures beyond the burst should not be recorded")
+}
+
+// TestListRecordHandler_ValidationFailure tests that malformed query parameters are rejected.
+func TestListRecordHandler_ValidationFailure(t *testing.T) {
+	testServer := SetupTestServer(t, configureRecordRoutes)
+
+	cases := []struct {
+		name   string
+		params url.Values
+		field  string
+	}{
+		{name: "Unknown Outcome", params: url.Values{"outcome": {"maybe"}}, field: "outcome"},
+		{name: "Malformed From", params: url.Values{"from": {"yesterday"}}, field: "from"},
+		{name: "Reversed Range", params: url.Values{
+			"from": {"2025-02-01T00:00:00Z"}, "to": {"2025-01-01T00:00:00Z"}}, field: "to"},
+		{name: "Limit Too Large", params: url.Values{"limit": {"500"}}, field: "limit"},
+		{name: "Malformed Cursor", params: url.Values{"cursor": {"!"}}, field: "cursor"},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			status, body := listRecords(t, testServer, tt.params)
+			assert.Equal(t, http.StatusUnprocessableEntity, status)
+			assert.Contains(t, tests.ProblemLocations(body), tt.field)
+		})
+	}
+}
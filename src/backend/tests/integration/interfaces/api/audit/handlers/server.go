@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"context"
+	"interfaces/middleware"
+	"log"
+	"net/http/httptest"
+	"testing"
+	"tests"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/julienschmidt/httprouter"
+)
+
+// testPrefix prefixes the actors and request IDs of every audit record created by the tests, so they can be
+// removed afterwards.
+const testPrefix = "test-"
+
+// TestServer contains the components for a test HTTP server and database.
+type TestServer struct {
+	Container *tests.TestContainer
+	Server    *httptest.Server
+	Router    *httprouter.Router
+	DB        *pgxpool.Pool
+}
+
+// SetupTestServer initializes the test container and server with customizable routes.
+func SetupTestServer(
+	t *testing.T, configureRoutes func(router *httprouter.Router, container *tests.TestContainer)) *TestServer {
+	container := tests.NewTestContainer()
+
+	router := httprouter.New()
+	configureRoutes(router, container)
+	handler := middleware.Chain(router, middleware.RequestId, container.AuditMiddleware.Get().Handle)
+	server := httptest.NewServer(handler)
+
+	t.Cleanup(func() {
+		server.Close()
+		Teardown(container.DB.Get())
+	})
+
+	return &TestServer{
+		Container: container,
+		Server:    server,
+		Router:    router,
+		DB:        container.DB.Get(),
+	}
+}
+
+// Teardown removes the audit records created by the tests.
+func Teardown(db *pgxpool.Pool) {
+	_, err := db.Exec(context.Background(), "DELETE FROM audit_log WHERE actor LIKE $1 OR request_id LIKE $1",
+		testPrefix+"%")
+	if err != nil {
+		log.Fatalf("failed to delete audit records: %v", err)
+	}
+}
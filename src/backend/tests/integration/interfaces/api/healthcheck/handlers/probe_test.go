@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	"application/config"
+	"application/healthcheck"
+	"context"
+	"encoding/json"
+	"errors"
+	"interfaces/api/healthcheck/dto"
+	"interfaces/api/healthcheck/handlers"
+	"interfaces/api/utils"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testChecker is a checker whose result is controlled by the test and which counts its runs.
+type testChecker struct {
+	err   atomic.Value // Error returned by the check, a nil error interface if the component is up.
+	delay time.Duration
+	runs  atomic.Int32
+}
+
+// checker returns the healthcheck.Checker of the named component.
+func (c *testChecker) checker(name string) healthcheck.Checker {
+	return healthcheck.Checker{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			c.runs.Add(1)
+			select {
+			case <-time.After(c.delay):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			if err, ok := c.err.Load().(error); ok {
+				return err
+			}
+			return nil
+		},
+	}
+}
+
+// setupProbeServer starts a server exposing the probe routes of a health check service running the checkers.
+func setupProbeServer(t *testing.T, cacheTTL time.Duration, checkers ...healthcheck.Checker) *httptest.Server {
+	cfg := &config.Configuration{Env: "test", Health: config.HealthConfig{Timeout: 50 * time.Millisecond,
+		CacheTTL: cacheTTL}}
+	service := healthcheck.NewService(cfg, checkers...)
+	h := utils.NewHandler()
+	e := utils.NewErrors(slog.Default(), h)
+
+	router := httprouter.New()
+	router.HandlerFunc(http.MethodGet, "/livez", handlers.NewLivenessHandler(h, e, service).Execute)
+	router.HandlerFunc(http.MethodGet, "/readyz", handlers.NewReadinessHandler(h, e, service).Execute)
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// probe requests the given probe and decodes its response.
+func probe(t *testing.T, server *httptest.Server, path string) (int, dto.Response) {
+	resp, err := http.Get(server.URL + path)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	var body dto.Response
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	return resp.StatusCode, body
+}
+
+// TestProbes tests the liveness and readiness probes.
+//
+// This test covers the following scenarios:
+// 1. The liveness probe should report the process available without running the checkers.
+// 2. The readiness probe should report every component up when all checks pass.
+// 3. The readiness probe should return 503 Service Unavailable and the error of a failing component.
+// 4. A check exceeding the timeout should report its component down.
+// 5. The result of a check should be reused until the cache expires.
+func TestProbes(t *testing.T) {
+	t.Run("Liveness", func(t *testing.T) {
+		database := &testChecker{}
+		database.err.Store(errors.New("connection refused"))
+		server := setupProbeServer(t, 0, database.checker("database"))
+
+		code, body := probe(t, server, "/livez")
+		assert.Equal(t, http.StatusOK, code)
+		require.NotNil(t, body.Status)
+		assert.Equal(t, "available", *body.Status)
+		assert.Empty(t, body.Components)
+		assert.Zero(t, database.runs.Load(), "the liveness probe should not check the dependencies")
+	})
+
+	t.Run("Ready", func(t *testing.T) {
+		server := setupProbeServer(t, 0, (&testChecker{}).checker("database"), (&testChecker{}).checker("nats"))
+
+		code, body := probe(t, server, "/readyz")
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "available", *body.Status)
+		require.Len(t, body.Components, 2)
+		assert.Equal(t, "database", body.Components[0].Name)
+		assert.Equal(t, "up", body.Components[0].Status)
+		assert.Nil(t, body.Components[0].Error)
+		assert.Equal(t, "nats", body.Components[1].Name)
+	})
+
+	t.Run("Failing Component", func(t *testing.T) {
+		nats := &testChecker{}
+		nats.err.Store(errors.New("connection is reconnecting"))
+		server := setupProbeServer(t, 0, (&testChecker{}).checker("database"), nats.checker("nats"))
+
+		code, body := probe(t, server, "/readyz")
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.Equal(t, "unavailable", *body.Status)
+		require.Len(t, body.Components, 2)
+		assert.Equal(t, "up", body.Components[0].Status)
+		assert.Equal(t, "down", body.Components[1].Status)
+		require.NotNil(t, body.Components[1].Error)
+		assert.Equal(t, "connection is reconnecting", *body.Components[1].Error)
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		server := setupProbeServer(t, 0, (&testChecker{delay: time.Second}).checker("database"))
+
+		code, body := probe(t, server, "/readyz")
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		require.Len(t, body.Components, 1)
+		assert.Equal(t, "down", body.Components[0].Status)
+		assert.Equal(t, "check timed out", *body.Components[0].Error)
+	})
+
+	t.Run("Cached Result", func(t *testing.T) {
+		database := &testChecker{}
+		server := setupProbeServer(t, time.Minute, database.checker("database"))
+
+		code, _ := probe(t, server, "/readyz")
+		assert.Equal(t, http.StatusOK, code)
+
+		// The failure is only seen once the cached result expires
+		database.err.Store(errors.New("connection refused"))
+		code, _ = probe(t, server, "/readyz")
+		assert.Equal(t, http.StatusOK, code)
+		assert.EqualValues(t, 1, database.runs.Load())
+	})
+}
@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMemberHandlers_Lifecycle tests inviting a member, accepting the invitation and listing the members.
+func TestMemberHandlers_Lifecycle(t *testing.T) {
+	testServer := SetupTestServer(t, configureOrganizationRoutes)
+	defer testServer.Server.Close()
+
+	id := createOrganization(t, testServer, testPrefix+"Initech")
+	members := fmt.Sprintf("/v1/organizations/%d/members", id)
+	invitee := testPrefix + "invitee"
+
+	// Principals outside the organization can neither invite members nor list them
+	status, _ := send(t, testServer, invitee, http.MethodPost, members, map[string]any{"member_id": invitee})
+	assert.Equal(t, http.StatusForbidden, status)
+	status, _ = send(t, testServer, invitee, http.MethodGet, members, nil)
+	assert.Equal(t, http.StatusForbidden, status)
+
+	// The owner invites a member
+	status, response := send(t, testServer, "", http.MethodPost, members, map[string]any{"member_id": invitee})
+	require.Equal(t, http.StatusCreated, status)
+	assert.Equal(t, "invited", response.(map[string]any)["status"])
+	assert.Equal(t, testOwner, response.(map[string]any)["invited_by"])
+
+	// Inviting the same member twice is rejected
+	status, _ = send(t, testServer, "", http.MethodPost, members, map[string]any{"member_id": invitee})
+	assert.Equal(t, http.StatusConflict, status)
+
+	// A pending invitation does not grant access to the organization
+	status, _ = send(t, testServer, invitee, http.MethodGet, members, nil)
+	assert.Equal(t, http.StatusForbidden, status)
+
+	// The invitee accepts the invitation
+	status, response = send(t, testServer, invitee, http.MethodPost, members+"/accept", nil)
+	require.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "active", response.(map[string]any)["status"])
+
+	// Active members list the members of the organization but cannot invite others
+	status, response = send(t, testServer, invitee, http.MethodGet, members, nil)
+	require.Equal(t, http.StatusOK, status)
+	assert.Len(t, response, 2)
+	status, _ = send(t, testServer, invitee, http.MethodPost, members, map[string]any{"member_id": testPrefix + "other"})
+	assert.Equal(t, http.StatusForbidden, status)
+}
+
+// TestAcceptInvitationHandler_NotInvited tests accepting an invitation that was never sent.
+func TestAcceptInvitationHandler_NotInvited(t *testing.T) {
+	testServer := SetupTestServer(t, configureOrganizationRoutes)
+	defer testServer.Server.Close()
+
+	id := createOrganization(t, testServer, testPrefix+"Umbrella")
+
+	status, _ := send(t, testServer, testPrefix+"stranger", http.MethodPost,
+		fmt.Sprintf("/v1/organizations/%d/members/accept", id), nil)
+	assert.Equal(t, http.StatusNotFound, status)
+}
@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"net/http"
+	"testing"
+	"tests"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// configureOrganizationRoutes registers the organization and membership routes used by the tests.
+func configureOrganizationRoutes(router *httprouter.Router, container *tests.TestContainer) {
+	router.HandlerFunc(http.MethodGet, "/v1/organizations", container.ListOrganizationHandler.Get().Execute)
+	router.HandlerFunc(http.MethodPost, "/v1/organizations", container.CreateOrganizationHandler.Get().Execute)
+	router.HandlerFunc(http.MethodGet, "/v1/organizations/:id/members", container.ListMemberHandler.Get().Execute)
+	router.HandlerFunc(http.MethodPost, "/v1/organizations/:id/members", container.InviteMemberHandler.Get().Execute)
+	router.HandlerFunc(http.MethodPost, "/v1/organizations/:id/members/accept",
+		container.AcceptInvitationHandler.Get().Execute)
+}
+
+// send sends a request with the given JSON payload on behalf of a principal, or of testOwner if empty.
+// Returns the status code and the decoded response body.
+func send(t *testing.T, testServer *TestServer, principal, method, path string, payload any) (int, any) {
+	var body bytes.Buffer
+	if payload != nil {
+		require.NoError(t, json.NewEncoder(&body).Encode(payload))
+	}
+
+	req, err := http.NewRequest(method, testServer.Server.URL+path, &body)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	if principal != "" {
+		req.Header.Set(tests.SubjectHeader, principal)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer func() {
+		if err = resp.Body.Close(); err != nil {
+			log.Println("failed to close response body")
+		}
+	}()
+
+	var response any
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&response))
+	return resp.StatusCode, response
+}
+
+// createOrganization registers an organization owned by testOwner and returns its ID.
+func createOrganization(t *testing.T, testServer *TestServer, name string) int64 {
+	status, response := send(t, testServer, "", http.MethodPost, "/v1/organizations", map[string]any{"name": name})
+	require.Equal(t, http.StatusCreated, status)
+
+	id, ok := response.(map[string]any)["id"].(float64)
+	require.True(t, ok, "expected valid ID in response")
+	return int64(id)
+}
+
+// TestCreateOrganizationHandler tests registering organizations.
+func TestCreateOrganizationHandler(t *testing.T) {
+	testServer := SetupTestServer(t, configureOrganizationRoutes)
+	defer testServer.Server.Close()
+
+	testCases := []struct {
+		name           string
+		payload        map[string]any
+		expectedStatus int
+	}{
+		{
+			name:           "Valid Organization",
+			payload:        map[string]any{"name": testPrefix + "Acme"},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "Duplicate Name",
+			payload:        map[string]any{"name": testPrefix + "Acme"},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "Missing Name",
+			payload:        map[string]any{"name": "  "},
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			status, response := send(t, testServer, "", http.MethodPost, "/v1/organizations", tc.payload)
+			assert.Equal(t, tc.expectedStatus, status)
+			if tc.expectedStatus == http.StatusCreated {
+				assert.Equal(t, testOwner, response.(map[string]any)["created_by"])
+			}
+		})
+	}
+}
+
+// TestListOrganizationHandler tests that the caller only sees the organizations it is an active member of.
+func TestListOrganizationHandler(t *testing.T) {
+	testServer := SetupTestServer(t, configureOrganizationRoutes)
+	defer testServer.Server.Close()
+
+	createOrganization(t, testServer, testPrefix+"Globex")
+
+	status, response := send(t, testServer, "", http.MethodGet, "/v1/organizations", nil)
+	require.Equal(t, http.StatusOK, status)
+	list := response.([]any)
+	require.Len(t, list, 1)
+	assert.Equal(t, testPrefix+"Globex", list[0].(map[string]any)["name"])
+
+	status, response = send(t, testServer, testPrefix+"stranger", http.MethodGet, "/v1/organizations", nil)
+	require.Equal(t, http.StatusOK, status)
+	assert.Empty(t, response)
+}
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"net/http/httptest"
+	"testing"
+	"tests"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/julienschmidt/httprouter"
+)
+
+// testPrefix prefixes the principals and organization names used by the tests, so they can be removed afterwards.
+const testPrefix = "test-"
+
+// testOwner is the default principal of the test requests, holding the scopes of the editor role.
+const testOwner = testPrefix + "owner"
+
+// TestServer contains the components for a test HTTP server and database.
+type TestServer struct {
+	Container *tests.TestContainer
+	Server    *httptest.Server
+	Router    *httprouter.Router
+	DB        *pgxpool.Pool
+}
+
+// SetupTestServer initializes the test container and server with customizable routes.
+func SetupTestServer(
+	t *testing.T, configureRoutes func(router *httprouter.Router, container *tests.TestContainer)) *TestServer {
+	container := tests.NewTestContainer()
+
+	router := httprouter.New()
+	configureRoutes(router, container)
+	server := httptest.NewServer(tests.WithClaims(router, testOwner, "read", "write", "delete"))
+
+	t.Cleanup(func() {
+		server.Close()
+		Teardown(container.DB.Get())
+	})
+
+	return &TestServer{
+		Container: container,
+		Server:    server,
+		Router:    router,
+		DB:        container.DB.Get(),
+	}
+}
+
+// Teardown removes the organizations, memberships and audit records created by the tests.
+func Teardown(db *pgxpool.Pool) {
+	ctx := context.Background()
+	_, err := db.Exec(ctx, "DELETE FROM organizations WHERE name LIKE $1", testPrefix+"%")
+	if err != nil {
+		log.Fatalf("failed to delete test organizations: %v", err)
+	}
+	_, err = db.Exec(ctx, "DELETE FROM audit_log WHERE actor LIKE $1", testPrefix+"%")
+	if err != nil {
+		log.Fatalf("failed to delete audit records: %v", err)
+	}
+}
@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"testing"
+	"tests"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// configureAssignmentRoutes registers the role and role assignment routes used by the tests.
+func configureAssignmentRoutes(router *httprouter.Router, container *tests.TestContainer) {
+	router.HandlerFunc(http.MethodPost, "/v1/admin/roles", container.CreateRoleHandler.Get().Execute)
+	router.HandlerFunc(http.MethodGet, "/v1/admin/role-assignments", container.ListAssignmentHandler.Get().Execute)
+	router.HandlerFunc(http.MethodPost, "/v1/admin/role-assignments", container.CreateAssignmentHandler.Get().Execute)
+	router.HandlerFunc(http.MethodDelete, "/v1/admin/role-assignments/:id",
+		container.DeleteAssignmentHandler.Get().Execute)
+}
+
+// postAssignment sends a POST request to assign a role and returns the status code and decoded body.
+func postAssignment(t *testing.T, testServer *TestServer, payload map[string]any) (int, map[string]any) {
+	body, err := json.Marshal(payload)
+	require.NoError(t, err)
+
+	resp, err := http.Post(testServer.Server.URL+"/v1/admin/role-assignments", "application/json",
+		bytes.NewBuffer(body))
+	require.NoError(t, err)
+	defer func() {
+		if err = resp.Body.Close(); err != nil {
+			log.Println("failed to close response body")
+		}
+	}()
+
+	var response map[string]any
+	err = json.NewDecoder(resp.Body).Decode(&response)
+	require.NoError(t, err)
+	return resp.StatusCode, response
+}
+
+// TestAssignmentHandlers_Lifecycle tests assigning a role, resolving the effective scopes and revoking it.
+func TestAssignmentHandlers_Lifecycle(t *testing.T) {
+	testServer := SetupTestServer(t, configureAssignmentRoutes)
+	defer testServer.Server.Close()
+
+	roleId := createRole(t, testServer, map[string]any{
+		"name":   testRolePrefix + "assignable",
+		"scopes": []string{"read", "purge"},
+	})
+
+	// Assign the role
+	status, response := postAssignment(t, testServer, map[string]any{
+		"subject_type": "client",
+		"subject_id":   testRolePrefix + "client",
+		"role_id":      roleId,
+	})
+	require.Equal(t, http.StatusCreated, status)
+	assert.Equal(t, testRolePrefix+"assignable", response["role"])
+	assignmentId := int(response["id"].(float64))
+
+	// Assigning the same role twice is rejected
+	status, _ = postAssignment(t, testServer, map[string]any{
+		"subject_type": "client",
+		"subject_id":   testRolePrefix + "client",
+		"role_id":      roleId,
+	})
+	assert.Equal(t, http.StatusConflict, status)
+
+	// The subject now holds the scopes of the role
+	scopes, err := testServer.Container.RoleService.Get().
+		EffectiveScopes(context.Background(), "client", testRolePrefix+"client")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"purge", "read"}, scopes)
+
+	// List the assignments of the subject
+	resp, err := http.Get(testServer.Server.URL + "/v1/admin/role-assignments?subject_type=client&subject_id=" +
+		testRolePrefix + "client")
+	require.NoError(t, err)
+	var list []map[string]any
+	err = json.NewDecoder(resp.Body).Decode(&list)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Len(t, list, 1)
+
+	// Revoke the assignment
+	req, err := http.NewRequest(http.MethodDelete,
+		testServer.Server.URL+"/v1/admin/role-assignments/"+strconv.Itoa(assignmentId), nil)
+	require.NoError(t, err)
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	scopes, err = testServer.Container.RoleService.Get().
+		EffectiveScopes(context.Background(), "client", testRolePrefix+"client")
+	require.NoError(t, err)
+	assert.Empty(t, scopes)
+}
+
+// TestCreateAssignmentHandler_ValidationFailure tests failure for unknown subject types and roles.
+func TestCreateAssignmentHandler_ValidationFailure(t *testing.T) {
+	testServer := SetupTestServer(t, configureAssignmentRoutes)
+	defer testServer.Server.Close()
+
+	status, response := postAssignment(t, testServer, map[string]any{
+		"subject_type": "service",
+		"subject_id":   testRolePrefix + "client",
+		"role_id":      1,
+	})
+	assert.Equal(t, http.StatusUnprocessableEntity, status)
+	assert.Contains(t, tests.ProblemLocations(response), "#/subject_type")
+
+	status, response = postAssignment(t, testServer, map[string]any{
+		"subject_type": "user",
+		"subject_id":   testRolePrefix + "user",
+		"role_id":      999_999,
+	})
+	assert.Equal(t, http.StatusUnprocessableEntity, status)
+	assert.Contains(t, tests.ProblemLocations(response), "#/role_id")
+}
+
+// TestDeleteAssignmentHandler_NotFound tests revoking a non-existent assignment.
+func TestDeleteAssignmentHandler_NotFound(t *testing.T) {
+	testServer := SetupTestServer(t, configureAssignmentRoutes)
+	defer testServer.Server.Close()
+
+	req, err := http.NewRequest(http.MethodDelete, testServer.Server.URL+"/v1/admin/role-assignments/999999", nil)
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
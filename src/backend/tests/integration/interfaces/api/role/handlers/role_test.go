@@ -0,0 +1,190 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"testing"
+	"tests"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createRole creates a role through the API and returns its ID.
+func createRole(t *testing.T, testServer *TestServer, payload map[string]any) int {
+	body, err := json.Marshal(payload)
+	require.NoError(t, err)
+
+	resp, err := http.Post(testServer.Server.URL+"/v1/admin/roles", "application/json", bytes.NewBuffer(body))
+	require.NoError(t, err)
+	defer func() {
+		if err = resp.Body.Close(); err != nil {
+			log.Println("failed to close response body")
+		}
+	}()
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var response map[string]any
+	err = json.NewDecoder(resp.Body).Decode(&response)
+	require.NoError(t, err)
+
+	return int(response["id"].(float64))
+}
+
+// patchRole sends a PATCH request for the given role and returns the response status code.
+func patchRole(t *testing.T, testServer *TestServer, id int, payload map[string]any) int {
+	body, err := json.Marshal(payload)
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPatch, testServer.Server.URL+"/v1/admin/roles/"+strconv.Itoa(id),
+		bytes.NewBuffer(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer func() {
+		if err = resp.Body.Close(); err != nil {
+			log.Println("failed to close response body")
+		}
+	}()
+	return resp.StatusCode
+}
+
+// TestCreateRoleHandler_Success tests the successful creation of a role and its audit record.
+func TestCreateRoleHandler_Success(t *testing.T) {
+	testServer := SetupTestServer(t, func(router *httprouter.Router, container *tests.TestContainer) {
+		router.HandlerFunc(http.MethodPost, "/v1/admin/roles", container.CreateRoleHandler.Get().Execute)
+	})
+	defer testServer.Server.Close()
+
+	id := createRole(t, testServer, map[string]any{
+		"name":        testRolePrefix + "reviewer",
+		"description": "Reads and edits vacancies",
+		"scopes":      []string{"read", "write"},
+	})
+
+	// Assert the change was recorded in the audit log
+	var count int
+	err := testServer.DB.QueryRow(context.Background(),
+		"SELECT COUNT(*) FROM audit_log WHERE action = 'role.create' AND target = $1", "role:"+strconv.Itoa(id)).
+		Scan(&count)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+// TestCreateRoleHandler_ValidationFailure tests failure when the scopes are missing or unknown.
+func TestCreateRoleHandler_ValidationFailure(t *testing.T) {
+	testServer := SetupTestServer(t, func(router *httprouter.Router, container *tests.TestContainer) {
+		router.HandlerFunc(http.MethodPost, "/v1/admin/roles", container.CreateRoleHandler.Get().Execute)
+	})
+	defer testServer.Server.Close()
+
+	payloads := []map[string]any{
+		{"name": testRolePrefix + "empty"},
+		{"name": testRolePrefix + "unknown", "scopes": []string{"read", "superuser"}},
+	}
+	for _, payload := range payloads {
+		body, err := json.Marshal(payload)
+		require.NoError(t, err)
+
+		resp, err := http.Post(testServer.Server.URL+"/v1/admin/roles", "application/json", bytes.NewBuffer(body))
+		require.NoError(t, err)
+
+		var response map[string]any
+		err = json.NewDecoder(resp.Body).Decode(&response)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+		assert.Contains(t, tests.ProblemLocations(response), "#/scopes")
+	}
+}
+
+// TestCreateRoleHandler_Duplicate tests that role names are unique.
+func TestCreateRoleHandler_Duplicate(t *testing.T) {
+	testServer := SetupTestServer(t, func(router *httprouter.Router, container *tests.TestContainer) {
+		router.HandlerFunc(http.MethodPost, "/v1/admin/roles", container.CreateRoleHandler.Get().Execute)
+	})
+	defer testServer.Server.Close()
+
+	payload := map[string]any{"name": testRolePrefix + "duplicate", "scopes": []string{"read"}}
+	createRole(t, testServer, payload)
+
+	body, err := json.Marshal(payload)
+	require.NoError(t, err)
+	resp, err := http.Post(testServer.Server.URL+"/v1/admin/roles", "application/json", bytes.NewBuffer(body))
+	require.NoError(t, err)
+	defer func() {
+		if err = resp.Body.Close(); err != nil {
+			log.Println("failed to close response body")
+		}
+	}()
+
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+}
+
+// TestUpdateRoleHandler_Success tests updating the scopes of a role.
+func TestUpdateRoleHandler_Success(t *testing.T) {
+	testServer := SetupTestServer(t, func(router *httprouter.Router, container *tests.TestContainer) {
+		router.HandlerFunc(http.MethodPost, "/v1/admin/roles", container.CreateRoleHandler.Get().Execute)
+		router.HandlerFunc(http.MethodPatch, "/v1/admin/roles/:id", container.UpdateRoleHandler.Get().Execute)
+	})
+	defer testServer.Server.Close()
+
+	id := createRole(t, testServer, map[string]any{"name": testRolePrefix + "updatable", "scopes": []string{"read"}})
+
+	status := patchRole(t, testServer, id, map[string]any{"scopes": []string{"read", "write"}, "version": 1})
+	assert.Equal(t, http.StatusOK, status)
+
+	role, err := testServer.Container.RoleService.Get().GetRole(context.Background(), int64(id))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"read", "write"}, role.GetScopes())
+	assert.Equal(t, int32(2), role.GetVersion())
+}
+
+// TestUpdateRoleHandler_EditConflict tests that a stale version is rejected.
+func TestUpdateRoleHandler_EditConflict(t *testing.T) {
+	testServer := SetupTestServer(t, func(router *httprouter.Router, container *tests.TestContainer) {
+		router.HandlerFunc(http.MethodPost, "/v1/admin/roles", container.CreateRoleHandler.Get().Execute)
+		router.HandlerFunc(http.MethodPatch, "/v1/admin/roles/:id", container.UpdateRoleHandler.Get().Execute)
+	})
+	defer testServer.Server.Close()
+
+	id := createRole(t, testServer, map[string]any{"name": testRolePrefix + "conflict", "scopes": []string{"read"}})
+
+	assert.Equal(t, http.StatusOK, patchRole(t, testServer, id, map[string]any{"description": "v2", "version": 1}))
+	assert.Equal(t, http.StatusConflict, patchRole(t, testServer, id, map[string]any{"description": "v3", "version": 1}))
+}
+
+// TestListRoleHandler_Success tests that the built-in roles are listed.
+func TestListRoleHandler_Success(t *testing.T) {
+	testServer := SetupTestServer(t, func(router *httprouter.Router, container *tests.TestContainer) {
+		router.HandlerFunc(http.MethodGet, "/v1/admin/roles", container.ListRoleHandler.Get().Execute)
+	})
+	defer testServer.Server.Close()
+
+	resp, err := http.Get(testServer.Server.URL + "/v1/admin/roles")
+	require.NoError(t, err)
+	defer func() {
+		if err = resp.Body.Close(); err != nil {
+			log.Println("failed to close response body")
+		}
+	}()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var response []map[string]any
+	err = json.NewDecoder(resp.Body).Decode(&response)
+	require.NoError(t, err)
+
+	var names []string
+	for _, item := range response {
+		names = append(names, item["name"].(string))
+	}
+	assert.Subset(t, names, []string{"admin", "editor", "ingestor", "viewer"})
+}
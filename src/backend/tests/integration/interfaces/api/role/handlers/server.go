@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"net/http/httptest"
+	"testing"
+	"tests"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/julienschmidt/httprouter"
+)
+
+// testRolePrefix prefixes the names of every role created by the tests, so they can be removed afterwards.
+const testRolePrefix = "test-"
+
+// TestServer contains the components for a test HTTP server and database.
+type TestServer struct {
+	Container *tests.TestContainer
+	Server    *httptest.Server
+	Router    *httprouter.Router
+	DB        *pgxpool.Pool
+}
+
+// SetupTestServer initializes the test container and server with customizable routes.
+func SetupTestServer(
+	t *testing.T, configureRoutes func(router *httprouter.Router, container *tests.TestContainer)) *TestServer {
+	container := tests.NewTestContainer()
+
+	router := httprouter.New()
+	configureRoutes(router, container)
+	server := httptest.NewServer(router)
+
+	t.Cleanup(func() {
+		server.Close()
+		Teardown(container.DB.Get())
+	})
+
+	return &TestServer{
+		Container: container,
+		Server:    server,
+		Router:    router,
+		DB:        container.DB.Get(),
+	}
+}
+
+// Teardown removes the roles, assignments and audit records created by the tests.
+// The built-in roles seeded by the migrations are left untouched.
+func Teardown(db *pgxpool.Pool) {
+	ctx := context.Background()
+	_, err := db.Exec(ctx, "DELETE FROM roles WHERE name LIKE $1", testRolePrefix+"%")
+	if err != nil {
+		log.Fatalf("failed to delete test roles: %v", err)
+	}
+	_, err = db.Exec(ctx, "DELETE FROM audit_log WHERE actor = 'anonymous'")
+	if err != nil {
+		log.Fatalf("failed to delete audit records: %v", err)
+	}
+}
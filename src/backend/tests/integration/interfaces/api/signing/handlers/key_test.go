@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	appAuth "application/auth"
+	"application/signing"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	middleware "interfaces/middleware/auth"
+	"log"
+	"net/http"
+	"strconv"
+	"testing"
+	"tests"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// configureKeyRoutes registers the signing key routes and an echo route protected by the HMAC middleware.
+func configureKeyRoutes(router *httprouter.Router, container *tests.TestContainer) {
+	router.HandlerFunc(http.MethodGet, "/v1/admin/signing-keys", container.ListKeyHandler.Get().Execute)
+	router.HandlerFunc(http.MethodPost, "/v1/admin/signing-keys", container.IssueKeyHandler.Get().Execute)
+	router.HandlerFunc(http.MethodDelete, "/v1/admin/signing-keys/:id", container.RevokeKeyHandler.Get().Execute)
+
+	verifier := signing.NewVerifier(container.SigningKeyRepository.Get(), container.RoleService.Get(),
+		signing.NewMemoryNonceCache(time.Minute), time.Minute)
+	hmac := middleware.NewHmacAuthMiddleware(verifier, container.Errors.Get())
+	echo := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, appAuth.PrincipalFromContext(r.Context()))
+	})
+	router.Handler(http.MethodPost, "/v1/echo", hmac.Handle(echo))
+}
+
+// doRequest sends a request to the test server and returns the status code and decoded body, if any.
+func doRequest(t *testing.T, req *http.Request) (int, []byte) {
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer func() {
+		if err = resp.Body.Close(); err != nil {
+			log.Println("failed to close response body")
+		}
+	}()
+
+	var body bytes.Buffer
+	_, err = body.ReadFrom(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, body.Bytes()
+}
+
+// signedEcho builds a request to the echo route signed with the given key.
+func signedEcho(t *testing.T, testServer *TestServer, keyId, secret, nonce string) *http.Request {
+	body := []byte(`{"title":"Software Engineer"}`)
+	req, err := http.NewRequest(http.MethodPost, testServer.Server.URL+"/v1/echo", bytes.NewReader(body))
+	require.NoError(t, err)
+
+	r := &signing.SignedRequest{
+		KeyId:     keyId,
+		Method:    http.MethodPost,
+		Path:      "/v1/echo",
+		Timestamp: strconv.FormatInt(time.Now().Unix(), 10),
+		Nonce:     nonce,
+		Digest:    signing.Digest(body),
+	}
+	req.Header.Set(signing.HeaderKeyId, r.KeyId)
+	req.Header.Set(signing.HeaderTimestamp, r.Timestamp)
+	req.Header.Set(signing.HeaderNonce, r.Nonce)
+	req.Header.Set(signing.HeaderDigest, r.Digest)
+	req.Header.Set(signing.HeaderSignature, signing.Sign(secret, r))
+	return req
+}
+
+// TestKeyHandlers_Lifecycle tests issuing a key, signing a request with it, listing it and revoking it.
+func TestKeyHandlers_Lifecycle(t *testing.T) {
+	testServer := SetupTestServer(t, configureKeyRoutes)
+	defer testServer.Server.Close()
+
+	clientId := testClientPrefix + "scraper"
+
+	// Issue a key, the only response carrying the secret
+	req, err := http.NewRequest(http.MethodPost, testServer.Server.URL+"/v1/admin/signing-keys",
+		bytes.NewBufferString(`{"client_id":"`+clientId+`"}`))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	status, body := doRequest(t, req)
+	require.Equal(t, http.StatusCreated, status)
+
+	var issued map[string]any
+	require.NoError(t, json.Unmarshal(body, &issued))
+	keyId, secret := issued["key_id"].(string), issued["secret"].(string)
+	require.NotEmpty(t, keyId)
+	require.NotEmpty(t, secret)
+
+	// A request signed with the key is authenticated as the client
+	status, body = doRequest(t, signedEcho(t, testServer, keyId, secret, "nonce-1"))
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, clientId, string(body))
+
+	// Replaying the nonce or signing with a wrong secret is rejected
+	status, _ = doRequest(t, signedEcho(t, testServer, keyId, secret, "nonce-1"))
+	assert.Equal(t, http.StatusUnauthorized, status)
+	status, _ = doRequest(t, signedEcho(t, testServer, keyId, "wrong-secret", "nonce-2"))
+	assert.Equal(t, http.StatusUnauthorized, status)
+
+	// The listing never exposes secrets
+	req, err = http.NewRequest(http.MethodGet, testServer.Server.URL+"/v1/admin/signing-keys?client_id="+clientId, nil)
+	require.NoError(t, err)
+	status, body = doRequest(t, req)
+	require.Equal(t, http.StatusOK, status)
+
+	var keys []map[string]any
+	require.NoError(t, json.Unmarshal(body, &keys))
+	require.Len(t, keys, 1)
+	assert.Equal(t, keyId, keys[0]["key_id"])
+	assert.NotContains(t, keys[0], "secret")
+
+	// Revoke the key; a second revocation finds no active key
+	id := strconv.Itoa(int(issued["id"].(float64)))
+	req, err = http.NewRequest(http.MethodDelete, testServer.Server.URL+"/v1/admin/signing-keys/"+id, nil)
+	require.NoError(t, err)
+	status, _ = doRequest(t, req)
+	assert.Equal(t, http.StatusNoContent, status)
+
+	req, err = http.NewRequest(http.MethodDelete, testServer.Server.URL+"/v1/admin/signing-keys/"+id, nil)
+	require.NoError(t, err)
+	status, _ = doRequest(t, req)
+	assert.Equal(t, http.StatusNotFound, status)
+
+	// Requests signed with a revoked key are rejected
+	status, _ = doRequest(t, signedEcho(t, testServer, keyId, secret, "nonce-3"))
+	assert.Equal(t, http.StatusUnauthorized, status)
+}
+
+// TestIssueKeyHandler_ValidationFailure tests failure when the client ID is missing.
+func TestIssueKeyHandler_ValidationFailure(t *testing.T) {
+	testServer := SetupTestServer(t, configureKeyRoutes)
+	defer testServer.Server.Close()
+
+	for _, payload := range []string{`{}`, `{"client_id":"  "}`} {
+		req, err := http.NewRequest(http.MethodPost, testServer.Server.URL+"/v1/admin/signing-keys",
+			bytes.NewBufferString(payload))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		status, _ := doRequest(t, req)
+		assert.Equal(t, http.StatusUnprocessableEntity, status)
+	}
+}
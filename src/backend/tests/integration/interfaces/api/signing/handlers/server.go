@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"net/http/httptest"
+	"testing"
+	"tests"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/julienschmidt/httprouter"
+)
+
+// testClientPrefix prefixes the API clients every signing key is issued to, so they can be removed afterwards.
+const testClientPrefix = "test-"
+
+// TestServer contains the components for a test HTTP server and database.
+type TestServer struct {
+	Container *tests.TestContainer
+	Server    *httptest.Server
+	Router    *httprouter.Router
+	DB        *pgxpool.Pool
+}
+
+// SetupTestServer initializes the test container and server with customizable routes.
+func SetupTestServer(
+	t *testing.T, configureRoutes func(router *httprouter.Router, container *tests.TestContainer)) *TestServer {
+	container := tests.NewTestContainer()
+
+	router := httprouter.New()
+	configureRoutes(router, container)
+	server := httptest.NewServer(router)
+
+	t.Cleanup(func() {
+		server.Close()
+		Teardown(container.DB.Get())
+	})
+
+	return &TestServer{
+		Container: container,
+		Server:    server,
+		Router:    router,
+		DB:        container.DB.Get(),
+	}
+}
+
+// Teardown removes the signing keys and audit records created by the tests.
+func Teardown(db *pgxpool.Pool) {
+	ctx := context.Background()
+	_, err := db.Exec(ctx, "DELETE FROM signing_keys WHERE client_id LIKE $1", testClientPrefix+"%")
+	if err != nil {
+		log.Fatalf("failed to delete test signing keys: %v", err)
+	}
+	_, err = db.Exec(ctx, "DELETE FROM audit_log WHERE actor = 'anonymous' AND action LIKE 'signing_key.%'")
+	if err != nil {
+		log.Fatalf("failed to delete audit records: %v", err)
+	}
+}
@@ -0,0 +1,238 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	vacancyv1 "infrastructure/proto/vacancy/gen"
+	"interfaces/api/utils"
+	"interfaces/api/utils/codec"
+	"interfaces/api/vacancy/dto"
+	"interfaces/api/vacancy/dto/jobposting"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// setupCodecServer starts a test server writing vacancies in the media type negotiated with the client and echoing
+// the vacancies it reads as JSON.
+func setupCodecServer(t *testing.T) *httptest.Server {
+	h := utils.NewHandler()
+	errors := utils.NewErrors(slog.New(slog.NewTextHandler(io.Discard, nil)), h)
+	id, title, location := int64(7), "Go, Engineer", "Berlin"
+	vacancies := []dto.Response{{ID: &id, Title: &title, Location: &location}}
+
+	router := httprouter.New()
+	router.HandlerFunc(http.MethodGet, "/v1/vacancies", func(w http.ResponseWriter, r *http.Request) {
+		if err := h.Write(w, r, http.StatusOK, vacancies, nil); err != nil {
+			errors.WriteErrorResponse(w, r, err)
+		}
+	})
+	router.HandlerFunc(http.MethodGet, "/v1/nested", func(w http.ResponseWriter, r *http.Request) {
+		if err := h.Write(w, r, http.StatusOK, map[string]any{"items": vacancies}, nil); err != nil {
+			errors.WriteErrorResponse(w, r, err)
+		}
+	})
+	router.HandlerFunc(http.MethodPost, "/v1/vacancies", func(w http.ResponseWriter, r *http.Request) {
+		request := dto.GetRequest()
+		if err := h.Read(w, r, &request); err != nil {
+			errors.ReadErrorResponse(w, r, err)
+			return
+		}
+		if err := h.WriteJson(w, http.StatusCreated, request, nil); err != nil {
+			errors.ServerErrorResponse(w, r, err)
+		}
+	})
+
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// exchange sends a request with the given body and headers, and returns the response with its body read.
+func exchange(t *testing.T, method, url string, body []byte, headers map[string]string) (*http.Response, []byte) {
+	req, err := http.NewRequest(method, url, bytes.NewReader(body))
+	require.NoError(t, err)
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp, data
+}
+
+// TestHandler_Write tests the negotiation of the media type of the responses.
+//
+// This test covers the following scenarios:
+// 1. Clients sending no Accept header should get compact JSON, indented with the pretty query parameter.
+// 2. The media type with the highest quality value should be chosen, e.g., MessagePack.
+// 3. Lists of flat objects should be sent as CSV, and lists of protobuf messages delimited by their size.
+// 4. Media types unable to represent the response should be skipped for the next acceptable one, and clients
+// accepting none should get 406 Not Acceptable listing the available media types.
+// 5. Clients accepting the legacy error format should get JSON.
+func TestHandler_Write(t *testing.T) {
+	server := setupCodecServer(t)
+	url := server.URL + "/v1/vacancies"
+
+	t.Run("JSON", func(t *testing.T) {
+		resp, data := exchange(t, http.MethodGet, url, nil, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/json; charset=utf-8", resp.Header.Get("Content-Type"))
+		assert.Equal(t, "Accept", resp.Header.Get("Vary"))
+		assert.Equal(t, `[{"id":7,"title":"Go, Engineer","location":"Berlin"}]`+"\n", string(data))
+
+		_, data = exchange(t, http.MethodGet, url+"?pretty", nil, nil)
+		assert.Contains(t, string(data), "\n\t{\n\t\t\"id\": 7,")
+	})
+
+	t.Run("Quality Values", func(t *testing.T) {
+		resp, data := exchange(t, http.MethodGet, url, nil,
+			map[string]string{"Accept": "application/json;q=0.5, application/msgpack"})
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/msgpack", resp.Header.Get("Content-Type"))
+
+		var vacancies []map[string]any
+		require.NoError(t, codec.MessagePack{}.Decode(bytes.NewReader(data), &vacancies))
+		assert.Equal(t, []map[string]any{{"id": 7.0, "title": "Go, Engineer", "location": "Berlin"}}, vacancies)
+
+		resp, _ = exchange(t, http.MethodGet, url, nil, map[string]string{"Accept": "application/json;q=0, */*"})
+		assert.Equal(t, "application/msgpack", resp.Header.Get("Content-Type"))
+	})
+
+	t.Run("CSV", func(t *testing.T) {
+		resp, data := exchange(t, http.MethodGet, url, nil, map[string]string{"Accept": "text/csv"})
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/csv; charset=utf-8", resp.Header.Get("Content-Type"))
+		assert.Equal(t, "id,title,company,description,posted_at,location,organization_id,version\n"+
+			`7,"Go, Engineer",,,,Berlin,,`+"\n", string(data))
+	})
+
+	t.Run("Protobuf", func(t *testing.T) {
+		resp, data := exchange(t, http.MethodGet, url, nil, map[string]string{"Accept": "application/x-protobuf"})
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/x-protobuf", resp.Header.Get("Content-Type"))
+
+		size, n := binary.Uvarint(data)
+		require.Positive(t, n)
+		require.Len(t, data, n+int(size))
+		var m vacancyv1.ExportedVacancy
+		require.NoError(t, proto.Unmarshal(data[n:], &m))
+		assert.Equal(t, int64(7), m.GetId())
+		assert.Equal(t, "Go, Engineer", m.GetTitle())
+	})
+
+	t.Run("Not Acceptable", func(t *testing.T) {
+		resp, data := exchange(t, http.MethodGet, server.URL+"/v1/nested", nil,
+			map[string]string{"Accept": "text/csv, application/json;q=0.1"})
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/json; charset=utf-8", resp.Header.Get("Content-Type"))
+		assert.Contains(t, string(data), `"items"`)
+
+		resp, data = exchange(t, http.MethodGet, server.URL+"/v1/nested", nil,
+			map[string]string{"Accept": "text/csv, application/x-protobuf"})
+		assert.Equal(t, http.StatusNotAcceptable, resp.StatusCode)
+		assert.Equal(t, utils.ProblemContentType, resp.Header.Get("Content-Type"))
+
+		resp, data = exchange(t, http.MethodGet, url, nil, map[string]string{"Accept": "application/xml"})
+		assert.Equal(t, http.StatusNotAcceptable, resp.StatusCode)
+		assert.Contains(t, string(data),
+			"the response is only available as application/json, application/msgpack, application/x-protobuf, text/csv")
+	})
+
+	t.Run("Legacy Errors", func(t *testing.T) {
+		resp, _ := exchange(t, http.MethodGet, url, nil, map[string]string{"Accept": utils.LegacyErrorType})
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/json; charset=utf-8", resp.Header.Get("Content-Type"))
+	})
+}
+
+// TestHandler_Read tests the decoding of the request bodies by content type.
+//
+// This test covers the following scenarios:
+// 1. A vacancy sent as the CreateVacancyRequest protobuf message should be decoded, leaving its empty fields unset.
+// 2. A vacancy sent as MessagePack should be decoded as strictly as JSON, rejecting unknown fields.
+// 3. Bodies of content types without a decoder should get 415 Unsupported Media Type.
+func TestHandler_Read(t *testing.T) {
+	server := setupCodecServer(t)
+	url := server.URL + "/v1/vacancies"
+
+	t.Run("Protobuf", func(t *testing.T) {
+		body, err := proto.Marshal(&vacancyv1.CreateVacancyRequest{Title: "Go Engineer", Company: "Tech Corp",
+			PostedAt: "2024-11-12", OrganizationId: 3})
+		require.NoError(t, err)
+
+		resp, data := exchange(t, http.MethodPost, url, body, map[string]string{"Content-Type": "application/x-protobuf"})
+		require.Equal(t, http.StatusCreated, resp.StatusCode, string(data))
+		assert.JSONEq(t, `{"title":"Go Engineer","company":"Tech Corp","posted_at":"2024-11-12","organization_id":3}`,
+			string(data))
+	})
+
+	t.Run("MessagePack", func(t *testing.T) {
+		var body bytes.Buffer
+		require.NoError(t, codec.MessagePack{}.Encode(&body, map[string]any{"title": "Go Engineer", "version": 2}))
+		resp, data := exchange(t, http.MethodPost, url, body.Bytes(),
+			map[string]string{"Content-Type": "application/msgpack"})
+		require.Equal(t, http.StatusCreated, resp.StatusCode, string(data))
+		assert.JSONEq(t, `{"title":"Go Engineer","version":2}`, string(data))
+
+		// fixmap of 1: fixstr "bogus" => true
+		resp, data = exchange(t, http.MethodPost, url, append([]byte{0x81, 0xa5}, append([]byte("bogus"), 0xc3)...),
+			map[string]string{"Content-Type": "application/x-msgpack"})
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Contains(t, string(data), `body contains unknown field \"bogus\"`)
+	})
+
+	t.Run("Unsupported Media Type", func(t *testing.T) {
+		resp, data := exchange(t, http.MethodPost, url, []byte("title\nGo Engineer\n"),
+			map[string]string{"Content-Type": "text/csv"})
+		assert.Equal(t, http.StatusUnsupportedMediaType, resp.StatusCode)
+		assert.True(t, strings.Contains(string(data), "application/json, application/msgpack, application/x-protobuf"))
+
+		var problem map[string]any
+		require.NoError(t, json.Unmarshal(data, &problem))
+		assert.EqualValues(t, http.StatusUnsupportedMediaType, problem["status"])
+	})
+}
+
+// TestHandler_Prefers tests the negotiation of a representation written by a handler itself, such as JSON-LD.
+//
+// This test covers the following scenarios:
+// 1. A client accepting the media type only, or with a higher quality value, should prefer it.
+// 2. A client excluding the media type with q=0 should not prefer it, even when a wildcard matches it.
+// 3. The codecs of the handler should win ties, so clients sending no Accept header or a wildcard keep JSON.
+// 4. A codec of the handler, such as CSV, should only be preferred when accepted above the others.
+func TestHandler_Prefers(t *testing.T) {
+	h := utils.NewHandler()
+	jsonLd := jobposting.Codec{}
+	prefers := func(accept string, c codec.Codec) bool {
+		r := httptest.NewRequest(http.MethodGet, "/v1/vacancies", nil)
+		if accept != "" {
+			r.Header.Set("Accept", accept)
+		}
+		return h.Prefers(r, c)
+	}
+
+	assert.True(t, prefers("application/ld+json", jsonLd))
+	assert.True(t, prefers("application/json;q=0.5, application/ld+json", jsonLd))
+	assert.False(t, prefers("application/ld+json;q=0, */*", jsonLd))
+	assert.False(t, prefers("application/ld+json;q=0", jsonLd))
+	assert.False(t, prefers("", jsonLd))
+	assert.False(t, prefers("*/*", jsonLd))
+	assert.False(t, prefers("application/ld+json, application/json", jsonLd))
+
+	assert.True(t, prefers("text/csv", codec.CSV{}))
+	assert.False(t, prefers("text/csv;q=0, application/json", codec.CSV{}))
+	assert.False(t, prefers("text/*", codec.JSON{}))
+}
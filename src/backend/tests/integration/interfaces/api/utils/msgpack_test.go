@@ -0,0 +1,123 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"interfaces/api/utils/codec"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// msgpackFormats are examples of each format of the MessagePack specification a JSON value can take, with the JSON
+// value they hold. The formats the codec encodes values in come first, in the order of the specification.
+var msgpackFormats = []struct {
+	name, json string
+	encoded    []byte
+}{
+	{"nil", `null`, []byte{0xc0}},
+	{"false", `false`, []byte{0xc2}},
+	{"true", `true`, []byte{0xc3}},
+	{"positive fixint", `127`, []byte{0x7f}},
+	{"negative fixint", `-32`, []byte{0xe0}},
+	{"uint 8", `255`, []byte{0xcc, 0xff}},
+	{"uint 16", `65535`, []byte{0xcd, 0xff, 0xff}},
+	{"uint 32", `4294967295`, []byte{0xce, 0xff, 0xff, 0xff, 0xff}},
+	{"uint 64", `18446744073709551615`, []byte{0xcf, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+	{"int 8", `-128`, []byte{0xd0, 0x80}},
+	{"int 16", `-32768`, []byte{0xd1, 0x80, 0x00}},
+	{"int 32", `-2147483648`, []byte{0xd2, 0x80, 0x00, 0x00, 0x00}},
+	{"int 64", `-9223372036854775808`, []byte{0xd3, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}},
+	{"float 64", `1.5`, []byte{0xcb, 0x3f, 0xf8, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}},
+	{"fixstr", `"Go"`, []byte{0xa2, 'G', 'o'}},
+	{"str 8", `"` + strings.Repeat("a", 32) + `"`, append([]byte{0xd9, 32}, strings.Repeat("a", 32)...)},
+	{"str 16", `"` + strings.Repeat("a", 256) + `"`, append([]byte{0xda, 0x01, 0x00}, strings.Repeat("a", 256)...)},
+	{"fixarray", `[1,"a"]`, []byte{0x92, 0x01, 0xa1, 'a'}},
+	{"array 16", `[` + strings.Repeat("0,", 15) + `0]`, append([]byte{0xdc, 0x00, 0x10}, make([]byte, 16)...)},
+	{"fixmap", `{"a":1,"b":[]}`, []byte{0x82, 0xa1, 'a', 0x01, 0xa1, 'b', 0x90}},
+
+	// Formats the codec decodes only.
+	{"float 32", `1.5`, []byte{0xca, 0x3f, 0xc0, 0x00, 0x00}},
+	{"uint 8 holding a fixint", `1`, []byte{0xcc, 0x01}},
+	{"bin 8", `"R28="`, []byte{0xc4, 0x02, 'G', 'o'}},
+	{"bin 16", `"R28="`, []byte{0xc5, 0x00, 0x02, 'G', 'o'}},
+	{"bin 32", `"R28="`, []byte{0xc6, 0x00, 0x00, 0x00, 0x02, 'G', 'o'}},
+	{"str 32", `"Go"`, []byte{0xdb, 0x00, 0x00, 0x00, 0x02, 'G', 'o'}},
+	{"array 32", `[true]`, []byte{0xdd, 0x00, 0x00, 0x00, 0x01, 0xc3}},
+	{"map 16", `{"a":null}`, []byte{0xde, 0x00, 0x01, 0xa1, 'a', 0xc0}},
+	{"map 32", `{"a":null}`, []byte{0xdf, 0x00, 0x00, 0x00, 0x01, 0xa1, 'a', 0xc0}},
+}
+
+// msgpackEncoded is the number of msgpackFormats the codec encodes values in.
+const msgpackEncoded = 20
+
+// TestMessagePack tests the MessagePack codec against the specification.
+//
+// This test covers the following scenarios:
+// 1. Every format should be decoded into the JSON value it holds.
+// 2. JSON values should be encoded in the smallest format holding them, with the keys of maps sorted.
+// 3. Bodies that are truncated, hold more than one value, extension types, keys other than strings or values
+// nested too deep should be rejected.
+func TestMessagePack(t *testing.T) {
+	for i, tc := range msgpackFormats {
+		t.Run(tc.name, func(t *testing.T) {
+			var value json.RawMessage
+			require.NoError(t, codec.MessagePack{}.Decode(bytes.NewReader(tc.encoded), &value))
+			assert.JSONEq(t, tc.json, string(value))
+
+			if i < msgpackEncoded {
+				var body bytes.Buffer
+				require.NoError(t, codec.MessagePack{}.Encode(&body, json.RawMessage(tc.json)))
+				assert.Equal(t, tc.encoded, body.Bytes())
+			}
+		})
+	}
+
+	t.Run("Rejected", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			encoded []byte
+		}{
+			{"truncated string", []byte{0xa2, 'G'}},
+			{"truncated array", []byte{0xdc, 0xff, 0xff, 0xc0}},
+			{"two values", []byte{0xc0, 0xc0}},
+			{"fixext 1", []byte{0xd4, 0x01, 0x00}},
+			{"timestamp 32", []byte{0xd6, 0xff, 0x00, 0x00, 0x00, 0x00}},
+			{"integer key", []byte{0x81, 0x01, 0xc0}},
+			{"never used", []byte{0xc1}},
+			{"too deep", bytes.Repeat([]byte{0x91}, 100)},
+		}
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				var value any
+				assert.Error(t, codec.MessagePack{}.Decode(bytes.NewReader(tc.encoded), &value))
+			})
+		}
+	})
+}
+
+// FuzzMessagePack tests that every body the MessagePack codec decodes is encoded back into a body holding the same
+// JSON value, in the smallest formats, so encoding it again gives the same bytes.
+func FuzzMessagePack(f *testing.F) {
+	for _, tc := range msgpackFormats {
+		f.Add(tc.encoded)
+	}
+	f.Fuzz(func(t *testing.T, encoded []byte) {
+		var value json.RawMessage
+		if err := (codec.MessagePack{}).Decode(bytes.NewReader(encoded), &value); err != nil {
+			return
+		}
+
+		var body bytes.Buffer
+		require.NoError(t, codec.MessagePack{}.Encode(&body, value))
+		var decoded json.RawMessage
+		require.NoError(t, codec.MessagePack{}.Decode(bytes.NewReader(body.Bytes()), &decoded))
+		assert.JSONEq(t, string(value), string(decoded))
+
+		var again bytes.Buffer
+		require.NoError(t, codec.MessagePack{}.Encode(&again, decoded))
+		assert.Equal(t, body.Bytes(), again.Bytes())
+	})
+}
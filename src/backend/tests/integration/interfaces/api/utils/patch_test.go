@@ -0,0 +1,111 @@
+package utils
+
+import (
+	"interfaces/api/utils/patch"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMerge tests the JSON Merge Patch (RFC 7396) with the examples of its appendix.
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		doc, patch, expected string
+	}{
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{`{"a":"b"}`, `{"a":null}`, `{}`},
+		{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{`{"e":null}`, `{"a":1}`, `{"a":1,"e":null}`},
+		{`[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`},
+		{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.patch, func(t *testing.T) {
+			patched, err := patch.Merge([]byte(tc.doc), []byte(tc.patch))
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.expected, string(patched))
+		})
+	}
+
+	_, err := patch.Merge([]byte(`{}`), []byte(`{"a":`))
+	assert.ErrorIs(t, err, patch.ErrMalformed)
+}
+
+// TestApply tests the JSON Patch (RFC 6902) with examples of its appendix.
+//
+// This test covers the following scenarios:
+// 1. Every operation should be applied in order, with arrays, escaped pointers and numbers compared by value.
+// 2. Documents that are not arrays of valid operations should be rejected with ErrMalformed.
+// 3. Operations on missing locations should be rejected with ErrInapplicable.
+// 4. Failed test operations should be rejected with ErrTestFailed.
+func TestApply(t *testing.T) {
+	t.Run("Applied", func(t *testing.T) {
+		tests := []struct {
+			name, doc, patch, expected string
+		}{
+			{"Add member", `{"foo":"bar"}`, `[{"op":"add","path":"/baz","value":"qux"}]`,
+				`{"foo":"bar","baz":"qux"}`},
+			{"Add item", `{"foo":["bar","baz"]}`, `[{"op":"add","path":"/foo/1","value":"qux"}]`,
+				`{"foo":["bar","qux","baz"]}`},
+			{"Append item", `{"foo":[1]}`, `[{"op":"add","path":"/foo/-","value":2}]`, `{"foo":[1,2]}`},
+			{"Remove member", `{"baz":"qux","foo":"bar"}`, `[{"op":"remove","path":"/baz"}]`, `{"foo":"bar"}`},
+			{"Remove item", `{"foo":["bar","qux","baz"]}`, `[{"op":"remove","path":"/foo/1"}]`,
+				`{"foo":["bar","baz"]}`},
+			{"Replace", `{"baz":"qux","foo":"bar"}`, `[{"op":"replace","path":"/baz","value":"boo"}]`,
+				`{"baz":"boo","foo":"bar"}`},
+			{"Move", `{"foo":{"bar":"baz","waldo":"fred"},"qux":{"corge":"grault"}}`,
+				`[{"op":"move","from":"/foo/waldo","path":"/qux/thud"}]`,
+				`{"foo":{"bar":"baz"},"qux":{"corge":"grault","thud":"fred"}}`},
+			{"Copy", `{"foo":{"bar":1}}`, `[{"op":"copy","from":"/foo","path":"/baz"}]`,
+				`{"foo":{"bar":1},"baz":{"bar":1}}`},
+			{"Test", `{"baz":"qux","foo":["a",2,"c"]}`,
+				`[{"op":"test","path":"/baz","value":"qux"},{"op":"test","path":"/foo/1","value":2.0}]`,
+				`{"baz":"qux","foo":["a",2,"c"]}`},
+			{"Escaped pointer", `{"/":9,"~1":10}`, `[{"op":"test","path":"/~01","value":10},` +
+				`{"op":"replace","path":"/~1","value":null}]`, `{"/":null,"~1":10}`},
+			{"Whole document", `{"foo":"bar"}`, `[{"op":"replace","path":"","value":{"baz":"qux"}}]`,
+				`{"baz":"qux"}`},
+		}
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				patched, err := patch.Apply([]byte(tc.doc), []byte(tc.patch))
+				require.NoError(t, err)
+				assert.JSONEq(t, tc.expected, string(patched))
+			})
+		}
+	})
+
+	t.Run("Rejected", func(t *testing.T) {
+		tests := []struct {
+			name, doc, patch string
+			expected         error
+		}{
+			{"Not an array", `{}`, `{"op":"add","path":"/a","value":1}`, patch.ErrMalformed},
+			{"Unknown operation", `{}`, `[{"op":"merge","path":"/a"}]`, patch.ErrMalformed},
+			{"Missing value", `{}`, `[{"op":"add","path":"/a"}]`, patch.ErrMalformed},
+			{"Missing from", `{}`, `[{"op":"copy","path":"/a"}]`, patch.ErrMalformed},
+			{"Invalid pointer", `{}`, `[{"op":"remove","path":"a"}]`, patch.ErrMalformed},
+			{"Missing member", `{"foo":"bar"}`, `[{"op":"replace","path":"/baz","value":1}]`, patch.ErrInapplicable},
+			{"Missing parent", `{"foo":"bar"}`, `[{"op":"add","path":"/baz/bat","value":"qux"}]`,
+				patch.ErrInapplicable},
+			{"Out of bounds", `{"foo":[1]}`, `[{"op":"add","path":"/foo/2","value":2}]`, patch.ErrInapplicable},
+			{"Leading zero", `{"foo":[1,2]}`, `[{"op":"remove","path":"/foo/01"}]`, patch.ErrInapplicable},
+			{"Move into child", `{"foo":{}}`, `[{"op":"move","from":"/foo","path":"/foo/bar"}]`,
+				patch.ErrInapplicable},
+			{"Test failed", `{"baz":"qux"}`, `[{"op":"add","path":"/foo","value":1},` +
+				`{"op":"test","path":"/baz","value":"bar"}]`, patch.ErrTestFailed},
+		}
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				patched, err := patch.Apply([]byte(tc.doc), []byte(tc.patch))
+				assert.ErrorIs(t, err, tc.expected)
+				assert.Nil(t, patched)
+			})
+		}
+	})
+}
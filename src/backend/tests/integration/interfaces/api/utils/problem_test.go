@@ -0,0 +1,131 @@
+package utils
+
+import (
+	"encoding/json"
+	"interfaces/api/utils"
+	"interfaces/middleware"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupServer starts a test server whose router answers errors in the format of the API, as the REST server does.
+func setupServer(t *testing.T) *httptest.Server {
+	errors := utils.NewErrors(slog.New(slog.NewTextHandler(io.Discard, nil)), utils.NewHandler())
+	router := httprouter.New()
+	router.NotFound = http.HandlerFunc(errors.NotFoundResponse)
+	router.MethodNotAllowed = http.HandlerFunc(errors.MethodNotAllowedResponse)
+	router.PanicHandler = middleware.NewRecoveryMiddleware(errors).Recover
+
+	router.HandlerFunc(http.MethodPost, "/v1/vacancies", func(w http.ResponseWriter, r *http.Request) {
+		errors.FailedValidationResponse(w, r, map[string]string{"title": "must be provided", "a/b~c": "is invalid"})
+	})
+	router.HandlerFunc(http.MethodGet, "/v1/vacancies", func(w http.ResponseWriter, r *http.Request) {
+		errors.FailedQueryValidationResponse(w, r, map[string]string{"page_size": "must be greater than zero"})
+	})
+	router.HandlerFunc(http.MethodGet, "/v1/panic", func(w http.ResponseWriter, r *http.Request) {
+		panic("test panic")
+	})
+
+	server := httptest.NewServer(middleware.Chain(router, middleware.RequestId))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// send sends a request with the given headers and returns the response with its decoded body.
+func send(t *testing.T, method, url string, headers map[string]string) (*http.Response, map[string]any) {
+	req, err := http.NewRequest(method, url, nil)
+	require.NoError(t, err)
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	var body map[string]any
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	return resp, body
+}
+
+// TestErrors_Problem tests that errors are sent as RFC 9457 problem details.
+//
+// This test covers the following scenarios:
+// 1. Validation errors should locate the invalid fields with escaped JSON pointers, sorted by field.
+// 2. Invalid query parameters should be located by name.
+// 3. Unknown routes, unsupported methods and panics should be answered as problems by the router hooks.
+// 4. The instance should be the request ID.
+func TestErrors_Problem(t *testing.T) {
+	server := setupServer(t)
+
+	t.Run("Validation", func(t *testing.T) {
+		resp, body := send(t, http.MethodPost, server.URL+"/v1/vacancies", map[string]string{"X-Request-Id": "req-1"})
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+		assert.Equal(t, utils.ProblemContentType, resp.Header.Get("Content-Type"))
+		assert.Equal(t, "/problems/validation-error", body["type"])
+		assert.Equal(t, "Unprocessable Entity", body["title"])
+		assert.Equal(t, float64(http.StatusUnprocessableEntity), body["status"])
+		assert.Equal(t, "req-1", body["instance"])
+		assert.Equal(t, []any{
+			map[string]any{"pointer": "#/a~1b~0c", "detail": "is invalid"},
+			map[string]any{"pointer": "#/title", "detail": "must be provided"},
+		}, body["errors"])
+	})
+
+	t.Run("Query Parameters", func(t *testing.T) {
+		_, body := send(t, http.MethodGet, server.URL+"/v1/vacancies", nil)
+		assert.Equal(t, []any{
+			map[string]any{"parameter": "page_size", "detail": "must be greater than zero"},
+		}, body["errors"])
+	})
+
+	t.Run("Router Hooks", func(t *testing.T) {
+		resp, body := send(t, http.MethodGet, server.URL+"/v1/unknown", nil)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		assert.Equal(t, "/problems/not-found", body["type"])
+
+		resp, body = send(t, http.MethodDelete, server.URL+"/v1/vacancies", nil)
+		assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+		assert.Equal(t, utils.ProblemContentType, resp.Header.Get("Content-Type"))
+		assert.Equal(t, "the DELETE method is not supported for this resource", body["detail"])
+		assert.Contains(t, resp.Header.Get("Allow"), http.MethodGet)
+
+		resp, body = send(t, http.MethodGet, server.URL+"/v1/panic", nil)
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+		assert.Equal(t, "/problems/internal-error", body["type"])
+	})
+}
+
+// TestErrors_Legacy tests that the legacy {"error": ...} bodies stay available during the migration.
+//
+// This test covers the following scenarios:
+// 1. Clients accepting the legacy media type should get legacy bodies.
+// 2. Clients of version 1 of the API should get legacy bodies, unless they accept problem details.
+func TestErrors_Legacy(t *testing.T) {
+	server := setupServer(t)
+
+	t.Run("Accept Header", func(t *testing.T) {
+		resp, body := send(t, http.MethodGet, server.URL+"/v1/unknown",
+			map[string]string{"Accept": "text/html, " + utils.LegacyErrorType + ";q=0.9"})
+		assert.Equal(t, "application/json; charset=utf-8", resp.Header.Get("Content-Type"))
+		assert.Equal(t, map[string]any{"error": "Not Found"}, body)
+	})
+
+	t.Run("API Version", func(t *testing.T) {
+		_, body := send(t, http.MethodPost, server.URL+"/v1/vacancies",
+			map[string]string{utils.HeaderApiVersion: utils.LegacyApiVersion})
+		assert.Equal(t, map[string]any{"error": map[string]any{"title": "must be provided", "a/b~c": "is invalid"}},
+			body)
+
+		resp, body := send(t, http.MethodPost, server.URL+"/v1/vacancies", map[string]string{
+			utils.HeaderApiVersion: utils.LegacyApiVersion, "Accept": utils.ProblemContentType})
+		assert.Equal(t, utils.ProblemContentType, resp.Header.Get("Content-Type"))
+		assert.Len(t, body["errors"], 2)
+	})
+}
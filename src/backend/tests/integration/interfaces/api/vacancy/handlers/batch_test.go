@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"interfaces/middleware"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+	"tests"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupBatchServer initializes the test server with the batch methods, dispatched as by the application routes.
+func setupBatchServer(t *testing.T) *TestServer {
+	return SetupTestServer(t, func(router *middleware.Router, container *tests.TestContainer) {
+		bh := container.BatchHandler.Get()
+		methods := map[string]http.HandlerFunc{
+			"batchCreate": bh.Create,
+			"batchUpdate": bh.Update,
+			"batchDelete": bh.Delete,
+		}
+		router.HandlerFunc(http.MethodPost, "/v1/vacancies:method", func(w http.ResponseWriter, r *http.Request) {
+			name := strings.TrimPrefix(httprouter.ParamsFromContext(r.Context()).ByName("method"), ":")
+			methods[name](w, r)
+		})
+	})
+}
+
+// sendBatch sends a batch request with the given JSON payload and returns the status code and the decoded response.
+func sendBatch(t *testing.T, testServer *TestServer, method string, payload map[string]any) (int, map[string]any) {
+	body, err := json.Marshal(payload)
+	require.NoError(t, err)
+
+	resp, err := http.Post(testServer.Server.URL+"/v1/vacancies:"+method, "application/json", bytes.NewBuffer(body))
+	require.NoError(t, err)
+	defer func() {
+		if err = resp.Body.Close(); err != nil {
+			log.Println("failed to close response body")
+		}
+	}()
+
+	var response map[string]any
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&response))
+	return resp.StatusCode, response
+}
+
+// batchItem returns the result of the item at the given index of a batch response.
+func batchItem(t *testing.T, response map[string]any, index int) map[string]any {
+	items, ok := response["items"].([]any)
+	require.True(t, ok, "expected items in response")
+	require.Greater(t, len(items), index)
+	item, ok := items[index].(map[string]any)
+	require.True(t, ok)
+	return item
+}
+
+// vacancyPayload returns a valid vacancy with the given title.
+func vacancyPayload(title string) map[string]any {
+	return map[string]any{
+		"title":       title,
+		"company":     "Tech Corp",
+		"description": "Works on the batch API",
+		"posted_at":   time.Now().Format(time.DateOnly),
+		"location":    "Remote",
+	}
+}
+
+// TestBatchVacancyHandler_Atomic tests that an atomic batch is applied as a whole or not at all.
+func TestBatchVacancyHandler_Atomic(t *testing.T) {
+	testServer := setupBatchServer(t)
+
+	// Every item is created
+	status, response := sendBatch(t, testServer, "batchCreate", map[string]any{
+		"items": []any{vacancyPayload("Engineer"), vacancyPayload("Tester")},
+	})
+	require.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "atomic", response["mode"])
+	assert.Equal(t, float64(2), response["succeeded"])
+	assert.Equal(t, float64(http.StatusCreated), batchItem(t, response, 0)["status"])
+	assert.NotNil(t, batchItem(t, response, 1)["id"])
+
+	// An invalid item aborts the others
+	invalid := vacancyPayload("")
+	status, response = sendBatch(t, testServer, "batchCreate", map[string]any{
+		"items": []any{vacancyPayload("Designer"), invalid},
+	})
+	require.Equal(t, http.StatusMultiStatus, status)
+	assert.Equal(t, float64(0), response["succeeded"])
+	assert.Equal(t, float64(2), response["failed"])
+	assert.Equal(t, float64(http.StatusFailedDependency), batchItem(t, response, 0)["status"])
+	assert.Equal(t, float64(http.StatusUnprocessableEntity), batchItem(t, response, 1)["status"])
+	errs, ok := batchItem(t, response, 1)["errors"].([]any)
+	require.True(t, ok)
+	assert.Equal(t, "#/items/1/title", errs[0].(map[string]any)["pointer"])
+
+	var count int
+	require.NoError(t, testServer.DB.QueryRow(context.Background(), "SELECT count(*) FROM job_vacancies").Scan(&count))
+	assert.Equal(t, 2, count)
+}
+
+// TestBatchVacancyHandler_BestEffort tests that a best-effort batch applies the items that succeed.
+func TestBatchVacancyHandler_BestEffort(t *testing.T) {
+	testServer := setupBatchServer(t)
+
+	status, response := sendBatch(t, testServer, "batchCreate", map[string]any{
+		"items": []any{vacancyPayload("Engineer"), vacancyPayload("Tester")},
+	})
+	require.Equal(t, http.StatusOK, status)
+	first := batchItem(t, response, 0)["id"]
+	second := batchItem(t, response, 1)["id"]
+
+	// Updating a missing vacancy fails on its own
+	status, response = sendBatch(t, testServer, "batchUpdate", map[string]any{
+		"mode": "best_effort",
+		"items": []any{
+			map[string]any{"id": first, "title": "Senior Engineer"},
+			map[string]any{"id": 9999, "title": "Ghost"},
+		},
+	})
+	require.Equal(t, http.StatusMultiStatus, status)
+	assert.Equal(t, float64(http.StatusOK), batchItem(t, response, 0)["status"])
+	assert.Equal(t, float64(http.StatusNotFound), batchItem(t, response, 1)["status"])
+
+	var title string
+	require.NoError(t, testServer.DB.QueryRow(context.Background(),
+		"SELECT title FROM job_vacancies WHERE id = $1", first).Scan(&title))
+	assert.Equal(t, "Senior Engineer", title)
+
+	// Deleting a missing vacancy fails on its own
+	status, response = sendBatch(t, testServer, "batchDelete", map[string]any{
+		"mode": "best_effort",
+		"ids":  []any{second, 9999},
+	})
+	require.Equal(t, http.StatusMultiStatus, status)
+	assert.Equal(t, float64(http.StatusNoContent), batchItem(t, response, 0)["status"])
+	assert.Equal(t, float64(http.StatusNotFound), batchItem(t, response, 1)["status"])
+	assert.Equal(t, float64(1), response["succeeded"])
+}
+
+// TestBatchVacancyHandler_InvalidBatch tests that batches with an invalid mode or too many items are rejected.
+func TestBatchVacancyHandler_InvalidBatch(t *testing.T) {
+	testServer := setupBatchServer(t)
+
+	status, _ := sendBatch(t, testServer, "batchDelete", map[string]any{"mode": "eventual", "ids": []any{1}})
+	assert.Equal(t, http.StatusUnprocessableEntity, status)
+
+	status, _ = sendBatch(t, testServer, "batchDelete", map[string]any{"ids": []any{}})
+	assert.Equal(t, http.StatusUnprocessableEntity, status)
+
+	ids := make([]any, testServer.Container.Config.Get().Batch.MaxItems+1)
+	for i := range ids {
+		ids[i] = i + 1
+	}
+	status, _ = sendBatch(t, testServer, "batchDelete", map[string]any{"ids": ids})
+	assert.Equal(t, http.StatusUnprocessableEntity, status)
+}
@@ -3,20 +3,20 @@ package handlers
 import (
 	"bytes"
 	"encoding/json"
+	"interfaces/middleware"
 	"log"
 	"net/http"
 	"testing"
 	"tests"
 	"time"
 
-	"github.com/julienschmidt/httprouter"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 // TestCreateVacancyHandler_Success tests the successful creation of a job vacancy.
 func TestCreateVacancyHandler_Success(t *testing.T) {
-	testServer := SetupTestServer(t, func(router *httprouter.Router, container *tests.TestContainer) {
+	testServer := SetupTestServer(t, func(router *middleware.Router, container *tests.TestContainer) {
 		router.HandlerFunc(http.MethodPost, "/v1/vacancies", container.CreateHandler.Get().Execute)
 	})
 	defer testServer.Server.Close()
@@ -57,7 +57,7 @@ func TestCreateVacancyHandler_Success(t *testing.T) {
 
 // TestCreateVacancyHandler_ValidationFailure tests failure when required fields are missing.
 func TestCreateVacancyHandler_ValidationFailure(t *testing.T) {
-	testServer := SetupTestServer(t, func(router *httprouter.Router, container *tests.TestContainer) {
+	testServer := SetupTestServer(t, func(router *middleware.Router, container *tests.TestContainer) {
 		router.HandlerFunc(http.MethodPost, "/v1/vacancies", container.CreateHandler.Get().Execute)
 	})
 	defer testServer.Server.Close()
@@ -86,9 +86,6 @@ func TestCreateVacancyHandler_ValidationFailure(t *testing.T) {
 	err = json.NewDecoder(resp.Body).Decode(&response)
 	require.NoError(t, err)
 
-	assert.Len(t, response["error"], 4)
-	assert.Contains(t, response["error"], "description")
-	assert.Contains(t, response["error"], "location")
-	assert.Contains(t, response["error"], "posted_at")
-	assert.Contains(t, response["error"], "title")
+	assert.Equal(t, []string{"#/description", "#/location", "#/posted_at", "#/title"},
+		tests.ProblemLocations(response))
 }
@@ -3,6 +3,7 @@ package handlers
 import (
 	"bytes"
 	"encoding/json"
+	"interfaces/middleware"
 	"log"
 	"net/http"
 	"strconv"
@@ -10,7 +11,6 @@ import (
 	"tests"
 	"time"
 
-	"github.com/julienschmidt/httprouter"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -41,7 +41,7 @@ func createVacancy(t *testing.T, testServer *TestServer, payload map[string]any)
 
 // TestDeleteVacancyHandler_Success tests the successful deletion of a job vacancy.
 func TestDeleteVacancyHandler_Success(t *testing.T) {
-	testServer := SetupTestServer(t, func(router *httprouter.Router, container *tests.TestContainer) {
+	testServer := SetupTestServer(t, func(router *middleware.Router, container *tests.TestContainer) {
 		router.HandlerFunc(http.MethodPost, "/v1/vacancies", container.CreateHandler.Get().Execute)
 		router.HandlerFunc(http.MethodDelete, "/v1/vacancies/:id", container.DeleteHandler.Get().Execute)
 	})
@@ -88,7 +88,7 @@ func TestDeleteVacancyHandler_Success(t *testing.T) {
 
 // TestDeleteVacancyHandler_NotFound tests attempting to delete a non-existent vacancy.
 func TestDeleteVacancyHandler_NotFound(t *testing.T) {
-	testServer := SetupTestServer(t, func(router *httprouter.Router, container *tests.TestContainer) {
+	testServer := SetupTestServer(t, func(router *middleware.Router, container *tests.TestContainer) {
 		router.HandlerFunc(http.MethodDelete, "/v1/vacancies/:id", container.DeleteHandler.Get().Execute)
 	})
 	defer testServer.Server.Close()
@@ -112,7 +112,7 @@ func TestDeleteVacancyHandler_NotFound(t *testing.T) {
 
 // TestDeleteVacancyHandler_InvalidID tests attempting to delete a vacancy with an invalid ID.
 func TestDeleteVacancyHandler_InvalidID(t *testing.T) {
-	testServer := SetupTestServer(t, func(router *httprouter.Router, container *tests.TestContainer) {
+	testServer := SetupTestServer(t, func(router *middleware.Router, container *tests.TestContainer) {
 		router.HandlerFunc(http.MethodDelete, "/v1/vacancies/:id", container.DeleteHandler.Get().Execute)
 	})
 	defer testServer.Server.Close()
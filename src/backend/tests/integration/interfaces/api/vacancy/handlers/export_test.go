@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"interfaces/middleware"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"testing"
+	"tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// getExport requests an export with the given query and Accept-Encoding header, if any, and returns the response
+// with its body read, as sent.
+func getExport(t *testing.T, testServer *TestServer, query, encoding string) (*http.Response, []byte) {
+	req, err := http.NewRequest(http.MethodGet, testServer.Server.URL+"/v1/vacancies/export?"+query, nil)
+	require.NoError(t, err)
+	if encoding != "" {
+		req.Header.Set("Accept-Encoding", encoding)
+	}
+
+	resp, err := http.DefaultTransport.RoundTrip(req)
+	require.NoError(t, err)
+	defer func() {
+		if err = resp.Body.Close(); err != nil {
+			log.Println("failed to close response body")
+		}
+	}()
+
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp, data
+}
+
+// TestExportVacancyHandler tests exporting the vacancies matching the list filters.
+//
+// This test covers the following scenarios:
+// 1. Every vacancy should be exported as CSV with a header row and every column by default, sorted by ID.
+// 2. The selected columns of the vacancies matching the filters should be exported as NDJSON, in order.
+// 3. The export should be gzipped if the client accepts it.
+// 4. Unknown formats, columns and sort orders should be rejected with 422 Unprocessable Entity.
+func TestExportVacancyHandler(t *testing.T) {
+	testServer := SetupTestServer(t, func(router *middleware.Router, container *tests.TestContainer) {
+		router.HandlerFunc(http.MethodGet, "/v1/vacancies/export", container.ExportHandler.Get().Execute)
+	})
+	defer testServer.Server.Close()
+
+	ctx := context.Background()
+	v1 := newVacancy("Integration Test Engineer", "Tech Corp", "Responsible for integration testing", "Remote")
+	v2 := newVacancy("Software Developer", "Innovatech", "Develop cutting-edge software", "New York")
+	require.NoError(t, testServer.Container.VacancyRepository.Get().Save(ctx, v1))
+	require.NoError(t, testServer.Container.VacancyRepository.Get().Save(ctx, v2))
+
+	t.Run("CSV", func(t *testing.T) {
+		resp, data := getExport(t, testServer, "", "")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/csv; charset=utf-8", resp.Header.Get("Content-Type"))
+		assert.Equal(t, `attachment; filename="vacancies.csv"`, resp.Header.Get("Content-Disposition"))
+
+		records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 3)
+		assert.Equal(t, []string{"id", "title", "company", "description", "posted_at", "location", "organization_id",
+			"version"}, records[0])
+		assert.Equal(t, strconv.FormatInt(v1.GetId(), 10), records[1][0])
+		assert.Equal(t, v1.GetTitle(), records[1][1])
+		assert.Equal(t, v2.GetTitle(), records[2][1])
+	})
+
+	t.Run("NDJSON", func(t *testing.T) {
+		resp, data := getExport(t, testServer, "format=ndjson&company=innova&columns=title,id", "")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/x-ndjson", resp.Header.Get("Content-Type"))
+		assert.Equal(t, `{"title":"Software Developer","id":`+strconv.FormatInt(v2.GetId(), 10)+"}\n", string(data))
+	})
+
+	t.Run("Gzip", func(t *testing.T) {
+		resp, data := getExport(t, testServer, "format=ndjson&sort_order=desc", "gzip")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "gzip", resp.Header.Get("Content-Encoding"))
+
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		require.NoError(t, err)
+		decoder := json.NewDecoder(reader)
+		var titles []any
+		for decoder.More() {
+			var line map[string]any
+			require.NoError(t, decoder.Decode(&line))
+			titles = append(titles, line["title"])
+		}
+		assert.Equal(t, []any{v2.GetTitle(), v1.GetTitle()}, titles)
+	})
+
+	t.Run("Validation Failure", func(t *testing.T) {
+		resp, data := getExport(t, testServer, "format=xml&columns=title,salary&sort_order=up", "")
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+		var response map[string]any
+		require.NoError(t, json.Unmarshal(data, &response))
+		assert.ElementsMatch(t, []string{"columns", "format", "sort_order"}, tests.ProblemLocations(response))
+	})
+}
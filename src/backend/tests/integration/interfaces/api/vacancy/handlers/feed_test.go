@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"interfaces/middleware"
+	"io"
+	"log"
+	"net/http"
+	"testing"
+	"tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// getFeed requests a feed with the given path, query and headers, and returns the response with its body read.
+func getFeed(
+	t *testing.T,
+	testServer *TestServer,
+	path, query string,
+	headers map[string]string,
+) (*http.Response, []byte) {
+	req, err := http.NewRequest(http.MethodGet, testServer.Server.URL+path+"?"+query, nil)
+	require.NoError(t, err)
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer func() {
+		if err = resp.Body.Close(); err != nil {
+			log.Println("failed to close response body")
+		}
+	}()
+
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp, data
+}
+
+// TestFeedVacancyHandler tests the RSS and Atom feeds of the vacancies matching the filters.
+//
+// This test covers the following scenarios:
+// 1. The RSS feed should list the most recently added vacancies matching the filters, newest first, with GUIDs
+// derived from their ID and version, leaving the token of the URL out of the links.
+// 2. The Atom feed should list the same vacancies as entries.
+// 3. Requests with the ETag or the Last-Modified of the feed should get 304 Not Modified, until a vacancy of the
+// feed is updated, which changes its GUID and the ETag.
+func TestFeedVacancyHandler(t *testing.T) {
+	testServer := SetupTestServer(t, func(router *middleware.Router, container *tests.TestContainer) {
+		router.HandlerFunc(http.MethodGet, "/v1/feeds/vacancies.rss", container.FeedHandler.Get().Rss)
+		router.HandlerFunc(http.MethodGet, "/v1/feeds/vacancies.atom", container.FeedHandler.Get().Atom)
+	})
+
+	ctx := context.Background()
+	v1 := newVacancy("Backend Engineer", "Tech Corp", "Builds APIs", "Berlin")
+	v2 := newVacancy("Frontend Engineer", "Tech Corp", "Builds pages", "Berlin")
+	v3 := newVacancy("Data Engineer", "Tech Corp", "Moves data", "Remote")
+	require.NoError(t, testServer.Container.VacancyRepository.Get().Save(ctx, v1))
+	require.NoError(t, testServer.Container.VacancyRepository.Get().Save(ctx, v2))
+	require.NoError(t, testServer.Container.VacancyRepository.Get().Save(ctx, v3))
+
+	t.Run("RSS", func(t *testing.T) {
+		resp, data := getFeed(t, testServer, "/v1/feeds/vacancies.rss", "location=berlin&token=secret", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/rss+xml; charset=utf-8", resp.Header.Get("Content-Type"))
+		assert.NotEmpty(t, resp.Header.Get("ETag"))
+		assert.NotEmpty(t, resp.Header.Get("Last-Modified"))
+
+		var document struct {
+			Channel struct {
+				Title string `xml:"title"`
+				Link  string `xml:"link"`
+				Items []struct {
+					Title string `xml:"title"`
+					GUID  string `xml:"guid"`
+				} `xml:"item"`
+			} `xml:"channel"`
+		}
+		require.NoError(t, xml.Unmarshal(data, &document))
+		assert.Equal(t, `Pulse Finder vacancies matching location "berlin"`, document.Channel.Title)
+		assert.Equal(t, testServer.Server.URL+"/v1/feeds/vacancies.rss?location=berlin", document.Channel.Link,
+			"the token should be left out of the link")
+		require.Len(t, document.Channel.Items, 2)
+		assert.Equal(t, "Frontend Engineer at Tech Corp (Berlin)", document.Channel.Items[0].Title)
+		assert.Equal(t, fmt.Sprintf("urn:pulse-finder:vacancy:%d:1", v2.GetId()), document.Channel.Items[0].GUID)
+		assert.Equal(t, fmt.Sprintf("urn:pulse-finder:vacancy:%d:1", v1.GetId()), document.Channel.Items[1].GUID)
+	})
+
+	t.Run("Atom", func(t *testing.T) {
+		resp, data := getFeed(t, testServer, "/v1/feeds/vacancies.atom", "title=data", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/atom+xml; charset=utf-8", resp.Header.Get("Content-Type"))
+
+		var document struct {
+			XMLName xml.Name `xml:"http://www.w3.org/2005/Atom feed"`
+			Entries []struct {
+				Id     string `xml:"id"`
+				Author string `xml:"author>name"`
+			} `xml:"entry"`
+		}
+		require.NoError(t, xml.Unmarshal(data, &document))
+		require.Len(t, document.Entries, 1)
+		assert.Equal(t, fmt.Sprintf("urn:pulse-finder:vacancy:%d:1", v3.GetId()), document.Entries[0].Id)
+		assert.Equal(t, "Tech Corp", document.Entries[0].Author)
+	})
+
+	t.Run("Conditional Requests", func(t *testing.T) {
+		resp, _ := getFeed(t, testServer, "/v1/feeds/vacancies.rss", "", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		etag, lastModified := resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")
+
+		resp, data := getFeed(t, testServer, "/v1/feeds/vacancies.rss", "", map[string]string{"If-None-Match": etag})
+		assert.Equal(t, http.StatusNotModified, resp.StatusCode)
+		assert.Empty(t, data)
+		resp, _ = getFeed(t, testServer, "/v1/feeds/vacancies.rss", "",
+			map[string]string{"If-Modified-Since": lastModified})
+		assert.Equal(t, http.StatusNotModified, resp.StatusCode)
+
+		require.NoError(t, testServer.Container.VacancyRepository.Get().Update(ctx, v1.SetTitle("Platform Engineer")))
+		resp, data = getFeed(t, testServer, "/v1/feeds/vacancies.rss", "", map[string]string{"If-None-Match": etag})
+		require.Equal(t, http.StatusOK, resp.StatusCode, "an updated vacancy should change the ETag")
+		assert.NotEqual(t, etag, resp.Header.Get("ETag"))
+		assert.Contains(t, string(data), fmt.Sprintf("urn:pulse-finder:vacancy:%d:2", v1.GetId()))
+	})
+}
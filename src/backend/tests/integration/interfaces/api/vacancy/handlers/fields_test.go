@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"interfaces/middleware"
+	"net/http"
+	"strconv"
+	"testing"
+	"tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestVacancyHandlers_Fields tests the sparse fieldsets of the get and list endpoints.
+//
+// This test covers the following scenarios:
+// 1. A vacancy requested with fields should hold the requested fields only.
+// 2. The listed vacancies should hold the requested fields only, the others left out of the JSON.
+// 3. Unknown or repeated fields should get 422 Unprocessable Entity.
+// 4. Clients accepting JSON-LD should get complete JobPostings, the fields being ignored.
+func TestVacancyHandlers_Fields(t *testing.T) {
+	testServer := SetupTestServer(t, func(router *middleware.Router, container *tests.TestContainer) {
+		router.HandlerFunc(http.MethodGet, "/v1/vacancies/:id", container.GetHandler.Get().Execute)
+		router.HandlerFunc(http.MethodGet, "/v1/vacancies", container.ListHandler.Get().Execute)
+	})
+
+	ctx := context.Background()
+	v := newVacancy("Sparse Grid Engineer", "Fieldset Corp", "A very long description", "Lisbon")
+	require.NoError(t, testServer.Container.VacancyRepository.Get().Save(ctx, v))
+	path := "/v1/vacancies/" + strconv.FormatInt(v.GetId(), 10)
+
+	t.Run("Get", func(t *testing.T) {
+		resp, data := getFeed(t, testServer, path, "fields=title,location", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(data))
+		assert.JSONEq(t, `{"title":"Sparse Grid Engineer","location":"Lisbon"}`, string(data))
+	})
+
+	t.Run("List", func(t *testing.T) {
+		resp, data := getFeed(t, testServer, "/v1/vacancies", "title=sparse+grid&fields=title,company,posted_at", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(data))
+
+		var items []map[string]any
+		require.NoError(t, json.Unmarshal(data, &items))
+		require.Len(t, items, 1)
+		assert.Equal(t, map[string]any{"title": "Sparse Grid Engineer", "company": "Fieldset Corp",
+			"posted_at": v.GetPostedAt().Format("2006-01-02")}, items[0])
+	})
+
+	t.Run("Unknown Field", func(t *testing.T) {
+		resp, data := getFeed(t, testServer, "/v1/vacancies", "fields=title,salary", nil)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+		assert.Contains(t, string(data), "fields must list distinct fields among")
+
+		resp, _ = getFeed(t, testServer, path, "fields=title,title", nil)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	})
+
+	t.Run("JSON-LD", func(t *testing.T) {
+		resp, data := getFeed(t, testServer, path, "fields=title", map[string]string{"Accept": "application/ld+json"})
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(data))
+
+		var posting map[string]any
+		require.NoError(t, json.Unmarshal(data, &posting))
+		assert.Equal(t, v.GetDescription(), posting["description"])
+	})
+}
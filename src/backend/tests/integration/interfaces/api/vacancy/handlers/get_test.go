@@ -4,6 +4,7 @@ import (
 	"context"
 	"domain/vacancy/entity"
 	"encoding/json"
+	"interfaces/middleware"
 	"log"
 	"net/http"
 	"strconv"
@@ -11,7 +12,6 @@ import (
 	"tests"
 	"time"
 
-	"github.com/julienschmidt/httprouter"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -28,7 +28,7 @@ func newVacancy(title, company, description, location string) *entity.Vacancy {
 
 // TestGetVacancyHandler_Success tests successfully fetching a job vacancy by ID.
 func TestGetVacancyHandler_Success(t *testing.T) {
-	testServer := SetupTestServer(t, func(router *httprouter.Router, container *tests.TestContainer) {
+	testServer := SetupTestServer(t, func(router *middleware.Router, container *tests.TestContainer) {
 		router.HandlerFunc(http.MethodGet, "/v1/vacancies/:id", container.GetHandler.Get().Execute)
 	})
 	defer testServer.Server.Close()
@@ -63,7 +63,7 @@ func TestGetVacancyHandler_Success(t *testing.T) {
 
 // TestGetVacancyHandler_NotFound tests fetching a vacancy that does not exist.
 func TestGetVacancyHandler_NotFound(t *testing.T) {
-	testServer := SetupTestServer(t, func(router *httprouter.Router, container *tests.TestContainer) {
+	testServer := SetupTestServer(t, func(router *middleware.Router, container *tests.TestContainer) {
 		router.HandlerFunc(http.MethodGet, "/v1/vacancies/:id", container.GetHandler.Get().Execute)
 	})
 	defer testServer.Server.Close()
@@ -85,5 +85,5 @@ func TestGetVacancyHandler_NotFound(t *testing.T) {
 	err = json.NewDecoder(resp.Body).Decode(&response)
 	require.NoError(t, err)
 
-	assert.Contains(t, response["error"], "Not Found")
+	assert.Equal(t, "Not Found", response["title"])
 }
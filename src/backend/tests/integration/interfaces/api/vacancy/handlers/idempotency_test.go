@@ -0,0 +1,154 @@
+package handlers
+
+import (
+	"application/idempotency"
+	"bytes"
+	"context"
+	"encoding/json"
+	infraIdempotency "infrastructure/idempotency"
+	"interfaces/middleware"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+	"tests"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sendIdempotent sends a request creating a vacancy with the given idempotency key, if any, and returns the
+// response with its body read.
+func sendIdempotent(t *testing.T, testServer *TestServer, key, body string) (*http.Response, []byte) {
+	req, err := http.NewRequest(http.MethodPost, testServer.Server.URL+"/v1/vacancies", bytes.NewBufferString(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	if key != "" {
+		req.Header.Set(idempotency.HeaderKey, key)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer func() {
+		if err = resp.Body.Close(); err != nil {
+			log.Println("failed to close response body")
+		}
+	}()
+
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp, data
+}
+
+// TestCreateVacancyHandler_Idempotency tests the creation of vacancies with an Idempotency-Key header.
+//
+// This test covers the following scenarios:
+// 1. A retry should get the response of the original request, marked as replayed, without creating a vacancy.
+// 2. Reusing a key with another body should be rejected with 422 Unprocessable Entity.
+// 3. A retry sent while the original request is in progress should be rejected with 409 Conflict.
+// 4. Invalid keys should be rejected with 400 Bad Request, and requests without a key are not deduplicated.
+func TestCreateVacancyHandler_Idempotency(t *testing.T) {
+	testServer := SetupTestServer(t, func(router *middleware.Router, container *tests.TestContainer) {
+		router.HandlerFunc(http.MethodPost, "/v1/vacancies", middleware.ApplyMiddleware(
+			container.CreateHandler.Get().Execute, container.IdempotencyMiddleware.Get().Handle(router)))
+	})
+	body := `{"title":"Integration Test Engineer","company":"Tech Corp",` +
+		`"description":"Responsible for integration testing","posted_at":"2025-01-01","location":"Remote"}`
+	count := func() int {
+		var n int
+		require.NoError(t, testServer.DB.QueryRow(context.Background(),
+			"SELECT COUNT(*) FROM job_vacancies").Scan(&n))
+		return n
+	}
+
+	t.Run("Replayed", func(t *testing.T) {
+		original, originalBody := sendIdempotent(t, testServer, "test-key-1", body)
+		require.Equal(t, http.StatusCreated, original.StatusCode)
+		assert.Empty(t, original.Header.Get(idempotency.HeaderReplayed))
+
+		retry, retryBody := sendIdempotent(t, testServer, "test-key-1", body)
+		require.Equal(t, http.StatusCreated, retry.StatusCode)
+		assert.Equal(t, "true", retry.Header.Get(idempotency.HeaderReplayed))
+		assert.Equal(t, original.Header.Get("Content-Type"), retry.Header.Get("Content-Type"))
+		assert.JSONEq(t, string(originalBody), string(retryBody))
+		assert.Equal(t, 1, count())
+	})
+
+	t.Run("Key Reused", func(t *testing.T) {
+		other := strings.Replace(body, "Remote", "Berlin", 1)
+		resp, data := sendIdempotent(t, testServer, "test-key-1", other)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+		var problem map[string]any
+		require.NoError(t, json.Unmarshal(data, &problem))
+		assert.Equal(t, idempotency.ErrKeyReused.Error(), problem["detail"])
+		assert.Equal(t, 1, count())
+	})
+
+	t.Run("In Progress", func(t *testing.T) {
+		key := idempotency.Key("POST /v1/vacancies", testPrincipal, "test-key-2")
+		_, stored, err := testServer.Container.Idempotency.Get().Begin(context.Background(), key,
+			idempotency.Fingerprint([]byte("/v1/vacancies"), []byte(body)))
+		require.NoError(t, err)
+		require.Nil(t, stored)
+
+		resp, _ := sendIdempotent(t, testServer, "test-key-2", body)
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+		assert.Equal(t, 1, count())
+	})
+
+	t.Run("Invalid Key", func(t *testing.T) {
+		resp, _ := sendIdempotent(t, testServer, strings.Repeat("k", idempotency.MaxKeyLength+1), body)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Without Key", func(t *testing.T) {
+		for range 2 {
+			resp, _ := sendIdempotent(t, testServer, "", body)
+			assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		}
+		assert.Equal(t, 3, count())
+	})
+}
+
+// TestPgxStore_Takeover tests a retry taking over the key of a request whose lock expired.
+//
+// This test covers the following scenarios:
+// 1. A retry sent once the lock of the original request expired should take the key over.
+// 2. The original request should neither store its response nor release the key once taken over.
+// 3. The retry should complete the key, its response being replayed to later retries.
+func TestPgxStore_Takeover(t *testing.T) {
+	testServer := SetupTestServer(t, func(*middleware.Router, *tests.TestContainer) {})
+	store := infraIdempotency.NewPgxStore(testServer.DB, time.Minute)
+	ctx := context.Background()
+	key := idempotency.Key("POST /v1/vacancies", testPrincipal, "test-key-takeover")
+	original := idempotency.Lock{Key: key, Owner: "original"}
+	retry := idempotency.Lock{Key: key, Owner: "retry"}
+	now := time.Now()
+
+	stored, err := store.Begin(ctx, original, "fingerprint", now, now.Add(time.Second))
+	require.NoError(t, err)
+	require.Nil(t, stored)
+
+	stored, err = store.Begin(ctx, retry, "fingerprint", now.Add(2*time.Second), now.Add(time.Minute))
+	require.NoError(t, err)
+	require.Nil(t, stored, "the retry should take the expired key over")
+
+	require.NoError(t, store.Complete(ctx, original, idempotency.Response{Status: http.StatusCreated}, now.Add(time.Hour)))
+	require.NoError(t, store.Release(ctx, original))
+	stored, err = store.Begin(ctx, original, "fingerprint", now.Add(3*time.Second), now.Add(time.Minute))
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	assert.Nil(t, stored.Response, "the original request should not touch the key taken over")
+
+	response := idempotency.Response{Status: http.StatusConflict, Body: []byte("retry")}
+	require.NoError(t, store.Complete(ctx, retry, response, now.Add(time.Hour)))
+	stored, err = store.Begin(ctx, original, "fingerprint", now.Add(4*time.Second), now.Add(time.Minute))
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	require.NotNil(t, stored.Response)
+	assert.Equal(t, http.StatusConflict, stored.Response.Status)
+	assert.Equal(t, []byte("retry"), stored.Response.Body)
+}
@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"interfaces/middleware"
+	"io"
+	"log"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+	"tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// uploadImport uploads the content as the file of an import with the given query and Accept header, if any, and
+// returns the response with its body read.
+func uploadImport(t *testing.T, testServer *TestServer, query, content, accept string) (*http.Response, []byte) {
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	if content != "" {
+		part, err := form.CreateFormFile("file", "vacancies")
+		require.NoError(t, err)
+		_, err = part.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, form.Close())
+
+	req, err := http.NewRequest(http.MethodPost, testServer.Server.URL+"/v1/vacancies:import?"+query, &body)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer func() {
+		if err = resp.Body.Close(); err != nil {
+			log.Println("failed to close response body")
+		}
+	}()
+
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp, data
+}
+
+// countVacancies returns the number of stored vacancies.
+func countVacancies(t *testing.T, testServer *TestServer) int {
+	var n int
+	require.NoError(t, testServer.DB.QueryRow(context.Background(), "SELECT count(*) FROM job_vacancies").Scan(&n))
+	return n
+}
+
+// TestImportVacancyHandler tests importing vacancies from uploaded CSV and NDJSON files.
+//
+// This test covers the following scenarios:
+// 1. A dry run should validate the rows, with their columns mapped, and report the errors of the invalid ones with
+// 207 Multi-Status, without creating any vacancy.
+// 2. The valid rows of an NDJSON file should be created and the invalid ones reported.
+// 3. The errors of the rows should be downloadable as CSV by accepting text/csv.
+// 4. Invalid query parameters and forms without a file should be rejected with 422 Unprocessable Entity.
+func TestImportVacancyHandler(t *testing.T) {
+	testServer := SetupTestServer(t, func(router *middleware.Router, container *tests.TestContainer) {
+		router.HandlerFunc(http.MethodPost, "/v1/vacancies:method", container.ImportHandler.Get().Execute)
+	})
+
+	csvFile := strings.Join([]string{
+		"Job Title,company,description,posted_at,location",
+		"Backend Engineer,Tech Corp,Builds APIs,2025-01-02,Remote",
+		"Frontend Engineer,Tech Corp,Builds pages,02/01/2025,Remote",
+		",Tech Corp,No title,2025-01-02,Remote",
+	}, "\n")
+
+	t.Run("Dry Run", func(t *testing.T) {
+		resp, data := uploadImport(t, testServer, "dry_run=true&mapping=title=Job%20Title", csvFile, "")
+		require.Equal(t, http.StatusMultiStatus, resp.StatusCode)
+
+		var report map[string]any
+		require.NoError(t, json.Unmarshal(data, &report))
+		assert.Equal(t, true, report["dry_run"])
+		assert.EqualValues(t, 3, report["rows"])
+		assert.EqualValues(t, 1, report["valid"])
+		assert.EqualValues(t, 0, report["imported"])
+		assert.EqualValues(t, 2, report["failed"])
+		assert.Equal(t, []any{
+			map[string]any{"line": 3.0, "field": "posted_at", "column": "posted_at",
+				"detail": "posted_at must be in the format YYYY-MM-DD. Example: 2006-01-02"},
+			map[string]any{"line": 4.0, "field": "title", "column": "Job Title",
+				"detail": "title must be provided and cannot be empty or whitespace"},
+		}, report["errors"])
+		assert.Zero(t, countVacancies(t, testServer), "a dry run should not create vacancies")
+	})
+
+	t.Run("NDJSON", func(t *testing.T) {
+		ndjson := strings.Join([]string{
+			`{"title":"Data Analyst","company":"Insight Co.","description":"Reads data","posted_at":"2025-01-03",` +
+				`"location":"Berlin"}`,
+			`{"title":"Data Engineer","company":"Insight Co.","description":"Moves data","posted_at":"2025-01-03",` +
+				`"location":"Berlin","organization_id":"first"}`,
+			`not json`,
+		}, "\n")
+		resp, data := uploadImport(t, testServer, "format=ndjson", ndjson, "")
+		require.Equal(t, http.StatusMultiStatus, resp.StatusCode)
+
+		var report map[string]any
+		require.NoError(t, json.Unmarshal(data, &report))
+		assert.EqualValues(t, 1, report["imported"])
+		assert.EqualValues(t, 2, report["failed"])
+		assert.Len(t, report["errors"], 2)
+		assert.Equal(t, 1, countVacancies(t, testServer))
+	})
+
+	t.Run("CSV Report", func(t *testing.T) {
+		resp, data := uploadImport(t, testServer, "dry_run=true", csvFile, "text/csv")
+		require.Equal(t, http.StatusMultiStatus, resp.StatusCode)
+		assert.Equal(t, "text/csv; charset=utf-8", resp.Header.Get("Content-Type"))
+		assert.Equal(t, `attachment; filename="import-report.csv"`, resp.Header.Get("Content-Disposition"))
+
+		records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+		require.NoError(t, err)
+		require.NotEmpty(t, records)
+		assert.Equal(t, []string{"line", "field", "column", "error"}, records[0])
+		assert.Contains(t, records, []string{"2", "title", "title",
+			"title must be provided and cannot be empty or whitespace"}, "unmapped columns should be read by field")
+	})
+
+	t.Run("Validation Failure", func(t *testing.T) {
+		resp, data := uploadImport(t, testServer, "format=xml&mapping=salary=Pay&dry_run=maybe", csvFile, "")
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+		var response map[string]any
+		require.NoError(t, json.Unmarshal(data, &response))
+		assert.ElementsMatch(t, []string{"dry_run", "format", "mapping"}, tests.ProblemLocations(response))
+
+		resp, _ = uploadImport(t, testServer, "", "", "")
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode, "a form without a file should be rejected")
+	})
+}
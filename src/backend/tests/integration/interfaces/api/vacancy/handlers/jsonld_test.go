@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"interfaces/middleware"
+	"net/http"
+	"strconv"
+	"testing"
+	"tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestVacancyHandlers_JobPosting tests the schema.org JobPosting representation of the vacancies in JSON-LD.
+//
+// This test covers the following scenarios:
+// 1. A vacancy requested with Accept: application/ld+json should be sent as a JobPosting with its JSON-LD context.
+// 2. A vacancy without a location should get 422 Unprocessable Entity naming the missing property.
+// 3. The list should be sent as an ItemList of JobPostings, leaving out the vacancies lacking required properties.
+func TestVacancyHandlers_JobPosting(t *testing.T) {
+	testServer := SetupTestServer(t, func(router *middleware.Router, container *tests.TestContainer) {
+		router.HandlerFunc(http.MethodGet, "/v1/vacancies/:id", container.GetHandler.Get().Execute)
+		router.HandlerFunc(http.MethodGet, "/v1/vacancies", container.ListHandler.Get().Execute)
+	})
+
+	ctx := context.Background()
+	complete := newVacancy("Linked Data Engineer", "Schema Corp", "Publishes postings", "Berlin")
+	incomplete := newVacancy("Linked Data Analyst", "Schema Corp", "Reads postings", "")
+	require.NoError(t, testServer.Container.VacancyRepository.Get().Save(ctx, complete))
+	require.NoError(t, testServer.Container.VacancyRepository.Get().Save(ctx, incomplete))
+	accept := map[string]string{"Accept": "application/ld+json"}
+
+	t.Run("JobPosting", func(t *testing.T) {
+		resp, data := getFeed(t, testServer, "/v1/vacancies/"+strconv.FormatInt(complete.GetId(), 10), "", accept)
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(data))
+		assert.Equal(t, "application/ld+json", resp.Header.Get("Content-Type"))
+		assert.Equal(t, "Accept", resp.Header.Get("Vary"))
+
+		var posting map[string]any
+		require.NoError(t, json.Unmarshal(data, &posting))
+		assert.Equal(t, "https://schema.org", posting["@context"])
+		assert.Equal(t, "JobPosting", posting["@type"])
+		assert.Equal(t, complete.GetTitle(), posting["title"])
+		assert.Equal(t, complete.GetDescription(), posting["description"])
+		assert.Equal(t, complete.GetPostedAt().Format("2006-01-02"), posting["datePosted"])
+		assert.Equal(t, map[string]any{"@type": "Organization", "name": "Schema Corp"}, posting["hiringOrganization"])
+		assert.Equal(t, map[string]any{"@type": "Place",
+			"address": map[string]any{"@type": "PostalAddress", "addressLocality": "Berlin"}}, posting["jobLocation"])
+	})
+
+	t.Run("Missing Properties", func(t *testing.T) {
+		resp, data := getFeed(t, testServer, "/v1/vacancies/"+strconv.FormatInt(incomplete.GetId(), 10), "", accept)
+		require.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+		assert.Contains(t, string(data), "jobLocation must be provided")
+	})
+
+	t.Run("ItemList", func(t *testing.T) {
+		resp, data := getFeed(t, testServer, "/v1/vacancies", "title=linked+data", accept)
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(data))
+		assert.Equal(t, "application/ld+json", resp.Header.Get("Content-Type"))
+
+		var list struct {
+			Context  string `json:"@context"`
+			Type     string `json:"@type"`
+			Elements []struct {
+				Position int            `json:"position"`
+				Item     map[string]any `json:"item"`
+			} `json:"itemListElement"`
+		}
+		require.NoError(t, json.Unmarshal(data, &list))
+		assert.Equal(t, "https://schema.org", list.Context)
+		assert.Equal(t, "ItemList", list.Type)
+		require.Len(t, list.Elements, 1)
+		assert.Equal(t, 1, list.Elements[0].Position)
+		assert.Equal(t, complete.GetTitle(), list.Elements[0].Item["title"])
+		assert.NotContains(t, list.Elements[0].Item, "@context")
+	})
+}
@@ -3,20 +3,20 @@ package handlers
 import (
 	"context"
 	"encoding/json"
+	"interfaces/middleware"
 	"log"
 	"net/http"
 	"strconv"
 	"testing"
 	"tests"
 
-	"github.com/julienschmidt/httprouter"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 // TestListVacancyHandler_Success tests successfully listing all job vacancies.
 func TestListVacancyHandler_Success(t *testing.T) {
-	testServer := SetupTestServer(t, func(router *httprouter.Router, container *tests.TestContainer) {
+	testServer := SetupTestServer(t, func(router *middleware.Router, container *tests.TestContainer) {
 		router.HandlerFunc(http.MethodGet, "/v1/vacancies", container.ListHandler.Get().Execute)
 	})
 	defer testServer.Server.Close()
@@ -56,7 +56,7 @@ func TestListVacancyHandler_Success(t *testing.T) {
 
 // TestListVacancyHandler_ValidationFailure tests validation errors.
 func TestListVacancyHandler_ValidationFailure(t *testing.T) {
-	testServer := SetupTestServer(t, func(router *httprouter.Router, container *tests.TestContainer) {
+	testServer := SetupTestServer(t, func(router *middleware.Router, container *tests.TestContainer) {
 		router.HandlerFunc(http.MethodGet, "/v1/vacancies", container.ListHandler.Get().Execute)
 	})
 	defer testServer.Server.Close()
@@ -76,12 +76,12 @@ func TestListVacancyHandler_ValidationFailure(t *testing.T) {
 	var response map[string]any
 	err = json.NewDecoder(resp.Body).Decode(&response)
 	require.NoError(t, err)
-	assert.Contains(t, response["error"], "size")
+	assert.Equal(t, []string{"page_size"}, tests.ProblemLocations(response))
 }
 
 // TestListVacancyHandler_FilterByTitle tests filtering vacancies by title.
 func TestListVacancyHandler_FilterByTitle(t *testing.T) {
-	testServer := SetupTestServer(t, func(router *httprouter.Router, container *tests.TestContainer) {
+	testServer := SetupTestServer(t, func(router *middleware.Router, container *tests.TestContainer) {
 		router.HandlerFunc(http.MethodGet, "/v1/vacancies", container.ListHandler.Get().Execute)
 	})
 	defer testServer.Server.Close()
@@ -119,7 +119,7 @@ func TestListVacancyHandler_FilterByTitle(t *testing.T) {
 
 // TestListVacancyHandler_SortByTitle tests sorting vacancies by title.
 func TestListVacancyHandler_SortByTitle(t *testing.T) {
-	testServer := SetupTestServer(t, func(router *httprouter.Router, container *tests.TestContainer) {
+	testServer := SetupTestServer(t, func(router *middleware.Router, container *tests.TestContainer) {
 		router.HandlerFunc(http.MethodGet, "/v1/vacancies", container.ListHandler.Get().Execute)
 	})
 	defer testServer.Server.Close()
@@ -158,7 +158,7 @@ func TestListVacancyHandler_SortByTitle(t *testing.T) {
 
 // TestListVacancyHandler_Pagination tests pagination of results.
 func TestListVacancyHandler_Pagination(t *testing.T) {
-	testServer := SetupTestServer(t, func(router *httprouter.Router, container *tests.TestContainer) {
+	testServer := SetupTestServer(t, func(router *middleware.Router, container *tests.TestContainer) {
 		router.HandlerFunc(http.MethodGet, "/v1/vacancies", container.ListHandler.Get().Execute)
 	})
 	defer testServer.Server.Close()
@@ -199,7 +199,7 @@ func TestListVacancyHandler_Pagination(t *testing.T) {
 
 // TestListVacancyHandler_MaxPageSize tests that the maximum allowed page_size is accepted.
 func TestListVacancyHandler_MaxPageSize(t *testing.T) {
-	testServer := SetupTestServer(t, func(router *httprouter.Router, container *tests.TestContainer) {
+	testServer := SetupTestServer(t, func(router *middleware.Router, container *tests.TestContainer) {
 		router.HandlerFunc(http.MethodGet, "/v1/vacancies", container.ListHandler.Get().Execute)
 	})
 	defer testServer.Server.Close()
@@ -0,0 +1,149 @@
+package handlers
+
+import (
+	"application/auth"
+	"bytes"
+	"context"
+	authEntity "domain/auth/entity"
+	"domain/organization/entity"
+	vacancyEntity "domain/vacancy/entity"
+	"encoding/json"
+	"interfaces/middleware"
+	"log"
+	"net/http"
+	"strconv"
+	"testing"
+	"tests"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Principals acting on behalf of an organization, holding the scopes of the editor role only.
+const (
+	testOwner    = "test-owner"
+	testOutsider = "test-outsider"
+	editorScopes = "read write delete"
+)
+
+// createOrganization registers an organization owned by the given principal and returns its ID.
+func createOrganization(t *testing.T, testServer *TestServer, owner, name string) int64 {
+	claims := authEntity.GetTokenClaims().SetIssuer(owner).SetSubject(owner)
+	defer claims.Release()
+
+	o := entity.GetOrganization().SetName(name)
+	defer o.Release()
+
+	ctx := auth.ContextWithClaims(context.Background(), claims)
+	require.NoError(t, testServer.Container.OrganizationService.Get().CreateOrganization(ctx, o))
+	return o.GetId()
+}
+
+// createUnowned creates a vacancy without an owner, as created before organizations, and returns its path.
+func createUnowned(t *testing.T, testServer *TestServer) string {
+	claims := authEntity.GetTokenClaims().SetSubject("test-admin").SetScope([]string{authEntity.ScopeAdmin})
+	defer claims.Release()
+
+	v := vacancyEntity.GetVacancy().SetTitle("Data Analyst").SetCompany("Legacy Corp").SetDescription("Analyzes data").
+		SetPostedAt(time.Now()).SetLocation("Remote")
+	defer v.Release()
+
+	ctx := auth.ContextWithClaims(context.Background(), claims)
+	require.NoError(t, testServer.Container.VacancyService.Get().CreateVacancy(ctx, v))
+	return "/v1/vacancies/" + strconv.FormatInt(v.GetId(), 10)
+}
+
+// sendAs sends a request with the given JSON payload on behalf of a principal holding the editor scopes.
+// Returns the status code and the decoded response body, if any.
+func sendAs(
+	t *testing.T,
+	testServer *TestServer,
+	principal, method, path string,
+	payload map[string]any,
+) (int, map[string]any) {
+	var body bytes.Buffer
+	if payload != nil {
+		require.NoError(t, json.NewEncoder(&body).Encode(payload))
+	}
+
+	req, err := http.NewRequest(method, testServer.Server.URL+path, &body)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(tests.SubjectHeader, principal)
+	req.Header.Set(tests.ScopeHeader, editorScopes)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer func() {
+		if err = resp.Body.Close(); err != nil {
+			log.Println("failed to close response body")
+		}
+	}()
+
+	var response map[string]any
+	if resp.StatusCode != http.StatusNoContent {
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&response))
+	}
+	return resp.StatusCode, response
+}
+
+// TestVacancyHandlers_Ownership tests that only members of the owning organization manage its vacancies, while
+// vacancies without an owner remain open to every editor.
+func TestVacancyHandlers_Ownership(t *testing.T) {
+	testServer := SetupTestServer(t, func(router *middleware.Router, container *tests.TestContainer) {
+		router.HandlerFunc(http.MethodPost, "/v1/vacancies", container.CreateHandler.Get().Execute)
+		router.HandlerFunc(http.MethodPatch, "/v1/vacancies/:id", container.UpdateHandler.Get().Execute)
+		router.HandlerFunc(http.MethodDelete, "/v1/vacancies/:id", container.DeleteHandler.Get().Execute)
+	})
+	defer testServer.Server.Close()
+
+	organizationId := createOrganization(t, testServer, testOwner, "test-Owning Corp")
+	payload := map[string]any{
+		"title":       "Backend Engineer",
+		"company":     "Owning Corp",
+		"description": "Builds the backend",
+		"posted_at":   time.Now().Format(time.DateOnly),
+		"location":    "Remote",
+	}
+
+	// Members of an organization cannot create vacancies without an owner
+	status, _ := sendAs(t, testServer, testOwner, http.MethodPost, "/v1/vacancies", payload)
+	assert.Equal(t, http.StatusForbidden, status)
+
+	// Principals outside the organization cannot create vacancies on its behalf
+	payload["organization_id"] = organizationId
+	status, _ = sendAs(t, testServer, testOutsider, http.MethodPost, "/v1/vacancies", payload)
+	assert.Equal(t, http.StatusForbidden, status)
+
+	// The owner creates a vacancy on behalf of the organization
+	status, response := sendAs(t, testServer, testOwner, http.MethodPost, "/v1/vacancies", payload)
+	require.Equal(t, http.StatusCreated, status)
+	assert.Equal(t, float64(organizationId), response["organization_id"])
+	path := "/v1/vacancies/" + strconv.Itoa(int(response["id"].(float64)))
+
+	// Principals outside the organization can neither update nor delete it
+	update := map[string]any{"title": "Senior Backend Engineer"}
+	status, _ = sendAs(t, testServer, testOutsider, http.MethodPatch, path, update)
+	assert.Equal(t, http.StatusForbidden, status)
+	status, _ = sendAs(t, testServer, testOutsider, http.MethodDelete, path, nil)
+	assert.Equal(t, http.StatusForbidden, status)
+
+	// The ownership of a vacancy cannot be transferred
+	status, _ = sendAs(t, testServer, testOwner, http.MethodPatch, path, map[string]any{"organization_id": 1})
+	assert.Equal(t, http.StatusUnprocessableEntity, status)
+
+	// Members of the owning organization update and delete it
+	status, response = sendAs(t, testServer, testOwner, http.MethodPatch, path, update)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "Senior Backend Engineer", response["title"])
+	status, _ = sendAs(t, testServer, testOwner, http.MethodDelete, path, nil)
+	assert.Equal(t, http.StatusNoContent, status)
+
+	// Editors outside any organization update and delete vacancies without an owner
+	path = createUnowned(t, testServer)
+	status, _ = sendAs(t, testServer, testOutsider, http.MethodPatch, path, update)
+	assert.Equal(t, http.StatusOK, status)
+	status, _ = sendAs(t, testServer, testOutsider, http.MethodDelete, path, nil)
+	assert.Equal(t, http.StatusNoContent, status)
+}
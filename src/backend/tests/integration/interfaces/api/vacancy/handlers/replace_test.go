@@ -0,0 +1,202 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"interfaces/api/utils/patch"
+	"interfaces/middleware"
+	"log"
+	"net/http"
+	"strconv"
+	"testing"
+	"tests"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupReplaceServer initializes the test server with the routes creating, patching and replacing vacancies and
+// creates a vacancy, returning its path.
+func setupReplaceServer(t *testing.T) (*TestServer, string) {
+	testServer := SetupTestServer(t, func(router *middleware.Router, container *tests.TestContainer) {
+		router.HandlerFunc(http.MethodPost, "/v1/vacancies", container.CreateHandler.Get().Execute)
+		router.HandlerFunc(http.MethodPatch, "/v1/vacancies/:id", container.UpdateHandler.Get().Execute)
+		router.HandlerFunc(http.MethodPut, "/v1/vacancies/:id", container.UpdateHandler.Get().Replace)
+	})
+	id := createVacancy(t, testServer, map[string]any{
+		"title":       "Integration Test Engineer",
+		"company":     "Tech Corp",
+		"description": "Responsible for integration testing",
+		"posted_at":   time.Now().Format(time.DateOnly),
+		"location":    "Remote",
+	})
+	return testServer, "/v1/vacancies/" + strconv.Itoa(id)
+}
+
+// sendDocument sends a request with the given body and content type and returns the status code and the decoded
+// response body.
+func sendDocument(
+	t *testing.T,
+	testServer *TestServer,
+	method, path, contentType, body string,
+) (int, map[string]any) {
+	return sendConditional(t, testServer, method, path, contentType, "", body)
+}
+
+// sendConditional sends a request like sendDocument, with the given If-Match header unless empty.
+func sendConditional(
+	t *testing.T,
+	testServer *TestServer,
+	method, path, contentType, ifMatch, body string,
+) (int, map[string]any) {
+	req, err := http.NewRequest(method, testServer.Server.URL+path, bytes.NewBufferString(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", contentType)
+	if ifMatch != "" {
+		req.Header.Set("If-Match", ifMatch)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer func() {
+		if err = resp.Body.Close(); err != nil {
+			log.Println("failed to close response body")
+		}
+	}()
+
+	var response map[string]any
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&response))
+	return resp.StatusCode, response
+}
+
+// TestUpdateVacancyHandler_MergePatch tests updating a vacancy with a JSON Merge Patch.
+func TestUpdateVacancyHandler_MergePatch(t *testing.T) {
+	testServer, path := setupReplaceServer(t)
+
+	status, response := sendDocument(t, testServer, http.MethodPatch, path, patch.MergePatchContentType,
+		`{"title":"Senior Test Engineer","location":"Berlin","version":1}`)
+	require.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "Senior Test Engineer", response["title"])
+	assert.Equal(t, "Berlin", response["location"])
+	assert.Equal(t, "Tech Corp", response["company"])
+	assert.Equal(t, float64(2), response["version"])
+
+	// Clearing a required field fails validation
+	status, response = sendDocument(t, testServer, http.MethodPatch, path, patch.MergePatchContentType,
+		`{"location":null,"version":2}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, status)
+	assert.Equal(t, []string{"#/location"}, tests.ProblemLocations(response))
+
+	// The ID cannot be changed
+	status, _ = sendDocument(t, testServer, http.MethodPatch, path, patch.MergePatchContentType,
+		`{"id":9999,"version":2}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, status)
+
+	// A stale version is a conflict, in the patch or in the If-Match header
+	status, _ = sendDocument(t, testServer, http.MethodPatch, path, patch.MergePatchContentType,
+		`{"title":"Lead Test Engineer","version":1}`)
+	assert.Equal(t, http.StatusConflict, status)
+	status, _ = sendConditional(t, testServer, http.MethodPatch, path, patch.MergePatchContentType, `"1"`,
+		`{"title":"Lead Test Engineer"}`)
+	assert.Equal(t, http.StatusConflict, status)
+
+	// A patch without version is refused
+	status, _ = sendDocument(t, testServer, http.MethodPatch, path, patch.MergePatchContentType,
+		`{"title":"Lead Test Engineer"}`)
+	assert.Equal(t, http.StatusPreconditionRequired, status)
+
+	// The If-Match header gives the version
+	status, response = sendConditional(t, testServer, http.MethodPatch, path, patch.MergePatchContentType, `"2"`,
+		`{"title":"Lead Test Engineer"}`)
+	require.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "Lead Test Engineer", response["title"])
+	assert.Equal(t, float64(3), response["version"])
+
+	// Malformed If-Match headers are rejected
+	status, _ = sendConditional(t, testServer, http.MethodPatch, path, patch.MergePatchContentType, `"three"`,
+		`{"title":"Lead Test Engineer"}`)
+	assert.Equal(t, http.StatusBadRequest, status)
+}
+
+// TestUpdateVacancyHandler_JsonPatch tests updating a vacancy with a JSON Patch.
+func TestUpdateVacancyHandler_JsonPatch(t *testing.T) {
+	testServer, path := setupReplaceServer(t)
+
+	status, response := sendDocument(t, testServer, http.MethodPatch, path, patch.JsonPatchContentType,
+		`[{"op":"add","path":"/version","value":1},{"op":"replace","path":"/title","value":"Senior Test Engineer"},`+
+			`{"op":"copy","from":"/company","path":"/location"}]`)
+	require.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "Senior Test Engineer", response["title"])
+	assert.Equal(t, "Tech Corp", response["location"])
+
+	// A failed test operation is a conflict, and nothing is applied
+	status, _ = sendConditional(t, testServer, http.MethodPatch, path, patch.JsonPatchContentType, `"2"`,
+		`[{"op":"replace","path":"/title","value":"Lead Test Engineer"},`+
+			`{"op":"test","path":"/company","value":"Other Corp"}]`)
+	assert.Equal(t, http.StatusConflict, status)
+
+	// A stale version is a conflict
+	status, _ = sendConditional(t, testServer, http.MethodPatch, path, patch.JsonPatchContentType, `"1"`,
+		`[{"op":"replace","path":"/title","value":"Lead Test Engineer"}]`)
+	assert.Equal(t, http.StatusConflict, status)
+
+	// A patch without version is refused
+	status, _ = sendDocument(t, testServer, http.MethodPatch, path, patch.JsonPatchContentType,
+		`[{"op":"replace","path":"/title","value":"Lead Test Engineer"}]`)
+	assert.Equal(t, http.StatusPreconditionRequired, status)
+
+	// Operations on missing members cannot be applied
+	status, _ = sendDocument(t, testServer, http.MethodPatch, path, patch.JsonPatchContentType,
+		`[{"op":"remove","path":"/salary"}]`)
+	assert.Equal(t, http.StatusUnprocessableEntity, status)
+
+	// Malformed patches are rejected
+	status, _ = sendDocument(t, testServer, http.MethodPatch, path, patch.JsonPatchContentType,
+		`{"op":"remove","path":"/title"}`)
+	assert.Equal(t, http.StatusBadRequest, status)
+
+	// Members the vacancy does not have are rejected
+	status, _ = sendDocument(t, testServer, http.MethodPatch, path, patch.JsonPatchContentType,
+		`[{"op":"add","path":"/salary","value":1000}]`)
+	assert.Equal(t, http.StatusUnprocessableEntity, status)
+
+	// Other content types are not supported
+	status, _ = sendDocument(t, testServer, http.MethodPatch, path, "text/plain", `title=Lead`)
+	assert.Equal(t, http.StatusUnsupportedMediaType, status)
+}
+
+// TestUpdateVacancyHandler_Replace tests replacing a vacancy with PUT.
+func TestUpdateVacancyHandler_Replace(t *testing.T) {
+	testServer, path := setupReplaceServer(t)
+
+	replacement := `{"title":"Designer","company":"Design Co","description":"Designs things",` +
+		`"posted_at":"2025-01-01","location":"Remote"}`
+	status, response := sendConditional(t, testServer, http.MethodPut, path, "application/json", `"1"`, replacement)
+	require.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "Designer", response["title"])
+	assert.Equal(t, "Design Co", response["company"])
+	assert.Equal(t, "2025-01-01", response["posted_at"])
+
+	// Every field must be provided
+	status, response = sendDocument(t, testServer, http.MethodPut, path, "application/json",
+		`{"title":"Designer","version":2}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, status)
+	assert.ElementsMatch(t, []string{"#/company", "#/description", "#/location", "#/posted_at"},
+		tests.ProblemLocations(response))
+
+	// A stale version is a conflict
+	stale := `{"title":"Designer","company":"Design Co","description":"Designs things",` +
+		`"posted_at":"2025-01-01","location":"Remote","version":1}`
+	status, _ = sendDocument(t, testServer, http.MethodPut, path, "application/json", stale)
+	assert.Equal(t, http.StatusConflict, status)
+
+	// A replacement without version is refused
+	status, _ = sendDocument(t, testServer, http.MethodPut, path, "application/json", replacement)
+	assert.Equal(t, http.StatusPreconditionRequired, status)
+
+	// Missing vacancies are not created
+	status, _ = sendConditional(t, testServer, http.MethodPut, "/v1/vacancies/9999", "application/json", `"1"`,
+		replacement)
+	assert.Equal(t, http.StatusNotFound, status)
+}
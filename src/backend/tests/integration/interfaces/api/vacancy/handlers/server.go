@@ -2,31 +2,36 @@ package handlers
 
 import (
 	"context"
+	"domain/auth/entity"
+	"interfaces/middleware"
 	"log"
 	"net/http/httptest"
 	"testing"
 	"tests"
 
 	"github.com/jackc/pgx/v5/pgxpool"
-	"github.com/julienschmidt/httprouter"
 )
 
+// testPrincipal is the principal of the test requests. It holds every scope, and therefore cross-organization
+// rights, unless a request overrides it with the tests.SubjectHeader and tests.ScopeHeader headers.
+const testPrincipal = "test-admin"
+
 // TestServer contains the components for a test HTTP server and database.
 type TestServer struct {
 	Container *tests.TestContainer
 	Server    *httptest.Server
-	Router    *httprouter.Router
+	Router    *middleware.Router
 	DB        *pgxpool.Pool
 }
 
 // SetupTestServer initializes the test container and server with customizable routes.
 func SetupTestServer(
-	t *testing.T, configureRoutes func(router *httprouter.Router, container *tests.TestContainer)) *TestServer {
+	t *testing.T, configureRoutes func(router *middleware.Router, container *tests.TestContainer)) *TestServer {
 	container := tests.NewTestContainer()
 
-	router := httprouter.New()
+	router := middleware.NewRouter()
 	configureRoutes(router, container)
-	server := httptest.NewServer(router)
+	server := httptest.NewServer(tests.WithClaims(router, testPrincipal, entity.Scopes()...))
 
 	t.Cleanup(func() {
 		server.Close()
@@ -41,11 +46,24 @@ func SetupTestServer(
 	}
 }
 
-// Teardown cleans up the database by truncating tables.
+// Teardown cleans up the database by truncating tables and removing the organizations and idempotency keys created
+// by the tests.
 func Teardown(db *pgxpool.Pool) {
 	ctx := context.Background()
 	_, err := db.Exec(ctx, "TRUNCATE TABLE job_vacancies RESTART IDENTITY CASCADE;")
 	if err != nil {
 		log.Fatalf("failed to truncate job_vacancies: %v", err)
 	}
+	_, err = db.Exec(ctx, "DELETE FROM organizations WHERE created_by LIKE 'test-%'")
+	if err != nil {
+		log.Fatalf("failed to delete test organizations: %v", err)
+	}
+	_, err = db.Exec(ctx, "DELETE FROM audit_log WHERE actor LIKE 'test-%'")
+	if err != nil {
+		log.Fatalf("failed to delete audit records: %v", err)
+	}
+	_, err = db.Exec(ctx, "DELETE FROM idempotency_keys WHERE key LIKE '%|subject:test-%'")
+	if err != nil {
+		log.Fatalf("failed to delete idempotency keys: %v", err)
+	}
 }
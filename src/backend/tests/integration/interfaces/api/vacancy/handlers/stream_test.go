@@ -0,0 +1,161 @@
+package handlers
+
+import (
+	"bufio"
+	"encoding/json"
+	"interfaces/middleware"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"tests"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sseEvent is an event read from a Server-Sent Events stream, or a comment of the stream.
+type sseEvent struct {
+	Id, Name, Data, Comment string
+}
+
+// openStream opens the stream of vacancy changes with the given query and Last-Event-ID, and returns the response
+// with the events of its body, read until the body is closed at the end of the test.
+func openStream(t *testing.T, testServer *TestServer, query, lastId string) (*http.Response, <-chan sseEvent) {
+	req, err := http.NewRequest(http.MethodGet, testServer.Server.URL+"/v1/vacancies/stream?"+query, nil)
+	require.NoError(t, err)
+	req.Header.Set("Accept", "text/event-stream")
+	if lastId != "" {
+		req.Header.Set("Last-Event-ID", lastId)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = resp.Body.Close() })
+
+	events := make(chan sseEvent, 64)
+	go func() {
+		defer close(events)
+		var e sseEvent
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			field, value, _ := strings.Cut(scanner.Text(), ": ")
+			switch field {
+			case "":
+				if e != (sseEvent{}) {
+					events <- e
+				}
+				e = sseEvent{}
+			case "id":
+				e.Id = value
+			case "event":
+				e.Name = value
+			case "data":
+				e.Data = value
+			default:
+				events <- sseEvent{Comment: value}
+			}
+		}
+	}()
+	return resp, events
+}
+
+// nextEvent returns the next event of the stream with a name, skipping comments and the events only carrying an ID.
+func nextEvent(t *testing.T, events <-chan sseEvent) sseEvent {
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case e, ok := <-events:
+			require.True(t, ok, "stream ended")
+			if e.Name != "" {
+				return e
+			}
+		case <-timeout:
+			require.FailNow(t, "no event received")
+		}
+	}
+}
+
+// TestStreamVacancyHandler tests the Server-Sent Events stream of the changes of vacancies.
+//
+// This test covers the following scenarios:
+// 1. Created and deleted vacancies matching the filters should be sent as events named by the type of the change,
+// with the vacancy or its ID as data, skipping the vacancies not matching the filters.
+// 2. Idle streams should get heartbeats.
+// 3. Clients resuming the stream with the ID of the last event received should get the events they missed.
+// 4. Clients resuming the stream with an unknown ID should get a reset event.
+func TestStreamVacancyHandler(t *testing.T) {
+	t.Setenv("STREAM_HEARTBEAT_SECONDS", "1")
+	testServer := SetupTestServer(t, func(router *middleware.Router, container *tests.TestContainer) {
+		router.HandlerFunc(http.MethodGet, "/v1/vacancies/stream", container.StreamHandler.Get().Execute)
+		router.HandlerFunc(http.MethodPost, "/v1/vacancies", container.CreateHandler.Get().Execute)
+		router.HandlerFunc(http.MethodDelete, "/v1/vacancies/:id", container.DeleteHandler.Get().Execute)
+	})
+	t.Cleanup(testServer.Container.ChangeStream.Get().Close) // End the streams before the server is closed
+
+	resp, events := openStream(t, testServer, "title=engineer", "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	assert.Equal(t, "no-cache", resp.Header.Get("Cache-Control"))
+
+	payload := map[string]any{
+		"title":       "Stream Engineer",
+		"company":     "Tech Corp",
+		"description": "Keeps the frontend up to date",
+		"posted_at":   time.Now().Format(time.DateOnly),
+		"location":    "Remote",
+	}
+	engineerId := createVacancy(t, testServer, payload)
+	payload["title"] = "Sales Manager"
+	createVacancy(t, testServer, payload)
+
+	var created sseEvent
+	t.Run("Changes", func(t *testing.T) {
+		created = nextEvent(t, events)
+		assert.Equal(t, "created", created.Name)
+		assert.NotEmpty(t, created.Id)
+		var vacancy map[string]any
+		require.NoError(t, json.Unmarshal([]byte(created.Data), &vacancy))
+		assert.EqualValues(t, engineerId, vacancy["id"])
+		assert.Equal(t, "Stream Engineer", vacancy["title"])
+
+		req, err := http.NewRequest(http.MethodDelete,
+			testServer.Server.URL+"/v1/vacancies/"+strconv.Itoa(engineerId), nil)
+		require.NoError(t, err)
+		deleteResp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		_ = deleteResp.Body.Close()
+
+		deleted := nextEvent(t, events) // The sales manager is skipped
+		assert.Equal(t, "deleted", deleted.Name)
+		assert.JSONEq(t, `{"id":`+strconv.Itoa(engineerId)+`}`, deleted.Data)
+	})
+
+	t.Run("Heartbeat", func(t *testing.T) {
+		timeout := time.After(5 * time.Second)
+		for {
+			select {
+			case e := <-events:
+				if e.Comment == "heartbeat" {
+					return
+				}
+			case <-timeout:
+				require.FailNow(t, "no heartbeat received")
+			}
+		}
+	})
+
+	t.Run("Resume", func(t *testing.T) {
+		_, resumed := openStream(t, testServer, "title=engineer", created.Id)
+		deleted := nextEvent(t, resumed)
+		assert.Equal(t, "deleted", deleted.Name)
+		assert.JSONEq(t, `{"id":`+strconv.Itoa(engineerId)+`}`, deleted.Data)
+	})
+
+	t.Run("Reset", func(t *testing.T) {
+		_, reset := openStream(t, testServer, "", "0-1")
+		e := nextEvent(t, reset)
+		assert.Equal(t, "reset", e.Name)
+	})
+}
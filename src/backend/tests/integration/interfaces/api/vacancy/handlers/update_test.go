@@ -3,6 +3,7 @@ package handlers
 import (
 	"bytes"
 	"encoding/json"
+	"interfaces/middleware"
 	"log"
 	"net/http"
 	"strconv"
@@ -10,14 +11,13 @@ import (
 	"tests"
 	"time"
 
-	"github.com/julienschmidt/httprouter"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 // TestUpdateVacancyHandler_Success tests the successful update of a job vacancy.
 func TestUpdateVacancyHandler_Success(t *testing.T) {
-	testServer := SetupTestServer(t, func(router *httprouter.Router, container *tests.TestContainer) {
+	testServer := SetupTestServer(t, func(router *middleware.Router, container *tests.TestContainer) {
 		router.HandlerFunc(http.MethodPost, "/v1/vacancies", container.CreateHandler.Get().Execute)
 		router.HandlerFunc(http.MethodPatch, "/v1/vacancies/:id", container.UpdateHandler.Get().Execute)
 	})
@@ -70,7 +70,7 @@ func TestUpdateVacancyHandler_Success(t *testing.T) {
 
 // TestUpdateVacancyHandler_NotFound tests updating a non-existent vacancy.
 func TestUpdateVacancyHandler_NotFound(t *testing.T) {
-	testServer := SetupTestServer(t, func(router *httprouter.Router, container *tests.TestContainer) {
+	testServer := SetupTestServer(t, func(router *middleware.Router, container *tests.TestContainer) {
 		router.HandlerFunc(http.MethodPatch, "/v1/vacancies/:id", container.UpdateHandler.Get().Execute)
 	})
 	defer testServer.Server.Close()
@@ -103,7 +103,7 @@ func TestUpdateVacancyHandler_NotFound(t *testing.T) {
 
 // TestUpdateVacancyHandler_ValidationFailure tests updating a vacancy with invalid data.
 func TestUpdateVacancyHandler_ValidationFailure(t *testing.T) {
-	testServer := SetupTestServer(t, func(router *httprouter.Router, container *tests.TestContainer) {
+	testServer := SetupTestServer(t, func(router *middleware.Router, container *tests.TestContainer) {
 		router.HandlerFunc(http.MethodPost, "/v1/vacancies", container.CreateHandler.Get().Execute)
 		router.HandlerFunc(http.MethodPatch, "/v1/vacancies/:id", container.UpdateHandler.Get().Execute)
 	})
@@ -148,13 +148,12 @@ func TestUpdateVacancyHandler_ValidationFailure(t *testing.T) {
 	err = json.NewDecoder(resp.Body).Decode(&response)
 	require.NoError(t, err)
 
-	assert.Len(t, response["error"], 1)
-	assert.Contains(t, response["error"], "title")
+	assert.Equal(t, []string{"#/title"}, tests.ProblemLocations(response))
 }
 
 // TestUpdateVacancyHandler_PartialUpdate tests partial updates for a vacancy.
 func TestUpdateVacancyHandler_PartialUpdate(t *testing.T) {
-	testServer := SetupTestServer(t, func(router *httprouter.Router, container *tests.TestContainer) {
+	testServer := SetupTestServer(t, func(router *middleware.Router, container *tests.TestContainer) {
 		router.HandlerFunc(http.MethodPost, "/v1/vacancies", container.CreateHandler.Get().Execute)
 		router.HandlerFunc(http.MethodPatch, "/v1/vacancies/:id", container.UpdateHandler.Get().Execute)
 	})
@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"application/config"
+	"application/feature"
+	"interfaces/api/utils"
+	"interfaces/middleware"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestFeatureMiddleware tests the routes of optional features switched by their flags.
+//
+// This test covers the following scenarios:
+// 1. A route should be served while its feature is switched on.
+// 2. A route should answer 404 Not Found once its feature is switched off, without reaching the handler.
+// 3. Switching the feature on again should serve the route at once.
+// 4. Other features should not be affected.
+func TestFeatureMiddleware(t *testing.T) {
+	flags := feature.NewFlags(config.FeatureConfig{Import: true, Feeds: true, Stream: true})
+	features := middleware.NewFeatureMiddleware(flags, utils.NewErrors(slog.Default(), utils.NewHandler()))
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+	request := func(name string) int {
+		rr := httptest.NewRecorder()
+		features.Require(name)(ok).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/", nil))
+		return rr.Code
+	}
+
+	assert.Equal(t, http.StatusOK, request(feature.Import))
+
+	flags.Set(config.FeatureConfig{Feeds: true, Stream: true})
+	assert.Equal(t, http.StatusNotFound, request(feature.Import))
+	assert.Equal(t, http.StatusOK, request(feature.Feeds))
+	assert.Equal(t, http.StatusOK, request(feature.Stream))
+
+	flags.Set(config.FeatureConfig{Import: true, Feeds: true, Stream: true})
+	assert.Equal(t, http.StatusOK, request(feature.Import))
+}
@@ -0,0 +1,140 @@
+package middleware
+
+import (
+	"application/metrics"
+	"bytes"
+	"context"
+	"interfaces/middleware"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// exposition returns the metrics of the registry in the text exposition format.
+func exposition(t *testing.T, r *metrics.Registry) string {
+	var b bytes.Buffer
+	require.NoError(t, r.Write(context.Background(), &b))
+	return b.String()
+}
+
+// TestMetricsMiddleware tests that requests are counted and timed per route pattern rather than per path.
+func TestMetricsMiddleware(t *testing.T) {
+	registry := metrics.NewRegistry()
+	router := middleware.NewRouter()
+	router.HandlerFunc(http.MethodGet, "/v1/vacancies/:id", func(w http.ResponseWriter, r *http.Request) {
+		if httprouter.ParamsFromContext(r.Context()).ByName("id") == "0" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	server := httptest.NewServer(middleware.Chain(router, middleware.NewMetricsMiddleware(registry).Handle(router)))
+	t.Cleanup(server.Close)
+
+	for _, path := range []string{"/v1/vacancies/1", "/v1/vacancies/2", "/v1/vacancies/0", "/v1/unknown"} {
+		resp, err := http.Get(server.URL + path)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+	}
+
+	out := exposition(t, registry)
+	assert.Contains(t, out, "# TYPE http_requests_total counter\n")
+	assert.Contains(t, out, `http_requests_total{method="GET",route="/v1/vacancies/:id",status="200"} 2`+"\n")
+	assert.Contains(t, out, `http_requests_total{method="GET",route="/v1/vacancies/:id",status="404"} 1`+"\n")
+	assert.Contains(t, out, `http_requests_total{method="GET",route="unmatched",status="404"} 1`+"\n")
+	assert.Contains(t, out, "# TYPE http_request_duration_seconds histogram\n")
+	assert.Contains(t, out, `http_request_duration_seconds_bucket{method="GET",route="/v1/vacancies/:id",le="+Inf"} 3`)
+	assert.Contains(t, out, `http_request_duration_seconds_count{method="GET",route="/v1/vacancies/:id"} 3`)
+}
+
+// TestMetricsMiddleware_Templates tests that requests are labelled by the templates of the registered routes.
+//
+// This test covers the following scenarios:
+// 1. Parameters should be restored by their position, even when several of them share a value.
+// 2. Custom methods recorded as routes should be labelled by their own template.
+// 3. Unknown custom methods, served by the route dispatching the custom methods, should create no new series.
+// 4. Literal routes recorded in place of a parameter, such as the event stream, should be labelled apart from it.
+func TestMetricsMiddleware_Templates(t *testing.T) {
+	registry := metrics.NewRegistry()
+	router := middleware.NewRouter()
+	ok := func(w http.ResponseWriter, r *http.Request) {}
+	router.HandlerFunc(http.MethodGet, "/v1/organizations/:id/members/:member", ok)
+	router.HandlerFunc(http.MethodPost, "/v1/vacancies:method", func(w http.ResponseWriter, r *http.Request) {
+		if httprouter.ParamsFromContext(r.Context()).ByName("method") != ":batchCreate" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	router.Route(http.MethodPost, "/v1/vacancies:batchCreate")
+	router.HandlerFunc(http.MethodGet, "/v1/vacancies/:id", ok)
+	router.Route(http.MethodGet, "/v1/vacancies/stream")
+	server := httptest.NewServer(middleware.Chain(router, middleware.NewMetricsMiddleware(registry).Handle(router)))
+	t.Cleanup(server.Close)
+
+	send := func(method, path string) {
+		req, err := http.NewRequest(method, server.URL+path, nil)
+		require.NoError(t, err)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+	}
+	send(http.MethodGet, "/v1/organizations/5/members/5")
+	send(http.MethodPost, "/v1/vacancies:batchCreate")
+	send(http.MethodGet, "/v1/vacancies/stream")
+	send(http.MethodGet, "/v1/vacancies/1")
+	series := strings.Count(exposition(t, registry), "\nhttp_requests_total{")
+	for i := range 3 {
+		send(http.MethodPost, "/v1/vacancies:random"+strconv.Itoa(i))
+	}
+
+	out := exposition(t, registry)
+	assert.Contains(t, out,
+		`http_requests_total{method="GET",route="/v1/organizations/:id/members/:member",status="200"} 1`+"\n")
+	assert.Contains(t, out, `http_requests_total{method="POST",route="/v1/vacancies:batchCreate",status="200"} 1`+"\n")
+	assert.Contains(t, out, `http_requests_total{method="POST",route="unmatched",status="404"} 3`+"\n")
+	assert.Contains(t, out, `http_request_duration_seconds_count{method="GET",route="/v1/vacancies/stream"} 1`+"\n")
+	assert.Contains(t, out, `http_request_duration_seconds_count{method="GET",route="/v1/vacancies/:id"} 1`+"\n")
+	assert.NotContains(t, out, "random")
+	assert.Equal(t, series+1, strings.Count(out, "\nhttp_requests_total{"),
+		"unknown custom methods should share a single series")
+}
+
+// TestRegistry_Exposition tests the text exposition format of counters, gauges and histograms.
+func TestRegistry_Exposition(t *testing.T) {
+	registry := metrics.NewRegistry()
+	counter := registry.NewCounterVec("test_events_total", "Events by kind.\nSecond line.", "kind")
+	counter.With(`quoted "kind"`).Add(2.5)
+	counter.With("plain").Inc()
+	assert.Same(t, counter, registry.NewCounterVec("test_events_total", "Events by kind.", "kind"),
+		"registering the same family twice should return the existing one")
+
+	histogram := registry.NewHistogramVec("test_latency_seconds", "Latency.", []float64{0.1, 1})
+	histogram.With().Observe(0.05)
+	histogram.With().Observe(0.1)
+	histogram.With().Observe(3)
+
+	registry.OnCollect(func(context.Context) {
+		registry.NewGaugeVec("test_queue_length", "Queue length.").With().Set(7)
+	})
+
+	expected := `# HELP test_events_total Events by kind.\nSecond line.
+# TYPE test_events_total counter
+test_events_total{kind="plain"} 1
+test_events_total{kind="quoted \"kind\""} 2.5
+# HELP test_latency_seconds Latency.
+# TYPE test_latency_seconds histogram
+test_latency_seconds_bucket{le="0.1"} 2
+test_latency_seconds_bucket{le="1"} 2
+test_latency_seconds_bucket{le="+Inf"} 3
+test_latency_seconds_sum 3.15
+test_latency_seconds_count 3
+# HELP test_queue_length Queue length.
+# TYPE test_queue_length gauge
+test_queue_length 7
+`
+	assert.Equal(t, expected, exposition(t, registry))
+	assert.Panics(t, func() { registry.NewGaugeVec("test_events_total", "Conflicting type.", "kind") })
+}
@@ -0,0 +1,157 @@
+package middleware
+
+import (
+	"application/requestid"
+	"bytes"
+	"encoding/json"
+	"interfaces/api/utils"
+	"interfaces/middleware"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupServer starts a test server behind the request ID, access log and recovery middlewares, logging JSON
+// entries to the returned buffer.
+func setupServer(t *testing.T, handler http.Handler) (*httptest.Server, *bytes.Buffer) {
+	var logs bytes.Buffer
+	logger := slog.New(requestid.NewLogHandler(slog.NewJSONHandler(&logs, nil)))
+	errors := utils.NewErrors(logger, utils.NewHandler())
+
+	server := httptest.NewServer(middleware.Chain(handler,
+		middleware.RequestId,
+		middleware.NewAccessLogMiddleware(logger).Handle,
+		middleware.NewRecoveryMiddleware(errors).Handle,
+	))
+	t.Cleanup(server.Close)
+	return server, &logs
+}
+
+// logEntries decodes the JSON log entries with the given message.
+func logEntries(t *testing.T, logs *bytes.Buffer, msg string) []map[string]any {
+	var entries []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(logs.String()), "\n") {
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		if entry["msg"] == msg {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// get sends a GET request with the given request ID header, if any, and returns the response.
+func get(t *testing.T, url, id string) *http.Response {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+	if id != "" {
+		req.Header.Set(requestid.Header, id)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = resp.Body.Close() })
+	return resp
+}
+
+// TestRequestId tests that request IDs are kept when valid, generated otherwise and propagated to the context.
+func TestRequestId(t *testing.T) {
+	server, _ := setupServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(requestid.FromContext(r.Context())))
+	}))
+
+	tests := []struct {
+		name     string
+		id       string
+		expected string
+	}{
+		{name: "Client Request ID", id: "test-request-1", expected: "test-request-1"},
+		{name: "Missing Request ID", id: ""},
+		{name: "Invalid Request ID", id: "test request"},
+		{name: "Oversized Request ID", id: strings.Repeat("a", 129)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := get(t, server.URL, tt.id)
+			var body bytes.Buffer
+			_, err := body.ReadFrom(resp.Body)
+			require.NoError(t, err)
+
+			id := resp.Header.Get(requestid.Header)
+			assert.Equal(t, id, body.String(), "the context should carry the returned request ID")
+			if tt.expected != "" {
+				assert.Equal(t, tt.expected, id)
+			} else {
+				assert.Len(t, id, 32, "a request ID should be generated")
+			}
+		})
+	}
+}
+
+// TestAccessLog tests that every request is logged with its status, size, principal and request ID.
+func TestAccessLog(t *testing.T) {
+	server, logs := setupServer(t, tests.WithClaims(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte("created"))
+	}), "test-editor"))
+
+	resp := get(t, server.URL+"/v1/vacancies?page=1", "test-request-2")
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	entries := logEntries(t, logs, "Request handled")
+	require.Len(t, entries, 1)
+	entry := entries[0]
+	assert.Equal(t, http.MethodGet, entry["method"])
+	assert.Equal(t, "/v1/vacancies?page=1", entry["uri"])
+	assert.EqualValues(t, http.StatusCreated, entry["status"])
+	assert.EqualValues(t, len("created"), entry["bytes"])
+	assert.Equal(t, "test-editor", entry["principal"])
+	assert.Equal(t, "test-request-2", entry["request_id"])
+	assert.Contains(t, entry, "latency")
+}
+
+// TestAccessLog_FeedToken tests that the token of a feed URL is redacted from the logged URI.
+func TestAccessLog_FeedToken(t *testing.T) {
+	server, logs := setupServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	resp := get(t, server.URL+"/v1/feeds/vacancies.rss?title=go&token=secret", "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	entries := logEntries(t, logs, "Request handled")
+	require.Len(t, entries, 1)
+	assert.Equal(t, "/v1/feeds/vacancies.rss?title=go&token=REDACTED", entries[0]["uri"])
+}
+
+// TestRecovery tests that a panicking handler results in a JSON 500 response and a logged stack.
+func TestRecovery(t *testing.T) {
+	server, logs := setupServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("test panic")
+	}))
+
+	resp := get(t, server.URL, "test-request-3")
+	require.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	assert.Equal(t, "test-request-3", resp.Header.Get(requestid.Header))
+
+	var body map[string]any
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, http.StatusText(http.StatusInternalServerError), body["title"])
+	assert.Equal(t, "test-request-3", body["instance"])
+
+	entries := logEntries(t, logs, "Panic recovered")
+	require.Len(t, entries, 1)
+	assert.Equal(t, "test panic", entries[0]["panic"])
+	assert.Equal(t, "test-request-3", entries[0]["request_id"])
+	assert.Contains(t, entries[0]["stack"], "TestRecovery")
+
+	access := logEntries(t, logs, "Request handled")
+	require.Len(t, access, 1)
+	assert.EqualValues(t, http.StatusInternalServerError, access[0]["status"])
+}
@@ -0,0 +1,118 @@
+package middleware
+
+import (
+	"application/auth"
+	"application/ratelimit"
+	authEntity "domain/auth/entity"
+	"interfaces/api/utils"
+	"interfaces/middleware"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRateLimitMiddleware tests the rate limiting of the requests per route and client.
+//
+// This test covers the following scenarios:
+// 1. Requests within the burst should be allowed and report the remaining requests in the RateLimit headers.
+// 2. Requests beyond the burst should be rejected with 429 Too Many Requests and a Retry-After header.
+// 3. Clients should be limited separately, by token subject or by IP address.
+// 4. Routes without a policy of their own should fall back to the default policy, unlimited here.
+func TestRateLimitMiddleware(t *testing.T) {
+	policies, err := ratelimit.NewPolicies("", map[string]string{"GET /v1/vacancies/:id": "1/m,2"})
+	require.NoError(t, err)
+	limiter := ratelimit.NewLimiter(ratelimit.NewMemoryStore(time.Minute), policies)
+	errors := utils.NewErrors(slog.Default(), utils.NewHandler())
+
+	router := middleware.NewRouter()
+	ok := func(w http.ResponseWriter, r *http.Request) {}
+	router.HandlerFunc(http.MethodGet, "/v1/vacancies/:id", ok)
+	router.HandlerFunc(http.MethodGet, "/v1/organizations", ok)
+	handler := middleware.Chain(router, middleware.NewRateLimitMiddleware(limiter, errors).Handle(router))
+
+	request := func(path, subject string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, path, nil)
+		if subject != "" {
+			claims := authEntity.GetTokenClaims().SetSubject(subject)
+			r = r.WithContext(auth.ContextWithClaims(r.Context(), claims))
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	t.Run("Burst", func(t *testing.T) {
+		for _, remaining := range []string{"1", "0"} {
+			w := request("/v1/vacancies/1", "alice")
+			require.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, "2", w.Header().Get(ratelimit.HeaderLimit))
+			assert.Equal(t, remaining, w.Header().Get(ratelimit.HeaderRemaining))
+			assert.Equal(t, "2;w=120", w.Header().Get(ratelimit.HeaderPolicy))
+			assert.Empty(t, w.Header().Get(ratelimit.HeaderRetryAfter))
+		}
+
+		w := request("/v1/vacancies/3", "alice")
+		assert.Equal(t, http.StatusTooManyRequests, w.Code)
+		assert.Equal(t, "60", w.Header().Get(ratelimit.HeaderRetryAfter))
+		assert.Equal(t, "0", w.Header().Get(ratelimit.HeaderRemaining))
+	})
+
+	t.Run("Separate Clients", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, request("/v1/vacancies/1", "bob").Code)
+		assert.Equal(t, http.StatusOK, request("/v1/vacancies/1", "").Code)
+	})
+
+	t.Run("Default Policy", func(t *testing.T) {
+		for range 3 {
+			w := request("/v1/organizations", "alice")
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Empty(t, w.Header().Get(ratelimit.HeaderLimit))
+		}
+	})
+}
+
+// TestPolicy_Take tests the refill of the token bucket over time.
+//
+// This test covers the following scenarios:
+// 1. Specifications should be parsed into rates and bursts, rejecting invalid ones.
+// 2. An emptied bucket should allow a request again once a token has been added back.
+// 3. The bucket should not hold more tokens than the burst, however long it stayed unused.
+func TestPolicy_Take(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		p, err := ratelimit.ParsePolicy("p", "10/s,20")
+		require.NoError(t, err)
+		assert.Equal(t, ratelimit.Policy{Name: "p", Rate: 10, Burst: 20}, p)
+
+		p, err = ratelimit.ParsePolicy("p", "")
+		require.NoError(t, err)
+		assert.True(t, p.Unlimited())
+
+		for _, spec := range []string{"10", "10/w", "0/s", "x/s", "10/s,0"} {
+			_, err = ratelimit.ParsePolicy("p", spec)
+			assert.Error(t, err, spec)
+		}
+	})
+
+	t.Run("Refill", func(t *testing.T) {
+		p, err := ratelimit.ParsePolicy("p", "2/s")
+		require.NoError(t, err)
+		now := time.Now()
+		var b ratelimit.Bucket
+
+		assert.True(t, p.Take(&b, now).Allowed)
+		assert.True(t, p.Take(&b, now).Allowed)
+		d := p.Take(&b, now)
+		assert.False(t, d.Allowed)
+		assert.Equal(t, 500*time.Millisecond, d.RetryAfter)
+
+		assert.True(t, p.Take(&b, now.Add(500*time.Millisecond)).Allowed)
+		d = p.Take(&b, now.Add(time.Hour))
+		assert.True(t, d.Allowed)
+		assert.Equal(t, 1, d.Remaining)
+	})
+}
@@ -0,0 +1,170 @@
+package middleware
+
+import (
+	"application/tracing"
+	"bufio"
+	"context"
+	"encoding/json"
+	infraTracing "infrastructure/tracing"
+	"interfaces/middleware"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Trace context sent by the client of the tests.
+const (
+	testTraceId      = "4bf92f3577b34da6a3ce929d0e0e4736"
+	testParentSpanId = "00f067aa0ba902b7"
+)
+
+// setupTracing makes a tracer appending every span to a JSON-lines file the default tracer for the duration of
+// the test. It returns a function stopping the tracer and returning the exported spans.
+func setupTracing(t *testing.T) func() []tracing.SpanData {
+	path := filepath.Join(t.TempDir(), "spans.jsonl")
+	exporter, err := infraTracing.NewExporter(infraTracing.ExporterJsonl, path, "")
+	require.NoError(t, err)
+
+	tracer := tracing.NewTracer("test-service", exporter, tracing.RatioSampler(1))
+	tracing.SetDefault(tracer)
+	t.Cleanup(func() { tracing.SetDefault(nil) })
+
+	return func() []tracing.SpanData {
+		require.NoError(t, tracer.Shutdown(context.Background()))
+		file, err := os.Open(path)
+		require.NoError(t, err)
+		defer func() { _ = file.Close() }()
+
+		var spans []tracing.SpanData
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			var span tracing.SpanData
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &span))
+			spans = append(spans, span)
+		}
+		require.NoError(t, scanner.Err())
+		return spans
+	}
+}
+
+// TestParseTraceparent tests the parsing of the W3C traceparent header.
+func TestParseTraceparent(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		valid   bool
+		sampled bool
+	}{
+		{name: "Sampled", value: "00-" + testTraceId + "-" + testParentSpanId + "-01", valid: true, sampled: true},
+		{name: "Not Sampled", value: "00-" + testTraceId + "-" + testParentSpanId + "-00", valid: true},
+		{name: "Future Version", value: "01-" + testTraceId + "-" + testParentSpanId + "-01-extra", valid: true,
+			sampled: true},
+		{name: "Invalid Version", value: "ff-" + testTraceId + "-" + testParentSpanId + "-01"},
+		{name: "Zero Trace ID", value: "00-00000000000000000000000000000000-" + testParentSpanId + "-01"},
+		{name: "Zero Span ID", value: "00-" + testTraceId + "-0000000000000000-01"},
+		{name: "Uppercase", value: "00-4BF92F3577B34DA6A3CE929D0E0E4736-" + testParentSpanId + "-01"},
+		{name: "Malformed", value: "not-a-traceparent"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sc, ok := tracing.ParseTraceparent(tt.value)
+			require.Equal(t, tt.valid, ok)
+			if !ok {
+				return
+			}
+			assert.Equal(t, testTraceId, sc.TraceId.String())
+			assert.Equal(t, testParentSpanId, sc.SpanId.String())
+			assert.Equal(t, tt.sampled, sc.Sampled)
+		})
+	}
+}
+
+// TestTracing tests the spans recorded for HTTP requests and the propagation of their trace context.
+//
+// This test covers the following scenarios:
+// 1. A request with a sampled traceparent header should record a server span continuing the trace of the client,
+// with the spans started by the handler as its children.
+// 2. A request without a traceparent header should start a new trace.
+// 3. A request with a traceparent header that is not sampled should record no span.
+// 4. A request failing with a server error should record a failed span.
+func TestTracing(t *testing.T) {
+	spans := setupTracing(t)
+
+	router := middleware.NewRouter()
+	router.HandlerFunc(http.MethodGet, "/v1/vacancies/:id", func(w http.ResponseWriter, r *http.Request) {
+		_, span := tracing.Start(r.Context(), "vacancy transaction", tracing.KindInternal)
+		span.End()
+		if httprouter.ParamsFromContext(r.Context()).ByName("id") == "0" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	server := httptest.NewServer(middleware.Chain(router, middleware.Tracing(router)))
+	t.Cleanup(server.Close)
+
+	requests := []struct {
+		path        string
+		traceparent string
+	}{
+		{path: "/v1/vacancies/1", traceparent: "00-" + testTraceId + "-" + testParentSpanId + "-01"},
+		{path: "/v1/vacancies/2"},
+		{path: "/v1/vacancies/3", traceparent: "00-" + testTraceId + "-" + testParentSpanId + "-00"},
+		{path: "/v1/vacancies/0"},
+	}
+	for _, r := range requests {
+		req, err := http.NewRequest(http.MethodGet, server.URL+r.path, nil)
+		require.NoError(t, err)
+		if r.traceparent != "" {
+			req.Header.Set(tracing.Header, r.traceparent)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+	}
+
+	recorded := spans()
+	require.Len(t, recorded, 6, "the request that is not sampled should record no span")
+	var servers []tracing.SpanData
+	for _, span := range recorded {
+		if span.Kind == tracing.KindServer {
+			servers = append(servers, span)
+		}
+	}
+	require.Len(t, servers, 3)
+
+	t.Run("Continued Trace", func(t *testing.T) {
+		span := servers[0]
+		assert.Equal(t, "GET /v1/vacancies/:id", span.Name)
+		assert.Equal(t, "test-service", span.Service)
+		assert.Equal(t, testTraceId, span.TraceId)
+		assert.Equal(t, testParentSpanId, span.ParentSpanId)
+		assert.Equal(t, "/v1/vacancies/:id", span.Attributes["http.route"])
+		assert.EqualValues(t, http.StatusOK, span.Attributes["http.status_code"])
+		assert.Equal(t, tracing.StatusUnset, span.Status)
+
+		child := recorded[0]
+		assert.Equal(t, "vacancy transaction", child.Name)
+		assert.Equal(t, span.TraceId, child.TraceId)
+		assert.Equal(t, span.SpanId, child.ParentSpanId)
+	})
+
+	t.Run("New Trace", func(t *testing.T) {
+		span := servers[1]
+		assert.NotEqual(t, testTraceId, span.TraceId)
+		assert.Len(t, span.TraceId, 32)
+		assert.Empty(t, span.ParentSpanId)
+	})
+
+	t.Run("Server Error", func(t *testing.T) {
+		span := servers[2]
+		assert.EqualValues(t, http.StatusInternalServerError, span.Attributes["http.status_code"])
+		assert.Equal(t, tracing.StatusError, span.Status)
+		assert.Equal(t, http.StatusText(http.StatusInternalServerError), span.StatusMessage)
+	})
+}
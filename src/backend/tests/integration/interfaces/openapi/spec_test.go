@@ -0,0 +1,242 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"interfaces/api/utils"
+	"interfaces/api/utils/patch"
+	"interfaces/api/vacancy/dto"
+	"interfaces/api/vacancy/dto/list"
+	"interfaces/middleware"
+	"interfaces/openapi"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newSpec returns a Spec describing the vacancy routes, as route.Register does.
+func newSpec() *openapi.Spec {
+	spec := openapi.NewSpec(openapi.Info{Title: "Test API", Version: "1.0.0"})
+	spec.Add(openapi.Operation{
+		Method: http.MethodGet, Path: "/v1/vacancies/:id", Id: "getVacancy", Tag: "Vacancies",
+		Auth:     []string{openapi.BearerAuth},
+		Response: dto.Response{},
+	})
+	spec.Add(openapi.Operation{
+		Method: http.MethodGet, Path: "/v1/vacancies", Id: "listVacancies", Tag: "Vacancies",
+		Auth:     []string{openapi.BearerAuth},
+		Query:    openapi.QueryOf(list.Request{}),
+		Response: []dto.Response{},
+	})
+	spec.Add(openapi.Operation{
+		Method: http.MethodPost, Path: "/v1/vacancies", Id: "createVacancy", Tag: "Vacancies",
+		Auth:     []string{openapi.HmacAuth, openapi.BearerAuth},
+		Scopes:   []string{"vacancy:write"},
+		Body:     dto.Request{},
+		Required: []string{"title", "company"},
+		Status:   http.StatusCreated,
+		Response: dto.Response{},
+	})
+	spec.Add(openapi.Operation{
+		Method: http.MethodPatch, Path: "/v1/vacancies/:id", Id: "updateVacancy", Tag: "Vacancies",
+		Auth:     []string{openapi.BearerAuth},
+		Body:     dto.Request{},
+		Bodies:   map[string]any{patch.JsonPatchContentType: []patch.Operation{}},
+		Response: dto.Response{},
+	})
+	return spec
+}
+
+// TestSpec_Document tests that the document is generated from the operations and their DTOs.
+//
+// This test covers the following scenarios:
+// 1. The DTOs should become component schemas named after their package, with pointers nullable and optional.
+// 2. Path parameters should be derived from the route pattern and query parameters from the query DTO.
+// 3. The request body should require the listed properties and the responses should include the implied errors,
+// described as problem details.
+// 4. The document should be served as JSON.
+func TestSpec_Document(t *testing.T) {
+	spec := newSpec()
+	doc := spec.Document()
+
+	t.Run("Components", func(t *testing.T) {
+		request := doc.Components.Schemas["VacancyRequest"]
+		require.NotNil(t, request)
+		assert.Empty(t, request.Required)
+		assert.Equal(t, false, request.AdditionalProperties)
+		assert.Equal(t, []string{openapi.TypeString, openapi.TypeNull}, request.Properties["title"].Type)
+		assert.Equal(t, []string{openapi.TypeInteger, openapi.TypeNull}, request.Properties["organization_id"].Type)
+		assert.Contains(t, doc.Components.Schemas, "VacancyResponse")
+		assert.Contains(t, doc.Components.Schemas, "Problem")
+		assert.Contains(t, doc.Components.Schemas, "ProblemError")
+	})
+
+	t.Run("Parameters", func(t *testing.T) {
+		get, ok := doc.Operation(http.MethodGet, "/v1/vacancies/:id")
+		require.True(t, ok)
+		require.Len(t, get.Parameters, 1)
+		assert.Equal(t, "id", get.Parameters[0].Name)
+		assert.Equal(t, "path", get.Parameters[0].In)
+		assert.True(t, get.Parameters[0].Required)
+
+		listOp, ok := doc.Operation(http.MethodGet, "/v1/vacancies")
+		require.True(t, ok)
+		var names []string
+		for _, p := range listOp.Parameters {
+			names = append(names, p.Name)
+		}
+		assert.Equal(t, []string{"title", "company", "page", "page_size", "sort_field", "sort_order"}, names)
+	})
+
+	t.Run("Body and responses", func(t *testing.T) {
+		create, ok := doc.Operation(http.MethodPost, "/v1/vacancies")
+		require.True(t, ok)
+		require.NotNil(t, create.RequestBody)
+		schema := create.RequestBody.Content["application/json"].Schema
+		require.Len(t, schema.AllOf, 2)
+		assert.Equal(t, "#/components/schemas/VacancyRequest", schema.AllOf[0].Ref)
+		assert.Equal(t, []string{"title", "company"}, schema.AllOf[1].Required)
+
+		var statuses []string
+		for status := range create.Responses {
+			statuses = append(statuses, status)
+		}
+		assert.ElementsMatch(t, []string{"201", "400", "401", "403", "406", "422", "500"}, statuses)
+		assert.Contains(t, create.Responses["422"].Content, utils.ProblemContentType)
+
+		update, ok := doc.Operation(http.MethodPatch, "/v1/vacancies/:id")
+		require.True(t, ok)
+		assert.Equal(t, "#/components/schemas/VacancyRequest", update.RequestBody.Content["application/json"].Schema.Ref)
+		schema = update.RequestBody.Content[patch.JsonPatchContentType].Schema
+		assert.Equal(t, openapi.TypeArray, schema.Type)
+		assert.Equal(t, "#/components/schemas/PatchOperation", schema.Items.Ref)
+		assert.Equal(t, []map[string][]string{
+			{openapi.HmacAuth: {"vacancy:write"}},
+			{openapi.BearerAuth: {"vacancy:write"}},
+		}, create.Security)
+	})
+
+	t.Run("Served", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		spec.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/v1/openapi.json", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var body map[string]any
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		assert.Equal(t, "3.1.0", body["openapi"])
+		assert.Contains(t, body["paths"], "/v1/vacancies/{id}")
+	})
+}
+
+// TestOpenApiMiddleware tests the validation of the requests against the document.
+//
+// This test covers the following scenarios:
+// 1. Valid requests should reach the handler with their body intact.
+// 2. Invalid query parameters should be rejected with 422 Unprocessable Entity, located by name.
+// 3. Missing, unknown and mistyped properties should be rejected with 422 Unprocessable Entity, located by pointer.
+// 4. Bodies that are not objects should be rejected with 400 Bad Request.
+// 5. Bodies that are not valid JSON should be left to the handler.
+// 6. Bodies should be validated against the schema of their content type.
+// 7. Requests accepting none of the media types of the responses should be rejected with 406 Not Acceptable before
+// reaching the handler, JSON responses being available in the media type of every codec.
+func TestOpenApiMiddleware(t *testing.T) {
+	errors := utils.NewErrors(slog.New(slog.NewTextHandler(io.Discard, nil)), utils.NewHandler())
+	router := middleware.NewRouter()
+	echo := func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(body)
+	}
+	router.HandlerFunc(http.MethodGet, "/v1/vacancies", echo)
+	router.HandlerFunc(http.MethodPost, "/v1/vacancies", echo)
+	router.HandlerFunc(http.MethodPatch, "/v1/vacancies/:id", echo)
+	handler := middleware.Chain(router, middleware.NewOpenApiMiddleware(newSpec(), errors).Handle(router))
+
+	sendAs := func(contentType, method, target, body string) (*httptest.ResponseRecorder, map[string]any) {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(method, target, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", contentType)
+		handler.ServeHTTP(w, req)
+		var decoded map[string]any
+		_ = json.Unmarshal(w.Body.Bytes(), &decoded)
+		return w, decoded
+	}
+	send := func(method, target, body string) (*httptest.ResponseRecorder, map[string]any) {
+		return sendAs("application/json", method, target, body)
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		w, _ := send(http.MethodGet, "/v1/vacancies?page=2&title=Go", "")
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		body := `{"title":"Go Developer","company":"Acme","location":null}`
+		w, _ = send(http.MethodPost, "/v1/vacancies", body)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, body, w.Body.String())
+	})
+
+	t.Run("Invalid query", func(t *testing.T) {
+		w, body := send(http.MethodGet, "/v1/vacancies?page=abc&page_size=1.5", "")
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Equal(t, []any{
+			map[string]any{"parameter": "page", "detail": "page must be an integer"},
+			map[string]any{"parameter": "page_size", "detail": "page_size must be an integer"},
+		}, body["errors"])
+	})
+
+	t.Run("Invalid body", func(t *testing.T) {
+		w, body := send(http.MethodPost, "/v1/vacancies", `{"title":42,"salary":1000}`)
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Equal(t, []string{"#/company", "#/salary", "#/title"}, tests.ProblemLocations(body))
+	})
+
+	t.Run("Not an object", func(t *testing.T) {
+		w, body := send(http.MethodPost, "/v1/vacancies", `["title"]`)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, "body must be an object", body["detail"])
+	})
+
+	t.Run("Content type", func(t *testing.T) {
+		body := `[{"op":"replace","path":"/title","value":"Go Developer"}]`
+		w, _ := sendAs(patch.JsonPatchContentType, http.MethodPatch, "/v1/vacancies/1", body)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, body, w.Body.String())
+
+		w, _ = sendAs(patch.JsonPatchContentType, http.MethodPatch, "/v1/vacancies/1", `[{"op":"remove"}]`)
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+
+		w, _ = send(http.MethodPatch, "/v1/vacancies/1", body)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Not acceptable", func(t *testing.T) {
+		accept := func(accept string) (*httptest.ResponseRecorder, map[string]any) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/v1/vacancies", nil)
+			req.Header.Set("Accept", accept)
+			handler.ServeHTTP(w, req)
+			var decoded map[string]any
+			_ = json.Unmarshal(w.Body.Bytes(), &decoded)
+			return w, decoded
+		}
+
+		w, _ := accept("application/msgpack;q=0.9, text/csv;q=0.1")
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		w, body := accept("application/xml, text/html;q=0.9")
+		assert.Equal(t, http.StatusNotAcceptable, w.Code)
+		assert.Equal(t, "the response is only available as application/json, application/msgpack, "+
+			"application/x-protobuf, text/csv", body["detail"])
+	})
+
+	t.Run("Malformed", func(t *testing.T) {
+		w, _ := send(http.MethodPost, "/v1/vacancies", `{"title":`)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `{"title":`, w.Body.String())
+	})
+}
@@ -0,0 +1,18 @@
+package tests
+
+// ProblemLocations returns the locations of the individual errors of a decoded problem details response body,
+// i.e., the JSON pointer of each invalid field of the body, such as "#/title", or the name of each invalid query
+// parameter.
+func ProblemLocations(body map[string]any) []string {
+	errors, _ := body["errors"].([]any)
+	locations := make([]string, 0, len(errors))
+	for _, e := range errors {
+		fields, _ := e.(map[string]any)
+		if pointer, ok := fields["pointer"].(string); ok {
+			locations = append(locations, pointer)
+		} else if parameter, ok := fields["parameter"].(string); ok {
+			locations = append(locations, parameter)
+		}
+	}
+	return locations
+}